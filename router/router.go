@@ -0,0 +1,122 @@
+// Package router contains the URL routes for Sourcegraph web pages
+// (e.g., repository and def pages) and generates canonical URLs to
+// them, so that web frontends need not reimplement the route path
+// templates.
+package router
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sourcegraph/mux"
+	"sourcegraph.com/sourcegraph/go-sourcegraph/routevar"
+)
+
+// Route names.
+const (
+	Repo      = "repo"
+	RepoTree  = "repo.tree"
+	RepoBuild = "repo.build"
+	Delta     = "delta"
+	Def       = "def"
+	User      = "user"
+)
+
+// New creates a new router with the Sourcegraph web page routes. If
+// base is nil, a new mux.Router is used.
+func New(base *mux.Router) *mux.Router {
+	if base == nil {
+		base = mux.NewRouter()
+	}
+
+	base.Path("/" + routevar.RepoRev + "/.tree" + routevar.TreeEntryPath).
+		PostMatchFunc(chainPostMatch(routevar.FixRepoRevVars, routevar.FixTreeEntryVars)).
+		BuildVarsFunc(chainBuildVars(routevar.PrepareRepoRevRouteVars, routevar.PrepareTreeEntryRouteVars)).
+		Name(RepoTree)
+
+	base.Path("/" + routevar.RepoRev + "/.deltas/{DeltaHeadResolvedRev:.+}").
+		PostMatchFunc(routevar.FixRepoRevVars).
+		BuildVarsFunc(routevar.PrepareRepoRevRouteVars).
+		Name(Delta)
+
+	base.Path("/" + routevar.RepoRev + "/" + routevar.Def).
+		PostMatchFunc(chainPostMatch(routevar.FixRepoRevVars, routevar.FixDefUnitVars)).
+		BuildVarsFunc(chainBuildVars(routevar.PrepareRepoRevRouteVars, routevar.PrepareDefRouteVars)).
+		Name(Def)
+
+	base.Path("/" + routevar.Repo + "/.builds/{CommitID}/{Attempt:[0-9]+}").
+		Name(RepoBuild)
+
+	base.Path("/~" + routevar.User).
+		Name(User)
+
+	// The Repo route matches the paths of most other routes, so it
+	// must be added last.
+	base.Path("/" + routevar.RepoRev).
+		PostMatchFunc(routevar.FixRepoRevVars).
+		BuildVarsFunc(routevar.PrepareRepoRevRouteVars).
+		Name(Repo)
+
+	return base
+}
+
+// BaseURL is the base URL of the URLs returned by URLTo.
+var BaseURL = &url.URL{Scheme: "https", Host: "sourcegraph.com"}
+
+var defaultRouter = New(nil)
+
+// Match returns the name and route variables of the route in r that
+// matches req. If r is nil, the routes created by New are used. If no
+// route matches, ok is false.
+func Match(r *mux.Router, req *http.Request) (routeName string, routeVars map[string]string, ok bool) {
+	if r == nil {
+		r = defaultRouter
+	}
+	var m mux.RouteMatch
+	if !r.Match(req, &m) || m.Route == nil {
+		return "", nil, false
+	}
+	return m.Route.GetName(), m.Vars, true
+}
+
+func chainPostMatch(fs ...mux.PostMatchFunc) mux.PostMatchFunc {
+	return func(req *http.Request, match *mux.RouteMatch, r *mux.Route) {
+		for _, f := range fs {
+			f(req, match, r)
+		}
+	}
+}
+
+func chainBuildVars(fs ...mux.BuildVarsFunc) mux.BuildVarsFunc {
+	return func(vars map[string]string) map[string]string {
+		for _, f := range fs {
+			vars = f(vars)
+		}
+		return vars
+	}
+}
+
+// URLTo returns the canonical URL (relative to BaseURL) to the named
+// route, with the given route variables (as returned by the RouteVars
+// methods of spec types such as RepoRevSpec) and query string.
+func URLTo(routeName string, routeVars map[string]string, query url.Values) (*url.URL, error) {
+	route := defaultRouter.Get(routeName)
+	if route == nil {
+		return nil, fmt.Errorf("no such route %q", routeName)
+	}
+
+	pairs := make([]string, 0, 2*len(routeVars))
+	for k, v := range routeVars {
+		pairs = append(pairs, k, v)
+	}
+	u, err := route.URLPath(pairs...)
+	if err != nil {
+		return nil, fmt.Errorf("route %q: %s", routeName, err)
+	}
+	u = BaseURL.ResolveReference(u)
+	if len(query) > 0 {
+		u.RawQuery = query.Encode()
+	}
+	return u, nil
+}
@@ -0,0 +1,105 @@
+package router
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/sourcegraph/mux"
+)
+
+const commitID = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+
+func TestURLTo(t *testing.T) {
+	tests := []struct {
+		route     string
+		routeVars map[string]string
+		query     url.Values
+		wantURL   string
+	}{
+		{
+			route:     Repo,
+			routeVars: map[string]string{"Repo": "github.com/foo/bar"},
+			wantURL:   "https://sourcegraph.com/github.com/foo/bar",
+		},
+		{
+			route:     Repo,
+			routeVars: map[string]string{"Repo": "r", "Rev": "v1", "CommitID": commitID},
+			query:     url.Values{"q": {"x"}},
+			wantURL:   "https://sourcegraph.com/r@v1===" + commitID + "?q=x",
+		},
+		{
+			route:     RepoTree,
+			routeVars: map[string]string{"Repo": "r", "Rev": "v1", "Path": "a/b.go"},
+			wantURL:   "https://sourcegraph.com/r@v1/.tree/a/b.go",
+		},
+		{
+			route:     Def,
+			routeVars: map[string]string{"Repo": "r", "UnitType": "GoPackage", "Unit": "u", "Path": "p/q"},
+			wantURL:   "https://sourcegraph.com/r/.GoPackage/u/.def/p/q",
+		},
+		{
+			route:     Def,
+			routeVars: map[string]string{"Repo": "r", "Rev": "v1", "UnitType": "GoPackage", "Unit": ".", "Path": "."},
+			wantURL:   "https://sourcegraph.com/r@v1/.GoPackage/.def",
+		},
+		{
+			route:     RepoBuild,
+			routeVars: map[string]string{"Repo": "r", "CommitID": commitID, "Attempt": "2"},
+			wantURL:   "https://sourcegraph.com/r/.builds/" + commitID + "/2",
+		},
+		{
+			route:     Delta,
+			routeVars: map[string]string{"Repo": "r", "Rev": "a", "DeltaHeadResolvedRev": "b"},
+			wantURL:   "https://sourcegraph.com/r@a/.deltas/b",
+		},
+		{
+			route:     User,
+			routeVars: map[string]string{"User": "alice"},
+			wantURL:   "https://sourcegraph.com/~alice",
+		},
+	}
+	for _, test := range tests {
+		u, err := URLTo(test.route, test.routeVars, test.query)
+		if err != nil {
+			t.Errorf("%s %v: %s", test.route, test.routeVars, err)
+			continue
+		}
+		if u.String() != test.wantURL {
+			t.Errorf("%s %v: got URL %q, want %q", test.route, test.routeVars, u, test.wantURL)
+		}
+	}
+
+	if _, err := URLTo("nonexistent", nil, nil); err == nil {
+		t.Error("got nil error for nonexistent route")
+	}
+}
+
+func TestNew_match(t *testing.T) {
+	r := New(nil)
+	tests := []struct {
+		path      string
+		wantRoute string
+		wantVars  map[string]string
+	}{
+		{"/r", Repo, map[string]string{"Repo": "r"}},
+		{"/r@v1", Repo, map[string]string{"Repo": "r", "Rev": "v1"}},
+		{"/r@v1/.tree/a/b.go", RepoTree, map[string]string{"Repo": "r", "Rev": "v1", "Path": "a/b.go"}},
+		{"/r/.GoPackage/u/.def/p/q", Def, map[string]string{"Repo": "r", "UnitType": "GoPackage", "Unit": "u", "Path": "p/q"}},
+		{"/~alice", User, map[string]string{"User": "alice"}},
+	}
+	for _, test := range tests {
+		var m mux.RouteMatch
+		if !r.Match(&http.Request{Method: "GET", URL: &url.URL{Path: test.path}}, &m) {
+			t.Errorf("%s: no match", test.path)
+			continue
+		}
+		if name := m.Route.GetName(); name != test.wantRoute {
+			t.Errorf("%s: got route %q, want %q", test.path, name, test.wantRoute)
+		}
+		if !reflect.DeepEqual(m.Vars, test.wantVars) {
+			t.Errorf("%s: got vars %v, want %v", test.path, m.Vars, test.wantVars)
+		}
+	}
+}
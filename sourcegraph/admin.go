@@ -0,0 +1,86 @@
+package sourcegraph
+
+import (
+	"encoding/json"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"sourcegraph.com/sqs/pbtypes"
+)
+
+// Decode unmarshals the JSON configuration in c.Contents into v.
+func (c *SiteConfig) Decode(v interface{}) error {
+	if c.Contents == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(c.Contents), v)
+}
+
+// maxSiteConfigUpdateAttempts is the number of times that
+// UpdateSiteConfig tries to update the site config if other updates
+// are made concurrently.
+const maxSiteConfigUpdateAttempts = 3
+
+// UpdateSiteConfig modifies the site configuration by calling update
+// with the current configuration (decoded as a JSON object, with
+// numbers decoded as json.Number so that they are saved unchanged)
+// and saving the result. If the configuration is concurrently updated
+// by someone else, it is fetched again and update is called again (up
+// to a few times), so update should not have side effects.
+//
+// If c is a *CachedAdminClient, its cache is bypassed, because a
+// stale configuration could never be saved.
+func UpdateSiteConfig(ctx context.Context, c AdminClient, update func(config map[string]interface{}) error) (*SiteConfig, error) {
+	if cc, ok := c.(*CachedAdminClient); ok {
+		c = cc.AdminClient
+	}
+
+	var err error
+	for attempt := 0; attempt < maxSiteConfigUpdateAttempts; attempt++ {
+		var current *SiteConfig
+		current, err = c.GetSiteConfig(ctx, &pbtypes.Void{})
+		if err != nil {
+			return nil, err
+		}
+
+		config := map[string]interface{}{}
+		if current.Contents != "" {
+			dec := json.NewDecoder(strings.NewReader(current.Contents))
+			dec.UseNumber()
+			if err := dec.Decode(&config); err != nil {
+				return nil, err
+			}
+		}
+		if err := update(config); err != nil {
+			return nil, err
+		}
+		var contents []byte
+		contents, err = json.MarshalIndent(config, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+
+		op := &SiteConfig{ID: current.ID, Contents: string(contents)}
+		var updated *SiteConfig
+		updated, err = c.UpdateSiteConfig(ctx, op)
+		if err == nil {
+			return updated, nil
+		}
+		if ErrorCode(err) != codes.FailedPrecondition {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+// Queue returns the stats for the named job queue, or nil if there is
+// no such queue.
+func (s *JobQueueStats) Queue(name string) *JobQueueStat {
+	for i := range s.Queues {
+		if s.Queues[i].Name == name {
+			return &s.Queues[i]
+		}
+	}
+	return nil
+}
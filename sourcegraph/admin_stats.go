@@ -0,0 +1,32 @@
+package sourcegraph
+
+// TotalBuilds returns the total number of builds created in the time
+// window covered by s.
+func (s *UsageStats) TotalBuilds() int64 {
+	var n int64
+	for _, c := range s.BuildsPerDay {
+		n += c.Count
+	}
+	return n
+}
+
+// TotalAPICalls returns the total number of API calls made (to all
+// routes) in the time window covered by s.
+func (s *UsageStats) TotalAPICalls() int64 {
+	var n int64
+	for _, c := range s.APICalls {
+		n += c.Count
+	}
+	return n
+}
+
+// APICallsTo returns the number of API calls made to route in the
+// time window covered by s.
+func (s *UsageStats) APICallsTo(route string) int64 {
+	for _, c := range s.APICalls {
+		if c.Route == route {
+			return c.Count
+		}
+	}
+	return 0
+}
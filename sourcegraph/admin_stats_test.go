@@ -0,0 +1,22 @@
+package sourcegraph
+
+import "testing"
+
+func TestUsageStats_Totals(t *testing.T) {
+	s := &UsageStats{
+		BuildsPerDay: []DailyCount{{Count: 3}, {Count: 0}, {Count: 4}},
+		APICalls:     []RouteCount{{Route: "Repos.Get", Count: 10}, {Route: "Defs.Get", Count: 5}},
+	}
+	if got, want := s.TotalBuilds(), int64(7); got != want {
+		t.Errorf("got TotalBuilds %d, want %d", got, want)
+	}
+	if got, want := s.TotalAPICalls(), int64(15); got != want {
+		t.Errorf("got TotalAPICalls %d, want %d", got, want)
+	}
+	if got, want := s.APICallsTo("Defs.Get"), int64(5); got != want {
+		t.Errorf("got APICallsTo(Defs.Get) %d, want %d", got, want)
+	}
+	if got, want := s.APICallsTo("Units.Get"), int64(0); got != want {
+		t.Errorf("got APICallsTo(Units.Get) %d, want %d", got, want)
+	}
+}
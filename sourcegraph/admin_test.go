@@ -0,0 +1,80 @@
+package sourcegraph
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"sourcegraph.com/sqs/pbtypes"
+)
+
+type siteConfigAdminClient struct {
+	AdminClient
+	config SiteConfig
+
+	// conflicts is the number of UpdateSiteConfig calls to fail (as
+	// if there were concurrent updates).
+	conflicts int
+	updates   int
+}
+
+func (c *siteConfigAdminClient) GetSiteConfig(ctx context.Context, _ *pbtypes.Void, opts ...grpc.CallOption) (*SiteConfig, error) {
+	config := c.config
+	return &config, nil
+}
+
+func (c *siteConfigAdminClient) UpdateSiteConfig(ctx context.Context, op *SiteConfig, opts ...grpc.CallOption) (*SiteConfig, error) {
+	c.updates++
+	if c.conflicts > 0 {
+		c.conflicts--
+		c.config.ID++
+	}
+	if op.ID != c.config.ID {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "site config was updated concurrently")
+	}
+	c.config = SiteConfig{ID: op.ID + 1, Contents: op.Contents}
+	config := c.config
+	return &config, nil
+}
+
+func TestUpdateSiteConfig(t *testing.T) {
+	c := &siteConfigAdminClient{config: SiteConfig{ID: 1, Contents: `{"a": 1, "n": 12345678901234567890}`}, conflicts: 1}
+	updated, err := UpdateSiteConfig(context.Background(), &CachedAdminClient{AdminClient: c}, func(config map[string]interface{}) error {
+		config["b"] = "x"
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.updates != 2 {
+		t.Errorf("got %d update attempts, want 2", c.updates)
+	}
+	if updated.ID != 3 {
+		t.Errorf("got ID %d, want 3", updated.ID)
+	}
+	var config map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(c.config.Contents), &config); err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]json.RawMessage{"a": json.RawMessage(`1`), "n": json.RawMessage(`12345678901234567890`), "b": json.RawMessage(`"x"`)}; !reflect.DeepEqual(config, want) {
+		t.Errorf("got config %v, want %v", config, want)
+	}
+
+	c = &siteConfigAdminClient{conflicts: maxSiteConfigUpdateAttempts}
+	if _, err := UpdateSiteConfig(context.Background(), c, func(map[string]interface{}) error { return nil }); err == nil {
+		t.Error("got nil error after too many conflicts")
+	}
+}
+
+func TestJobQueueStats_Queue(t *testing.T) {
+	s := &JobQueueStats{Queues: []JobQueueStat{{Name: "builds", Queued: 3}, {Name: "mirrors"}}}
+	if q := s.Queue("builds"); q == nil || q.Queued != 3 {
+		t.Errorf("got %+v, want builds queue", q)
+	}
+	if q := s.Queue("x"); q != nil {
+		t.Errorf("got %+v, want nil", q)
+	}
+}
@@ -0,0 +1,29 @@
+package sourcegraph
+
+import "sort"
+
+// Annotations sorts annotations by StartByte (and then by EndByte).
+type Annotations []*Annotation
+
+func (v Annotations) Len() int      { return len(v) }
+func (v Annotations) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v Annotations) Less(i, j int) bool {
+	if v[i].StartByte != v[j].StartByte {
+		return v[i].StartByte < v[j].StartByte
+	}
+	return v[i].EndByte < v[j].EndByte
+}
+
+// At returns the annotations whose byte range contains offset. The
+// annotations in v must be sorted (by sort.Sort(v)).
+func (v Annotations) At(offset int) []*Annotation {
+	// Annotations that start after offset can't contain it.
+	n := sort.Search(len(v), func(i int) bool { return int(v[i].StartByte) > offset })
+	var anns []*Annotation
+	for _, ann := range v[:n] {
+		if offset < int(ann.EndByte) {
+			anns = append(anns, ann)
+		}
+	}
+	return anns
+}
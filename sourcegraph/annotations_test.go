@@ -0,0 +1,34 @@
+package sourcegraph
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestAnnotations_At(t *testing.T) {
+	anns := Annotations{
+		{StartByte: 10, EndByte: 15, URL: "c"},
+		{StartByte: 0, EndByte: 5, URL: "a"},
+		{StartByte: 3, EndByte: 8, URL: "b"},
+	}
+	sort.Sort(anns)
+
+	tests := map[int][]string{
+		0:  {"a"},
+		4:  {"a", "b"},
+		5:  {"b"},
+		9:  nil,
+		14: {"c"},
+		15: nil,
+	}
+	for offset, want := range tests {
+		var got []string
+		for _, ann := range anns.At(offset) {
+			got = append(got, ann.URL)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("offset %d: got %v, want %v", offset, got, want)
+		}
+	}
+}
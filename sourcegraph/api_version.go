@@ -0,0 +1,108 @@
+package sourcegraph
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// APIVersionMetadataKey is the gRPC metadata key that carries API
+// versions (see APIVersion). Clients send their API version in
+// request metadata under this key, and servers send theirs in
+// response header metadata.
+const APIVersionMetadataKey = "sourcegraph-api-version"
+
+// APIVersionError is returned by calls made through a strict
+// APIVersionChecker to a server whose API major version differs from
+// the client's.
+type APIVersionError struct {
+	ServerVersion string // the server's API version
+	ClientVersion string // the client's API version (APIVersion)
+}
+
+func (e *APIVersionError) Error() string {
+	return fmt.Sprintf("incompatible Sourcegraph API version: server is %s, client is %s", e.ServerVersion, e.ClientVersion)
+}
+
+// headerCallOption is grpc.Header; it is a var so that tests can set
+// the header that APIVersionChecker reads.
+var headerCallOption = grpc.Header
+
+// APIVersionChecker records the API version that the server
+// advertises in the response headers of calls, and warns about (or,
+// in strict mode, fails) calls to servers whose API major version
+// differs from this client's. Use its Interceptor with
+// Client.UseInterceptor.
+type APIVersionChecker struct {
+	// Strict is whether calls to incompatible servers fail with an
+	// *APIVersionError (instead of only being warned about).
+	Strict bool
+
+	// Warn is called (once per incompatible server version) when an
+	// incompatible server is detected. If nil, a warning is logged.
+	Warn func(serverVersion string)
+
+	mu            sync.Mutex
+	serverVersion string
+	warned        map[string]bool
+}
+
+// ServerAPIVersion returns the API version that the server advertised
+// in the most recent call's response header, or the empty string if
+// no server has advertised one.
+func (c *APIVersionChecker) ServerAPIVersion() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.serverVersion
+}
+
+// Interceptor returns an Interceptor that checks the server's API
+// version in each call's response header.
+func (c *APIVersionChecker) Interceptor() Interceptor {
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+			var header metadata.MD
+			result, err := next(WithCallOption(ctx, headerCallOption(&header)), method, in)
+			v := header[APIVersionMetadataKey]
+			if len(v) == 0 || v[0] == "" {
+				return result, err
+			}
+			if verr := c.check(v[0]); verr != nil && err == nil {
+				return nil, verr
+			}
+			return result, err
+		}
+	}
+}
+
+// check records serverVersion and returns an *APIVersionError if it
+// is incompatible and c is strict.
+func (c *APIVersionChecker) check(serverVersion string) error {
+	c.mu.Lock()
+	c.serverVersion = serverVersion
+	compatible := apiMajorVersion(serverVersion) == apiMajorVersion(APIVersion)
+	warn := !compatible && !c.warned[serverVersion]
+	if warn {
+		if c.warned == nil {
+			c.warned = map[string]bool{}
+		}
+		c.warned[serverVersion] = true
+	}
+	c.mu.Unlock()
+
+	if warn {
+		if c.Warn != nil {
+			c.Warn(serverVersion)
+		} else {
+			log.Printf("warning: Sourcegraph server API version %s is incompatible with this client's API version %s", serverVersion, APIVersion)
+		}
+	}
+	if !compatible && c.Strict {
+		return &APIVersionError{ServerVersion: serverVersion, ClientVersion: APIVersion}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package sourcegraph
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAPIVersionChecker(t *testing.T) {
+	var header *metadata.MD
+	headerCallOption = func(md *metadata.MD) grpc.CallOption {
+		header = md
+		return nil
+	}
+	defer func() { headerCallOption = grpc.Header }()
+
+	serverVersion := ""
+	invoker := func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		if serverVersion != "" {
+			*header = metadata.Pairs(APIVersionMetadataKey, serverVersion)
+		}
+		return "ok", nil
+	}
+
+	var warnings []string
+	c := &APIVersionChecker{Warn: func(v string) { warnings = append(warnings, v) }}
+	call := func() (interface{}, error) {
+		return c.Interceptor()(invoker)(context.Background(), "Repos.Get", nil)
+	}
+
+	// No advertised version.
+	if result, err := call(); err != nil || result != "ok" {
+		t.Fatalf("got (%v, %v), want (ok, nil)", result, err)
+	}
+	if v := c.ServerAPIVersion(); v != "" {
+		t.Errorf("got server API version %q, want empty", v)
+	}
+
+	// Compatible version.
+	serverVersion = "1.5"
+	if _, err := call(); err != nil {
+		t.Fatal(err)
+	}
+	if v := c.ServerAPIVersion(); v != "1.5" {
+		t.Errorf("got server API version %q, want 1.5", v)
+	}
+
+	// Incompatible version is warned about once.
+	serverVersion = "2.0"
+	for i := 0; i < 2; i++ {
+		if _, err := call(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(warnings) != 1 || warnings[0] != "2.0" {
+		t.Errorf("got warnings %v, want [2.0]", warnings)
+	}
+
+	// Strict mode fails calls to incompatible servers.
+	c.Strict = true
+	if _, err := call(); err == nil {
+		t.Error("got nil error in strict mode")
+	} else if e, ok := err.(*APIVersionError); !ok || e.ServerVersion != "2.0" || e.ClientVersion != APIVersion {
+		t.Errorf("got error %#v, want *APIVersionError", err)
+	}
+}
+
+// apiVersionReposClient is a ReposClient whose Get advertises
+// serverVersion in its response header (if its caller asked for the
+// header).
+type apiVersionReposClient struct {
+	ReposClient
+	serverVersion string
+}
+
+func (c *apiVersionReposClient) Get(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*Repo, error) {
+	for _, opt := range opts {
+		if opt, ok := opt.(metadataCallOption); ok {
+			*opt.md = metadata.Pairs(APIVersionMetadataKey, c.serverVersion)
+		}
+	}
+	return &Repo{URI: in.URI}, nil
+}
+
+func TestAPIVersionChecker_client(t *testing.T) {
+	headerCallOption = func(md *metadata.MD) grpc.CallOption { return metadataCallOption{md: md} }
+	defer func() { headerCallOption = grpc.Header }()
+
+	c := NewClient(nil)
+	c.Repos.(*CachedReposClient).ReposClient.(*InterceptedReposClient).ReposClient = &apiVersionReposClient{serverVersion: "2.0"}
+	checker := &APIVersionChecker{Strict: true, Warn: func(string) {}}
+	c.UseInterceptor(checker.Interceptor())
+
+	if _, err := c.Repos.Get(context.Background(), &RepoSpec{URI: "r"}); err == nil {
+		t.Error("got nil error in strict mode")
+	}
+	if v := checker.ServerAPIVersion(); v != "2.0" {
+		t.Errorf("got server API version %q, want 2.0", v)
+	}
+}
+
+func TestContextCredentials_apiVersion(t *testing.T) {
+	ctx := WithClientMetadata(context.Background(), map[string]string{"k": "v"})
+	md, err := (contextCredentials{}).GetRequestMetadata(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if md[APIVersionMetadataKey] != APIVersion || md["k"] != "v" {
+		t.Errorf("got metadata %v, want API version and client metadata", md)
+	}
+}
@@ -0,0 +1,38 @@
+package sourcegraph
+
+import (
+	"io"
+
+	"golang.org/x/net/context"
+)
+
+// Ext returns the conventional file name extension (including the
+// leading ".") for archives in format f.
+func (f ArchiveFormat) Ext() string {
+	switch f {
+	case ArchiveFormat_TarGz:
+		return ".tar.gz"
+	case ArchiveFormat_Zip:
+		return ".zip"
+	}
+	return ""
+}
+
+// RepoArchiveReader returns an io.ReadCloser that reads the archive
+// specified by op. The archive is fetched lazily, one GetArchive call
+// per chunk, starting at op.Offset. Closing the reader stops it from
+// fetching any more chunks.
+func RepoArchiveReader(ctx context.Context, c ReposClient, op *ReposGetArchiveOp) io.ReadCloser {
+	op2 := *op
+	return &chunkReader{
+		offset: op.Offset,
+		fetch: func(offset int64) ([]byte, bool, error) {
+			op2.Offset = offset
+			archive, err := c.GetArchive(ctx, &op2)
+			if err != nil {
+				return nil, false, err
+			}
+			return archive.Data, archive.EOF, nil
+		},
+	}
+}
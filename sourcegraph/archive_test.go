@@ -0,0 +1,58 @@
+package sourcegraph
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// chunkedArchiveReposClient serves archive in chunks of at most
+// chunkSize bytes.
+type chunkedArchiveReposClient struct {
+	ReposClient
+	archive   string
+	chunkSize int
+}
+
+func (c *chunkedArchiveReposClient) GetArchive(ctx context.Context, op *ReposGetArchiveOp, opts ...grpc.CallOption) (*RepoArchive, error) {
+	start := int(op.Offset)
+	end := start + c.chunkSize
+	if end > len(c.archive) {
+		end = len(c.archive)
+	}
+	return &RepoArchive{
+		Data:   []byte(c.archive[start:end]),
+		Offset: int64(start),
+		EOF:    end == len(c.archive),
+	}, nil
+}
+
+func TestRepoArchiveReader(t *testing.T) {
+	c := &chunkedArchiveReposClient{archive: "abcdefg", chunkSize: 2}
+	r := RepoArchiveReader(context.Background(), c, &ReposGetArchiveOp{Format: ArchiveFormat_Zip})
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "abcdefg"; string(data) != want {
+		t.Errorf("got %q, want %q", data, want)
+	}
+}
+
+func TestRepoArchiveReader_Close(t *testing.T) {
+	c := &chunkedArchiveReposClient{archive: "abcdefg", chunkSize: 2}
+	r := RepoArchiveReader(context.Background(), c, &ReposGetArchiveOp{})
+	buf := make([]byte, 1)
+	if _, err := r.Read(buf); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Read(buf); err != io.EOF {
+		t.Errorf("got error %v after Close, want io.EOF", err)
+	}
+}
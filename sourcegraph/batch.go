@@ -0,0 +1,93 @@
+package sourcegraph
+
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+)
+
+// DefaultBatchParallelism is the default maximum number of calls in a
+// Batch that are run concurrently.
+const DefaultBatchParallelism = 4
+
+// A Batch queues API calls and runs them concurrently, so that
+// fetching the many independent pieces of data needed to, e.g.,
+// render a repository page does not require many serial round trips.
+//
+// Each call is a func that makes one or more API calls and stores
+// their results (typically in variables captured by the func):
+//
+//	var repo *Repo
+//	var readme *Readme
+//	b := c.Batch()
+//	b.Add(func(ctx context.Context) (err error) {
+//		repo, err = c.Repos.Get(ctx, &repoSpec)
+//		return
+//	})
+//	b.Add(func(ctx context.Context) (err error) {
+//		readme, err = c.Repos.GetReadme(ctx, &ReposGetReadmeOp{Rev: repoRevSpec})
+//		return
+//	})
+//	errs := b.Do(ctx)
+type Batch struct {
+	// MaxParallel is the maximum number of calls that are run
+	// concurrently. If zero, DefaultBatchParallelism is used.
+	MaxParallel int
+
+	calls []func(context.Context) error
+}
+
+// Batch returns a new, empty batch of API calls.
+func (c *Client) Batch() *Batch {
+	return &Batch{}
+}
+
+// Add queues call to be run by Do. It returns the index of the call's
+// error in the slice returned by Do.
+func (b *Batch) Add(call func(ctx context.Context) error) int {
+	b.calls = append(b.calls, call)
+	return len(b.calls) - 1
+}
+
+// Len returns the number of queued calls.
+func (b *Batch) Len() int { return len(b.calls) }
+
+// Do runs all queued calls and waits for them to complete. It returns
+// the error returned by each call, in the order that they were added
+// (or nil if all calls succeeded). A failed call does not prevent the
+// others from running.
+func (b *Batch) Do(ctx context.Context) []error {
+	par := b.MaxParallel
+	if par <= 0 {
+		par = DefaultBatchParallelism
+	}
+
+	var (
+		errs   = make([]error, len(b.calls))
+		failed bool
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		sem    = make(chan struct{}, par)
+	)
+	for i, call := range b.calls {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, call func(context.Context) error) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := call(ctx); err != nil {
+				mu.Lock()
+				errs[i] = err
+				failed = true
+				mu.Unlock()
+			}
+		}(i, call)
+	}
+	wg.Wait()
+	if !failed {
+		return nil
+	}
+	return errs
+}
@@ -0,0 +1,57 @@
+package sourcegraph
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+func TestBatch(t *testing.T) {
+	var active, maxActive int32
+	call := func(err error) func(context.Context) error {
+		return func(context.Context) error {
+			n := atomic.AddInt32(&active, 1)
+			for {
+				m := atomic.LoadInt32(&maxActive)
+				if n <= m || atomic.CompareAndSwapInt32(&maxActive, m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&active, -1)
+			return err
+		}
+	}
+
+	b := (&Client{}).Batch()
+	b.MaxParallel = 2
+	wantErr := errors.New("x")
+	for i := 0; i < 5; i++ {
+		b.Add(call(nil))
+	}
+	failIdx := b.Add(call(wantErr))
+
+	errs := b.Do(context.Background())
+	if len(errs) != b.Len() {
+		t.Fatalf("got %d errors, want %d", len(errs), b.Len())
+	}
+	for i, err := range errs {
+		if i == failIdx && err != wantErr {
+			t.Errorf("call %d: got error %v, want %v", i, err, wantErr)
+		} else if i != failIdx && err != nil {
+			t.Errorf("call %d: got error %v, want nil", i, err)
+		}
+	}
+	if maxActive > 2 {
+		t.Errorf("got %d concurrent calls, want at most 2", maxActive)
+	}
+
+	b = (&Client{}).Batch()
+	b.Add(call(nil))
+	if errs := b.Do(context.Background()); errs != nil {
+		t.Errorf("got errors %v, want nil", errs)
+	}
+}
@@ -1,10 +1,17 @@
 package sourcegraph
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"strconv"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"sourcegraph.com/sourcegraph/go-sourcegraph/router"
+	"sourcegraph.com/sqs/pbtypes"
 )
 
 func (s *BuildSpec) RouteVars() map[string]string {
@@ -14,6 +21,39 @@ func (s *BuildSpec) RouteVars() map[string]string {
 	return m
 }
 
+// SpecString returns the string representation of the BuildSpec
+// (e.g., "repo/.builds/commit/1"). It is the inverse of
+// ParseBuildSpec for complete, valid specs; see routeSpecString.
+func (s *BuildSpec) SpecString() string {
+	return routeSpecString(router.RepoBuild, s, s.RouteVars, s.Repo.URI)
+}
+
+// ParseBuildSpec parses a string generated by
+// (*BuildSpec).SpecString() and returns the equivalent BuildSpec
+// struct.
+func ParseBuildSpec(s string) (BuildSpec, error) {
+	routeVars, err := parseRouteSpec(router.RepoBuild, "BuildSpec", s)
+	if err != nil {
+		return BuildSpec{}, err
+	}
+	return UnmarshalBuildSpec(routeVars)
+}
+
+// UnmarshalBuildSpec marshals a map containing route variables
+// generated by (*BuildSpec).RouteVars() and returns the equivalent
+// BuildSpec struct.
+func UnmarshalBuildSpec(routeVars map[string]string) (BuildSpec, error) {
+	repo, err := UnmarshalRepoSpec(routeVars)
+	if err != nil {
+		return BuildSpec{}, err
+	}
+	attempt, err := strconv.ParseUint(routeVars["Attempt"], 10, 32)
+	if err != nil {
+		return BuildSpec{}, fmt.Errorf("invalid build attempt %q: %s", routeVars["Attempt"], err)
+	}
+	return BuildSpec{Repo: repo, CommitID: routeVars["CommitID"], Attempt: uint32(attempt)}, nil
+}
+
 func (s *TaskSpec) RouteVars() map[string]string {
 	v := s.BuildSpec.RouteVars()
 	v["TaskID"] = fmt.Sprintf("%d", s.TaskID)
@@ -55,3 +95,214 @@ func (t TaskSpec) IDString() string {
 }
 
 var ErrBuildNotFound = errors.New("build not found")
+
+// Log levels, for LogEntry.Level.
+const (
+	LogLevelDebug = "debug"
+	LogLevelInfo  = "info"
+	LogLevelWarn  = "warn"
+	LogLevelError = "error"
+)
+
+// Validate returns an *InvalidOptionsError if o.Offset or o.Limit is
+// negative.
+func (o *BuildGetLogOptions) Validate() error {
+	if o.Offset < 0 || o.Limit < 0 {
+		return &InvalidOptionsError{Reason: fmt.Sprintf("negative log offset (%d) or limit (%d)", o.Offset, o.Limit)}
+	}
+	return nil
+}
+
+// jsonLogEntry is the JSON lines representation of a LogEntry in
+// stored task logs.
+type jsonLogEntry struct {
+	Time    time.Time `json:"time"`
+	Level   string    `json:"level"`
+	Message string    `json:"msg"`
+}
+
+// ParseLogEntry parses a line of a task log stored as JSON lines
+// (e.g., {"time":"2015-06-30T12:00:00Z","level":"info","msg":"..."}).
+func ParseLogEntry(line string) (*LogEntry, error) {
+	var e jsonLogEntry
+	if err := json.Unmarshal([]byte(line), &e); err != nil {
+		return nil, err
+	}
+	return &LogEntry{Time: pbtypes.NewTimestamp(e.Time), Level: e.Level, Message: e.Message}, nil
+}
+
+// FormatLogEntry returns the JSON lines representation of e (without
+// a trailing newline). It is the inverse of ParseLogEntry.
+func FormatLogEntry(e *LogEntry) string {
+	b, _ := json.Marshal(jsonLogEntry{Time: e.Time.Time().UTC(), Level: e.Level, Message: e.Message})
+	return string(b)
+}
+
+// Sort fields for BuildListOptions.Sort.
+const (
+	BuildSortCreatedAt = "created_at"
+	BuildSortStartedAt = "started_at"
+)
+
+// Matches reports whether b satisfies the filters in opt. It is
+// intended for clients that filter builds they already have (e.g.,
+// from a watched build queue) the same way that Builds.List would.
+// All set filters must match.
+func (opt *BuildListOptions) Matches(b *Build) bool {
+	if opt == nil {
+		return true
+	}
+	if opt.Queued && !(b.Queue && b.StartedAt == nil) {
+		return false
+	}
+	if opt.Active && !(b.StartedAt != nil && b.EndedAt == nil) {
+		return false
+	}
+	if opt.Ended && b.EndedAt == nil {
+		return false
+	}
+	if opt.Succeeded && !b.Success {
+		return false
+	}
+	if opt.Failed && !b.Failure {
+		return false
+	}
+	if opt.Purged && !b.Purged {
+		return false
+	}
+	if opt.Repo != "" && opt.Repo != b.Repo {
+		return false
+	}
+	if opt.CommitID != "" && opt.CommitID != b.CommitID {
+		return false
+	}
+	if opt.Branch != "" && opt.Branch != b.Branch {
+		return false
+	}
+	for _, tag := range opt.Tags {
+		if !b.HasTag(tag) {
+			return false
+		}
+	}
+	if opt.RequestedBy != "" && opt.RequestedBy != b.RequestedBy {
+		return false
+	}
+	return b.Priority >= opt.MinPriority
+}
+
+// HasTag reports whether c has the given tag.
+func (c *BuildConfig) HasTag(tag string) bool {
+	for _, t := range c.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// SendHeartbeats calls Builds.Heartbeat for build every interval until
+// ctx is done. Build workers should run it (typically in a separate
+// goroutine) for as long as they are working on the build. It returns
+// nil when ctx is done, or the first error returned by Heartbeat.
+func SendHeartbeats(ctx context.Context, c BuildsClient, build BuildSpec, interval time.Duration) error {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		if _, err := c.Heartbeat(ctx, &build); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// MaxGetMultipleRepoBuildInfo is the maximum number of repo revspecs
+// that may be requested in a single call to
+// Builds.GetMultipleRepoBuildInfo.
+const MaxGetMultipleRepoBuildInfo = 100
+
+// Validate returns an *InvalidOptionsError if op requests more than
+// MaxGetMultipleRepoBuildInfo repo revspecs.
+func (op *BuildsGetMultipleRepoBuildInfoOp) Validate() error {
+	if len(op.Repos) > MaxGetMultipleRepoBuildInfo {
+		return &InvalidOptionsError{Reason: fmt.Sprintf("too many repo revspecs requested (%d > %d)", len(op.Repos), MaxGetMultipleRepoBuildInfo)}
+	}
+	return nil
+}
+
+// GetMultipleRepoBuildInfo fetches the build info for the repo
+// revspecs using Builds.GetMultipleRepoBuildInfo, splitting them into
+// as many calls as are necessary to stay within
+// MaxGetMultipleRepoBuildInfo per call. The returned infos are in the
+// same order as repos.
+func GetMultipleRepoBuildInfo(ctx context.Context, c BuildsClient, repos []RepoRevSpec, opt *BuildsGetRepoBuildInfoOptions) ([]*RepoBuildInfo, error) {
+	infos := make([]*RepoBuildInfo, 0, len(repos))
+	for len(repos) > 0 {
+		n := len(repos)
+		if n > MaxGetMultipleRepoBuildInfo {
+			n = MaxGetMultipleRepoBuildInfo
+		}
+		list, err := c.GetMultipleRepoBuildInfo(ctx, &BuildsGetMultipleRepoBuildInfoOp{Repos: repos[:n], Opt: opt})
+		if err != nil {
+			return nil, err
+		}
+		if len(list.Infos) != n {
+			return nil, fmt.Errorf("got %d repo build infos, want %d", len(list.Infos), n)
+		}
+		infos = append(infos, list.Infos...)
+		repos = repos[n:]
+	}
+	return infos, nil
+}
+
+// EnsureDeltaBuilt ensures that the base and head commits of a delta
+// have builds, creating a build (with opt, or with the default build
+// config if opt is nil) for each that lacks one. It returns the
+// RepoBuildInfo for each side; its Exact field is the existing or
+// newly created build. The delta's base and head CommitIDs should be
+// set, so that builds are looked up and created for exactly those
+// commits.
+func EnsureDeltaBuilt(ctx context.Context, c BuildsClient, ds DeltaSpec, opt *BuildCreateOptions) (base, head *RepoBuildInfo, err error) {
+	base, err = ensureBuilt(ctx, c, ds.Base, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	head, err = ensureBuilt(ctx, c, ds.Head, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return base, head, nil
+}
+
+// ensureBuilt returns the build info for the exact revision rev,
+// creating a build for it if none exists.
+func ensureBuilt(ctx context.Context, c BuildsClient, rev RepoRevSpec, opt *BuildCreateOptions) (*RepoBuildInfo, error) {
+	getOp := &BuildsGetRepoBuildInfoOp{Repo: rev, Opt: &BuildsGetRepoBuildInfoOptions{Exact: true}}
+	info, err := c.GetRepoBuildInfo(ctx, getOp)
+	if err != nil && ErrorCode(err) != codes.NotFound {
+		return nil, err
+	}
+	if info == nil || err != nil {
+		info = &RepoBuildInfo{}
+	}
+	if info.Exact != nil {
+		return info, nil
+	}
+
+	if opt == nil {
+		opt = &BuildCreateOptions{BuildConfig: BuildConfig{Queue: true}}
+	}
+	createOp := &BuildsCreateOp{RepoRev: rev, Opt: opt}
+	b, err := c.Create(ctx, createOp)
+	if err != nil {
+		return nil, err
+	}
+	info.Exact = b
+	return info, nil
+}
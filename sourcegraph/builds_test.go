@@ -0,0 +1,255 @@
+package sourcegraph
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"sourcegraph.com/sqs/pbtypes"
+)
+
+func TestBuildListOptions_Matches(t *testing.T) {
+	ts := &pbtypes.Timestamp{Seconds: 1}
+	queued := &Build{Repo: "r", CommitID: "c", Branch: "master", BuildConfig: BuildConfig{Queue: true, Priority: 5, Tags: []string{"ci", "urgent"}, RequestedBy: "webhook"}}
+	active := &Build{Repo: "r", CommitID: "c", StartedAt: ts, BuildConfig: BuildConfig{Queue: true}}
+	failed := &Build{Repo: "r2", StartedAt: ts, EndedAt: ts, Failure: true}
+
+	tests := []struct {
+		label string
+		opt   *BuildListOptions
+		build *Build
+		want  bool
+	}{
+		{"nil opt", nil, failed, true},
+		{"empty opt", &BuildListOptions{}, queued, true},
+		{"queued", &BuildListOptions{Queued: true}, queued, true},
+		{"queued but started", &BuildListOptions{Queued: true}, active, false},
+		{"active", &BuildListOptions{Active: true}, active, true},
+		{"active but ended", &BuildListOptions{Active: true}, failed, false},
+		{"ended", &BuildListOptions{Ended: true}, failed, true},
+		{"failed", &BuildListOptions{Failed: true}, failed, true},
+		{"succeeded", &BuildListOptions{Succeeded: true}, failed, false},
+		{"repo", &BuildListOptions{Repo: "r"}, failed, false},
+		{"branch", &BuildListOptions{Branch: "master"}, queued, true},
+		{"branch mismatch", &BuildListOptions{Branch: "master"}, active, false},
+		{"min priority", &BuildListOptions{MinPriority: 5}, queued, true},
+		{"min priority too high", &BuildListOptions{MinPriority: 6}, queued, false},
+		{"tags", &BuildListOptions{Tags: []string{"urgent", "ci"}}, queued, true},
+		{"tags missing one", &BuildListOptions{Tags: []string{"ci", "nightly"}}, queued, false},
+		{"tags on untagged build", &BuildListOptions{Tags: []string{"ci"}}, active, false},
+		{"requested by", &BuildListOptions{RequestedBy: "webhook"}, queued, true},
+		{"requested by mismatch", &BuildListOptions{RequestedBy: "alice"}, queued, false},
+	}
+	for _, test := range tests {
+		if got := test.opt.Matches(test.build); got != test.want {
+			t.Errorf("%s: got %v, want %v", test.label, got, test.want)
+		}
+	}
+}
+
+type heartbeatBuildsClient struct {
+	BuildsClient
+	calls  int
+	failAt int
+	cancel func()
+}
+
+func (c *heartbeatBuildsClient) Heartbeat(ctx context.Context, build *BuildSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	c.calls++
+	if c.calls == c.failAt {
+		return nil, errors.New("x")
+	}
+	if c.calls == 3 {
+		c.cancel()
+	}
+	return &pbtypes.Void{}, nil
+}
+
+func TestSendHeartbeats(t *testing.T) {
+	build := BuildSpec{Repo: RepoSpec{URI: "r"}, CommitID: "c", Attempt: 1}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &heartbeatBuildsClient{cancel: cancel}
+	if err := SendHeartbeats(ctx, c, build, time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if want := 3; c.calls != want {
+		t.Errorf("got %d heartbeats, want %d", c.calls, want)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	c = &heartbeatBuildsClient{cancel: cancel, failAt: 2}
+	err := SendHeartbeats(ctx, c, build, time.Millisecond)
+	if err == nil || err.Error() != "x" {
+		t.Errorf("got error %v, want x", err)
+	}
+}
+
+func (BuildSpec) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(BuildSpec{
+		Repo:     RepoSpec{URI: randPath(r)},
+		CommitID: randCommitID(r),
+		Attempt:  r.Uint32(),
+	})
+}
+
+func TestBuildSpec_SpecString(t *testing.T) {
+	roundTrip := func(s BuildSpec) bool {
+		s2, err := ParseBuildSpec(s.SpecString())
+		if err != nil {
+			t.Logf("%q: %s", s.SpecString(), err)
+			return false
+		}
+		return s2 == s
+	}
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := ParseBuildSpec("r/.builds/c"); err == nil {
+		t.Error("got nil error for invalid BuildSpec string")
+	}
+
+	// Incomplete specs must not panic, and their strings must not parse.
+	for _, s := range []BuildSpec{{}, {CommitID: "c", Attempt: 1}} {
+		if _, err := ParseBuildSpec(s.SpecString()); err == nil {
+			t.Errorf("%+v: got nil error parsing SpecString %q of incomplete spec", s, s.SpecString())
+		}
+	}
+}
+
+type multipleBuildInfoBuildsClient struct {
+	BuildsClient
+	calls int
+}
+
+func (c *multipleBuildInfoBuildsClient) GetMultipleRepoBuildInfo(ctx context.Context, op *BuildsGetMultipleRepoBuildInfoOp, opts ...grpc.CallOption) (*RepoBuildInfoList, error) {
+	c.calls++
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+	list := &RepoBuildInfoList{}
+	for _, rr := range op.Repos {
+		list.Infos = append(list.Infos, &RepoBuildInfo{Exact: &Build{Repo: rr.URI}})
+	}
+	return list, nil
+}
+
+func TestGetMultipleRepoBuildInfo(t *testing.T) {
+	var repos []RepoRevSpec
+	for i := 0; i < MaxGetMultipleRepoBuildInfo*2+1; i++ {
+		repos = append(repos, RepoRevSpec{RepoSpec: RepoSpec{URI: fmt.Sprintf("r%d", i)}})
+	}
+
+	c := &multipleBuildInfoBuildsClient{}
+	infos, err := GetMultipleRepoBuildInfo(context.Background(), c, repos, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.calls != 3 {
+		t.Errorf("got %d calls, want 3", c.calls)
+	}
+	if len(infos) != len(repos) {
+		t.Fatalf("got %d infos, want %d", len(infos), len(repos))
+	}
+	for i, info := range infos {
+		if info.Exact.Repo != repos[i].URI {
+			t.Errorf("info %d: got repo %q, want %q", i, info.Exact.Repo, repos[i].URI)
+		}
+	}
+}
+
+type ensureBuiltBuildsClient struct {
+	BuildsClient
+	builds  map[string]*Build // commit ID -> build
+	created []string
+}
+
+func (c *ensureBuiltBuildsClient) GetRepoBuildInfo(ctx context.Context, op *BuildsGetRepoBuildInfoOp, opts ...grpc.CallOption) (*RepoBuildInfo, error) {
+	if !op.Opt.Exact {
+		return nil, errors.New("want exact build info")
+	}
+	b, ok := c.builds[op.Repo.CommitID]
+	if !ok {
+		return nil, grpc.Errorf(codes.NotFound, "no build for %s", op.Repo.CommitID)
+	}
+	return &RepoBuildInfo{Exact: b, LastSuccessful: b}, nil
+}
+
+func (c *ensureBuiltBuildsClient) Create(ctx context.Context, op *BuildsCreateOp, opts ...grpc.CallOption) (*Build, error) {
+	c.created = append(c.created, op.RepoRev.CommitID)
+	b := &Build{Repo: op.RepoRev.URI, CommitID: op.RepoRev.CommitID, Attempt: 1, BuildConfig: op.Opt.BuildConfig}
+	c.builds[b.CommitID] = b
+	return b, nil
+}
+
+func TestEnsureDeltaBuilt(t *testing.T) {
+	existing := &Build{Repo: "r", CommitID: baseCommit, Attempt: 3}
+	c := &ensureBuiltBuildsClient{builds: map[string]*Build{baseCommit: existing}}
+	ds := DeltaSpec{
+		Base: RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, CommitID: baseCommit},
+		Head: RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, CommitID: headCommit},
+	}
+
+	base, head, err := EnsureDeltaBuilt(context.Background(), c, ds, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if base.Exact != existing {
+		t.Errorf("got base build %+v, want existing build %+v", base.Exact, existing)
+	}
+	if head.Exact == nil || head.Exact.CommitID != headCommit || !head.Exact.Queue {
+		t.Errorf("got head build %+v, want queued build for head commit", head.Exact)
+	}
+	if want := []string{headCommit}; !reflect.DeepEqual(c.created, want) {
+		t.Errorf("got created %v, want %v", c.created, want)
+	}
+
+	// Both sides are now built, so no more builds are created.
+	if _, _, err := EnsureDeltaBuilt(context.Background(), c, ds, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.created) != 1 {
+		t.Errorf("got %d builds created, want 1", len(c.created))
+	}
+}
+
+func TestParseLogEntry(t *testing.T) {
+	line := `{"time":"2015-06-30T12:00:00Z","level":"warn","msg":"retrying fetch"}`
+	e, err := ParseLogEntry(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &LogEntry{
+		Time:    pbtypes.NewTimestamp(time.Date(2015, 6, 30, 12, 0, 0, 0, time.UTC)),
+		Level:   LogLevelWarn,
+		Message: "retrying fetch",
+	}
+	if !reflect.DeepEqual(e, want) {
+		t.Errorf("got %+v, want %+v", e, want)
+	}
+	if got := FormatLogEntry(e); got != line {
+		t.Errorf("got formatted %q, want %q", got, line)
+	}
+
+	if _, err := ParseLogEntry("plain text"); err == nil {
+		t.Error("got nil error for non-JSON line")
+	}
+}
+
+func TestBuildGetLogOptions_Validate(t *testing.T) {
+	if err := (&BuildGetLogOptions{Offset: 10, Limit: 100, Structured: true}).Validate(); err != nil {
+		t.Error(err)
+	}
+	if err := (&BuildGetLogOptions{Limit: -1}).Validate(); err == nil {
+		t.Error("got nil error for negative limit")
+	}
+}
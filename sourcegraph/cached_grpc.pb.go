@@ -176,11 +176,11 @@ func (s *CachedAccountsClient) Update(ctx context.Context, in *User, opts ...grp
 	return result, nil
 }
 
-type CachedAuthServer struct{ AuthServer }
+type CachedAdminServer struct{ AdminServer }
 
-func (s *CachedAuthServer) GetAuthorizationCode(ctx context.Context, in *AuthorizationCodeRequest) (*AuthorizationCode, error) {
+func (s *CachedAdminServer) GetSiteConfig(ctx context.Context, in *pbtypes.Void) (*SiteConfig, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.AuthServer.GetAuthorizationCode(ctx, in)
+	result, err := s.AdminServer.GetSiteConfig(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -189,9 +189,9 @@ func (s *CachedAuthServer) GetAuthorizationCode(ctx context.Context, in *Authori
 	return result, err
 }
 
-func (s *CachedAuthServer) GetAccessToken(ctx context.Context, in *AccessTokenRequest) (*AccessTokenResponse, error) {
+func (s *CachedAdminServer) UpdateSiteConfig(ctx context.Context, in *SiteConfig) (*SiteConfig, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.AuthServer.GetAccessToken(ctx, in)
+	result, err := s.AdminServer.UpdateSiteConfig(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -200,9 +200,9 @@ func (s *CachedAuthServer) GetAccessToken(ctx context.Context, in *AccessTokenRe
 	return result, err
 }
 
-func (s *CachedAuthServer) Identify(ctx context.Context, in *pbtypes.Void) (*AuthInfo, error) {
+func (s *CachedAdminServer) ReindexAllRepos(ctx context.Context, in *AdminReindexAllReposOp) (*AdminReindexResult, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.AuthServer.Identify(ctx, in)
+	result, err := s.AdminServer.ReindexAllRepos(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -211,9 +211,9 @@ func (s *CachedAuthServer) Identify(ctx context.Context, in *pbtypes.Void) (*Aut
 	return result, err
 }
 
-func (s *CachedAuthServer) GetPermissions(ctx context.Context, in *pbtypes.Void) (*UserPermissions, error) {
+func (s *CachedAdminServer) DeactivateUser(ctx context.Context, in *UserSpec) (*pbtypes.Void, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.AuthServer.GetPermissions(ctx, in)
+	result, err := s.AdminServer.DeactivateUser(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -222,15 +222,26 @@ func (s *CachedAuthServer) GetPermissions(ctx context.Context, in *pbtypes.Void)
 	return result, err
 }
 
-type CachedAuthClient struct {
-	AuthClient
+func (s *CachedAdminServer) GetJobQueueStats(ctx context.Context, in *pbtypes.Void) (*JobQueueStats, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.AdminServer.GetJobQueueStats(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedAdminClient struct {
+	AdminClient
 	Cache *grpccache.Cache
 }
 
-func (s *CachedAuthClient) GetAuthorizationCode(ctx context.Context, in *AuthorizationCodeRequest, opts ...grpc.CallOption) (*AuthorizationCode, error) {
+func (s *CachedAdminClient) GetSiteConfig(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*SiteConfig, error) {
 	if s.Cache != nil {
-		var cachedResult AuthorizationCode
-		cached, err := s.Cache.Get(ctx, "Auth.GetAuthorizationCode", in, &cachedResult)
+		var cachedResult SiteConfig
+		cached, err := s.Cache.Get(ctx, "Admin.GetSiteConfig", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -241,22 +252,22 @@ func (s *CachedAuthClient) GetAuthorizationCode(ctx context.Context, in *Authori
 
 	var trailer metadata.MD
 
-	result, err := s.AuthClient.GetAuthorizationCode(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.AdminClient.GetSiteConfig(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Auth.GetAuthorizationCode", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Admin.GetSiteConfig", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedAuthClient) GetAccessToken(ctx context.Context, in *AccessTokenRequest, opts ...grpc.CallOption) (*AccessTokenResponse, error) {
+func (s *CachedAdminClient) UpdateSiteConfig(ctx context.Context, in *SiteConfig, opts ...grpc.CallOption) (*SiteConfig, error) {
 	if s.Cache != nil {
-		var cachedResult AccessTokenResponse
-		cached, err := s.Cache.Get(ctx, "Auth.GetAccessToken", in, &cachedResult)
+		var cachedResult SiteConfig
+		cached, err := s.Cache.Get(ctx, "Admin.UpdateSiteConfig", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -267,22 +278,22 @@ func (s *CachedAuthClient) GetAccessToken(ctx context.Context, in *AccessTokenRe
 
 	var trailer metadata.MD
 
-	result, err := s.AuthClient.GetAccessToken(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.AdminClient.UpdateSiteConfig(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Auth.GetAccessToken", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Admin.UpdateSiteConfig", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedAuthClient) Identify(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*AuthInfo, error) {
+func (s *CachedAdminClient) ReindexAllRepos(ctx context.Context, in *AdminReindexAllReposOp, opts ...grpc.CallOption) (*AdminReindexResult, error) {
 	if s.Cache != nil {
-		var cachedResult AuthInfo
-		cached, err := s.Cache.Get(ctx, "Auth.Identify", in, &cachedResult)
+		var cachedResult AdminReindexResult
+		cached, err := s.Cache.Get(ctx, "Admin.ReindexAllRepos", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -293,22 +304,22 @@ func (s *CachedAuthClient) Identify(ctx context.Context, in *pbtypes.Void, opts
 
 	var trailer metadata.MD
 
-	result, err := s.AuthClient.Identify(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.AdminClient.ReindexAllRepos(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Auth.Identify", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Admin.ReindexAllRepos", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedAuthClient) GetPermissions(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*UserPermissions, error) {
+func (s *CachedAdminClient) DeactivateUser(ctx context.Context, in *UserSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
 	if s.Cache != nil {
-		var cachedResult UserPermissions
-		cached, err := s.Cache.Get(ctx, "Auth.GetPermissions", in, &cachedResult)
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "Admin.DeactivateUser", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -319,34 +330,49 @@ func (s *CachedAuthClient) GetPermissions(ctx context.Context, in *pbtypes.Void,
 
 	var trailer metadata.MD
 
-	result, err := s.AuthClient.GetPermissions(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.AdminClient.DeactivateUser(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Auth.GetPermissions", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Admin.DeactivateUser", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-type CachedBuildsServer struct{ BuildsServer }
+func (s *CachedAdminClient) GetJobQueueStats(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*JobQueueStats, error) {
+	if s.Cache != nil {
+		var cachedResult JobQueueStats
+		cached, err := s.Cache.Get(ctx, "Admin.GetJobQueueStats", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
 
-func (s *CachedBuildsServer) Get(ctx context.Context, in *BuildSpec) (*Build, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.BuildsServer.Get(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+	var trailer metadata.MD
+
+	result, err := s.AdminClient.GetJobQueueStats(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Admin.GetJobQueueStats", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
-	return result, err
+	return result, nil
 }
 
-func (s *CachedBuildsServer) GetRepoBuildInfo(ctx context.Context, in *BuildsGetRepoBuildInfoOp) (*RepoBuildInfo, error) {
+type CachedAdminStatsServer struct{ AdminStatsServer }
+
+func (s *CachedAdminStatsServer) GetUsage(ctx context.Context, in *AdminStatsGetUsageOp) (*UsageStats, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.BuildsServer.GetRepoBuildInfo(ctx, in)
+	result, err := s.AdminStatsServer.GetUsage(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -355,20 +381,42 @@ func (s *CachedBuildsServer) GetRepoBuildInfo(ctx context.Context, in *BuildsGet
 	return result, err
 }
 
-func (s *CachedBuildsServer) List(ctx context.Context, in *BuildListOptions) (*BuildList, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.BuildsServer.List(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+type CachedAdminStatsClient struct {
+	AdminStatsClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedAdminStatsClient) GetUsage(ctx context.Context, in *AdminStatsGetUsageOp, opts ...grpc.CallOption) (*UsageStats, error) {
+	if s.Cache != nil {
+		var cachedResult UsageStats
+		cached, err := s.Cache.Get(ctx, "AdminStats.GetUsage", in, &cachedResult)
+		if err != nil {
 			return nil, err
 		}
+		if cached {
+			return &cachedResult, nil
+		}
 	}
-	return result, err
+
+	var trailer metadata.MD
+
+	result, err := s.AdminStatsClient.GetUsage(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "AdminStats.GetUsage", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
 }
 
-func (s *CachedBuildsServer) Create(ctx context.Context, in *BuildsCreateOp) (*Build, error) {
+type CachedAnnotationsServer struct{ AnnotationsServer }
+
+func (s *CachedAnnotationsServer) List(ctx context.Context, in *AnnotationsListOptions) (*AnnotationList, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.BuildsServer.Create(ctx, in)
+	result, err := s.AnnotationsServer.List(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -377,9 +425,42 @@ func (s *CachedBuildsServer) Create(ctx context.Context, in *BuildsCreateOp) (*B
 	return result, err
 }
 
-func (s *CachedBuildsServer) Update(ctx context.Context, in *BuildsUpdateOp) (*Build, error) {
+type CachedAnnotationsClient struct {
+	AnnotationsClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedAnnotationsClient) List(ctx context.Context, in *AnnotationsListOptions, opts ...grpc.CallOption) (*AnnotationList, error) {
+	if s.Cache != nil {
+		var cachedResult AnnotationList
+		cached, err := s.Cache.Get(ctx, "Annotations.List", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.AnnotationsClient.List(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Annotations.List", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+type CachedAuthServer struct{ AuthServer }
+
+func (s *CachedAuthServer) GetAuthorizationCode(ctx context.Context, in *AuthorizationCodeRequest) (*AuthorizationCode, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.BuildsServer.Update(ctx, in)
+	result, err := s.AuthServer.GetAuthorizationCode(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -388,9 +469,9 @@ func (s *CachedBuildsServer) Update(ctx context.Context, in *BuildsUpdateOp) (*B
 	return result, err
 }
 
-func (s *CachedBuildsServer) ListBuildTasks(ctx context.Context, in *BuildsListBuildTasksOp) (*BuildTaskList, error) {
+func (s *CachedAuthServer) GetAccessToken(ctx context.Context, in *AccessTokenRequest) (*AccessTokenResponse, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.BuildsServer.ListBuildTasks(ctx, in)
+	result, err := s.AuthServer.GetAccessToken(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -399,9 +480,9 @@ func (s *CachedBuildsServer) ListBuildTasks(ctx context.Context, in *BuildsListB
 	return result, err
 }
 
-func (s *CachedBuildsServer) CreateTasks(ctx context.Context, in *BuildsCreateTasksOp) (*BuildTaskList, error) {
+func (s *CachedAuthServer) Identify(ctx context.Context, in *pbtypes.Void) (*AuthInfo, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.BuildsServer.CreateTasks(ctx, in)
+	result, err := s.AuthServer.Identify(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -410,9 +491,9 @@ func (s *CachedBuildsServer) CreateTasks(ctx context.Context, in *BuildsCreateTa
 	return result, err
 }
 
-func (s *CachedBuildsServer) UpdateTask(ctx context.Context, in *BuildsUpdateTaskOp) (*BuildTask, error) {
+func (s *CachedAuthServer) GetPermissions(ctx context.Context, in *pbtypes.Void) (*UserPermissions, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.BuildsServer.UpdateTask(ctx, in)
+	result, err := s.AuthServer.GetPermissions(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -421,9 +502,9 @@ func (s *CachedBuildsServer) UpdateTask(ctx context.Context, in *BuildsUpdateTas
 	return result, err
 }
 
-func (s *CachedBuildsServer) GetLog(ctx context.Context, in *BuildsGetLogOp) (*LogEntries, error) {
+func (s *CachedAuthServer) CreateToken(ctx context.Context, in *AuthCreateTokenOp) (*PersonalAccessToken, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.BuildsServer.GetLog(ctx, in)
+	result, err := s.AuthServer.CreateToken(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -432,9 +513,9 @@ func (s *CachedBuildsServer) GetLog(ctx context.Context, in *BuildsGetLogOp) (*L
 	return result, err
 }
 
-func (s *CachedBuildsServer) GetTaskLog(ctx context.Context, in *BuildsGetTaskLogOp) (*LogEntries, error) {
+func (s *CachedAuthServer) ListTokens(ctx context.Context, in *AuthListTokensOp) (*PersonalAccessTokenList, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.BuildsServer.GetTaskLog(ctx, in)
+	result, err := s.AuthServer.ListTokens(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -443,9 +524,9 @@ func (s *CachedBuildsServer) GetTaskLog(ctx context.Context, in *BuildsGetTaskLo
 	return result, err
 }
 
-func (s *CachedBuildsServer) DequeueNext(ctx context.Context, in *BuildsDequeueNextOp) (*Build, error) {
+func (s *CachedAuthServer) RevokeToken(ctx context.Context, in *AuthRevokeTokenOp) (*pbtypes.Void, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.BuildsServer.DequeueNext(ctx, in)
+	result, err := s.AuthServer.RevokeToken(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -454,15 +535,15 @@ func (s *CachedBuildsServer) DequeueNext(ctx context.Context, in *BuildsDequeueN
 	return result, err
 }
 
-type CachedBuildsClient struct {
-	BuildsClient
+type CachedAuthClient struct {
+	AuthClient
 	Cache *grpccache.Cache
 }
 
-func (s *CachedBuildsClient) Get(ctx context.Context, in *BuildSpec, opts ...grpc.CallOption) (*Build, error) {
+func (s *CachedAuthClient) GetAuthorizationCode(ctx context.Context, in *AuthorizationCodeRequest, opts ...grpc.CallOption) (*AuthorizationCode, error) {
 	if s.Cache != nil {
-		var cachedResult Build
-		cached, err := s.Cache.Get(ctx, "Builds.Get", in, &cachedResult)
+		var cachedResult AuthorizationCode
+		cached, err := s.Cache.Get(ctx, "Auth.GetAuthorizationCode", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -473,22 +554,22 @@ func (s *CachedBuildsClient) Get(ctx context.Context, in *BuildSpec, opts ...grp
 
 	var trailer metadata.MD
 
-	result, err := s.BuildsClient.Get(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.AuthClient.GetAuthorizationCode(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Builds.Get", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Auth.GetAuthorizationCode", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedBuildsClient) GetRepoBuildInfo(ctx context.Context, in *BuildsGetRepoBuildInfoOp, opts ...grpc.CallOption) (*RepoBuildInfo, error) {
+func (s *CachedAuthClient) GetAccessToken(ctx context.Context, in *AccessTokenRequest, opts ...grpc.CallOption) (*AccessTokenResponse, error) {
 	if s.Cache != nil {
-		var cachedResult RepoBuildInfo
-		cached, err := s.Cache.Get(ctx, "Builds.GetRepoBuildInfo", in, &cachedResult)
+		var cachedResult AccessTokenResponse
+		cached, err := s.Cache.Get(ctx, "Auth.GetAccessToken", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -499,22 +580,22 @@ func (s *CachedBuildsClient) GetRepoBuildInfo(ctx context.Context, in *BuildsGet
 
 	var trailer metadata.MD
 
-	result, err := s.BuildsClient.GetRepoBuildInfo(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.AuthClient.GetAccessToken(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Builds.GetRepoBuildInfo", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Auth.GetAccessToken", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedBuildsClient) List(ctx context.Context, in *BuildListOptions, opts ...grpc.CallOption) (*BuildList, error) {
+func (s *CachedAuthClient) Identify(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*AuthInfo, error) {
 	if s.Cache != nil {
-		var cachedResult BuildList
-		cached, err := s.Cache.Get(ctx, "Builds.List", in, &cachedResult)
+		var cachedResult AuthInfo
+		cached, err := s.Cache.Get(ctx, "Auth.Identify", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -525,22 +606,22 @@ func (s *CachedBuildsClient) List(ctx context.Context, in *BuildListOptions, opt
 
 	var trailer metadata.MD
 
-	result, err := s.BuildsClient.List(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.AuthClient.Identify(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Builds.List", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Auth.Identify", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedBuildsClient) Create(ctx context.Context, in *BuildsCreateOp, opts ...grpc.CallOption) (*Build, error) {
+func (s *CachedAuthClient) GetPermissions(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*UserPermissions, error) {
 	if s.Cache != nil {
-		var cachedResult Build
-		cached, err := s.Cache.Get(ctx, "Builds.Create", in, &cachedResult)
+		var cachedResult UserPermissions
+		cached, err := s.Cache.Get(ctx, "Auth.GetPermissions", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -551,22 +632,22 @@ func (s *CachedBuildsClient) Create(ctx context.Context, in *BuildsCreateOp, opt
 
 	var trailer metadata.MD
 
-	result, err := s.BuildsClient.Create(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.AuthClient.GetPermissions(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Builds.Create", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Auth.GetPermissions", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedBuildsClient) Update(ctx context.Context, in *BuildsUpdateOp, opts ...grpc.CallOption) (*Build, error) {
+func (s *CachedAuthClient) CreateToken(ctx context.Context, in *AuthCreateTokenOp, opts ...grpc.CallOption) (*PersonalAccessToken, error) {
 	if s.Cache != nil {
-		var cachedResult Build
-		cached, err := s.Cache.Get(ctx, "Builds.Update", in, &cachedResult)
+		var cachedResult PersonalAccessToken
+		cached, err := s.Cache.Get(ctx, "Auth.CreateToken", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -577,22 +658,22 @@ func (s *CachedBuildsClient) Update(ctx context.Context, in *BuildsUpdateOp, opt
 
 	var trailer metadata.MD
 
-	result, err := s.BuildsClient.Update(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.AuthClient.CreateToken(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Builds.Update", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Auth.CreateToken", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedBuildsClient) ListBuildTasks(ctx context.Context, in *BuildsListBuildTasksOp, opts ...grpc.CallOption) (*BuildTaskList, error) {
+func (s *CachedAuthClient) ListTokens(ctx context.Context, in *AuthListTokensOp, opts ...grpc.CallOption) (*PersonalAccessTokenList, error) {
 	if s.Cache != nil {
-		var cachedResult BuildTaskList
-		cached, err := s.Cache.Get(ctx, "Builds.ListBuildTasks", in, &cachedResult)
+		var cachedResult PersonalAccessTokenList
+		cached, err := s.Cache.Get(ctx, "Auth.ListTokens", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -603,22 +684,22 @@ func (s *CachedBuildsClient) ListBuildTasks(ctx context.Context, in *BuildsListB
 
 	var trailer metadata.MD
 
-	result, err := s.BuildsClient.ListBuildTasks(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.AuthClient.ListTokens(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Builds.ListBuildTasks", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Auth.ListTokens", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedBuildsClient) CreateTasks(ctx context.Context, in *BuildsCreateTasksOp, opts ...grpc.CallOption) (*BuildTaskList, error) {
+func (s *CachedAuthClient) RevokeToken(ctx context.Context, in *AuthRevokeTokenOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
 	if s.Cache != nil {
-		var cachedResult BuildTaskList
-		cached, err := s.Cache.Get(ctx, "Builds.CreateTasks", in, &cachedResult)
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "Auth.RevokeToken", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -629,127 +710,100 @@ func (s *CachedBuildsClient) CreateTasks(ctx context.Context, in *BuildsCreateTa
 
 	var trailer metadata.MD
 
-	result, err := s.BuildsClient.CreateTasks(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.AuthClient.RevokeToken(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Builds.CreateTasks", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Auth.RevokeToken", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedBuildsClient) UpdateTask(ctx context.Context, in *BuildsUpdateTaskOp, opts ...grpc.CallOption) (*BuildTask, error) {
-	if s.Cache != nil {
-		var cachedResult BuildTask
-		cached, err := s.Cache.Get(ctx, "Builds.UpdateTask", in, &cachedResult)
-		if err != nil {
+type CachedBuildsServer struct{ BuildsServer }
+
+func (s *CachedBuildsServer) Get(ctx context.Context, in *BuildSpec) (*Build, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.BuildsServer.Get(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
-		if cached {
-			return &cachedResult, nil
-		}
 	}
+	return result, err
+}
 
-	var trailer metadata.MD
-
-	result, err := s.BuildsClient.UpdateTask(ctx, in, grpc.Trailer(&trailer))
-	if err != nil {
-		return nil, err
-	}
-	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Builds.UpdateTask", in, result, trailer); err != nil {
+func (s *CachedBuildsServer) GetRepoBuildInfo(ctx context.Context, in *BuildsGetRepoBuildInfoOp) (*RepoBuildInfo, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.BuildsServer.GetRepoBuildInfo(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
 	}
-	return result, nil
+	return result, err
 }
 
-func (s *CachedBuildsClient) GetLog(ctx context.Context, in *BuildsGetLogOp, opts ...grpc.CallOption) (*LogEntries, error) {
-	if s.Cache != nil {
-		var cachedResult LogEntries
-		cached, err := s.Cache.Get(ctx, "Builds.GetLog", in, &cachedResult)
-		if err != nil {
+func (s *CachedBuildsServer) GetMultipleRepoBuildInfo(ctx context.Context, in *BuildsGetMultipleRepoBuildInfoOp) (*RepoBuildInfoList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.BuildsServer.GetMultipleRepoBuildInfo(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
-		if cached {
-			return &cachedResult, nil
+	}
+	return result, err
+}
+
+func (s *CachedBuildsServer) List(ctx context.Context, in *BuildListOptions) (*BuildList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.BuildsServer.List(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
 		}
 	}
+	return result, err
+}
 
-	var trailer metadata.MD
-
-	result, err := s.BuildsClient.GetLog(ctx, in, grpc.Trailer(&trailer))
-	if err != nil {
-		return nil, err
-	}
-	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Builds.GetLog", in, result, trailer); err != nil {
+func (s *CachedBuildsServer) ListByRepo(ctx context.Context, in *BuildsListByRepoOp) (*BuildList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.BuildsServer.ListByRepo(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
 	}
-	return result, nil
+	return result, err
 }
 
-func (s *CachedBuildsClient) GetTaskLog(ctx context.Context, in *BuildsGetTaskLogOp, opts ...grpc.CallOption) (*LogEntries, error) {
-	if s.Cache != nil {
-		var cachedResult LogEntries
-		cached, err := s.Cache.Get(ctx, "Builds.GetTaskLog", in, &cachedResult)
-		if err != nil {
-			return nil, err
-		}
-		if cached {
-			return &cachedResult, nil
-		}
-	}
-
-	var trailer metadata.MD
-
-	result, err := s.BuildsClient.GetTaskLog(ctx, in, grpc.Trailer(&trailer))
-	if err != nil {
-		return nil, err
-	}
-	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Builds.GetTaskLog", in, result, trailer); err != nil {
+func (s *CachedBuildsServer) Create(ctx context.Context, in *BuildsCreateOp) (*Build, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.BuildsServer.Create(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
 	}
-	return result, nil
+	return result, err
 }
 
-func (s *CachedBuildsClient) DequeueNext(ctx context.Context, in *BuildsDequeueNextOp, opts ...grpc.CallOption) (*Build, error) {
-	if s.Cache != nil {
-		var cachedResult Build
-		cached, err := s.Cache.Get(ctx, "Builds.DequeueNext", in, &cachedResult)
-		if err != nil {
-			return nil, err
-		}
-		if cached {
-			return &cachedResult, nil
-		}
-	}
-
-	var trailer metadata.MD
-
-	result, err := s.BuildsClient.DequeueNext(ctx, in, grpc.Trailer(&trailer))
-	if err != nil {
-		return nil, err
-	}
-	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Builds.DequeueNext", in, result, trailer); err != nil {
+func (s *CachedBuildsServer) Update(ctx context.Context, in *BuildsUpdateOp) (*Build, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.BuildsServer.Update(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
 	}
-	return result, nil
+	return result, err
 }
 
-type CachedChangesetsServer struct{ ChangesetsServer }
-
-func (s *CachedChangesetsServer) Create(ctx context.Context, in *ChangesetCreateOp) (*Changeset, error) {
+func (s *CachedBuildsServer) ListBuildTasks(ctx context.Context, in *BuildsListBuildTasksOp) (*BuildTaskList, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ChangesetsServer.Create(ctx, in)
+	result, err := s.BuildsServer.ListBuildTasks(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -758,9 +812,9 @@ func (s *CachedChangesetsServer) Create(ctx context.Context, in *ChangesetCreate
 	return result, err
 }
 
-func (s *CachedChangesetsServer) Get(ctx context.Context, in *ChangesetSpec) (*Changeset, error) {
+func (s *CachedBuildsServer) CreateTasks(ctx context.Context, in *BuildsCreateTasksOp) (*BuildTaskList, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ChangesetsServer.Get(ctx, in)
+	result, err := s.BuildsServer.CreateTasks(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -769,9 +823,9 @@ func (s *CachedChangesetsServer) Get(ctx context.Context, in *ChangesetSpec) (*C
 	return result, err
 }
 
-func (s *CachedChangesetsServer) List(ctx context.Context, in *ChangesetListOp) (*ChangesetList, error) {
+func (s *CachedBuildsServer) UpdateTask(ctx context.Context, in *BuildsUpdateTaskOp) (*BuildTask, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ChangesetsServer.List(ctx, in)
+	result, err := s.BuildsServer.UpdateTask(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -780,9 +834,9 @@ func (s *CachedChangesetsServer) List(ctx context.Context, in *ChangesetListOp)
 	return result, err
 }
 
-func (s *CachedChangesetsServer) Update(ctx context.Context, in *ChangesetUpdateOp) (*ChangesetEvent, error) {
+func (s *CachedBuildsServer) GetLog(ctx context.Context, in *BuildsGetLogOp) (*LogEntries, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ChangesetsServer.Update(ctx, in)
+	result, err := s.BuildsServer.GetLog(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -791,9 +845,9 @@ func (s *CachedChangesetsServer) Update(ctx context.Context, in *ChangesetUpdate
 	return result, err
 }
 
-func (s *CachedChangesetsServer) Merge(ctx context.Context, in *ChangesetMergeOp) (*ChangesetEvent, error) {
+func (s *CachedBuildsServer) GetTaskLog(ctx context.Context, in *BuildsGetTaskLogOp) (*LogEntries, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ChangesetsServer.Merge(ctx, in)
+	result, err := s.BuildsServer.GetTaskLog(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -802,9 +856,9 @@ func (s *CachedChangesetsServer) Merge(ctx context.Context, in *ChangesetMergeOp
 	return result, err
 }
 
-func (s *CachedChangesetsServer) UpdateAffected(ctx context.Context, in *ChangesetUpdateAffectedOp) (*ChangesetEventList, error) {
+func (s *CachedBuildsServer) DequeueNext(ctx context.Context, in *BuildsDequeueNextOp) (*Build, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ChangesetsServer.UpdateAffected(ctx, in)
+	result, err := s.BuildsServer.DequeueNext(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -813,9 +867,9 @@ func (s *CachedChangesetsServer) UpdateAffected(ctx context.Context, in *Changes
 	return result, err
 }
 
-func (s *CachedChangesetsServer) CreateReview(ctx context.Context, in *ChangesetCreateReviewOp) (*ChangesetReview, error) {
+func (s *CachedBuildsServer) Heartbeat(ctx context.Context, in *BuildSpec) (*pbtypes.Void, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ChangesetsServer.CreateReview(ctx, in)
+	result, err := s.BuildsServer.Heartbeat(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -824,9 +878,9 @@ func (s *CachedChangesetsServer) CreateReview(ctx context.Context, in *Changeset
 	return result, err
 }
 
-func (s *CachedChangesetsServer) ListReviews(ctx context.Context, in *ChangesetListReviewsOp) (*ChangesetReviewList, error) {
+func (s *CachedBuildsServer) Cancel(ctx context.Context, in *BuildsCancelOp) (*Build, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ChangesetsServer.ListReviews(ctx, in)
+	result, err := s.BuildsServer.Cancel(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -835,9 +889,9 @@ func (s *CachedChangesetsServer) ListReviews(ctx context.Context, in *ChangesetL
 	return result, err
 }
 
-func (s *CachedChangesetsServer) ListEvents(ctx context.Context, in *ChangesetSpec) (*ChangesetEventList, error) {
+func (s *CachedBuildsServer) Restart(ctx context.Context, in *BuildsRestartOp) (*Build, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ChangesetsServer.ListEvents(ctx, in)
+	result, err := s.BuildsServer.Restart(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -846,15 +900,15 @@ func (s *CachedChangesetsServer) ListEvents(ctx context.Context, in *ChangesetSp
 	return result, err
 }
 
-type CachedChangesetsClient struct {
-	ChangesetsClient
+type CachedBuildsClient struct {
+	BuildsClient
 	Cache *grpccache.Cache
 }
 
-func (s *CachedChangesetsClient) Create(ctx context.Context, in *ChangesetCreateOp, opts ...grpc.CallOption) (*Changeset, error) {
+func (s *CachedBuildsClient) Get(ctx context.Context, in *BuildSpec, opts ...grpc.CallOption) (*Build, error) {
 	if s.Cache != nil {
-		var cachedResult Changeset
-		cached, err := s.Cache.Get(ctx, "Changesets.Create", in, &cachedResult)
+		var cachedResult Build
+		cached, err := s.Cache.Get(ctx, "Builds.Get", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -865,22 +919,22 @@ func (s *CachedChangesetsClient) Create(ctx context.Context, in *ChangesetCreate
 
 	var trailer metadata.MD
 
-	result, err := s.ChangesetsClient.Create(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.BuildsClient.Get(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Changesets.Create", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Builds.Get", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedChangesetsClient) Get(ctx context.Context, in *ChangesetSpec, opts ...grpc.CallOption) (*Changeset, error) {
+func (s *CachedBuildsClient) GetRepoBuildInfo(ctx context.Context, in *BuildsGetRepoBuildInfoOp, opts ...grpc.CallOption) (*RepoBuildInfo, error) {
 	if s.Cache != nil {
-		var cachedResult Changeset
-		cached, err := s.Cache.Get(ctx, "Changesets.Get", in, &cachedResult)
+		var cachedResult RepoBuildInfo
+		cached, err := s.Cache.Get(ctx, "Builds.GetRepoBuildInfo", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -891,22 +945,22 @@ func (s *CachedChangesetsClient) Get(ctx context.Context, in *ChangesetSpec, opt
 
 	var trailer metadata.MD
 
-	result, err := s.ChangesetsClient.Get(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.BuildsClient.GetRepoBuildInfo(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Changesets.Get", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Builds.GetRepoBuildInfo", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedChangesetsClient) List(ctx context.Context, in *ChangesetListOp, opts ...grpc.CallOption) (*ChangesetList, error) {
+func (s *CachedBuildsClient) GetMultipleRepoBuildInfo(ctx context.Context, in *BuildsGetMultipleRepoBuildInfoOp, opts ...grpc.CallOption) (*RepoBuildInfoList, error) {
 	if s.Cache != nil {
-		var cachedResult ChangesetList
-		cached, err := s.Cache.Get(ctx, "Changesets.List", in, &cachedResult)
+		var cachedResult RepoBuildInfoList
+		cached, err := s.Cache.Get(ctx, "Builds.GetMultipleRepoBuildInfo", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -917,22 +971,22 @@ func (s *CachedChangesetsClient) List(ctx context.Context, in *ChangesetListOp,
 
 	var trailer metadata.MD
 
-	result, err := s.ChangesetsClient.List(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.BuildsClient.GetMultipleRepoBuildInfo(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Changesets.List", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Builds.GetMultipleRepoBuildInfo", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedChangesetsClient) Update(ctx context.Context, in *ChangesetUpdateOp, opts ...grpc.CallOption) (*ChangesetEvent, error) {
+func (s *CachedBuildsClient) List(ctx context.Context, in *BuildListOptions, opts ...grpc.CallOption) (*BuildList, error) {
 	if s.Cache != nil {
-		var cachedResult ChangesetEvent
-		cached, err := s.Cache.Get(ctx, "Changesets.Update", in, &cachedResult)
+		var cachedResult BuildList
+		cached, err := s.Cache.Get(ctx, "Builds.List", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -943,22 +997,22 @@ func (s *CachedChangesetsClient) Update(ctx context.Context, in *ChangesetUpdate
 
 	var trailer metadata.MD
 
-	result, err := s.ChangesetsClient.Update(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.BuildsClient.List(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Changesets.Update", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Builds.List", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedChangesetsClient) Merge(ctx context.Context, in *ChangesetMergeOp, opts ...grpc.CallOption) (*ChangesetEvent, error) {
+func (s *CachedBuildsClient) ListByRepo(ctx context.Context, in *BuildsListByRepoOp, opts ...grpc.CallOption) (*BuildList, error) {
 	if s.Cache != nil {
-		var cachedResult ChangesetEvent
-		cached, err := s.Cache.Get(ctx, "Changesets.Merge", in, &cachedResult)
+		var cachedResult BuildList
+		cached, err := s.Cache.Get(ctx, "Builds.ListByRepo", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -969,22 +1023,22 @@ func (s *CachedChangesetsClient) Merge(ctx context.Context, in *ChangesetMergeOp
 
 	var trailer metadata.MD
 
-	result, err := s.ChangesetsClient.Merge(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.BuildsClient.ListByRepo(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Changesets.Merge", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Builds.ListByRepo", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedChangesetsClient) UpdateAffected(ctx context.Context, in *ChangesetUpdateAffectedOp, opts ...grpc.CallOption) (*ChangesetEventList, error) {
+func (s *CachedBuildsClient) Create(ctx context.Context, in *BuildsCreateOp, opts ...grpc.CallOption) (*Build, error) {
 	if s.Cache != nil {
-		var cachedResult ChangesetEventList
-		cached, err := s.Cache.Get(ctx, "Changesets.UpdateAffected", in, &cachedResult)
+		var cachedResult Build
+		cached, err := s.Cache.Get(ctx, "Builds.Create", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -995,22 +1049,22 @@ func (s *CachedChangesetsClient) UpdateAffected(ctx context.Context, in *Changes
 
 	var trailer metadata.MD
 
-	result, err := s.ChangesetsClient.UpdateAffected(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.BuildsClient.Create(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Changesets.UpdateAffected", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Builds.Create", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedChangesetsClient) CreateReview(ctx context.Context, in *ChangesetCreateReviewOp, opts ...grpc.CallOption) (*ChangesetReview, error) {
+func (s *CachedBuildsClient) Update(ctx context.Context, in *BuildsUpdateOp, opts ...grpc.CallOption) (*Build, error) {
 	if s.Cache != nil {
-		var cachedResult ChangesetReview
-		cached, err := s.Cache.Get(ctx, "Changesets.CreateReview", in, &cachedResult)
+		var cachedResult Build
+		cached, err := s.Cache.Get(ctx, "Builds.Update", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1021,22 +1075,22 @@ func (s *CachedChangesetsClient) CreateReview(ctx context.Context, in *Changeset
 
 	var trailer metadata.MD
 
-	result, err := s.ChangesetsClient.CreateReview(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.BuildsClient.Update(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Changesets.CreateReview", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Builds.Update", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedChangesetsClient) ListReviews(ctx context.Context, in *ChangesetListReviewsOp, opts ...grpc.CallOption) (*ChangesetReviewList, error) {
+func (s *CachedBuildsClient) ListBuildTasks(ctx context.Context, in *BuildsListBuildTasksOp, opts ...grpc.CallOption) (*BuildTaskList, error) {
 	if s.Cache != nil {
-		var cachedResult ChangesetReviewList
-		cached, err := s.Cache.Get(ctx, "Changesets.ListReviews", in, &cachedResult)
+		var cachedResult BuildTaskList
+		cached, err := s.Cache.Get(ctx, "Builds.ListBuildTasks", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1047,22 +1101,22 @@ func (s *CachedChangesetsClient) ListReviews(ctx context.Context, in *ChangesetL
 
 	var trailer metadata.MD
 
-	result, err := s.ChangesetsClient.ListReviews(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.BuildsClient.ListBuildTasks(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Changesets.ListReviews", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Builds.ListBuildTasks", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedChangesetsClient) ListEvents(ctx context.Context, in *ChangesetSpec, opts ...grpc.CallOption) (*ChangesetEventList, error) {
+func (s *CachedBuildsClient) CreateTasks(ctx context.Context, in *BuildsCreateTasksOp, opts ...grpc.CallOption) (*BuildTaskList, error) {
 	if s.Cache != nil {
-		var cachedResult ChangesetEventList
-		cached, err := s.Cache.Get(ctx, "Changesets.ListEvents", in, &cachedResult)
+		var cachedResult BuildTaskList
+		cached, err := s.Cache.Get(ctx, "Builds.CreateTasks", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1073,96 +1127,75 @@ func (s *CachedChangesetsClient) ListEvents(ctx context.Context, in *ChangesetSp
 
 	var trailer metadata.MD
 
-	result, err := s.ChangesetsClient.ListEvents(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.BuildsClient.CreateTasks(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Changesets.ListEvents", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Builds.CreateTasks", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-type CachedDefsServer struct{ DefsServer }
-
-func (s *CachedDefsServer) Get(ctx context.Context, in *DefsGetOp) (*Def, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.DefsServer.Get(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+func (s *CachedBuildsClient) UpdateTask(ctx context.Context, in *BuildsUpdateTaskOp, opts ...grpc.CallOption) (*BuildTask, error) {
+	if s.Cache != nil {
+		var cachedResult BuildTask
+		cached, err := s.Cache.Get(ctx, "Builds.UpdateTask", in, &cachedResult)
+		if err != nil {
 			return nil, err
 		}
+		if cached {
+			return &cachedResult, nil
+		}
 	}
-	return result, err
-}
 
-func (s *CachedDefsServer) List(ctx context.Context, in *DefListOptions) (*DefList, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.DefsServer.List(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+	var trailer metadata.MD
+
+	result, err := s.BuildsClient.UpdateTask(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Builds.UpdateTask", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
-	return result, err
+	return result, nil
 }
 
-func (s *CachedDefsServer) ListRefs(ctx context.Context, in *DefsListRefsOp) (*RefList, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.DefsServer.ListRefs(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+func (s *CachedBuildsClient) GetLog(ctx context.Context, in *BuildsGetLogOp, opts ...grpc.CallOption) (*LogEntries, error) {
+	if s.Cache != nil {
+		var cachedResult LogEntries
+		cached, err := s.Cache.Get(ctx, "Builds.GetLog", in, &cachedResult)
+		if err != nil {
 			return nil, err
 		}
+		if cached {
+			return &cachedResult, nil
+		}
 	}
-	return result, err
-}
 
-func (s *CachedDefsServer) ListExamples(ctx context.Context, in *DefsListExamplesOp) (*ExampleList, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.DefsServer.ListExamples(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+	var trailer metadata.MD
+
+	result, err := s.BuildsClient.GetLog(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Builds.GetLog", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
-	return result, err
+	return result, nil
 }
 
-func (s *CachedDefsServer) ListAuthors(ctx context.Context, in *DefsListAuthorsOp) (*DefAuthorList, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.DefsServer.ListAuthors(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
-			return nil, err
-		}
-	}
-	return result, err
-}
-
-func (s *CachedDefsServer) ListClients(ctx context.Context, in *DefsListClientsOp) (*DefClientList, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.DefsServer.ListClients(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
-			return nil, err
-		}
-	}
-	return result, err
-}
-
-type CachedDefsClient struct {
-	DefsClient
-	Cache *grpccache.Cache
-}
-
-func (s *CachedDefsClient) Get(ctx context.Context, in *DefsGetOp, opts ...grpc.CallOption) (*Def, error) {
-	if s.Cache != nil {
-		var cachedResult Def
-		cached, err := s.Cache.Get(ctx, "Defs.Get", in, &cachedResult)
-		if err != nil {
+func (s *CachedBuildsClient) GetTaskLog(ctx context.Context, in *BuildsGetTaskLogOp, opts ...grpc.CallOption) (*LogEntries, error) {
+	if s.Cache != nil {
+		var cachedResult LogEntries
+		cached, err := s.Cache.Get(ctx, "Builds.GetTaskLog", in, &cachedResult)
+		if err != nil {
 			return nil, err
 		}
 		if cached {
@@ -1172,22 +1205,22 @@ func (s *CachedDefsClient) Get(ctx context.Context, in *DefsGetOp, opts ...grpc.
 
 	var trailer metadata.MD
 
-	result, err := s.DefsClient.Get(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.BuildsClient.GetTaskLog(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Defs.Get", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Builds.GetTaskLog", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedDefsClient) List(ctx context.Context, in *DefListOptions, opts ...grpc.CallOption) (*DefList, error) {
+func (s *CachedBuildsClient) DequeueNext(ctx context.Context, in *BuildsDequeueNextOp, opts ...grpc.CallOption) (*Build, error) {
 	if s.Cache != nil {
-		var cachedResult DefList
-		cached, err := s.Cache.Get(ctx, "Defs.List", in, &cachedResult)
+		var cachedResult Build
+		cached, err := s.Cache.Get(ctx, "Builds.DequeueNext", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1198,22 +1231,22 @@ func (s *CachedDefsClient) List(ctx context.Context, in *DefListOptions, opts ..
 
 	var trailer metadata.MD
 
-	result, err := s.DefsClient.List(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.BuildsClient.DequeueNext(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Defs.List", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Builds.DequeueNext", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedDefsClient) ListRefs(ctx context.Context, in *DefsListRefsOp, opts ...grpc.CallOption) (*RefList, error) {
+func (s *CachedBuildsClient) Heartbeat(ctx context.Context, in *BuildSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
 	if s.Cache != nil {
-		var cachedResult RefList
-		cached, err := s.Cache.Get(ctx, "Defs.ListRefs", in, &cachedResult)
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "Builds.Heartbeat", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1224,22 +1257,22 @@ func (s *CachedDefsClient) ListRefs(ctx context.Context, in *DefsListRefsOp, opt
 
 	var trailer metadata.MD
 
-	result, err := s.DefsClient.ListRefs(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.BuildsClient.Heartbeat(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Defs.ListRefs", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Builds.Heartbeat", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedDefsClient) ListExamples(ctx context.Context, in *DefsListExamplesOp, opts ...grpc.CallOption) (*ExampleList, error) {
+func (s *CachedBuildsClient) Cancel(ctx context.Context, in *BuildsCancelOp, opts ...grpc.CallOption) (*Build, error) {
 	if s.Cache != nil {
-		var cachedResult ExampleList
-		cached, err := s.Cache.Get(ctx, "Defs.ListExamples", in, &cachedResult)
+		var cachedResult Build
+		cached, err := s.Cache.Get(ctx, "Builds.Cancel", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1250,22 +1283,22 @@ func (s *CachedDefsClient) ListExamples(ctx context.Context, in *DefsListExample
 
 	var trailer metadata.MD
 
-	result, err := s.DefsClient.ListExamples(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.BuildsClient.Cancel(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Defs.ListExamples", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Builds.Cancel", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedDefsClient) ListAuthors(ctx context.Context, in *DefsListAuthorsOp, opts ...grpc.CallOption) (*DefAuthorList, error) {
+func (s *CachedBuildsClient) Restart(ctx context.Context, in *BuildsRestartOp, opts ...grpc.CallOption) (*Build, error) {
 	if s.Cache != nil {
-		var cachedResult DefAuthorList
-		cached, err := s.Cache.Get(ctx, "Defs.ListAuthors", in, &cachedResult)
+		var cachedResult Build
+		cached, err := s.Cache.Get(ctx, "Builds.Restart", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1276,49 +1309,56 @@ func (s *CachedDefsClient) ListAuthors(ctx context.Context, in *DefsListAuthorsO
 
 	var trailer metadata.MD
 
-	result, err := s.DefsClient.ListAuthors(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.BuildsClient.Restart(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Defs.ListAuthors", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Builds.Restart", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedDefsClient) ListClients(ctx context.Context, in *DefsListClientsOp, opts ...grpc.CallOption) (*DefClientList, error) {
-	if s.Cache != nil {
-		var cachedResult DefClientList
-		cached, err := s.Cache.Get(ctx, "Defs.ListClients", in, &cachedResult)
-		if err != nil {
+type CachedChangesetsServer struct{ ChangesetsServer }
+
+func (s *CachedChangesetsServer) Create(ctx context.Context, in *ChangesetCreateOp) (*Changeset, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ChangesetsServer.Create(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
-		if cached {
-			return &cachedResult, nil
-		}
 	}
+	return result, err
+}
 
-	var trailer metadata.MD
-
-	result, err := s.DefsClient.ListClients(ctx, in, grpc.Trailer(&trailer))
-	if err != nil {
-		return nil, err
-	}
-	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Defs.ListClients", in, result, trailer); err != nil {
+func (s *CachedChangesetsServer) Get(ctx context.Context, in *ChangesetSpec) (*Changeset, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ChangesetsServer.Get(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
 	}
-	return result, nil
+	return result, err
 }
 
-type CachedDeltasServer struct{ DeltasServer }
+func (s *CachedChangesetsServer) List(ctx context.Context, in *ChangesetListOp) (*ChangesetList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ChangesetsServer.List(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
 
-func (s *CachedDeltasServer) Get(ctx context.Context, in *DeltaSpec) (*Delta, error) {
+func (s *CachedChangesetsServer) Update(ctx context.Context, in *ChangesetUpdateOp) (*ChangesetEvent, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.DeltasServer.Get(ctx, in)
+	result, err := s.ChangesetsServer.Update(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -1327,9 +1367,9 @@ func (s *CachedDeltasServer) Get(ctx context.Context, in *DeltaSpec) (*Delta, er
 	return result, err
 }
 
-func (s *CachedDeltasServer) ListUnits(ctx context.Context, in *DeltasListUnitsOp) (*UnitDeltaList, error) {
+func (s *CachedChangesetsServer) Merge(ctx context.Context, in *ChangesetMergeOp) (*ChangesetEvent, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.DeltasServer.ListUnits(ctx, in)
+	result, err := s.ChangesetsServer.Merge(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -1338,9 +1378,9 @@ func (s *CachedDeltasServer) ListUnits(ctx context.Context, in *DeltasListUnitsO
 	return result, err
 }
 
-func (s *CachedDeltasServer) ListDefs(ctx context.Context, in *DeltasListDefsOp) (*DeltaDefs, error) {
+func (s *CachedChangesetsServer) UpdateAffected(ctx context.Context, in *ChangesetUpdateAffectedOp) (*ChangesetEventList, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.DeltasServer.ListDefs(ctx, in)
+	result, err := s.ChangesetsServer.UpdateAffected(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -1349,9 +1389,9 @@ func (s *CachedDeltasServer) ListDefs(ctx context.Context, in *DeltasListDefsOp)
 	return result, err
 }
 
-func (s *CachedDeltasServer) ListFiles(ctx context.Context, in *DeltasListFilesOp) (*DeltaFiles, error) {
+func (s *CachedChangesetsServer) CreateReview(ctx context.Context, in *ChangesetCreateReviewOp) (*ChangesetReview, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.DeltasServer.ListFiles(ctx, in)
+	result, err := s.ChangesetsServer.CreateReview(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -1360,9 +1400,9 @@ func (s *CachedDeltasServer) ListFiles(ctx context.Context, in *DeltasListFilesO
 	return result, err
 }
 
-func (s *CachedDeltasServer) ListAffectedAuthors(ctx context.Context, in *DeltasListAffectedAuthorsOp) (*DeltaAffectedPersonList, error) {
+func (s *CachedChangesetsServer) ListReviews(ctx context.Context, in *ChangesetListReviewsOp) (*ChangesetReviewList, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.DeltasServer.ListAffectedAuthors(ctx, in)
+	result, err := s.ChangesetsServer.ListReviews(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -1371,9 +1411,9 @@ func (s *CachedDeltasServer) ListAffectedAuthors(ctx context.Context, in *Deltas
 	return result, err
 }
 
-func (s *CachedDeltasServer) ListAffectedClients(ctx context.Context, in *DeltasListAffectedClientsOp) (*DeltaAffectedPersonList, error) {
+func (s *CachedChangesetsServer) ListEvents(ctx context.Context, in *ChangesetSpec) (*ChangesetEventList, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.DeltasServer.ListAffectedClients(ctx, in)
+	result, err := s.ChangesetsServer.ListEvents(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -1382,15 +1422,15 @@ func (s *CachedDeltasServer) ListAffectedClients(ctx context.Context, in *Deltas
 	return result, err
 }
 
-type CachedDeltasClient struct {
-	DeltasClient
+type CachedChangesetsClient struct {
+	ChangesetsClient
 	Cache *grpccache.Cache
 }
 
-func (s *CachedDeltasClient) Get(ctx context.Context, in *DeltaSpec, opts ...grpc.CallOption) (*Delta, error) {
+func (s *CachedChangesetsClient) Create(ctx context.Context, in *ChangesetCreateOp, opts ...grpc.CallOption) (*Changeset, error) {
 	if s.Cache != nil {
-		var cachedResult Delta
-		cached, err := s.Cache.Get(ctx, "Deltas.Get", in, &cachedResult)
+		var cachedResult Changeset
+		cached, err := s.Cache.Get(ctx, "Changesets.Create", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1401,22 +1441,22 @@ func (s *CachedDeltasClient) Get(ctx context.Context, in *DeltaSpec, opts ...grp
 
 	var trailer metadata.MD
 
-	result, err := s.DeltasClient.Get(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ChangesetsClient.Create(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Deltas.Get", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Changesets.Create", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedDeltasClient) ListUnits(ctx context.Context, in *DeltasListUnitsOp, opts ...grpc.CallOption) (*UnitDeltaList, error) {
+func (s *CachedChangesetsClient) Get(ctx context.Context, in *ChangesetSpec, opts ...grpc.CallOption) (*Changeset, error) {
 	if s.Cache != nil {
-		var cachedResult UnitDeltaList
-		cached, err := s.Cache.Get(ctx, "Deltas.ListUnits", in, &cachedResult)
+		var cachedResult Changeset
+		cached, err := s.Cache.Get(ctx, "Changesets.Get", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1427,22 +1467,22 @@ func (s *CachedDeltasClient) ListUnits(ctx context.Context, in *DeltasListUnitsO
 
 	var trailer metadata.MD
 
-	result, err := s.DeltasClient.ListUnits(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ChangesetsClient.Get(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Deltas.ListUnits", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Changesets.Get", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedDeltasClient) ListDefs(ctx context.Context, in *DeltasListDefsOp, opts ...grpc.CallOption) (*DeltaDefs, error) {
+func (s *CachedChangesetsClient) List(ctx context.Context, in *ChangesetListOp, opts ...grpc.CallOption) (*ChangesetList, error) {
 	if s.Cache != nil {
-		var cachedResult DeltaDefs
-		cached, err := s.Cache.Get(ctx, "Deltas.ListDefs", in, &cachedResult)
+		var cachedResult ChangesetList
+		cached, err := s.Cache.Get(ctx, "Changesets.List", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1453,22 +1493,22 @@ func (s *CachedDeltasClient) ListDefs(ctx context.Context, in *DeltasListDefsOp,
 
 	var trailer metadata.MD
 
-	result, err := s.DeltasClient.ListDefs(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ChangesetsClient.List(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Deltas.ListDefs", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Changesets.List", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedDeltasClient) ListFiles(ctx context.Context, in *DeltasListFilesOp, opts ...grpc.CallOption) (*DeltaFiles, error) {
+func (s *CachedChangesetsClient) Update(ctx context.Context, in *ChangesetUpdateOp, opts ...grpc.CallOption) (*ChangesetEvent, error) {
 	if s.Cache != nil {
-		var cachedResult DeltaFiles
-		cached, err := s.Cache.Get(ctx, "Deltas.ListFiles", in, &cachedResult)
+		var cachedResult ChangesetEvent
+		cached, err := s.Cache.Get(ctx, "Changesets.Update", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1479,22 +1519,22 @@ func (s *CachedDeltasClient) ListFiles(ctx context.Context, in *DeltasListFilesO
 
 	var trailer metadata.MD
 
-	result, err := s.DeltasClient.ListFiles(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ChangesetsClient.Update(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Deltas.ListFiles", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Changesets.Update", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedDeltasClient) ListAffectedAuthors(ctx context.Context, in *DeltasListAffectedAuthorsOp, opts ...grpc.CallOption) (*DeltaAffectedPersonList, error) {
+func (s *CachedChangesetsClient) Merge(ctx context.Context, in *ChangesetMergeOp, opts ...grpc.CallOption) (*ChangesetEvent, error) {
 	if s.Cache != nil {
-		var cachedResult DeltaAffectedPersonList
-		cached, err := s.Cache.Get(ctx, "Deltas.ListAffectedAuthors", in, &cachedResult)
+		var cachedResult ChangesetEvent
+		cached, err := s.Cache.Get(ctx, "Changesets.Merge", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1505,22 +1545,22 @@ func (s *CachedDeltasClient) ListAffectedAuthors(ctx context.Context, in *Deltas
 
 	var trailer metadata.MD
 
-	result, err := s.DeltasClient.ListAffectedAuthors(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ChangesetsClient.Merge(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Deltas.ListAffectedAuthors", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Changesets.Merge", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedDeltasClient) ListAffectedClients(ctx context.Context, in *DeltasListAffectedClientsOp, opts ...grpc.CallOption) (*DeltaAffectedPersonList, error) {
+func (s *CachedChangesetsClient) UpdateAffected(ctx context.Context, in *ChangesetUpdateAffectedOp, opts ...grpc.CallOption) (*ChangesetEventList, error) {
 	if s.Cache != nil {
-		var cachedResult DeltaAffectedPersonList
-		cached, err := s.Cache.Get(ctx, "Deltas.ListAffectedClients", in, &cachedResult)
+		var cachedResult ChangesetEventList
+		cached, err := s.Cache.Get(ctx, "Changesets.UpdateAffected", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1531,84 +1571,3109 @@ func (s *CachedDeltasClient) ListAffectedClients(ctx context.Context, in *Deltas
 
 	var trailer metadata.MD
 
-	result, err := s.DeltasClient.ListAffectedClients(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ChangesetsClient.UpdateAffected(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Deltas.ListAffectedClients", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Changesets.UpdateAffected", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-type CachedDiscussionsServer struct{ DiscussionsServer }
-
-func (s *CachedDiscussionsServer) Create(ctx context.Context, in *Discussion) (*Discussion, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.DiscussionsServer.Create(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+func (s *CachedChangesetsClient) CreateReview(ctx context.Context, in *ChangesetCreateReviewOp, opts ...grpc.CallOption) (*ChangesetReview, error) {
+	if s.Cache != nil {
+		var cachedResult ChangesetReview
+		cached, err := s.Cache.Get(ctx, "Changesets.CreateReview", in, &cachedResult)
+		if err != nil {
 			return nil, err
 		}
+		if cached {
+			return &cachedResult, nil
+		}
 	}
-	return result, err
-}
 
-func (s *CachedDiscussionsServer) Get(ctx context.Context, in *DiscussionSpec) (*Discussion, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.DiscussionsServer.Get(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+	var trailer metadata.MD
+
+	result, err := s.ChangesetsClient.CreateReview(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Changesets.CreateReview", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
-	return result, err
+	return result, nil
 }
 
-func (s *CachedDiscussionsServer) List(ctx context.Context, in *DiscussionListOp) (*DiscussionList, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.DiscussionsServer.List(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+func (s *CachedChangesetsClient) ListReviews(ctx context.Context, in *ChangesetListReviewsOp, opts ...grpc.CallOption) (*ChangesetReviewList, error) {
+	if s.Cache != nil {
+		var cachedResult ChangesetReviewList
+		cached, err := s.Cache.Get(ctx, "Changesets.ListReviews", in, &cachedResult)
+		if err != nil {
 			return nil, err
 		}
+		if cached {
+			return &cachedResult, nil
+		}
 	}
-	return result, err
-}
 
-func (s *CachedDiscussionsServer) CreateComment(ctx context.Context, in *DiscussionCommentCreateOp) (*DiscussionComment, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.DiscussionsServer.CreateComment(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+	var trailer metadata.MD
+
+	result, err := s.ChangesetsClient.ListReviews(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Changesets.ListReviews", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
-	return result, err
+	return result, nil
 }
 
-func (s *CachedDiscussionsServer) UpdateRating(ctx context.Context, in *DiscussionRatingUpdateOp) (*pbtypes.Void, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.DiscussionsServer.UpdateRating(ctx, in)
-	if !cc.IsZero() {
+func (s *CachedChangesetsClient) ListEvents(ctx context.Context, in *ChangesetSpec, opts ...grpc.CallOption) (*ChangesetEventList, error) {
+	if s.Cache != nil {
+		var cachedResult ChangesetEventList
+		cached, err := s.Cache.Get(ctx, "Changesets.ListEvents", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.ChangesetsClient.ListEvents(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Changesets.ListEvents", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+type CachedDefsServer struct{ DefsServer }
+
+func (s *CachedDefsServer) Get(ctx context.Context, in *DefsGetOp) (*Def, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DefsServer.Get(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDefsServer) GetByPosition(ctx context.Context, in *DefsGetByPositionOp) (*Def, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DefsServer.GetByPosition(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDefsServer) Hover(ctx context.Context, in *DefsGetByPositionOp) (*Hover, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DefsServer.Hover(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDefsServer) GetMultiple(ctx context.Context, in *DefsGetMultipleOp) (*DefList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DefsServer.GetMultiple(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDefsServer) List(ctx context.Context, in *DefListOptions) (*DefList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DefsServer.List(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDefsServer) ListRefs(ctx context.Context, in *DefsListRefsOp) (*RefList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DefsServer.ListRefs(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDefsServer) ListExamples(ctx context.Context, in *DefsListExamplesOp) (*ExampleList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DefsServer.ListExamples(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDefsServer) ListAuthors(ctx context.Context, in *DefsListAuthorsOp) (*DefAuthorList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DefsServer.ListAuthors(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDefsServer) ListClients(ctx context.Context, in *DefsListClientsOp) (*DefClientList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DefsServer.ListClients(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDefsServer) ListDependents(ctx context.Context, in *DefsListDependentsOp) (*DefDependentList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DefsServer.ListDependents(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDefsServer) ListHistory(ctx context.Context, in *DefsListHistoryOp) (*DefHistory, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DefsServer.ListHistory(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDefsServer) ListTop(ctx context.Context, in *DefsListTopOp) (*DefList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DefsServer.ListTop(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDefsServer) GetLineage(ctx context.Context, in *DefsGetLineageOp) (*DefLineage, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DefsServer.GetLineage(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDefsServer) ListCallers(ctx context.Context, in *DefsListCallersOp) (*DefList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DefsServer.ListCallers(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDefsServer) ListCallees(ctx context.Context, in *DefsListCalleesOp) (*DefList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DefsServer.ListCallees(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDefsServer) DiffRefs(ctx context.Context, in *DefsDiffRefsOp) (*RefDiff, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DefsServer.DiffRefs(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDefsServer) Search(ctx context.Context, in *DefSearchOptions) (*DefSearchResultList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DefsServer.Search(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedDefsClient struct {
+	DefsClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedDefsClient) Get(ctx context.Context, in *DefsGetOp, opts ...grpc.CallOption) (*Def, error) {
+	if s.Cache != nil {
+		var cachedResult Def
+		cached, err := s.Cache.Get(ctx, "Defs.Get", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DefsClient.Get(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Defs.Get", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDefsClient) GetByPosition(ctx context.Context, in *DefsGetByPositionOp, opts ...grpc.CallOption) (*Def, error) {
+	if s.Cache != nil {
+		var cachedResult Def
+		cached, err := s.Cache.Get(ctx, "Defs.GetByPosition", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DefsClient.GetByPosition(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Defs.GetByPosition", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDefsClient) Hover(ctx context.Context, in *DefsGetByPositionOp, opts ...grpc.CallOption) (*Hover, error) {
+	if s.Cache != nil {
+		var cachedResult Hover
+		cached, err := s.Cache.Get(ctx, "Defs.Hover", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DefsClient.Hover(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Defs.Hover", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDefsClient) GetMultiple(ctx context.Context, in *DefsGetMultipleOp, opts ...grpc.CallOption) (*DefList, error) {
+	if s.Cache != nil {
+		var cachedResult DefList
+		cached, err := s.Cache.Get(ctx, "Defs.GetMultiple", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DefsClient.GetMultiple(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Defs.GetMultiple", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDefsClient) List(ctx context.Context, in *DefListOptions, opts ...grpc.CallOption) (*DefList, error) {
+	if s.Cache != nil {
+		var cachedResult DefList
+		cached, err := s.Cache.Get(ctx, "Defs.List", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DefsClient.List(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Defs.List", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDefsClient) ListRefs(ctx context.Context, in *DefsListRefsOp, opts ...grpc.CallOption) (*RefList, error) {
+	if s.Cache != nil {
+		var cachedResult RefList
+		cached, err := s.Cache.Get(ctx, "Defs.ListRefs", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DefsClient.ListRefs(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Defs.ListRefs", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDefsClient) ListExamples(ctx context.Context, in *DefsListExamplesOp, opts ...grpc.CallOption) (*ExampleList, error) {
+	if s.Cache != nil {
+		var cachedResult ExampleList
+		cached, err := s.Cache.Get(ctx, "Defs.ListExamples", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DefsClient.ListExamples(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Defs.ListExamples", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDefsClient) ListAuthors(ctx context.Context, in *DefsListAuthorsOp, opts ...grpc.CallOption) (*DefAuthorList, error) {
+	if s.Cache != nil {
+		var cachedResult DefAuthorList
+		cached, err := s.Cache.Get(ctx, "Defs.ListAuthors", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DefsClient.ListAuthors(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Defs.ListAuthors", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDefsClient) ListClients(ctx context.Context, in *DefsListClientsOp, opts ...grpc.CallOption) (*DefClientList, error) {
+	if s.Cache != nil {
+		var cachedResult DefClientList
+		cached, err := s.Cache.Get(ctx, "Defs.ListClients", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DefsClient.ListClients(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Defs.ListClients", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDefsClient) ListDependents(ctx context.Context, in *DefsListDependentsOp, opts ...grpc.CallOption) (*DefDependentList, error) {
+	if s.Cache != nil {
+		var cachedResult DefDependentList
+		cached, err := s.Cache.Get(ctx, "Defs.ListDependents", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DefsClient.ListDependents(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Defs.ListDependents", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDefsClient) ListHistory(ctx context.Context, in *DefsListHistoryOp, opts ...grpc.CallOption) (*DefHistory, error) {
+	if s.Cache != nil {
+		var cachedResult DefHistory
+		cached, err := s.Cache.Get(ctx, "Defs.ListHistory", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DefsClient.ListHistory(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Defs.ListHistory", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDefsClient) ListTop(ctx context.Context, in *DefsListTopOp, opts ...grpc.CallOption) (*DefList, error) {
+	if s.Cache != nil {
+		var cachedResult DefList
+		cached, err := s.Cache.Get(ctx, "Defs.ListTop", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DefsClient.ListTop(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Defs.ListTop", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDefsClient) GetLineage(ctx context.Context, in *DefsGetLineageOp, opts ...grpc.CallOption) (*DefLineage, error) {
+	if s.Cache != nil {
+		var cachedResult DefLineage
+		cached, err := s.Cache.Get(ctx, "Defs.GetLineage", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DefsClient.GetLineage(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Defs.GetLineage", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDefsClient) ListCallers(ctx context.Context, in *DefsListCallersOp, opts ...grpc.CallOption) (*DefList, error) {
+	if s.Cache != nil {
+		var cachedResult DefList
+		cached, err := s.Cache.Get(ctx, "Defs.ListCallers", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DefsClient.ListCallers(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Defs.ListCallers", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDefsClient) ListCallees(ctx context.Context, in *DefsListCalleesOp, opts ...grpc.CallOption) (*DefList, error) {
+	if s.Cache != nil {
+		var cachedResult DefList
+		cached, err := s.Cache.Get(ctx, "Defs.ListCallees", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DefsClient.ListCallees(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Defs.ListCallees", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDefsClient) DiffRefs(ctx context.Context, in *DefsDiffRefsOp, opts ...grpc.CallOption) (*RefDiff, error) {
+	if s.Cache != nil {
+		var cachedResult RefDiff
+		cached, err := s.Cache.Get(ctx, "Defs.DiffRefs", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DefsClient.DiffRefs(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Defs.DiffRefs", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDefsClient) Search(ctx context.Context, in *DefSearchOptions, opts ...grpc.CallOption) (*DefSearchResultList, error) {
+	if s.Cache != nil {
+		var cachedResult DefSearchResultList
+		cached, err := s.Cache.Get(ctx, "Defs.Search", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DefsClient.Search(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Defs.Search", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+type CachedDeltasServer struct{ DeltasServer }
+
+func (s *CachedDeltasServer) Get(ctx context.Context, in *DeltaSpec) (*Delta, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DeltasServer.Get(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDeltasServer) GetMergeBase(ctx context.Context, in *DeltaSpec) (*DeltaMergeBase, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DeltasServer.GetMergeBase(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDeltasServer) ListUnits(ctx context.Context, in *DeltasListUnitsOp) (*UnitDeltaList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DeltasServer.ListUnits(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDeltasServer) ListDefs(ctx context.Context, in *DeltasListDefsOp) (*DeltaDefs, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DeltasServer.ListDefs(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDeltasServer) ListFiles(ctx context.Context, in *DeltasListFilesOp) (*DeltaFiles, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DeltasServer.ListFiles(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDeltasServer) GetPatch(ctx context.Context, in *DeltasGetPatchOp) (*DeltaPatch, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DeltasServer.GetPatch(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDeltasServer) ListAffectedAuthors(ctx context.Context, in *DeltasListAffectedAuthorsOp) (*DeltaAffectedPersonList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DeltasServer.ListAffectedAuthors(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDeltasServer) ListAffectedClients(ctx context.Context, in *DeltasListAffectedClientsOp) (*DeltaAffectedPersonList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DeltasServer.ListAffectedClients(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDeltasServer) GetImpact(ctx context.Context, in *DeltaSpec) (*DeltaImpact, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DeltasServer.GetImpact(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDeltasServer) ListDependencies(ctx context.Context, in *DeltasListDependenciesOp) (*DeltaDependencies, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DeltasServer.ListDependencies(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDeltasServer) ListIncoming(ctx context.Context, in *DeltasListIncomingOp) (*DeltaList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DeltasServer.ListIncoming(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDeltasServer) SetLabels(ctx context.Context, in *DeltasSetLabelsOp) (*Delta, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DeltasServer.SetLabels(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDeltasServer) SetMilestone(ctx context.Context, in *DeltasSetMilestoneOp) (*Delta, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DeltasServer.SetMilestone(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDeltasServer) AssignReviewer(ctx context.Context, in *DeltasReviewerOp) (*Delta, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DeltasServer.AssignReviewer(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDeltasServer) UnassignReviewer(ctx context.Context, in *DeltasReviewerOp) (*Delta, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DeltasServer.UnassignReviewer(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDeltasServer) ListSuggestedReviewers(ctx context.Context, in *DeltasListSuggestedReviewersOp) (*SuggestedReviewerList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DeltasServer.ListSuggestedReviewers(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedDeltasClient struct {
+	DeltasClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedDeltasClient) Get(ctx context.Context, in *DeltaSpec, opts ...grpc.CallOption) (*Delta, error) {
+	if s.Cache != nil {
+		var cachedResult Delta
+		cached, err := s.Cache.Get(ctx, "Deltas.Get", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DeltasClient.Get(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Deltas.Get", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDeltasClient) GetMergeBase(ctx context.Context, in *DeltaSpec, opts ...grpc.CallOption) (*DeltaMergeBase, error) {
+	if s.Cache != nil {
+		var cachedResult DeltaMergeBase
+		cached, err := s.Cache.Get(ctx, "Deltas.GetMergeBase", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DeltasClient.GetMergeBase(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Deltas.GetMergeBase", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDeltasClient) ListUnits(ctx context.Context, in *DeltasListUnitsOp, opts ...grpc.CallOption) (*UnitDeltaList, error) {
+	if s.Cache != nil {
+		var cachedResult UnitDeltaList
+		cached, err := s.Cache.Get(ctx, "Deltas.ListUnits", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DeltasClient.ListUnits(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Deltas.ListUnits", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDeltasClient) ListDefs(ctx context.Context, in *DeltasListDefsOp, opts ...grpc.CallOption) (*DeltaDefs, error) {
+	if s.Cache != nil {
+		var cachedResult DeltaDefs
+		cached, err := s.Cache.Get(ctx, "Deltas.ListDefs", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DeltasClient.ListDefs(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Deltas.ListDefs", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDeltasClient) ListFiles(ctx context.Context, in *DeltasListFilesOp, opts ...grpc.CallOption) (*DeltaFiles, error) {
+	if s.Cache != nil {
+		var cachedResult DeltaFiles
+		cached, err := s.Cache.Get(ctx, "Deltas.ListFiles", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DeltasClient.ListFiles(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Deltas.ListFiles", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDeltasClient) GetPatch(ctx context.Context, in *DeltasGetPatchOp, opts ...grpc.CallOption) (*DeltaPatch, error) {
+	if s.Cache != nil {
+		var cachedResult DeltaPatch
+		cached, err := s.Cache.Get(ctx, "Deltas.GetPatch", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DeltasClient.GetPatch(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Deltas.GetPatch", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDeltasClient) ListAffectedAuthors(ctx context.Context, in *DeltasListAffectedAuthorsOp, opts ...grpc.CallOption) (*DeltaAffectedPersonList, error) {
+	if s.Cache != nil {
+		var cachedResult DeltaAffectedPersonList
+		cached, err := s.Cache.Get(ctx, "Deltas.ListAffectedAuthors", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DeltasClient.ListAffectedAuthors(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Deltas.ListAffectedAuthors", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDeltasClient) ListAffectedClients(ctx context.Context, in *DeltasListAffectedClientsOp, opts ...grpc.CallOption) (*DeltaAffectedPersonList, error) {
+	if s.Cache != nil {
+		var cachedResult DeltaAffectedPersonList
+		cached, err := s.Cache.Get(ctx, "Deltas.ListAffectedClients", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DeltasClient.ListAffectedClients(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Deltas.ListAffectedClients", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDeltasClient) GetImpact(ctx context.Context, in *DeltaSpec, opts ...grpc.CallOption) (*DeltaImpact, error) {
+	if s.Cache != nil {
+		var cachedResult DeltaImpact
+		cached, err := s.Cache.Get(ctx, "Deltas.GetImpact", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DeltasClient.GetImpact(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Deltas.GetImpact", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDeltasClient) ListDependencies(ctx context.Context, in *DeltasListDependenciesOp, opts ...grpc.CallOption) (*DeltaDependencies, error) {
+	if s.Cache != nil {
+		var cachedResult DeltaDependencies
+		cached, err := s.Cache.Get(ctx, "Deltas.ListDependencies", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DeltasClient.ListDependencies(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Deltas.ListDependencies", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDeltasClient) ListIncoming(ctx context.Context, in *DeltasListIncomingOp, opts ...grpc.CallOption) (*DeltaList, error) {
+	if s.Cache != nil {
+		var cachedResult DeltaList
+		cached, err := s.Cache.Get(ctx, "Deltas.ListIncoming", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DeltasClient.ListIncoming(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Deltas.ListIncoming", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDeltasClient) SetLabels(ctx context.Context, in *DeltasSetLabelsOp, opts ...grpc.CallOption) (*Delta, error) {
+	if s.Cache != nil {
+		var cachedResult Delta
+		cached, err := s.Cache.Get(ctx, "Deltas.SetLabels", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DeltasClient.SetLabels(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Deltas.SetLabels", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDeltasClient) SetMilestone(ctx context.Context, in *DeltasSetMilestoneOp, opts ...grpc.CallOption) (*Delta, error) {
+	if s.Cache != nil {
+		var cachedResult Delta
+		cached, err := s.Cache.Get(ctx, "Deltas.SetMilestone", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DeltasClient.SetMilestone(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Deltas.SetMilestone", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDeltasClient) AssignReviewer(ctx context.Context, in *DeltasReviewerOp, opts ...grpc.CallOption) (*Delta, error) {
+	if s.Cache != nil {
+		var cachedResult Delta
+		cached, err := s.Cache.Get(ctx, "Deltas.AssignReviewer", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DeltasClient.AssignReviewer(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Deltas.AssignReviewer", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDeltasClient) UnassignReviewer(ctx context.Context, in *DeltasReviewerOp, opts ...grpc.CallOption) (*Delta, error) {
+	if s.Cache != nil {
+		var cachedResult Delta
+		cached, err := s.Cache.Get(ctx, "Deltas.UnassignReviewer", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DeltasClient.UnassignReviewer(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Deltas.UnassignReviewer", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDeltasClient) ListSuggestedReviewers(ctx context.Context, in *DeltasListSuggestedReviewersOp, opts ...grpc.CallOption) (*SuggestedReviewerList, error) {
+	if s.Cache != nil {
+		var cachedResult SuggestedReviewerList
+		cached, err := s.Cache.Get(ctx, "Deltas.ListSuggestedReviewers", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DeltasClient.ListSuggestedReviewers(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Deltas.ListSuggestedReviewers", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+type CachedDiscussionsServer struct{ DiscussionsServer }
+
+func (s *CachedDiscussionsServer) Create(ctx context.Context, in *Discussion) (*Discussion, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DiscussionsServer.Create(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDiscussionsServer) Get(ctx context.Context, in *DiscussionSpec) (*Discussion, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DiscussionsServer.Get(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDiscussionsServer) List(ctx context.Context, in *DiscussionListOp) (*DiscussionList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DiscussionsServer.List(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDiscussionsServer) CreateComment(ctx context.Context, in *DiscussionCommentCreateOp) (*DiscussionComment, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DiscussionsServer.CreateComment(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedDiscussionsServer) UpdateRating(ctx context.Context, in *DiscussionRatingUpdateOp) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.DiscussionsServer.UpdateRating(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedDiscussionsClient struct {
+	DiscussionsClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedDiscussionsClient) Create(ctx context.Context, in *Discussion, opts ...grpc.CallOption) (*Discussion, error) {
+	if s.Cache != nil {
+		var cachedResult Discussion
+		cached, err := s.Cache.Get(ctx, "Discussions.Create", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DiscussionsClient.Create(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Discussions.Create", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDiscussionsClient) Get(ctx context.Context, in *DiscussionSpec, opts ...grpc.CallOption) (*Discussion, error) {
+	if s.Cache != nil {
+		var cachedResult Discussion
+		cached, err := s.Cache.Get(ctx, "Discussions.Get", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DiscussionsClient.Get(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Discussions.Get", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDiscussionsClient) List(ctx context.Context, in *DiscussionListOp, opts ...grpc.CallOption) (*DiscussionList, error) {
+	if s.Cache != nil {
+		var cachedResult DiscussionList
+		cached, err := s.Cache.Get(ctx, "Discussions.List", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DiscussionsClient.List(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Discussions.List", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDiscussionsClient) CreateComment(ctx context.Context, in *DiscussionCommentCreateOp, opts ...grpc.CallOption) (*DiscussionComment, error) {
+	if s.Cache != nil {
+		var cachedResult DiscussionComment
+		cached, err := s.Cache.Get(ctx, "Discussions.CreateComment", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DiscussionsClient.CreateComment(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Discussions.CreateComment", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedDiscussionsClient) UpdateRating(ctx context.Context, in *DiscussionRatingUpdateOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	if s.Cache != nil {
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "Discussions.UpdateRating", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.DiscussionsClient.UpdateRating(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Discussions.UpdateRating", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+type CachedGraphQLServer struct{ GraphQLServer }
+
+func (s *CachedGraphQLServer) Query(ctx context.Context, in *GraphQLRequest) (*GraphQLResponse, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.GraphQLServer.Query(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedGraphQLClient struct {
+	GraphQLClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedGraphQLClient) Query(ctx context.Context, in *GraphQLRequest, opts ...grpc.CallOption) (*GraphQLResponse, error) {
+	if s.Cache != nil {
+		var cachedResult GraphQLResponse
+		cached, err := s.Cache.Get(ctx, "GraphQL.Query", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.GraphQLClient.Query(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "GraphQL.Query", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+type CachedGraphUplinkServer struct{ GraphUplinkServer }
+
+func (s *CachedGraphUplinkServer) Push(ctx context.Context, in *MetricsSnapshot) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.GraphUplinkServer.Push(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedGraphUplinkServer) PushEvents(ctx context.Context, in *UserEventList) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.GraphUplinkServer.PushEvents(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedGraphUplinkClient struct {
+	GraphUplinkClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedGraphUplinkClient) Push(ctx context.Context, in *MetricsSnapshot, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	if s.Cache != nil {
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "GraphUplink.Push", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.GraphUplinkClient.Push(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "GraphUplink.Push", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedGraphUplinkClient) PushEvents(ctx context.Context, in *UserEventList, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	if s.Cache != nil {
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "GraphUplink.PushEvents", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.GraphUplinkClient.PushEvents(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "GraphUplink.PushEvents", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+type CachedIssuesServer struct{ IssuesServer }
+
+func (s *CachedIssuesServer) Get(ctx context.Context, in *IssueSpec) (*Issue, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.IssuesServer.Get(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedIssuesServer) List(ctx context.Context, in *IssuesListOp) (*IssueList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.IssuesServer.List(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedIssuesServer) Create(ctx context.Context, in *IssuesCreateOp) (*Issue, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.IssuesServer.Create(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedIssuesServer) CreateComment(ctx context.Context, in *IssuesCreateCommentOp) (*IssueComment, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.IssuesServer.CreateComment(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedIssuesClient struct {
+	IssuesClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedIssuesClient) Get(ctx context.Context, in *IssueSpec, opts ...grpc.CallOption) (*Issue, error) {
+	if s.Cache != nil {
+		var cachedResult Issue
+		cached, err := s.Cache.Get(ctx, "Issues.Get", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.IssuesClient.Get(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Issues.Get", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedIssuesClient) List(ctx context.Context, in *IssuesListOp, opts ...grpc.CallOption) (*IssueList, error) {
+	if s.Cache != nil {
+		var cachedResult IssueList
+		cached, err := s.Cache.Get(ctx, "Issues.List", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.IssuesClient.List(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Issues.List", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedIssuesClient) Create(ctx context.Context, in *IssuesCreateOp, opts ...grpc.CallOption) (*Issue, error) {
+	if s.Cache != nil {
+		var cachedResult Issue
+		cached, err := s.Cache.Get(ctx, "Issues.Create", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.IssuesClient.Create(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Issues.Create", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedIssuesClient) CreateComment(ctx context.Context, in *IssuesCreateCommentOp, opts ...grpc.CallOption) (*IssueComment, error) {
+	if s.Cache != nil {
+		var cachedResult IssueComment
+		cached, err := s.Cache.Get(ctx, "Issues.CreateComment", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.IssuesClient.CreateComment(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Issues.CreateComment", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+type CachedMarkdownServer struct{ MarkdownServer }
+
+func (s *CachedMarkdownServer) Render(ctx context.Context, in *MarkdownRenderOp) (*MarkdownData, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.MarkdownServer.Render(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedMarkdownClient struct {
+	MarkdownClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedMarkdownClient) Render(ctx context.Context, in *MarkdownRenderOp, opts ...grpc.CallOption) (*MarkdownData, error) {
+	if s.Cache != nil {
+		var cachedResult MarkdownData
+		cached, err := s.Cache.Get(ctx, "Markdown.Render", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.MarkdownClient.Render(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Markdown.Render", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+type CachedMetaServer struct{ MetaServer }
+
+func (s *CachedMetaServer) Status(ctx context.Context, in *pbtypes.Void) (*ServerStatus, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.MetaServer.Status(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedMetaServer) Config(ctx context.Context, in *pbtypes.Void) (*ServerConfig, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.MetaServer.Config(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedMetaServer) PubKey(ctx context.Context, in *pbtypes.Void) (*ServerPubKey, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.MetaServer.PubKey(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedMetaClient struct {
+	MetaClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedMetaClient) Status(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*ServerStatus, error) {
+	if s.Cache != nil {
+		var cachedResult ServerStatus
+		cached, err := s.Cache.Get(ctx, "Meta.Status", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.MetaClient.Status(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Meta.Status", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedMetaClient) Config(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*ServerConfig, error) {
+	if s.Cache != nil {
+		var cachedResult ServerConfig
+		cached, err := s.Cache.Get(ctx, "Meta.Config", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.MetaClient.Config(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Meta.Config", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedMetaClient) PubKey(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*ServerPubKey, error) {
+	if s.Cache != nil {
+		var cachedResult ServerPubKey
+		cached, err := s.Cache.Get(ctx, "Meta.PubKey", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.MetaClient.PubKey(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Meta.PubKey", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+type CachedMirrorReposServer struct{ MirrorReposServer }
+
+func (s *CachedMirrorReposServer) RefreshVCS(ctx context.Context, in *MirrorReposRefreshVCSOp) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.MirrorReposServer.RefreshVCS(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedMirrorReposServer) Enable(ctx context.Context, in *MirrorReposEnableOp) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.MirrorReposServer.Enable(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedMirrorReposServer) Disable(ctx context.Context, in *RepoSpec) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.MirrorReposServer.Disable(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedMirrorReposServer) GetStatus(ctx context.Context, in *RepoSpec) (*MirrorStatus, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.MirrorReposServer.GetStatus(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedMirrorReposClient struct {
+	MirrorReposClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedMirrorReposClient) RefreshVCS(ctx context.Context, in *MirrorReposRefreshVCSOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	if s.Cache != nil {
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "MirrorRepos.RefreshVCS", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.MirrorReposClient.RefreshVCS(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "MirrorRepos.RefreshVCS", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedMirrorReposClient) Enable(ctx context.Context, in *MirrorReposEnableOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	if s.Cache != nil {
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "MirrorRepos.Enable", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.MirrorReposClient.Enable(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "MirrorRepos.Enable", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedMirrorReposClient) Disable(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	if s.Cache != nil {
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "MirrorRepos.Disable", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.MirrorReposClient.Disable(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "MirrorRepos.Disable", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedMirrorReposClient) GetStatus(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*MirrorStatus, error) {
+	if s.Cache != nil {
+		var cachedResult MirrorStatus
+		cached, err := s.Cache.Get(ctx, "MirrorRepos.GetStatus", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.MirrorReposClient.GetStatus(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "MirrorRepos.GetStatus", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+type CachedMirroredRepoSSHKeysServer struct{ MirroredRepoSSHKeysServer }
+
+func (s *CachedMirroredRepoSSHKeysServer) Create(ctx context.Context, in *MirroredRepoSSHKeysCreateOp) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.MirroredRepoSSHKeysServer.Create(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedMirroredRepoSSHKeysServer) Get(ctx context.Context, in *RepoSpec) (*SSHPrivateKey, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.MirroredRepoSSHKeysServer.Get(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedMirroredRepoSSHKeysServer) Delete(ctx context.Context, in *RepoSpec) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.MirroredRepoSSHKeysServer.Delete(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedMirroredRepoSSHKeysClient struct {
+	MirroredRepoSSHKeysClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedMirroredRepoSSHKeysClient) Create(ctx context.Context, in *MirroredRepoSSHKeysCreateOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	if s.Cache != nil {
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "MirroredRepoSSHKeys.Create", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.MirroredRepoSSHKeysClient.Create(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "MirroredRepoSSHKeys.Create", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedMirroredRepoSSHKeysClient) Get(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*SSHPrivateKey, error) {
+	if s.Cache != nil {
+		var cachedResult SSHPrivateKey
+		cached, err := s.Cache.Get(ctx, "MirroredRepoSSHKeys.Get", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.MirroredRepoSSHKeysClient.Get(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "MirroredRepoSSHKeys.Get", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedMirroredRepoSSHKeysClient) Delete(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	if s.Cache != nil {
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "MirroredRepoSSHKeys.Delete", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.MirroredRepoSSHKeysClient.Delete(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "MirroredRepoSSHKeys.Delete", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+type CachedNotifyServer struct{ NotifyServer }
+
+func (s *CachedNotifyServer) GenericEvent(ctx context.Context, in *NotifyGenericEvent) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.NotifyServer.GenericEvent(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedNotifyClient struct {
+	NotifyClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedNotifyClient) GenericEvent(ctx context.Context, in *NotifyGenericEvent, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	if s.Cache != nil {
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "Notify.GenericEvent", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.NotifyClient.GenericEvent(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Notify.GenericEvent", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+type CachedOrgsServer struct{ OrgsServer }
+
+func (s *CachedOrgsServer) Get(ctx context.Context, in *OrgSpec) (*Org, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.OrgsServer.Get(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedOrgsServer) List(ctx context.Context, in *OrgsListOp) (*OrgList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.OrgsServer.List(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedOrgsServer) ListMembers(ctx context.Context, in *OrgsListMembersOp) (*UserList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.OrgsServer.ListMembers(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedOrgsServer) ListTeams(ctx context.Context, in *OrgsListTeamsOp) (*TeamList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.OrgsServer.ListTeams(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedOrgsServer) GetTeam(ctx context.Context, in *TeamSpec) (*Team, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.OrgsServer.GetTeam(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedOrgsServer) ListTeamMembers(ctx context.Context, in *OrgsListTeamMembersOp) (*UserList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.OrgsServer.ListTeamMembers(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedOrgsServer) AddTeamRepo(ctx context.Context, in *OrgsAddTeamRepoOp) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.OrgsServer.AddTeamRepo(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedOrgsClient struct {
+	OrgsClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedOrgsClient) Get(ctx context.Context, in *OrgSpec, opts ...grpc.CallOption) (*Org, error) {
+	if s.Cache != nil {
+		var cachedResult Org
+		cached, err := s.Cache.Get(ctx, "Orgs.Get", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.OrgsClient.Get(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Orgs.Get", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedOrgsClient) List(ctx context.Context, in *OrgsListOp, opts ...grpc.CallOption) (*OrgList, error) {
+	if s.Cache != nil {
+		var cachedResult OrgList
+		cached, err := s.Cache.Get(ctx, "Orgs.List", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.OrgsClient.List(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Orgs.List", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedOrgsClient) ListMembers(ctx context.Context, in *OrgsListMembersOp, opts ...grpc.CallOption) (*UserList, error) {
+	if s.Cache != nil {
+		var cachedResult UserList
+		cached, err := s.Cache.Get(ctx, "Orgs.ListMembers", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.OrgsClient.ListMembers(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Orgs.ListMembers", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedOrgsClient) ListTeams(ctx context.Context, in *OrgsListTeamsOp, opts ...grpc.CallOption) (*TeamList, error) {
+	if s.Cache != nil {
+		var cachedResult TeamList
+		cached, err := s.Cache.Get(ctx, "Orgs.ListTeams", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.OrgsClient.ListTeams(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Orgs.ListTeams", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedOrgsClient) GetTeam(ctx context.Context, in *TeamSpec, opts ...grpc.CallOption) (*Team, error) {
+	if s.Cache != nil {
+		var cachedResult Team
+		cached, err := s.Cache.Get(ctx, "Orgs.GetTeam", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.OrgsClient.GetTeam(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Orgs.GetTeam", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedOrgsClient) ListTeamMembers(ctx context.Context, in *OrgsListTeamMembersOp, opts ...grpc.CallOption) (*UserList, error) {
+	if s.Cache != nil {
+		var cachedResult UserList
+		cached, err := s.Cache.Get(ctx, "Orgs.ListTeamMembers", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.OrgsClient.ListTeamMembers(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Orgs.ListTeamMembers", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedOrgsClient) AddTeamRepo(ctx context.Context, in *OrgsAddTeamRepoOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	if s.Cache != nil {
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "Orgs.AddTeamRepo", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.OrgsClient.AddTeamRepo(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Orgs.AddTeamRepo", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+type CachedPeopleServer struct{ PeopleServer }
+
+func (s *CachedPeopleServer) Get(ctx context.Context, in *PersonSpec) (*Person, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.PeopleServer.Get(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedPeopleClient struct {
+	PeopleClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedPeopleClient) Get(ctx context.Context, in *PersonSpec, opts ...grpc.CallOption) (*Person, error) {
+	if s.Cache != nil {
+		var cachedResult Person
+		cached, err := s.Cache.Get(ctx, "People.Get", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.PeopleClient.Get(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "People.Get", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+type CachedRegisteredClientsServer struct{ RegisteredClientsServer }
+
+func (s *CachedRegisteredClientsServer) Get(ctx context.Context, in *RegisteredClientSpec) (*RegisteredClient, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RegisteredClientsServer.Get(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedRegisteredClientsServer) GetCurrent(ctx context.Context, in *pbtypes.Void) (*RegisteredClient, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RegisteredClientsServer.GetCurrent(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedRegisteredClientsServer) Create(ctx context.Context, in *RegisteredClient) (*RegisteredClient, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RegisteredClientsServer.Create(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedRegisteredClientsServer) Update(ctx context.Context, in *RegisteredClient) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RegisteredClientsServer.Update(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedRegisteredClientsServer) Delete(ctx context.Context, in *RegisteredClientSpec) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RegisteredClientsServer.Delete(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedRegisteredClientsServer) List(ctx context.Context, in *RegisteredClientListOptions) (*RegisteredClientList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RegisteredClientsServer.List(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedRegisteredClientsServer) GetUserPermissions(ctx context.Context, in *UserPermissionsOptions) (*UserPermissions, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RegisteredClientsServer.GetUserPermissions(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedRegisteredClientsServer) SetUserPermissions(ctx context.Context, in *UserPermissions) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RegisteredClientsServer.SetUserPermissions(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedRegisteredClientsServer) ListUserPermissions(ctx context.Context, in *RegisteredClientSpec) (*UserPermissionsList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RegisteredClientsServer.ListUserPermissions(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedRegisteredClientsClient struct {
+	RegisteredClientsClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedRegisteredClientsClient) Get(ctx context.Context, in *RegisteredClientSpec, opts ...grpc.CallOption) (*RegisteredClient, error) {
+	if s.Cache != nil {
+		var cachedResult RegisteredClient
+		cached, err := s.Cache.Get(ctx, "RegisteredClients.Get", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.RegisteredClientsClient.Get(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "RegisteredClients.Get", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedRegisteredClientsClient) GetCurrent(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*RegisteredClient, error) {
+	if s.Cache != nil {
+		var cachedResult RegisteredClient
+		cached, err := s.Cache.Get(ctx, "RegisteredClients.GetCurrent", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.RegisteredClientsClient.GetCurrent(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "RegisteredClients.GetCurrent", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedRegisteredClientsClient) Create(ctx context.Context, in *RegisteredClient, opts ...grpc.CallOption) (*RegisteredClient, error) {
+	if s.Cache != nil {
+		var cachedResult RegisteredClient
+		cached, err := s.Cache.Get(ctx, "RegisteredClients.Create", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.RegisteredClientsClient.Create(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "RegisteredClients.Create", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedRegisteredClientsClient) Update(ctx context.Context, in *RegisteredClient, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	if s.Cache != nil {
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "RegisteredClients.Update", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.RegisteredClientsClient.Update(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "RegisteredClients.Update", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedRegisteredClientsClient) Delete(ctx context.Context, in *RegisteredClientSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	if s.Cache != nil {
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "RegisteredClients.Delete", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.RegisteredClientsClient.Delete(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "RegisteredClients.Delete", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedRegisteredClientsClient) List(ctx context.Context, in *RegisteredClientListOptions, opts ...grpc.CallOption) (*RegisteredClientList, error) {
+	if s.Cache != nil {
+		var cachedResult RegisteredClientList
+		cached, err := s.Cache.Get(ctx, "RegisteredClients.List", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.RegisteredClientsClient.List(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "RegisteredClients.List", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedRegisteredClientsClient) GetUserPermissions(ctx context.Context, in *UserPermissionsOptions, opts ...grpc.CallOption) (*UserPermissions, error) {
+	if s.Cache != nil {
+		var cachedResult UserPermissions
+		cached, err := s.Cache.Get(ctx, "RegisteredClients.GetUserPermissions", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.RegisteredClientsClient.GetUserPermissions(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "RegisteredClients.GetUserPermissions", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedRegisteredClientsClient) SetUserPermissions(ctx context.Context, in *UserPermissions, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	if s.Cache != nil {
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "RegisteredClients.SetUserPermissions", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.RegisteredClientsClient.SetUserPermissions(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "RegisteredClients.SetUserPermissions", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedRegisteredClientsClient) ListUserPermissions(ctx context.Context, in *RegisteredClientSpec, opts ...grpc.CallOption) (*UserPermissionsList, error) {
+	if s.Cache != nil {
+		var cachedResult UserPermissionsList
+		cached, err := s.Cache.Get(ctx, "RegisteredClients.ListUserPermissions", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.RegisteredClientsClient.ListUserPermissions(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "RegisteredClients.ListUserPermissions", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+type CachedRepoBadgesServer struct{ RepoBadgesServer }
+
+func (s *CachedRepoBadgesServer) ListBadges(ctx context.Context, in *RepoSpec) (*BadgeList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RepoBadgesServer.ListBadges(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedRepoBadgesServer) ListCounters(ctx context.Context, in *RepoSpec) (*CounterList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RepoBadgesServer.ListCounters(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedRepoBadgesServer) RecordHit(ctx context.Context, in *RepoSpec) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RepoBadgesServer.RecordHit(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedRepoBadgesServer) CountHits(ctx context.Context, in *RepoBadgesCountHitsOp) (*RepoBadgesCountHitsResult, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RepoBadgesServer.CountHits(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedRepoBadgesServer) CreateBadge(ctx context.Context, in *RepoBadgesCreateOp) (*Badge, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RepoBadgesServer.CreateBadge(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedRepoBadgesServer) DeleteBadge(ctx context.Context, in *RepoBadgesDeleteOp) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RepoBadgesServer.DeleteBadge(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedRepoBadgesServer) CreateCounter(ctx context.Context, in *RepoBadgesCreateOp) (*Counter, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RepoBadgesServer.CreateCounter(ctx, in)
+	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
 	}
-	return result, err
+	return result, err
+}
+
+func (s *CachedRepoBadgesServer) DeleteCounter(ctx context.Context, in *RepoBadgesDeleteOp) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RepoBadgesServer.DeleteCounter(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedRepoBadgesClient struct {
+	RepoBadgesClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedRepoBadgesClient) ListBadges(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*BadgeList, error) {
+	if s.Cache != nil {
+		var cachedResult BadgeList
+		cached, err := s.Cache.Get(ctx, "RepoBadges.ListBadges", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.RepoBadgesClient.ListBadges(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "RepoBadges.ListBadges", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedRepoBadgesClient) ListCounters(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*CounterList, error) {
+	if s.Cache != nil {
+		var cachedResult CounterList
+		cached, err := s.Cache.Get(ctx, "RepoBadges.ListCounters", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.RepoBadgesClient.ListCounters(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "RepoBadges.ListCounters", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
 }
 
-type CachedDiscussionsClient struct {
-	DiscussionsClient
-	Cache *grpccache.Cache
+func (s *CachedRepoBadgesClient) RecordHit(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	if s.Cache != nil {
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "RepoBadges.RecordHit", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.RepoBadgesClient.RecordHit(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "RepoBadges.RecordHit", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
 }
 
-func (s *CachedDiscussionsClient) Create(ctx context.Context, in *Discussion, opts ...grpc.CallOption) (*Discussion, error) {
+func (s *CachedRepoBadgesClient) CountHits(ctx context.Context, in *RepoBadgesCountHitsOp, opts ...grpc.CallOption) (*RepoBadgesCountHitsResult, error) {
 	if s.Cache != nil {
-		var cachedResult Discussion
-		cached, err := s.Cache.Get(ctx, "Discussions.Create", in, &cachedResult)
+		var cachedResult RepoBadgesCountHitsResult
+		cached, err := s.Cache.Get(ctx, "RepoBadges.CountHits", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1619,22 +4684,22 @@ func (s *CachedDiscussionsClient) Create(ctx context.Context, in *Discussion, op
 
 	var trailer metadata.MD
 
-	result, err := s.DiscussionsClient.Create(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.RepoBadgesClient.CountHits(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Discussions.Create", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "RepoBadges.CountHits", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedDiscussionsClient) Get(ctx context.Context, in *DiscussionSpec, opts ...grpc.CallOption) (*Discussion, error) {
+func (s *CachedRepoBadgesClient) CreateBadge(ctx context.Context, in *RepoBadgesCreateOp, opts ...grpc.CallOption) (*Badge, error) {
 	if s.Cache != nil {
-		var cachedResult Discussion
-		cached, err := s.Cache.Get(ctx, "Discussions.Get", in, &cachedResult)
+		var cachedResult Badge
+		cached, err := s.Cache.Get(ctx, "RepoBadges.CreateBadge", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1645,22 +4710,22 @@ func (s *CachedDiscussionsClient) Get(ctx context.Context, in *DiscussionSpec, o
 
 	var trailer metadata.MD
 
-	result, err := s.DiscussionsClient.Get(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.RepoBadgesClient.CreateBadge(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Discussions.Get", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "RepoBadges.CreateBadge", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedDiscussionsClient) List(ctx context.Context, in *DiscussionListOp, opts ...grpc.CallOption) (*DiscussionList, error) {
+func (s *CachedRepoBadgesClient) DeleteBadge(ctx context.Context, in *RepoBadgesDeleteOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
 	if s.Cache != nil {
-		var cachedResult DiscussionList
-		cached, err := s.Cache.Get(ctx, "Discussions.List", in, &cachedResult)
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "RepoBadges.DeleteBadge", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1671,22 +4736,22 @@ func (s *CachedDiscussionsClient) List(ctx context.Context, in *DiscussionListOp
 
 	var trailer metadata.MD
 
-	result, err := s.DiscussionsClient.List(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.RepoBadgesClient.DeleteBadge(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Discussions.List", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "RepoBadges.DeleteBadge", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedDiscussionsClient) CreateComment(ctx context.Context, in *DiscussionCommentCreateOp, opts ...grpc.CallOption) (*DiscussionComment, error) {
+func (s *CachedRepoBadgesClient) CreateCounter(ctx context.Context, in *RepoBadgesCreateOp, opts ...grpc.CallOption) (*Counter, error) {
 	if s.Cache != nil {
-		var cachedResult DiscussionComment
-		cached, err := s.Cache.Get(ctx, "Discussions.CreateComment", in, &cachedResult)
+		var cachedResult Counter
+		cached, err := s.Cache.Get(ctx, "RepoBadges.CreateCounter", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1697,22 +4762,22 @@ func (s *CachedDiscussionsClient) CreateComment(ctx context.Context, in *Discuss
 
 	var trailer metadata.MD
 
-	result, err := s.DiscussionsClient.CreateComment(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.RepoBadgesClient.CreateCounter(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Discussions.CreateComment", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "RepoBadges.CreateCounter", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedDiscussionsClient) UpdateRating(ctx context.Context, in *DiscussionRatingUpdateOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+func (s *CachedRepoBadgesClient) DeleteCounter(ctx context.Context, in *RepoBadgesDeleteOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
 	if s.Cache != nil {
 		var cachedResult pbtypes.Void
-		cached, err := s.Cache.Get(ctx, "Discussions.UpdateRating", in, &cachedResult)
+		cached, err := s.Cache.Get(ctx, "RepoBadges.DeleteCounter", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1723,23 +4788,23 @@ func (s *CachedDiscussionsClient) UpdateRating(ctx context.Context, in *Discussi
 
 	var trailer metadata.MD
 
-	result, err := s.DiscussionsClient.UpdateRating(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.RepoBadgesClient.DeleteCounter(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Discussions.UpdateRating", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "RepoBadges.DeleteCounter", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-type CachedGraphUplinkServer struct{ GraphUplinkServer }
+type CachedRepoDependenciesServer struct{ RepoDependenciesServer }
 
-func (s *CachedGraphUplinkServer) Push(ctx context.Context, in *MetricsSnapshot) (*pbtypes.Void, error) {
+func (s *CachedRepoDependenciesServer) ListDependencies(ctx context.Context, in *RepoDependenciesListOp) (*RepoDependencyList, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.GraphUplinkServer.Push(ctx, in)
+	result, err := s.RepoDependenciesServer.ListDependencies(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -1748,9 +4813,9 @@ func (s *CachedGraphUplinkServer) Push(ctx context.Context, in *MetricsSnapshot)
 	return result, err
 }
 
-func (s *CachedGraphUplinkServer) PushEvents(ctx context.Context, in *UserEventList) (*pbtypes.Void, error) {
+func (s *CachedRepoDependenciesServer) ListDependents(ctx context.Context, in *RepoDependenciesListOp) (*RepoDependencyList, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.GraphUplinkServer.PushEvents(ctx, in)
+	result, err := s.RepoDependenciesServer.ListDependents(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -1759,15 +4824,15 @@ func (s *CachedGraphUplinkServer) PushEvents(ctx context.Context, in *UserEventL
 	return result, err
 }
 
-type CachedGraphUplinkClient struct {
-	GraphUplinkClient
+type CachedRepoDependenciesClient struct {
+	RepoDependenciesClient
 	Cache *grpccache.Cache
 }
 
-func (s *CachedGraphUplinkClient) Push(ctx context.Context, in *MetricsSnapshot, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+func (s *CachedRepoDependenciesClient) ListDependencies(ctx context.Context, in *RepoDependenciesListOp, opts ...grpc.CallOption) (*RepoDependencyList, error) {
 	if s.Cache != nil {
-		var cachedResult pbtypes.Void
-		cached, err := s.Cache.Get(ctx, "GraphUplink.Push", in, &cachedResult)
+		var cachedResult RepoDependencyList
+		cached, err := s.Cache.Get(ctx, "RepoDependencies.ListDependencies", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1778,22 +4843,22 @@ func (s *CachedGraphUplinkClient) Push(ctx context.Context, in *MetricsSnapshot,
 
 	var trailer metadata.MD
 
-	result, err := s.GraphUplinkClient.Push(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.RepoDependenciesClient.ListDependencies(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "GraphUplink.Push", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "RepoDependencies.ListDependencies", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedGraphUplinkClient) PushEvents(ctx context.Context, in *UserEventList, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+func (s *CachedRepoDependenciesClient) ListDependents(ctx context.Context, in *RepoDependenciesListOp, opts ...grpc.CallOption) (*RepoDependencyList, error) {
 	if s.Cache != nil {
-		var cachedResult pbtypes.Void
-		cached, err := s.Cache.Get(ctx, "GraphUplink.PushEvents", in, &cachedResult)
+		var cachedResult RepoDependencyList
+		cached, err := s.Cache.Get(ctx, "RepoDependencies.ListDependents", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1804,23 +4869,23 @@ func (s *CachedGraphUplinkClient) PushEvents(ctx context.Context, in *UserEventL
 
 	var trailer metadata.MD
 
-	result, err := s.GraphUplinkClient.PushEvents(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.RepoDependenciesClient.ListDependents(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "GraphUplink.PushEvents", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "RepoDependencies.ListDependents", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-type CachedMarkdownServer struct{ MarkdownServer }
+type CachedRepoStatusesServer struct{ RepoStatusesServer }
 
-func (s *CachedMarkdownServer) Render(ctx context.Context, in *MarkdownRenderOp) (*MarkdownData, error) {
+func (s *CachedRepoStatusesServer) GetCombined(ctx context.Context, in *RepoRevSpec) (*CombinedStatus, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.MarkdownServer.Render(ctx, in)
+	result, err := s.RepoStatusesServer.GetCombined(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -1829,42 +4894,9 @@ func (s *CachedMarkdownServer) Render(ctx context.Context, in *MarkdownRenderOp)
 	return result, err
 }
 
-type CachedMarkdownClient struct {
-	MarkdownClient
-	Cache *grpccache.Cache
-}
-
-func (s *CachedMarkdownClient) Render(ctx context.Context, in *MarkdownRenderOp, opts ...grpc.CallOption) (*MarkdownData, error) {
-	if s.Cache != nil {
-		var cachedResult MarkdownData
-		cached, err := s.Cache.Get(ctx, "Markdown.Render", in, &cachedResult)
-		if err != nil {
-			return nil, err
-		}
-		if cached {
-			return &cachedResult, nil
-		}
-	}
-
-	var trailer metadata.MD
-
-	result, err := s.MarkdownClient.Render(ctx, in, grpc.Trailer(&trailer))
-	if err != nil {
-		return nil, err
-	}
-	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Markdown.Render", in, result, trailer); err != nil {
-			return nil, err
-		}
-	}
-	return result, nil
-}
-
-type CachedMetaServer struct{ MetaServer }
-
-func (s *CachedMetaServer) Status(ctx context.Context, in *pbtypes.Void) (*ServerStatus, error) {
+func (s *CachedRepoStatusesServer) Create(ctx context.Context, in *RepoStatusesCreateOp) (*RepoStatus, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.MetaServer.Status(ctx, in)
+	result, err := s.RepoStatusesServer.Create(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -1873,9 +4905,9 @@ func (s *CachedMetaServer) Status(ctx context.Context, in *pbtypes.Void) (*Serve
 	return result, err
 }
 
-func (s *CachedMetaServer) Config(ctx context.Context, in *pbtypes.Void) (*ServerConfig, error) {
+func (s *CachedRepoStatusesServer) GetRollup(ctx context.Context, in *RepoStatusesGetRollupOp) (*CombinedStatus, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.MetaServer.Config(ctx, in)
+	result, err := s.RepoStatusesServer.GetRollup(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -1884,9 +4916,9 @@ func (s *CachedMetaServer) Config(ctx context.Context, in *pbtypes.Void) (*Serve
 	return result, err
 }
 
-func (s *CachedMetaServer) PubKey(ctx context.Context, in *pbtypes.Void) (*ServerPubKey, error) {
+func (s *CachedRepoStatusesServer) List(ctx context.Context, in *RepoStatusesListOp) (*RepoStatusList, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.MetaServer.PubKey(ctx, in)
+	result, err := s.RepoStatusesServer.List(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -1895,15 +4927,15 @@ func (s *CachedMetaServer) PubKey(ctx context.Context, in *pbtypes.Void) (*Serve
 	return result, err
 }
 
-type CachedMetaClient struct {
-	MetaClient
+type CachedRepoStatusesClient struct {
+	RepoStatusesClient
 	Cache *grpccache.Cache
 }
 
-func (s *CachedMetaClient) Status(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*ServerStatus, error) {
+func (s *CachedRepoStatusesClient) GetCombined(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*CombinedStatus, error) {
 	if s.Cache != nil {
-		var cachedResult ServerStatus
-		cached, err := s.Cache.Get(ctx, "Meta.Status", in, &cachedResult)
+		var cachedResult CombinedStatus
+		cached, err := s.Cache.Get(ctx, "RepoStatuses.GetCombined", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1914,22 +4946,22 @@ func (s *CachedMetaClient) Status(ctx context.Context, in *pbtypes.Void, opts ..
 
 	var trailer metadata.MD
 
-	result, err := s.MetaClient.Status(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.RepoStatusesClient.GetCombined(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Meta.Status", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "RepoStatuses.GetCombined", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedMetaClient) Config(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*ServerConfig, error) {
+func (s *CachedRepoStatusesClient) Create(ctx context.Context, in *RepoStatusesCreateOp, opts ...grpc.CallOption) (*RepoStatus, error) {
 	if s.Cache != nil {
-		var cachedResult ServerConfig
-		cached, err := s.Cache.Get(ctx, "Meta.Config", in, &cachedResult)
+		var cachedResult RepoStatus
+		cached, err := s.Cache.Get(ctx, "RepoStatuses.Create", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1940,22 +4972,22 @@ func (s *CachedMetaClient) Config(ctx context.Context, in *pbtypes.Void, opts ..
 
 	var trailer metadata.MD
 
-	result, err := s.MetaClient.Config(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.RepoStatusesClient.Create(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Meta.Config", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "RepoStatuses.Create", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedMetaClient) PubKey(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*ServerPubKey, error) {
+func (s *CachedRepoStatusesClient) GetRollup(ctx context.Context, in *RepoStatusesGetRollupOp, opts ...grpc.CallOption) (*CombinedStatus, error) {
 	if s.Cache != nil {
-		var cachedResult ServerPubKey
-		cached, err := s.Cache.Get(ctx, "Meta.PubKey", in, &cachedResult)
+		var cachedResult CombinedStatus
+		cached, err := s.Cache.Get(ctx, "RepoStatuses.GetRollup", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -1966,40 +4998,22 @@ func (s *CachedMetaClient) PubKey(ctx context.Context, in *pbtypes.Void, opts ..
 
 	var trailer metadata.MD
 
-	result, err := s.MetaClient.PubKey(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.RepoStatusesClient.GetRollup(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Meta.PubKey", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "RepoStatuses.GetRollup", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-type CachedMirrorReposServer struct{ MirrorReposServer }
-
-func (s *CachedMirrorReposServer) RefreshVCS(ctx context.Context, in *MirrorReposRefreshVCSOp) (*pbtypes.Void, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.MirrorReposServer.RefreshVCS(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
-			return nil, err
-		}
-	}
-	return result, err
-}
-
-type CachedMirrorReposClient struct {
-	MirrorReposClient
-	Cache *grpccache.Cache
-}
-
-func (s *CachedMirrorReposClient) RefreshVCS(ctx context.Context, in *MirrorReposRefreshVCSOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+func (s *CachedRepoStatusesClient) List(ctx context.Context, in *RepoStatusesListOp, opts ...grpc.CallOption) (*RepoStatusList, error) {
 	if s.Cache != nil {
-		var cachedResult pbtypes.Void
-		cached, err := s.Cache.Get(ctx, "MirrorRepos.RefreshVCS", in, &cachedResult)
+		var cachedResult RepoStatusList
+		cached, err := s.Cache.Get(ctx, "RepoStatuses.List", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2010,23 +5024,23 @@ func (s *CachedMirrorReposClient) RefreshVCS(ctx context.Context, in *MirrorRepo
 
 	var trailer metadata.MD
 
-	result, err := s.MirrorReposClient.RefreshVCS(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.RepoStatusesClient.List(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "MirrorRepos.RefreshVCS", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "RepoStatuses.List", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-type CachedMirroredRepoSSHKeysServer struct{ MirroredRepoSSHKeysServer }
+type CachedRepoTreeServer struct{ RepoTreeServer }
 
-func (s *CachedMirroredRepoSSHKeysServer) Create(ctx context.Context, in *MirroredRepoSSHKeysCreateOp) (*pbtypes.Void, error) {
+func (s *CachedRepoTreeServer) Get(ctx context.Context, in *RepoTreeGetOp) (*TreeEntry, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.MirroredRepoSSHKeysServer.Create(ctx, in)
+	result, err := s.RepoTreeServer.Get(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -2035,9 +5049,9 @@ func (s *CachedMirroredRepoSSHKeysServer) Create(ctx context.Context, in *Mirror
 	return result, err
 }
 
-func (s *CachedMirroredRepoSSHKeysServer) Get(ctx context.Context, in *RepoSpec) (*SSHPrivateKey, error) {
+func (s *CachedRepoTreeServer) Search(ctx context.Context, in *RepoTreeSearchOp) (*VCSSearchResultList, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.MirroredRepoSSHKeysServer.Get(ctx, in)
+	result, err := s.RepoTreeServer.Search(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -2046,9 +5060,9 @@ func (s *CachedMirroredRepoSSHKeysServer) Get(ctx context.Context, in *RepoSpec)
 	return result, err
 }
 
-func (s *CachedMirroredRepoSSHKeysServer) Delete(ctx context.Context, in *RepoSpec) (*pbtypes.Void, error) {
+func (s *CachedRepoTreeServer) List(ctx context.Context, in *RepoTreeListOp) (*RepoTreeListResult, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.MirroredRepoSSHKeysServer.Delete(ctx, in)
+	result, err := s.RepoTreeServer.List(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -2057,15 +5071,26 @@ func (s *CachedMirroredRepoSSHKeysServer) Delete(ctx context.Context, in *RepoSp
 	return result, err
 }
 
-type CachedMirroredRepoSSHKeysClient struct {
-	MirroredRepoSSHKeysClient
+func (s *CachedRepoTreeServer) ListFileDefs(ctx context.Context, in *RepoTreeListFileDefsOp) (*FileDefList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.RepoTreeServer.ListFileDefs(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedRepoTreeClient struct {
+	RepoTreeClient
 	Cache *grpccache.Cache
 }
 
-func (s *CachedMirroredRepoSSHKeysClient) Create(ctx context.Context, in *MirroredRepoSSHKeysCreateOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+func (s *CachedRepoTreeClient) Get(ctx context.Context, in *RepoTreeGetOp, opts ...grpc.CallOption) (*TreeEntry, error) {
 	if s.Cache != nil {
-		var cachedResult pbtypes.Void
-		cached, err := s.Cache.Get(ctx, "MirroredRepoSSHKeys.Create", in, &cachedResult)
+		var cachedResult TreeEntry
+		cached, err := s.Cache.Get(ctx, "RepoTree.Get", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2076,22 +5101,22 @@ func (s *CachedMirroredRepoSSHKeysClient) Create(ctx context.Context, in *Mirror
 
 	var trailer metadata.MD
 
-	result, err := s.MirroredRepoSSHKeysClient.Create(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.RepoTreeClient.Get(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "MirroredRepoSSHKeys.Create", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "RepoTree.Get", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedMirroredRepoSSHKeysClient) Get(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*SSHPrivateKey, error) {
+func (s *CachedRepoTreeClient) Search(ctx context.Context, in *RepoTreeSearchOp, opts ...grpc.CallOption) (*VCSSearchResultList, error) {
 	if s.Cache != nil {
-		var cachedResult SSHPrivateKey
-		cached, err := s.Cache.Get(ctx, "MirroredRepoSSHKeys.Get", in, &cachedResult)
+		var cachedResult VCSSearchResultList
+		cached, err := s.Cache.Get(ctx, "RepoTree.Search", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2102,22 +5127,22 @@ func (s *CachedMirroredRepoSSHKeysClient) Get(ctx context.Context, in *RepoSpec,
 
 	var trailer metadata.MD
 
-	result, err := s.MirroredRepoSSHKeysClient.Get(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.RepoTreeClient.Search(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "MirroredRepoSSHKeys.Get", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "RepoTree.Search", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedMirroredRepoSSHKeysClient) Delete(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+func (s *CachedRepoTreeClient) List(ctx context.Context, in *RepoTreeListOp, opts ...grpc.CallOption) (*RepoTreeListResult, error) {
 	if s.Cache != nil {
-		var cachedResult pbtypes.Void
-		cached, err := s.Cache.Get(ctx, "MirroredRepoSSHKeys.Delete", in, &cachedResult)
+		var cachedResult RepoTreeListResult
+		cached, err := s.Cache.Get(ctx, "RepoTree.List", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2128,40 +5153,22 @@ func (s *CachedMirroredRepoSSHKeysClient) Delete(ctx context.Context, in *RepoSp
 
 	var trailer metadata.MD
 
-	result, err := s.MirroredRepoSSHKeysClient.Delete(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.RepoTreeClient.List(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "MirroredRepoSSHKeys.Delete", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "RepoTree.List", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-type CachedNotifyServer struct{ NotifyServer }
-
-func (s *CachedNotifyServer) GenericEvent(ctx context.Context, in *NotifyGenericEvent) (*pbtypes.Void, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.NotifyServer.GenericEvent(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
-			return nil, err
-		}
-	}
-	return result, err
-}
-
-type CachedNotifyClient struct {
-	NotifyClient
-	Cache *grpccache.Cache
-}
-
-func (s *CachedNotifyClient) GenericEvent(ctx context.Context, in *NotifyGenericEvent, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+func (s *CachedRepoTreeClient) ListFileDefs(ctx context.Context, in *RepoTreeListFileDefsOp, opts ...grpc.CallOption) (*FileDefList, error) {
 	if s.Cache != nil {
-		var cachedResult pbtypes.Void
-		cached, err := s.Cache.Get(ctx, "Notify.GenericEvent", in, &cachedResult)
+		var cachedResult FileDefList
+		cached, err := s.Cache.Get(ctx, "RepoTree.ListFileDefs", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2172,23 +5179,23 @@ func (s *CachedNotifyClient) GenericEvent(ctx context.Context, in *NotifyGeneric
 
 	var trailer metadata.MD
 
-	result, err := s.NotifyClient.GenericEvent(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.RepoTreeClient.ListFileDefs(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Notify.GenericEvent", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "RepoTree.ListFileDefs", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-type CachedOrgsServer struct{ OrgsServer }
+type CachedReposServer struct{ ReposServer }
 
-func (s *CachedOrgsServer) Get(ctx context.Context, in *OrgSpec) (*Org, error) {
+func (s *CachedReposServer) Get(ctx context.Context, in *RepoSpec) (*Repo, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.OrgsServer.Get(ctx, in)
+	result, err := s.ReposServer.Get(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -2197,9 +5204,9 @@ func (s *CachedOrgsServer) Get(ctx context.Context, in *OrgSpec) (*Org, error) {
 	return result, err
 }
 
-func (s *CachedOrgsServer) List(ctx context.Context, in *OrgsListOp) (*OrgList, error) {
+func (s *CachedReposServer) List(ctx context.Context, in *RepoListOptions) (*RepoList, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.OrgsServer.List(ctx, in)
+	result, err := s.ReposServer.List(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -2208,9 +5215,9 @@ func (s *CachedOrgsServer) List(ctx context.Context, in *OrgsListOp) (*OrgList,
 	return result, err
 }
 
-func (s *CachedOrgsServer) ListMembers(ctx context.Context, in *OrgsListMembersOp) (*UserList, error) {
+func (s *CachedReposServer) Create(ctx context.Context, in *ReposCreateOp) (*Repo, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.OrgsServer.ListMembers(ctx, in)
+	result, err := s.ReposServer.Create(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -2219,94 +5226,86 @@ func (s *CachedOrgsServer) ListMembers(ctx context.Context, in *OrgsListMembersO
 	return result, err
 }
 
-type CachedOrgsClient struct {
-	OrgsClient
-	Cache *grpccache.Cache
+func (s *CachedReposServer) Update(ctx context.Context, in *ReposUpdateOp) (*Repo, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.Update(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
 }
 
-func (s *CachedOrgsClient) Get(ctx context.Context, in *OrgSpec, opts ...grpc.CallOption) (*Org, error) {
-	if s.Cache != nil {
-		var cachedResult Org
-		cached, err := s.Cache.Get(ctx, "Orgs.Get", in, &cachedResult)
-		if err != nil {
+func (s *CachedReposServer) Delete(ctx context.Context, in *RepoSpec) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.Delete(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
-		if cached {
-			return &cachedResult, nil
-		}
 	}
+	return result, err
+}
 
-	var trailer metadata.MD
-
-	result, err := s.OrgsClient.Get(ctx, in, grpc.Trailer(&trailer))
-	if err != nil {
-		return nil, err
-	}
-	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Orgs.Get", in, result, trailer); err != nil {
+func (s *CachedReposServer) GetReadme(ctx context.Context, in *ReposGetReadmeOp) (*Readme, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.GetReadme(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
 	}
-	return result, nil
+	return result, err
 }
 
-func (s *CachedOrgsClient) List(ctx context.Context, in *OrgsListOp, opts ...grpc.CallOption) (*OrgList, error) {
-	if s.Cache != nil {
-		var cachedResult OrgList
-		cached, err := s.Cache.Get(ctx, "Orgs.List", in, &cachedResult)
-		if err != nil {
+func (s *CachedReposServer) GetInventory(ctx context.Context, in *RepoRevSpec) (*Inventory, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.GetInventory(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
-		if cached {
-			return &cachedResult, nil
-		}
 	}
+	return result, err
+}
 
-	var trailer metadata.MD
-
-	result, err := s.OrgsClient.List(ctx, in, grpc.Trailer(&trailer))
-	if err != nil {
-		return nil, err
-	}
-	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Orgs.List", in, result, trailer); err != nil {
+func (s *CachedReposServer) GetStatsHistory(ctx context.Context, in *ReposGetStatsHistoryOp) (*RepoStatsHistory, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.GetStatsHistory(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
 	}
-	return result, nil
+	return result, err
 }
 
-func (s *CachedOrgsClient) ListMembers(ctx context.Context, in *OrgsListMembersOp, opts ...grpc.CallOption) (*UserList, error) {
-	if s.Cache != nil {
-		var cachedResult UserList
-		cached, err := s.Cache.Get(ctx, "Orgs.ListMembers", in, &cachedResult)
-		if err != nil {
+func (s *CachedReposServer) Enable(ctx context.Context, in *RepoSpec) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.Enable(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
-		if cached {
-			return &cachedResult, nil
-		}
 	}
+	return result, err
+}
 
-	var trailer metadata.MD
-
-	result, err := s.OrgsClient.ListMembers(ctx, in, grpc.Trailer(&trailer))
-	if err != nil {
-		return nil, err
-	}
-	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Orgs.ListMembers", in, result, trailer); err != nil {
+func (s *CachedReposServer) Disable(ctx context.Context, in *RepoSpec) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.Disable(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
 	}
-	return result, nil
+	return result, err
 }
 
-type CachedPeopleServer struct{ PeopleServer }
-
-func (s *CachedPeopleServer) Get(ctx context.Context, in *PersonSpec) (*Person, error) {
+func (s *CachedReposServer) GetConfig(ctx context.Context, in *RepoSpec) (*RepoConfig, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.PeopleServer.Get(ctx, in)
+	result, err := s.ReposServer.GetConfig(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -2315,42 +5314,86 @@ func (s *CachedPeopleServer) Get(ctx context.Context, in *PersonSpec) (*Person,
 	return result, err
 }
 
-type CachedPeopleClient struct {
-	PeopleClient
-	Cache *grpccache.Cache
+func (s *CachedReposServer) ListCollaborators(ctx context.Context, in *ReposListCollaboratorsOp) (*CollaboratorList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.ListCollaborators(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
 }
 
-func (s *CachedPeopleClient) Get(ctx context.Context, in *PersonSpec, opts ...grpc.CallOption) (*Person, error) {
-	if s.Cache != nil {
-		var cachedResult Person
-		cached, err := s.Cache.Get(ctx, "People.Get", in, &cachedResult)
-		if err != nil {
+func (s *CachedReposServer) AddCollaborator(ctx context.Context, in *ReposAddCollaboratorOp) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.AddCollaborator(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
-		if cached {
-			return &cachedResult, nil
+	}
+	return result, err
+}
+
+func (s *CachedReposServer) RemoveCollaborator(ctx context.Context, in *ReposRemoveCollaboratorOp) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.RemoveCollaborator(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
 		}
 	}
+	return result, err
+}
 
-	var trailer metadata.MD
+func (s *CachedReposServer) GetPermissions(ctx context.Context, in *ReposGetPermissionsOp) (*RepoPermissions, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.GetPermissions(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
 
-	result, err := s.PeopleClient.Get(ctx, in, grpc.Trailer(&trailer))
-	if err != nil {
-		return nil, err
+func (s *CachedReposServer) ListKeys(ctx context.Context, in *ReposListKeysOp) (*DeployKeyList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.ListKeys(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
 	}
-	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "People.Get", in, result, trailer); err != nil {
+	return result, err
+}
+
+func (s *CachedReposServer) AddKey(ctx context.Context, in *ReposAddKeyOp) (*DeployKey, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.AddKey(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
 	}
-	return result, nil
+	return result, err
 }
 
-type CachedRegisteredClientsServer struct{ RegisteredClientsServer }
+func (s *CachedReposServer) DeleteKey(ctx context.Context, in *ReposDeleteKeyOp) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.DeleteKey(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
 
-func (s *CachedRegisteredClientsServer) Get(ctx context.Context, in *RegisteredClientSpec) (*RegisteredClient, error) {
+func (s *CachedReposServer) Watch(ctx context.Context, in *ReposWatchOp) (*RepoSubscription, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RegisteredClientsServer.Get(ctx, in)
+	result, err := s.ReposServer.Watch(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -2359,9 +5402,9 @@ func (s *CachedRegisteredClientsServer) Get(ctx context.Context, in *RegisteredC
 	return result, err
 }
 
-func (s *CachedRegisteredClientsServer) GetCurrent(ctx context.Context, in *pbtypes.Void) (*RegisteredClient, error) {
+func (s *CachedReposServer) Unwatch(ctx context.Context, in *RepoSpec) (*pbtypes.Void, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RegisteredClientsServer.GetCurrent(ctx, in)
+	result, err := s.ReposServer.Unwatch(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -2370,9 +5413,9 @@ func (s *CachedRegisteredClientsServer) GetCurrent(ctx context.Context, in *pbty
 	return result, err
 }
 
-func (s *CachedRegisteredClientsServer) Create(ctx context.Context, in *RegisteredClient) (*RegisteredClient, error) {
+func (s *CachedReposServer) GetSubscription(ctx context.Context, in *RepoSpec) (*RepoSubscription, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RegisteredClientsServer.Create(ctx, in)
+	result, err := s.ReposServer.GetSubscription(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -2381,9 +5424,9 @@ func (s *CachedRegisteredClientsServer) Create(ctx context.Context, in *Register
 	return result, err
 }
 
-func (s *CachedRegisteredClientsServer) Update(ctx context.Context, in *RegisteredClient) (*pbtypes.Void, error) {
+func (s *CachedReposServer) ListWatched(ctx context.Context, in *ReposListWatchedOp) (*RepoList, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RegisteredClientsServer.Update(ctx, in)
+	result, err := s.ReposServer.ListWatched(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -2392,9 +5435,9 @@ func (s *CachedRegisteredClientsServer) Update(ctx context.Context, in *Register
 	return result, err
 }
 
-func (s *CachedRegisteredClientsServer) Delete(ctx context.Context, in *RegisteredClientSpec) (*pbtypes.Void, error) {
+func (s *CachedReposServer) GetCommit(ctx context.Context, in *RepoRevSpec) (*vcs.Commit, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RegisteredClientsServer.Delete(ctx, in)
+	result, err := s.ReposServer.GetCommit(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -2403,9 +5446,9 @@ func (s *CachedRegisteredClientsServer) Delete(ctx context.Context, in *Register
 	return result, err
 }
 
-func (s *CachedRegisteredClientsServer) List(ctx context.Context, in *RegisteredClientListOptions) (*RegisteredClientList, error) {
+func (s *CachedReposServer) GetCommitDetail(ctx context.Context, in *ReposGetCommitOp) (*CommitDetail, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RegisteredClientsServer.List(ctx, in)
+	result, err := s.ReposServer.GetCommitDetail(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -2414,9 +5457,9 @@ func (s *CachedRegisteredClientsServer) List(ctx context.Context, in *Registered
 	return result, err
 }
 
-func (s *CachedRegisteredClientsServer) GetUserPermissions(ctx context.Context, in *UserPermissionsOptions) (*UserPermissions, error) {
+func (s *CachedReposServer) GetCommitPatch(ctx context.Context, in *ReposGetCommitPatchOp) (*CommitPatch, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RegisteredClientsServer.GetUserPermissions(ctx, in)
+	result, err := s.ReposServer.GetCommitPatch(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -2425,9 +5468,9 @@ func (s *CachedRegisteredClientsServer) GetUserPermissions(ctx context.Context,
 	return result, err
 }
 
-func (s *CachedRegisteredClientsServer) SetUserPermissions(ctx context.Context, in *UserPermissions) (*pbtypes.Void, error) {
+func (s *CachedReposServer) GetArchive(ctx context.Context, in *ReposGetArchiveOp) (*RepoArchive, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RegisteredClientsServer.SetUserPermissions(ctx, in)
+	result, err := s.ReposServer.GetArchive(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -2436,9 +5479,9 @@ func (s *CachedRegisteredClientsServer) SetUserPermissions(ctx context.Context,
 	return result, err
 }
 
-func (s *CachedRegisteredClientsServer) ListUserPermissions(ctx context.Context, in *RegisteredClientSpec) (*UserPermissionsList, error) {
+func (s *CachedReposServer) ListCommits(ctx context.Context, in *ReposListCommitsOp) (*CommitList, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RegisteredClientsServer.ListUserPermissions(ctx, in)
+	result, err := s.ReposServer.ListCommits(ctx, in)
 	if !cc.IsZero() {
 		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
@@ -2447,41 +5490,81 @@ func (s *CachedRegisteredClientsServer) ListUserPermissions(ctx context.Context,
 	return result, err
 }
 
-type CachedRegisteredClientsClient struct {
-	RegisteredClientsClient
-	Cache *grpccache.Cache
+func (s *CachedReposServer) ListBranches(ctx context.Context, in *ReposListBranchesOp) (*BranchList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.ListBranches(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
 }
 
-func (s *CachedRegisteredClientsClient) Get(ctx context.Context, in *RegisteredClientSpec, opts ...grpc.CallOption) (*RegisteredClient, error) {
-	if s.Cache != nil {
-		var cachedResult RegisteredClient
-		cached, err := s.Cache.Get(ctx, "RegisteredClients.Get", in, &cachedResult)
-		if err != nil {
+func (s *CachedReposServer) ListTags(ctx context.Context, in *ReposListTagsOp) (*TagList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.ListTags(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
-		if cached {
-			return &cachedResult, nil
+	}
+	return result, err
+}
+
+func (s *CachedReposServer) ListCommitters(ctx context.Context, in *ReposListCommittersOp) (*CommitterList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.ListCommitters(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
 		}
 	}
+	return result, err
+}
 
-	var trailer metadata.MD
+func (s *CachedReposServer) ListContributors(ctx context.Context, in *ReposListContributorsOp) (*ContributorList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.ListContributors(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
 
-	result, err := s.RegisteredClientsClient.Get(ctx, in, grpc.Trailer(&trailer))
-	if err != nil {
-		return nil, err
+func (s *CachedReposServer) SearchCode(ctx context.Context, in *ReposSearchCodeOp) (*CodeSearchResultList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.SearchCode(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
 	}
-	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RegisteredClients.Get", in, result, trailer); err != nil {
+	return result, err
+}
+
+func (s *CachedReposServer) GetCodeOwners(ctx context.Context, in *ReposGetCodeOwnersOp) (*CodeOwners, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.ReposServer.GetCodeOwners(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
 			return nil, err
 		}
 	}
-	return result, nil
+	return result, err
 }
 
-func (s *CachedRegisteredClientsClient) GetCurrent(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*RegisteredClient, error) {
+type CachedReposClient struct {
+	ReposClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedReposClient) Get(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*Repo, error) {
 	if s.Cache != nil {
-		var cachedResult RegisteredClient
-		cached, err := s.Cache.Get(ctx, "RegisteredClients.GetCurrent", in, &cachedResult)
+		var cachedResult Repo
+		cached, err := s.Cache.Get(ctx, "Repos.Get", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2492,22 +5575,22 @@ func (s *CachedRegisteredClientsClient) GetCurrent(ctx context.Context, in *pbty
 
 	var trailer metadata.MD
 
-	result, err := s.RegisteredClientsClient.GetCurrent(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.Get(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RegisteredClients.GetCurrent", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.Get", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedRegisteredClientsClient) Create(ctx context.Context, in *RegisteredClient, opts ...grpc.CallOption) (*RegisteredClient, error) {
+func (s *CachedReposClient) List(ctx context.Context, in *RepoListOptions, opts ...grpc.CallOption) (*RepoList, error) {
 	if s.Cache != nil {
-		var cachedResult RegisteredClient
-		cached, err := s.Cache.Get(ctx, "RegisteredClients.Create", in, &cachedResult)
+		var cachedResult RepoList
+		cached, err := s.Cache.Get(ctx, "Repos.List", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2518,22 +5601,22 @@ func (s *CachedRegisteredClientsClient) Create(ctx context.Context, in *Register
 
 	var trailer metadata.MD
 
-	result, err := s.RegisteredClientsClient.Create(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.List(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RegisteredClients.Create", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.List", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedRegisteredClientsClient) Update(ctx context.Context, in *RegisteredClient, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+func (s *CachedReposClient) Create(ctx context.Context, in *ReposCreateOp, opts ...grpc.CallOption) (*Repo, error) {
 	if s.Cache != nil {
-		var cachedResult pbtypes.Void
-		cached, err := s.Cache.Get(ctx, "RegisteredClients.Update", in, &cachedResult)
+		var cachedResult Repo
+		cached, err := s.Cache.Get(ctx, "Repos.Create", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2544,22 +5627,22 @@ func (s *CachedRegisteredClientsClient) Update(ctx context.Context, in *Register
 
 	var trailer metadata.MD
 
-	result, err := s.RegisteredClientsClient.Update(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.Create(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RegisteredClients.Update", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.Create", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedRegisteredClientsClient) Delete(ctx context.Context, in *RegisteredClientSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+func (s *CachedReposClient) Update(ctx context.Context, in *ReposUpdateOp, opts ...grpc.CallOption) (*Repo, error) {
 	if s.Cache != nil {
-		var cachedResult pbtypes.Void
-		cached, err := s.Cache.Get(ctx, "RegisteredClients.Delete", in, &cachedResult)
+		var cachedResult Repo
+		cached, err := s.Cache.Get(ctx, "Repos.Update", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2570,22 +5653,22 @@ func (s *CachedRegisteredClientsClient) Delete(ctx context.Context, in *Register
 
 	var trailer metadata.MD
 
-	result, err := s.RegisteredClientsClient.Delete(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.Update(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RegisteredClients.Delete", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.Update", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedRegisteredClientsClient) List(ctx context.Context, in *RegisteredClientListOptions, opts ...grpc.CallOption) (*RegisteredClientList, error) {
+func (s *CachedReposClient) Delete(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
 	if s.Cache != nil {
-		var cachedResult RegisteredClientList
-		cached, err := s.Cache.Get(ctx, "RegisteredClients.List", in, &cachedResult)
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "Repos.Delete", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2596,22 +5679,22 @@ func (s *CachedRegisteredClientsClient) List(ctx context.Context, in *Registered
 
 	var trailer metadata.MD
 
-	result, err := s.RegisteredClientsClient.List(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.Delete(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RegisteredClients.List", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.Delete", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedRegisteredClientsClient) GetUserPermissions(ctx context.Context, in *UserPermissionsOptions, opts ...grpc.CallOption) (*UserPermissions, error) {
+func (s *CachedReposClient) GetReadme(ctx context.Context, in *ReposGetReadmeOp, opts ...grpc.CallOption) (*Readme, error) {
 	if s.Cache != nil {
-		var cachedResult UserPermissions
-		cached, err := s.Cache.Get(ctx, "RegisteredClients.GetUserPermissions", in, &cachedResult)
+		var cachedResult Readme
+		cached, err := s.Cache.Get(ctx, "Repos.GetReadme", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2622,22 +5705,22 @@ func (s *CachedRegisteredClientsClient) GetUserPermissions(ctx context.Context,
 
 	var trailer metadata.MD
 
-	result, err := s.RegisteredClientsClient.GetUserPermissions(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.GetReadme(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RegisteredClients.GetUserPermissions", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.GetReadme", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedRegisteredClientsClient) SetUserPermissions(ctx context.Context, in *UserPermissions, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+func (s *CachedReposClient) GetInventory(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*Inventory, error) {
 	if s.Cache != nil {
-		var cachedResult pbtypes.Void
-		cached, err := s.Cache.Get(ctx, "RegisteredClients.SetUserPermissions", in, &cachedResult)
+		var cachedResult Inventory
+		cached, err := s.Cache.Get(ctx, "Repos.GetInventory", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2648,22 +5731,22 @@ func (s *CachedRegisteredClientsClient) SetUserPermissions(ctx context.Context,
 
 	var trailer metadata.MD
 
-	result, err := s.RegisteredClientsClient.SetUserPermissions(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.GetInventory(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RegisteredClients.SetUserPermissions", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.GetInventory", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedRegisteredClientsClient) ListUserPermissions(ctx context.Context, in *RegisteredClientSpec, opts ...grpc.CallOption) (*UserPermissionsList, error) {
+func (s *CachedReposClient) GetStatsHistory(ctx context.Context, in *ReposGetStatsHistoryOp, opts ...grpc.CallOption) (*RepoStatsHistory, error) {
 	if s.Cache != nil {
-		var cachedResult UserPermissionsList
-		cached, err := s.Cache.Get(ctx, "RegisteredClients.ListUserPermissions", in, &cachedResult)
+		var cachedResult RepoStatsHistory
+		cached, err := s.Cache.Get(ctx, "Repos.GetStatsHistory", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2674,73 +5757,22 @@ func (s *CachedRegisteredClientsClient) ListUserPermissions(ctx context.Context,
 
 	var trailer metadata.MD
 
-	result, err := s.RegisteredClientsClient.ListUserPermissions(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.GetStatsHistory(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RegisteredClients.ListUserPermissions", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.GetStatsHistory", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-type CachedRepoBadgesServer struct{ RepoBadgesServer }
-
-func (s *CachedRepoBadgesServer) ListBadges(ctx context.Context, in *RepoSpec) (*BadgeList, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RepoBadgesServer.ListBadges(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
-			return nil, err
-		}
-	}
-	return result, err
-}
-
-func (s *CachedRepoBadgesServer) ListCounters(ctx context.Context, in *RepoSpec) (*CounterList, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RepoBadgesServer.ListCounters(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
-			return nil, err
-		}
-	}
-	return result, err
-}
-
-func (s *CachedRepoBadgesServer) RecordHit(ctx context.Context, in *RepoSpec) (*pbtypes.Void, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RepoBadgesServer.RecordHit(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
-			return nil, err
-		}
-	}
-	return result, err
-}
-
-func (s *CachedRepoBadgesServer) CountHits(ctx context.Context, in *RepoBadgesCountHitsOp) (*RepoBadgesCountHitsResult, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RepoBadgesServer.CountHits(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
-			return nil, err
-		}
-	}
-	return result, err
-}
-
-type CachedRepoBadgesClient struct {
-	RepoBadgesClient
-	Cache *grpccache.Cache
-}
-
-func (s *CachedRepoBadgesClient) ListBadges(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*BadgeList, error) {
+func (s *CachedReposClient) Enable(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
 	if s.Cache != nil {
-		var cachedResult BadgeList
-		cached, err := s.Cache.Get(ctx, "RepoBadges.ListBadges", in, &cachedResult)
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "Repos.Enable", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2751,22 +5783,22 @@ func (s *CachedRepoBadgesClient) ListBadges(ctx context.Context, in *RepoSpec, o
 
 	var trailer metadata.MD
 
-	result, err := s.RepoBadgesClient.ListBadges(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.Enable(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RepoBadges.ListBadges", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.Enable", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedRepoBadgesClient) ListCounters(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*CounterList, error) {
+func (s *CachedReposClient) Disable(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
 	if s.Cache != nil {
-		var cachedResult CounterList
-		cached, err := s.Cache.Get(ctx, "RepoBadges.ListCounters", in, &cachedResult)
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "Repos.Disable", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2777,22 +5809,22 @@ func (s *CachedRepoBadgesClient) ListCounters(ctx context.Context, in *RepoSpec,
 
 	var trailer metadata.MD
 
-	result, err := s.RepoBadgesClient.ListCounters(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.Disable(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RepoBadges.ListCounters", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.Disable", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedRepoBadgesClient) RecordHit(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+func (s *CachedReposClient) GetConfig(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*RepoConfig, error) {
 	if s.Cache != nil {
-		var cachedResult pbtypes.Void
-		cached, err := s.Cache.Get(ctx, "RepoBadges.RecordHit", in, &cachedResult)
+		var cachedResult RepoConfig
+		cached, err := s.Cache.Get(ctx, "Repos.GetConfig", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2803,22 +5835,22 @@ func (s *CachedRepoBadgesClient) RecordHit(ctx context.Context, in *RepoSpec, op
 
 	var trailer metadata.MD
 
-	result, err := s.RepoBadgesClient.RecordHit(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.GetConfig(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RepoBadges.RecordHit", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.GetConfig", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedRepoBadgesClient) CountHits(ctx context.Context, in *RepoBadgesCountHitsOp, opts ...grpc.CallOption) (*RepoBadgesCountHitsResult, error) {
+func (s *CachedReposClient) ListCollaborators(ctx context.Context, in *ReposListCollaboratorsOp, opts ...grpc.CallOption) (*CollaboratorList, error) {
 	if s.Cache != nil {
-		var cachedResult RepoBadgesCountHitsResult
-		cached, err := s.Cache.Get(ctx, "RepoBadges.CountHits", in, &cachedResult)
+		var cachedResult CollaboratorList
+		cached, err := s.Cache.Get(ctx, "Repos.ListCollaborators", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2829,51 +5861,22 @@ func (s *CachedRepoBadgesClient) CountHits(ctx context.Context, in *RepoBadgesCo
 
 	var trailer metadata.MD
 
-	result, err := s.RepoBadgesClient.CountHits(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.ListCollaborators(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RepoBadges.CountHits", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.ListCollaborators", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-type CachedRepoStatusesServer struct{ RepoStatusesServer }
-
-func (s *CachedRepoStatusesServer) GetCombined(ctx context.Context, in *RepoRevSpec) (*CombinedStatus, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RepoStatusesServer.GetCombined(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
-			return nil, err
-		}
-	}
-	return result, err
-}
-
-func (s *CachedRepoStatusesServer) Create(ctx context.Context, in *RepoStatusesCreateOp) (*RepoStatus, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RepoStatusesServer.Create(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
-			return nil, err
-		}
-	}
-	return result, err
-}
-
-type CachedRepoStatusesClient struct {
-	RepoStatusesClient
-	Cache *grpccache.Cache
-}
-
-func (s *CachedRepoStatusesClient) GetCombined(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*CombinedStatus, error) {
+func (s *CachedReposClient) AddCollaborator(ctx context.Context, in *ReposAddCollaboratorOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
 	if s.Cache != nil {
-		var cachedResult CombinedStatus
-		cached, err := s.Cache.Get(ctx, "RepoStatuses.GetCombined", in, &cachedResult)
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "Repos.AddCollaborator", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2884,22 +5887,22 @@ func (s *CachedRepoStatusesClient) GetCombined(ctx context.Context, in *RepoRevS
 
 	var trailer metadata.MD
 
-	result, err := s.RepoStatusesClient.GetCombined(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.AddCollaborator(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RepoStatuses.GetCombined", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.AddCollaborator", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedRepoStatusesClient) Create(ctx context.Context, in *RepoStatusesCreateOp, opts ...grpc.CallOption) (*RepoStatus, error) {
+func (s *CachedReposClient) RemoveCollaborator(ctx context.Context, in *ReposRemoveCollaboratorOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
 	if s.Cache != nil {
-		var cachedResult RepoStatus
-		cached, err := s.Cache.Get(ctx, "RepoStatuses.Create", in, &cachedResult)
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "Repos.RemoveCollaborator", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2910,62 +5913,22 @@ func (s *CachedRepoStatusesClient) Create(ctx context.Context, in *RepoStatusesC
 
 	var trailer metadata.MD
 
-	result, err := s.RepoStatusesClient.Create(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.RemoveCollaborator(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RepoStatuses.Create", in, result, trailer); err != nil {
-			return nil, err
-		}
-	}
-	return result, nil
-}
-
-type CachedRepoTreeServer struct{ RepoTreeServer }
-
-func (s *CachedRepoTreeServer) Get(ctx context.Context, in *RepoTreeGetOp) (*TreeEntry, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RepoTreeServer.Get(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
-			return nil, err
-		}
-	}
-	return result, err
-}
-
-func (s *CachedRepoTreeServer) Search(ctx context.Context, in *RepoTreeSearchOp) (*VCSSearchResultList, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RepoTreeServer.Search(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
-			return nil, err
-		}
-	}
-	return result, err
-}
-
-func (s *CachedRepoTreeServer) List(ctx context.Context, in *RepoTreeListOp) (*RepoTreeListResult, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.RepoTreeServer.List(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.RemoveCollaborator", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
-	return result, err
-}
-
-type CachedRepoTreeClient struct {
-	RepoTreeClient
-	Cache *grpccache.Cache
+	return result, nil
 }
 
-func (s *CachedRepoTreeClient) Get(ctx context.Context, in *RepoTreeGetOp, opts ...grpc.CallOption) (*TreeEntry, error) {
+func (s *CachedReposClient) GetPermissions(ctx context.Context, in *ReposGetPermissionsOp, opts ...grpc.CallOption) (*RepoPermissions, error) {
 	if s.Cache != nil {
-		var cachedResult TreeEntry
-		cached, err := s.Cache.Get(ctx, "RepoTree.Get", in, &cachedResult)
+		var cachedResult RepoPermissions
+		cached, err := s.Cache.Get(ctx, "Repos.GetPermissions", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -2976,22 +5939,22 @@ func (s *CachedRepoTreeClient) Get(ctx context.Context, in *RepoTreeGetOp, opts
 
 	var trailer metadata.MD
 
-	result, err := s.RepoTreeClient.Get(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.GetPermissions(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RepoTree.Get", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.GetPermissions", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedRepoTreeClient) Search(ctx context.Context, in *RepoTreeSearchOp, opts ...grpc.CallOption) (*VCSSearchResultList, error) {
+func (s *CachedReposClient) ListKeys(ctx context.Context, in *ReposListKeysOp, opts ...grpc.CallOption) (*DeployKeyList, error) {
 	if s.Cache != nil {
-		var cachedResult VCSSearchResultList
-		cached, err := s.Cache.Get(ctx, "RepoTree.Search", in, &cachedResult)
+		var cachedResult DeployKeyList
+		cached, err := s.Cache.Get(ctx, "Repos.ListKeys", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -3002,22 +5965,22 @@ func (s *CachedRepoTreeClient) Search(ctx context.Context, in *RepoTreeSearchOp,
 
 	var trailer metadata.MD
 
-	result, err := s.RepoTreeClient.Search(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.ListKeys(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RepoTree.Search", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.ListKeys", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedRepoTreeClient) List(ctx context.Context, in *RepoTreeListOp, opts ...grpc.CallOption) (*RepoTreeListResult, error) {
+func (s *CachedReposClient) AddKey(ctx context.Context, in *ReposAddKeyOp, opts ...grpc.CallOption) (*DeployKey, error) {
 	if s.Cache != nil {
-		var cachedResult RepoTreeListResult
-		cached, err := s.Cache.Get(ctx, "RepoTree.List", in, &cachedResult)
+		var cachedResult DeployKey
+		cached, err := s.Cache.Get(ctx, "Repos.AddKey", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -3028,183 +5991,178 @@ func (s *CachedRepoTreeClient) List(ctx context.Context, in *RepoTreeListOp, opt
 
 	var trailer metadata.MD
 
-	result, err := s.RepoTreeClient.List(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.AddKey(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "RepoTree.List", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.AddKey", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-type CachedReposServer struct{ ReposServer }
-
-func (s *CachedReposServer) Get(ctx context.Context, in *RepoSpec) (*Repo, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ReposServer.Get(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+func (s *CachedReposClient) DeleteKey(ctx context.Context, in *ReposDeleteKeyOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	if s.Cache != nil {
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "Repos.DeleteKey", in, &cachedResult)
+		if err != nil {
 			return nil, err
 		}
-	}
-	return result, err
-}
-
-func (s *CachedReposServer) List(ctx context.Context, in *RepoListOptions) (*RepoList, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ReposServer.List(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
-			return nil, err
+		if cached {
+			return &cachedResult, nil
 		}
 	}
-	return result, err
-}
 
-func (s *CachedReposServer) Create(ctx context.Context, in *ReposCreateOp) (*Repo, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ReposServer.Create(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
-			return nil, err
-		}
-	}
-	return result, err
-}
+	var trailer metadata.MD
 
-func (s *CachedReposServer) Update(ctx context.Context, in *ReposUpdateOp) (*Repo, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ReposServer.Update(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+	result, err := s.ReposClient.DeleteKey(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Repos.DeleteKey", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
-	return result, err
+	return result, nil
 }
 
-func (s *CachedReposServer) Delete(ctx context.Context, in *RepoSpec) (*pbtypes.Void, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ReposServer.Delete(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+func (s *CachedReposClient) Watch(ctx context.Context, in *ReposWatchOp, opts ...grpc.CallOption) (*RepoSubscription, error) {
+	if s.Cache != nil {
+		var cachedResult RepoSubscription
+		cached, err := s.Cache.Get(ctx, "Repos.Watch", in, &cachedResult)
+		if err != nil {
 			return nil, err
 		}
+		if cached {
+			return &cachedResult, nil
+		}
 	}
-	return result, err
-}
 
-func (s *CachedReposServer) GetReadme(ctx context.Context, in *RepoRevSpec) (*Readme, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ReposServer.GetReadme(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+	var trailer metadata.MD
+
+	result, err := s.ReposClient.Watch(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Repos.Watch", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
-	return result, err
+	return result, nil
 }
 
-func (s *CachedReposServer) Enable(ctx context.Context, in *RepoSpec) (*pbtypes.Void, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ReposServer.Enable(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+func (s *CachedReposClient) Unwatch(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	if s.Cache != nil {
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "Repos.Unwatch", in, &cachedResult)
+		if err != nil {
 			return nil, err
 		}
+		if cached {
+			return &cachedResult, nil
+		}
 	}
-	return result, err
-}
 
-func (s *CachedReposServer) Disable(ctx context.Context, in *RepoSpec) (*pbtypes.Void, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ReposServer.Disable(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+	var trailer metadata.MD
+
+	result, err := s.ReposClient.Unwatch(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Repos.Unwatch", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
-	return result, err
+	return result, nil
 }
 
-func (s *CachedReposServer) GetConfig(ctx context.Context, in *RepoSpec) (*RepoConfig, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ReposServer.GetConfig(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+func (s *CachedReposClient) GetSubscription(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*RepoSubscription, error) {
+	if s.Cache != nil {
+		var cachedResult RepoSubscription
+		cached, err := s.Cache.Get(ctx, "Repos.GetSubscription", in, &cachedResult)
+		if err != nil {
 			return nil, err
 		}
+		if cached {
+			return &cachedResult, nil
+		}
 	}
-	return result, err
-}
 
-func (s *CachedReposServer) GetCommit(ctx context.Context, in *RepoRevSpec) (*vcs.Commit, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ReposServer.GetCommit(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+	var trailer metadata.MD
+
+	result, err := s.ReposClient.GetSubscription(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Repos.GetSubscription", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
-	return result, err
+	return result, nil
 }
 
-func (s *CachedReposServer) ListCommits(ctx context.Context, in *ReposListCommitsOp) (*CommitList, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ReposServer.ListCommits(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+func (s *CachedReposClient) ListWatched(ctx context.Context, in *ReposListWatchedOp, opts ...grpc.CallOption) (*RepoList, error) {
+	if s.Cache != nil {
+		var cachedResult RepoList
+		cached, err := s.Cache.Get(ctx, "Repos.ListWatched", in, &cachedResult)
+		if err != nil {
 			return nil, err
 		}
+		if cached {
+			return &cachedResult, nil
+		}
 	}
-	return result, err
-}
 
-func (s *CachedReposServer) ListBranches(ctx context.Context, in *ReposListBranchesOp) (*BranchList, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ReposServer.ListBranches(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+	var trailer metadata.MD
+
+	result, err := s.ReposClient.ListWatched(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Repos.ListWatched", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
-	return result, err
+	return result, nil
 }
 
-func (s *CachedReposServer) ListTags(ctx context.Context, in *ReposListTagsOp) (*TagList, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ReposServer.ListTags(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+func (s *CachedReposClient) GetCommit(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*vcs.Commit, error) {
+	if s.Cache != nil {
+		var cachedResult vcs.Commit
+		cached, err := s.Cache.Get(ctx, "Repos.GetCommit", in, &cachedResult)
+		if err != nil {
 			return nil, err
 		}
+		if cached {
+			return &cachedResult, nil
+		}
 	}
-	return result, err
-}
 
-func (s *CachedReposServer) ListCommitters(ctx context.Context, in *ReposListCommittersOp) (*CommitterList, error) {
-	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
-	result, err := s.ReposServer.ListCommitters(ctx, in)
-	if !cc.IsZero() {
-		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+	var trailer metadata.MD
+
+	result, err := s.ReposClient.GetCommit(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Repos.GetCommit", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
-	return result, err
-}
-
-type CachedReposClient struct {
-	ReposClient
-	Cache *grpccache.Cache
+	return result, nil
 }
 
-func (s *CachedReposClient) Get(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*Repo, error) {
+func (s *CachedReposClient) GetCommitDetail(ctx context.Context, in *ReposGetCommitOp, opts ...grpc.CallOption) (*CommitDetail, error) {
 	if s.Cache != nil {
-		var cachedResult Repo
-		cached, err := s.Cache.Get(ctx, "Repos.Get", in, &cachedResult)
+		var cachedResult CommitDetail
+		cached, err := s.Cache.Get(ctx, "Repos.GetCommitDetail", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -3215,22 +6173,22 @@ func (s *CachedReposClient) Get(ctx context.Context, in *RepoSpec, opts ...grpc.
 
 	var trailer metadata.MD
 
-	result, err := s.ReposClient.Get(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.GetCommitDetail(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Repos.Get", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.GetCommitDetail", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedReposClient) List(ctx context.Context, in *RepoListOptions, opts ...grpc.CallOption) (*RepoList, error) {
+func (s *CachedReposClient) GetCommitPatch(ctx context.Context, in *ReposGetCommitPatchOp, opts ...grpc.CallOption) (*CommitPatch, error) {
 	if s.Cache != nil {
-		var cachedResult RepoList
-		cached, err := s.Cache.Get(ctx, "Repos.List", in, &cachedResult)
+		var cachedResult CommitPatch
+		cached, err := s.Cache.Get(ctx, "Repos.GetCommitPatch", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -3241,22 +6199,22 @@ func (s *CachedReposClient) List(ctx context.Context, in *RepoListOptions, opts
 
 	var trailer metadata.MD
 
-	result, err := s.ReposClient.List(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.GetCommitPatch(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Repos.List", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.GetCommitPatch", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedReposClient) Create(ctx context.Context, in *ReposCreateOp, opts ...grpc.CallOption) (*Repo, error) {
+func (s *CachedReposClient) GetArchive(ctx context.Context, in *ReposGetArchiveOp, opts ...grpc.CallOption) (*RepoArchive, error) {
 	if s.Cache != nil {
-		var cachedResult Repo
-		cached, err := s.Cache.Get(ctx, "Repos.Create", in, &cachedResult)
+		var cachedResult RepoArchive
+		cached, err := s.Cache.Get(ctx, "Repos.GetArchive", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -3267,22 +6225,22 @@ func (s *CachedReposClient) Create(ctx context.Context, in *ReposCreateOp, opts
 
 	var trailer metadata.MD
 
-	result, err := s.ReposClient.Create(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.GetArchive(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Repos.Create", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.GetArchive", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedReposClient) Update(ctx context.Context, in *ReposUpdateOp, opts ...grpc.CallOption) (*Repo, error) {
+func (s *CachedReposClient) ListCommits(ctx context.Context, in *ReposListCommitsOp, opts ...grpc.CallOption) (*CommitList, error) {
 	if s.Cache != nil {
-		var cachedResult Repo
-		cached, err := s.Cache.Get(ctx, "Repos.Update", in, &cachedResult)
+		var cachedResult CommitList
+		cached, err := s.Cache.Get(ctx, "Repos.ListCommits", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -3293,22 +6251,22 @@ func (s *CachedReposClient) Update(ctx context.Context, in *ReposUpdateOp, opts
 
 	var trailer metadata.MD
 
-	result, err := s.ReposClient.Update(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.ListCommits(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Repos.Update", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.ListCommits", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedReposClient) Delete(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+func (s *CachedReposClient) ListBranches(ctx context.Context, in *ReposListBranchesOp, opts ...grpc.CallOption) (*BranchList, error) {
 	if s.Cache != nil {
-		var cachedResult pbtypes.Void
-		cached, err := s.Cache.Get(ctx, "Repos.Delete", in, &cachedResult)
+		var cachedResult BranchList
+		cached, err := s.Cache.Get(ctx, "Repos.ListBranches", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -3319,22 +6277,22 @@ func (s *CachedReposClient) Delete(ctx context.Context, in *RepoSpec, opts ...gr
 
 	var trailer metadata.MD
 
-	result, err := s.ReposClient.Delete(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.ListBranches(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Repos.Delete", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.ListBranches", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedReposClient) GetReadme(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*Readme, error) {
+func (s *CachedReposClient) ListTags(ctx context.Context, in *ReposListTagsOp, opts ...grpc.CallOption) (*TagList, error) {
 	if s.Cache != nil {
-		var cachedResult Readme
-		cached, err := s.Cache.Get(ctx, "Repos.GetReadme", in, &cachedResult)
+		var cachedResult TagList
+		cached, err := s.Cache.Get(ctx, "Repos.ListTags", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -3345,22 +6303,22 @@ func (s *CachedReposClient) GetReadme(ctx context.Context, in *RepoRevSpec, opts
 
 	var trailer metadata.MD
 
-	result, err := s.ReposClient.GetReadme(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.ListTags(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Repos.GetReadme", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.ListTags", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedReposClient) Enable(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+func (s *CachedReposClient) ListCommitters(ctx context.Context, in *ReposListCommittersOp, opts ...grpc.CallOption) (*CommitterList, error) {
 	if s.Cache != nil {
-		var cachedResult pbtypes.Void
-		cached, err := s.Cache.Get(ctx, "Repos.Enable", in, &cachedResult)
+		var cachedResult CommitterList
+		cached, err := s.Cache.Get(ctx, "Repos.ListCommitters", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -3371,22 +6329,22 @@ func (s *CachedReposClient) Enable(ctx context.Context, in *RepoSpec, opts ...gr
 
 	var trailer metadata.MD
 
-	result, err := s.ReposClient.Enable(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.ListCommitters(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Repos.Enable", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.ListCommitters", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedReposClient) Disable(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
-	if s.Cache != nil {
-		var cachedResult pbtypes.Void
-		cached, err := s.Cache.Get(ctx, "Repos.Disable", in, &cachedResult)
+func (s *CachedReposClient) ListContributors(ctx context.Context, in *ReposListContributorsOp, opts ...grpc.CallOption) (*ContributorList, error) {
+	if s.Cache != nil {
+		var cachedResult ContributorList
+		cached, err := s.Cache.Get(ctx, "Repos.ListContributors", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -3397,22 +6355,22 @@ func (s *CachedReposClient) Disable(ctx context.Context, in *RepoSpec, opts ...g
 
 	var trailer metadata.MD
 
-	result, err := s.ReposClient.Disable(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.ListContributors(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Repos.Disable", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.ListContributors", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedReposClient) GetConfig(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*RepoConfig, error) {
+func (s *CachedReposClient) SearchCode(ctx context.Context, in *ReposSearchCodeOp, opts ...grpc.CallOption) (*CodeSearchResultList, error) {
 	if s.Cache != nil {
-		var cachedResult RepoConfig
-		cached, err := s.Cache.Get(ctx, "Repos.GetConfig", in, &cachedResult)
+		var cachedResult CodeSearchResultList
+		cached, err := s.Cache.Get(ctx, "Repos.SearchCode", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -3423,22 +6381,22 @@ func (s *CachedReposClient) GetConfig(ctx context.Context, in *RepoSpec, opts ..
 
 	var trailer metadata.MD
 
-	result, err := s.ReposClient.GetConfig(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.SearchCode(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Repos.GetConfig", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.SearchCode", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedReposClient) GetCommit(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*vcs.Commit, error) {
+func (s *CachedReposClient) GetCodeOwners(ctx context.Context, in *ReposGetCodeOwnersOp, opts ...grpc.CallOption) (*CodeOwners, error) {
 	if s.Cache != nil {
-		var cachedResult vcs.Commit
-		cached, err := s.Cache.Get(ctx, "Repos.GetCommit", in, &cachedResult)
+		var cachedResult CodeOwners
+		cached, err := s.Cache.Get(ctx, "Repos.GetCodeOwners", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -3449,22 +6407,73 @@ func (s *CachedReposClient) GetCommit(ctx context.Context, in *RepoRevSpec, opts
 
 	var trailer metadata.MD
 
-	result, err := s.ReposClient.GetCommit(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.ReposClient.GetCodeOwners(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Repos.GetCommit", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "Repos.GetCodeOwners", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedReposClient) ListCommits(ctx context.Context, in *ReposListCommitsOp, opts ...grpc.CallOption) (*CommitList, error) {
+type CachedSavedSearchesServer struct{ SavedSearchesServer }
+
+func (s *CachedSavedSearchesServer) Create(ctx context.Context, in *SavedSearch) (*SavedSearch, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.SavedSearchesServer.Create(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedSavedSearchesServer) List(ctx context.Context, in *SavedSearchListOptions) (*SavedSearchList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.SavedSearchesServer.List(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedSavedSearchesServer) Delete(ctx context.Context, in *SavedSearchSpec) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.SavedSearchesServer.Delete(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedSavedSearchesServer) UpdateNotifications(ctx context.Context, in *SavedSearchesUpdateNotificationsOp) (*SavedSearch, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.SavedSearchesServer.UpdateNotifications(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+type CachedSavedSearchesClient struct {
+	SavedSearchesClient
+	Cache *grpccache.Cache
+}
+
+func (s *CachedSavedSearchesClient) Create(ctx context.Context, in *SavedSearch, opts ...grpc.CallOption) (*SavedSearch, error) {
 	if s.Cache != nil {
-		var cachedResult CommitList
-		cached, err := s.Cache.Get(ctx, "Repos.ListCommits", in, &cachedResult)
+		var cachedResult SavedSearch
+		cached, err := s.Cache.Get(ctx, "SavedSearches.Create", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -3475,22 +6484,22 @@ func (s *CachedReposClient) ListCommits(ctx context.Context, in *ReposListCommit
 
 	var trailer metadata.MD
 
-	result, err := s.ReposClient.ListCommits(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.SavedSearchesClient.Create(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Repos.ListCommits", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "SavedSearches.Create", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedReposClient) ListBranches(ctx context.Context, in *ReposListBranchesOp, opts ...grpc.CallOption) (*BranchList, error) {
+func (s *CachedSavedSearchesClient) List(ctx context.Context, in *SavedSearchListOptions, opts ...grpc.CallOption) (*SavedSearchList, error) {
 	if s.Cache != nil {
-		var cachedResult BranchList
-		cached, err := s.Cache.Get(ctx, "Repos.ListBranches", in, &cachedResult)
+		var cachedResult SavedSearchList
+		cached, err := s.Cache.Get(ctx, "SavedSearches.List", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -3501,22 +6510,22 @@ func (s *CachedReposClient) ListBranches(ctx context.Context, in *ReposListBranc
 
 	var trailer metadata.MD
 
-	result, err := s.ReposClient.ListBranches(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.SavedSearchesClient.List(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Repos.ListBranches", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "SavedSearches.List", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedReposClient) ListTags(ctx context.Context, in *ReposListTagsOp, opts ...grpc.CallOption) (*TagList, error) {
+func (s *CachedSavedSearchesClient) Delete(ctx context.Context, in *SavedSearchSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
 	if s.Cache != nil {
-		var cachedResult TagList
-		cached, err := s.Cache.Get(ctx, "Repos.ListTags", in, &cachedResult)
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "SavedSearches.Delete", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -3527,22 +6536,22 @@ func (s *CachedReposClient) ListTags(ctx context.Context, in *ReposListTagsOp, o
 
 	var trailer metadata.MD
 
-	result, err := s.ReposClient.ListTags(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.SavedSearchesClient.Delete(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Repos.ListTags", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "SavedSearches.Delete", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
 
-func (s *CachedReposClient) ListCommitters(ctx context.Context, in *ReposListCommittersOp, opts ...grpc.CallOption) (*CommitterList, error) {
+func (s *CachedSavedSearchesClient) UpdateNotifications(ctx context.Context, in *SavedSearchesUpdateNotificationsOp, opts ...grpc.CallOption) (*SavedSearch, error) {
 	if s.Cache != nil {
-		var cachedResult CommitterList
-		cached, err := s.Cache.Get(ctx, "Repos.ListCommitters", in, &cachedResult)
+		var cachedResult SavedSearch
+		cached, err := s.Cache.Get(ctx, "SavedSearches.UpdateNotifications", in, &cachedResult)
 		if err != nil {
 			return nil, err
 		}
@@ -3553,12 +6562,12 @@ func (s *CachedReposClient) ListCommitters(ctx context.Context, in *ReposListCom
 
 	var trailer metadata.MD
 
-	result, err := s.ReposClient.ListCommitters(ctx, in, grpc.Trailer(&trailer))
+	result, err := s.SavedSearchesClient.UpdateNotifications(ctx, in, grpc.Trailer(&trailer))
 	if err != nil {
 		return nil, err
 	}
 	if s.Cache != nil {
-		if err := s.Cache.Store(ctx, "Repos.ListCommitters", in, result, trailer); err != nil {
+		if err := s.Cache.Store(ctx, "SavedSearches.UpdateNotifications", in, result, trailer); err != nil {
 			return nil, err
 		}
 	}
@@ -4246,6 +7255,39 @@ func (s *CachedUsersServer) GetWithEmail(ctx context.Context, in *EmailAddr) (*U
 	return result, err
 }
 
+func (s *CachedUsersServer) GetByExternal(ctx context.Context, in *ExternalAccountSpec) (*User, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.UsersServer.GetByExternal(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedUsersServer) ListAuthoredDefs(ctx context.Context, in *UsersListAuthoredDefsOp) (*AuthoredDefList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.UsersServer.ListAuthoredDefs(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedUsersServer) ListContributions(ctx context.Context, in *UsersListContributionsOp) (*UserContributionList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.UsersServer.ListContributions(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
 func (s *CachedUsersServer) ListEmails(ctx context.Context, in *UserSpec) (*EmailAddrList, error) {
 	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
 	result, err := s.UsersServer.ListEmails(ctx, in)
@@ -4268,6 +7310,39 @@ func (s *CachedUsersServer) List(ctx context.Context, in *UsersListOptions) (*Us
 	return result, err
 }
 
+func (s *CachedUsersServer) ListKeys(ctx context.Context, in *UsersListKeysOp) (*UserKeyList, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.UsersServer.ListKeys(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedUsersServer) AddKey(ctx context.Context, in *UsersAddKeyOp) (*UserKey, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.UsersServer.AddKey(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
+func (s *CachedUsersServer) DeleteKey(ctx context.Context, in *UsersDeleteKeyOp) (*pbtypes.Void, error) {
+	ctx, cc := grpccache.Internal_WithCacheControl(ctx)
+	result, err := s.UsersServer.DeleteKey(ctx, in)
+	if !cc.IsZero() {
+		if err := grpccache.Internal_SetCacheControlTrailer(ctx, *cc); err != nil {
+			return nil, err
+		}
+	}
+	return result, err
+}
+
 type CachedUsersClient struct {
 	UsersClient
 	Cache *grpccache.Cache
@@ -4325,6 +7400,84 @@ func (s *CachedUsersClient) GetWithEmail(ctx context.Context, in *EmailAddr, opt
 	return result, nil
 }
 
+func (s *CachedUsersClient) GetByExternal(ctx context.Context, in *ExternalAccountSpec, opts ...grpc.CallOption) (*User, error) {
+	if s.Cache != nil {
+		var cachedResult User
+		cached, err := s.Cache.Get(ctx, "Users.GetByExternal", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.UsersClient.GetByExternal(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Users.GetByExternal", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedUsersClient) ListAuthoredDefs(ctx context.Context, in *UsersListAuthoredDefsOp, opts ...grpc.CallOption) (*AuthoredDefList, error) {
+	if s.Cache != nil {
+		var cachedResult AuthoredDefList
+		cached, err := s.Cache.Get(ctx, "Users.ListAuthoredDefs", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.UsersClient.ListAuthoredDefs(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Users.ListAuthoredDefs", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedUsersClient) ListContributions(ctx context.Context, in *UsersListContributionsOp, opts ...grpc.CallOption) (*UserContributionList, error) {
+	if s.Cache != nil {
+		var cachedResult UserContributionList
+		cached, err := s.Cache.Get(ctx, "Users.ListContributions", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.UsersClient.ListContributions(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Users.ListContributions", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
 func (s *CachedUsersClient) ListEmails(ctx context.Context, in *UserSpec, opts ...grpc.CallOption) (*EmailAddrList, error) {
 	if s.Cache != nil {
 		var cachedResult EmailAddrList
@@ -4376,3 +7529,81 @@ func (s *CachedUsersClient) List(ctx context.Context, in *UsersListOptions, opts
 	}
 	return result, nil
 }
+
+func (s *CachedUsersClient) ListKeys(ctx context.Context, in *UsersListKeysOp, opts ...grpc.CallOption) (*UserKeyList, error) {
+	if s.Cache != nil {
+		var cachedResult UserKeyList
+		cached, err := s.Cache.Get(ctx, "Users.ListKeys", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.UsersClient.ListKeys(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Users.ListKeys", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedUsersClient) AddKey(ctx context.Context, in *UsersAddKeyOp, opts ...grpc.CallOption) (*UserKey, error) {
+	if s.Cache != nil {
+		var cachedResult UserKey
+		cached, err := s.Cache.Get(ctx, "Users.AddKey", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.UsersClient.AddKey(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Users.AddKey", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CachedUsersClient) DeleteKey(ctx context.Context, in *UsersDeleteKeyOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	if s.Cache != nil {
+		var cachedResult pbtypes.Void
+		cached, err := s.Cache.Get(ctx, "Users.DeleteKey", in, &cachedResult)
+		if err != nil {
+			return nil, err
+		}
+		if cached {
+			return &cachedResult, nil
+		}
+	}
+
+	var trailer metadata.MD
+
+	result, err := s.UsersClient.DeleteKey(ctx, in, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cache != nil {
+		if err := s.Cache.Store(ctx, "Users.DeleteKey", in, result, trailer); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
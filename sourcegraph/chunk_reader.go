@@ -0,0 +1,46 @@
+package sourcegraph
+
+import "io"
+
+// A chunkReader is an io.ReadCloser that reads a large blob by
+// fetching successive chunks of it, for API methods that return
+// blobs in size-capped chunks (such as Repos.GetCommitPatch).
+type chunkReader struct {
+	// fetch fetches the chunk that begins at offset. It returns the
+	// chunk's data and whether the data extends to the end of the
+	// blob.
+	fetch func(offset int64) (data []byte, eof bool, err error)
+
+	offset int64  // offset of the next chunk to fetch
+	buf    []byte // unread data from the last chunk
+	eof    bool   // whether the last chunk reached the end of the blob
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.eof {
+			return 0, io.EOF
+		}
+		data, eof, err := r.fetch(r.offset)
+		if err != nil {
+			return 0, err
+		}
+		if len(data) == 0 && !eof {
+			return 0, io.ErrNoProgress
+		}
+		r.buf = data
+		r.eof = eof
+		r.offset += int64(len(data))
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// Close stops r from fetching any more chunks. Subsequent reads
+// return io.EOF.
+func (r *chunkReader) Close() error {
+	r.buf = nil
+	r.eof = true
+	return nil
+}
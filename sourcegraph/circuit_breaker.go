@@ -0,0 +1,150 @@
+package sourcegraph
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+// ErrCircuitOpen is returned by calls made through a CircuitBreaker
+// whose circuit for the method is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// A CircuitBreaker fails calls to an API method fast (with
+// ErrCircuitOpen) after the method has failed a number of consecutive
+// times due to server or transport errors, to protect callers (and
+// the server) during outages. After a cooldown period, a single trial
+// call is allowed through (while other calls still fail fast); if it
+// fails, the circuit is reopened, and otherwise it is closed.
+//
+// Calls that fail because their deadline was exceeded are not counted
+// as failures, because the deadline is chosen by the caller (or the
+// client's default timeouts), not the server.
+//
+// Add it to a client with:
+//
+//	c.UseInterceptor((&CircuitBreaker{}).Interceptor())
+type CircuitBreaker struct {
+	// Threshold is the number of consecutive failures after which
+	// the circuit for a method is opened (default 5).
+	Threshold int
+
+	// Cooldown is how long a circuit stays open before calls are
+	// allowed through again (default 30s).
+	Cooldown time.Duration
+
+	now func() time.Time // for testing
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+type circuit struct {
+	failures int
+	openedAt time.Time
+	trial    bool // whether a trial call is in progress
+}
+
+func (b *CircuitBreaker) threshold() int {
+	if b.Threshold > 0 {
+		return b.Threshold
+	}
+	return 5
+}
+
+func (b *CircuitBreaker) cooldown() time.Duration {
+	if b.Cooldown > 0 {
+		return b.Cooldown
+	}
+	return 30 * time.Second
+}
+
+func (b *CircuitBreaker) timeNow() time.Time {
+	if b.now != nil {
+		return b.now()
+	}
+	return time.Now()
+}
+
+// Open reports whether the circuit for method is open (i.e., whether
+// calls to it currently fail fast).
+func (b *CircuitBreaker) Open(method string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.circuits[method]
+	return c != nil && c.failures >= b.threshold() && (c.trial || b.timeNow().Before(c.openedAt.Add(b.cooldown())))
+}
+
+// allow reports whether a call to method may be made, and whether it
+// is the trial call after the circuit's cooldown.
+func (b *CircuitBreaker) allow(method string) (ok, trial bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.circuits[method]
+	if c == nil || c.failures < b.threshold() {
+		return true, false
+	}
+	if c.trial || b.timeNow().Before(c.openedAt.Add(b.cooldown())) {
+		return false, false
+	}
+	c.trial = true
+	return true, true
+}
+
+// record records the result of a call to method.
+func (b *CircuitBreaker) record(method string, err error, trial bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.circuits == nil {
+		b.circuits = map[string]*circuit{}
+	}
+	c := b.circuits[method]
+	if c == nil {
+		c = &circuit{}
+		b.circuits[method] = c
+	}
+	if trial {
+		c.trial = false
+	}
+	if !isBackendFailure(err) {
+		c.failures = 0
+		return
+	}
+	c.failures++
+	if c.failures >= b.threshold() {
+		c.openedAt = b.timeNow()
+	}
+}
+
+// Interceptor returns an Interceptor that applies the circuit breaker
+// to each call.
+func (b *CircuitBreaker) Interceptor() Interceptor {
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+			ok, trial := b.allow(method)
+			if !ok {
+				return nil, ErrCircuitOpen
+			}
+			result, err := next(ctx, method, in)
+			b.record(method, err, trial)
+			return result, err
+		}
+	}
+}
+
+// isBackendFailure reports whether err indicates that the server is
+// unavailable or failing (as opposed to, e.g., the request being
+// invalid or its deadline being exceeded).
+func isBackendFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch ErrorCode(err) {
+	case codes.Unavailable, codes.Internal, codes.DataLoss:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package sourcegraph
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := &CircuitBreaker{Threshold: 2, Cooldown: time.Minute, now: func() time.Time { return now }}
+
+	var err error
+	calls := 0
+	invoke := b.Interceptor()(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		calls++
+		return nil, err
+	})
+	ctx := context.Background()
+
+	for _, code := range []codes.Code{codes.NotFound, codes.DeadlineExceeded} {
+		err = grpc.Errorf(code, "x")
+		invoke(ctx, "Repos.Get", nil)
+		invoke(ctx, "Repos.Get", nil)
+		if b.Open("Repos.Get") {
+			t.Fatalf("circuit opened for %v errors", code)
+		}
+	}
+
+	err = grpc.Errorf(codes.Unavailable, "x")
+	invoke(ctx, "Repos.Get", nil)
+	invoke(ctx, "Repos.Get", nil)
+	if !b.Open("Repos.Get") {
+		t.Fatal("circuit not opened after consecutive failures")
+	}
+	if b.Open("Repos.List") {
+		t.Error("circuit for other method is open")
+	}
+	calls = 0
+	if _, err := invoke(ctx, "Repos.Get", nil); err != ErrCircuitOpen {
+		t.Errorf("got error %v, want ErrCircuitOpen", err)
+	}
+	if calls != 0 {
+		t.Error("call was made while circuit was open")
+	}
+
+	// After the cooldown, a failing call reopens the circuit.
+	now = now.Add(time.Minute)
+	invoke(ctx, "Repos.Get", nil)
+	if calls != 1 || !b.Open("Repos.Get") {
+		t.Errorf("got %d calls, open %v; want 1 call and reopened circuit", calls, b.Open("Repos.Get"))
+	}
+
+	// After the cooldown, a successful call closes the circuit.
+	now = now.Add(time.Minute)
+	err = nil
+	invoke(ctx, "Repos.Get", nil)
+	if b.Open("Repos.Get") {
+		t.Error("circuit not closed after successful call")
+	}
+}
+
+func TestCircuitBreaker_halfOpen(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := &CircuitBreaker{Threshold: 1, Cooldown: time.Minute, now: func() time.Time { return now }}
+
+	fail := b.Interceptor()(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return nil, grpc.Errorf(codes.Unavailable, "x")
+	})
+	ctx := context.Background()
+	fail(ctx, "Repos.Get", nil)
+	if !b.Open("Repos.Get") {
+		t.Fatal("circuit not opened after failure")
+	}
+
+	// After the cooldown, only one trial call is allowed through.
+	now = now.Add(time.Minute)
+	started, done := make(chan struct{}), make(chan struct{})
+	trial := b.Interceptor()(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		close(started)
+		<-done
+		return nil, nil
+	})
+	result := make(chan error)
+	go func() {
+		_, err := trial(ctx, "Repos.Get", nil)
+		result <- err
+	}()
+	<-started
+	if !b.Open("Repos.Get") {
+		t.Error("circuit not open during trial call")
+	}
+	if _, err := trial(ctx, "Repos.Get", nil); err != ErrCircuitOpen {
+		t.Errorf("got error %v during trial call, want ErrCircuitOpen", err)
+	}
+	close(done)
+	if err := <-result; err != nil {
+		t.Fatal(err)
+	}
+	if b.Open("Repos.Get") {
+		t.Error("circuit not closed after successful trial call")
+	}
+}
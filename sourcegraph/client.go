@@ -10,12 +10,17 @@ import (
 type Client struct {
 	// Services used to communicate with different parts of the Sourcegraph API.
 	Accounts            AccountsClient
+	Admin               AdminClient
+	AdminStats          AdminStatsClient
+	Annotations         AnnotationsClient
 	Auth                AuthClient
 	Builds              BuildsClient
 	Defs                DefsClient
 	Deltas              DeltasClient
 	Discussions         DiscussionsClient
+	GraphQL             GraphQLClient
 	GraphUplink         GraphUplinkClient
+	Issues              IssuesClient
 	Markdown            MarkdownClient
 	Meta                MetaClient
 	MirrorRepos         MirrorReposClient
@@ -25,9 +30,11 @@ type Client struct {
 	People              PeopleClient
 	RegisteredClients   RegisteredClientsClient
 	RepoBadges          RepoBadgesClient
+	RepoDependencies    RepoDependenciesClient
 	RepoStatuses        RepoStatusesClient
 	RepoTree            RepoTreeClient
 	Repos               ReposClient
+	SavedSearches       SavedSearchesClient
 	Storage             StorageClient
 	Changesets          ChangesetsClient
 	Search              SearchClient
@@ -38,42 +45,35 @@ type Client struct {
 	// gRPC client connection used to communicate with the Sourcegraph
 	// API.
 	Conn *grpc.ClientConn
+
+	// MaxResponseBytes, if nonzero, is the largest response message
+	// (in bytes, as encoded on the wire) that the client accepts.
+	// Calls whose response is larger fail with an
+	// *ErrResponseTooLarge, without the response being decoded. It
+	// may be overridden per call using WithMaxResponseBytes. It must
+	// not be changed while calls are in progress.
+	MaxResponseBytes int
+
+	concurrencyLimit *concurrencyLimit // set by SetMaxConcurrentCalls
 }
 
 // Cache is the gRPC cache used to cache API responses.
 var Cache *grpccache.Cache
 
 // NewClient returns a Sourcegraph API client.
+//
+// Each service's gRPC client is wrapped (beneath its cache) by an
+// intercepted client, so that call options added using
+// WithCallOption reach gRPC even though the cached clients do not
+// pass their callers' call options through.
 func NewClient(conn *grpc.ClientConn) *Client {
 	c := new(Client)
 
 	// gRPC (HTTP/2)
 	c.Conn = conn
-	c.Accounts = &CachedAccountsClient{NewAccountsClient(conn), Cache}
-	c.Auth = &CachedAuthClient{NewAuthClient(conn), Cache}
-	c.Builds = &CachedBuildsClient{NewBuildsClient(conn), Cache}
-	c.Defs = &CachedDefsClient{NewDefsClient(conn), Cache}
-	c.Deltas = &CachedDeltasClient{NewDeltasClient(conn), Cache}
-	c.Discussions = &CachedDiscussionsClient{NewDiscussionsClient(conn), Cache}
-	c.GraphUplink = &CachedGraphUplinkClient{NewGraphUplinkClient(conn), Cache}
-	c.Markdown = &CachedMarkdownClient{NewMarkdownClient(conn), Cache}
-	c.Meta = &CachedMetaClient{NewMetaClient(conn), Cache}
-	c.MirrorRepos = &CachedMirrorReposClient{NewMirrorReposClient(conn), Cache}
-	c.MirroredRepoSSHKeys = &CachedMirroredRepoSSHKeysClient{NewMirroredRepoSSHKeysClient(conn), Cache}
-	c.Notify = &CachedNotifyClient{NewNotifyClient(conn), Cache}
-	c.Orgs = &CachedOrgsClient{NewOrgsClient(conn), Cache}
-	c.People = &CachedPeopleClient{NewPeopleClient(conn), Cache}
-	c.RegisteredClients = &CachedRegisteredClientsClient{NewRegisteredClientsClient(conn), Cache}
-	c.RepoBadges = &CachedRepoBadgesClient{NewRepoBadgesClient(conn), Cache}
-	c.RepoStatuses = &CachedRepoStatusesClient{NewRepoStatusesClient(conn), Cache}
-	c.RepoTree = &CachedRepoTreeClient{NewRepoTreeClient(conn), Cache}
-	c.Repos = &CachedReposClient{NewReposClient(conn), Cache}
-	c.Storage = &CachedStorageClient{NewStorageClient(conn), Cache}
-	c.Changesets = &CachedChangesetsClient{NewChangesetsClient(conn), Cache}
-	c.Search = &CachedSearchClient{NewSearchClient(conn), Cache}
-	c.Units = &CachedUnitsClient{NewUnitsClient(conn), Cache}
-	c.Users = &CachedUsersClient{NewUsersClient(conn), Cache}
-	c.UserKeys = &CachedUserKeysClient{NewUserKeysClient(conn), Cache}
+	for _, service := range serviceNames {
+		c.setServiceConn(service, conn)
+	}
 
 	return c
 }
@@ -0,0 +1,80 @@
+package sourcegraph
+
+import (
+	"bufio"
+	"bytes"
+	"path"
+	"strings"
+)
+
+// CodeOwnersFilePaths are the paths (relative to the repository
+// root) where a code owners file is looked for, in order of
+// preference.
+var CodeOwnersFilePaths = []string{"CODEOWNERS", ".github/CODEOWNERS", "docs/CODEOWNERS", "OWNERS"}
+
+// ParseCodeOwners parses the rules in a code owners file. Each
+// nonblank line that isn't a comment (starting with "#") is a rule,
+// consisting of a path pattern followed by zero or more
+// whitespace-separated owners.
+func ParseCodeOwners(data []byte) []*CodeOwnersRule {
+	var rules []*CodeOwnersRule
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for line := int32(1); s.Scan(); line++ {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		rule := &CodeOwnersRule{Pattern: fields[0], Line: line}
+		for _, owner := range fields[1:] {
+			if strings.HasPrefix(owner, "#") {
+				break
+			}
+			rule.Owners = append(rule.Owners, owner)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// MatchCodeOwners returns the last rule in rules that matches p (the
+// rule that determines p's owners), or nil if none match.
+func MatchCodeOwners(rules []*CodeOwnersRule, p string) *CodeOwnersRule {
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].Match(p) {
+			return rules[i]
+		}
+	}
+	return nil
+}
+
+// Match reports whether r's pattern matches p, a path relative to
+// the repository root. As in .gitignore files, a pattern containing a
+// non-trailing "/" is matched against the path from the root, and a
+// pattern without one is matched against each path component. A
+// pattern with a trailing "/" only matches directories. A pattern
+// that matches a directory also matches everything under it.
+func (r *CodeOwnersRule) Match(p string) bool {
+	pat := strings.TrimSuffix(r.Pattern, "/")
+	dirOnly := pat != r.Pattern
+	segs := strings.Split(strings.Trim(p, "/"), "/")
+
+	if strings.Contains(pat, "/") {
+		pat = strings.TrimPrefix(pat, "/")
+		n := strings.Count(pat, "/") + 1
+		if n > len(segs) || (dirOnly && n == len(segs)) {
+			return false
+		}
+		ok, _ := path.Match(pat, strings.Join(segs[:n], "/"))
+		return ok
+	}
+
+	for i, seg := range segs {
+		if dirOnly && i == len(segs)-1 {
+			break
+		}
+		if ok, _ := path.Match(pat, seg); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package sourcegraph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCodeOwners(t *testing.T) {
+	data := []byte(`# Default owners.
+*       @org/core
+
+/docs/  alice@example.com @bob # docs team
+*.go    @carol
+`)
+	want := []*CodeOwnersRule{
+		{Pattern: "*", Owners: []string{"@org/core"}, Line: 2},
+		{Pattern: "/docs/", Owners: []string{"alice@example.com", "@bob"}, Line: 4},
+		{Pattern: "*.go", Owners: []string{"@carol"}, Line: 5},
+	}
+	rules := ParseCodeOwners(data)
+	if !reflect.DeepEqual(rules, want) {
+		t.Fatalf("got rules %+v, want %+v", rules, want)
+	}
+
+	tests := map[string]int32{
+		"README.md":      2,
+		"docs/index.md":  4,
+		"docs/api/x.go":  5,
+		"cmd/main.go":    5,
+		"src/docs/x.txt": 2,
+	}
+	for p, wantLine := range tests {
+		rule := MatchCodeOwners(rules, p)
+		if rule == nil || rule.Line != wantLine {
+			t.Errorf("%q: got rule %+v, want rule on line %d", p, rule, wantLine)
+		}
+	}
+}
+
+func TestCodeOwnersRule_Match(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"*", "a/b.txt", true},
+		{"*.go", "a/b.go", true},
+		{"*.go", "a/b.txt", false},
+		{"build", "x/build/y", true},
+		{"build/", "x/build", false},
+		{"build/", "x/build/y", true},
+		{"/docs", "docs", true},
+		{"/docs", "docs/a.md", true},
+		{"/docs", "src/docs/a.md", false},
+		{"src/*.go", "src/a.go", true},
+		{"src/*.go", "src/a/b.go", false},
+		{"src/*.go", "lib/src/a.go", false},
+		{"/a/b/c", "a/b", false},
+	}
+	for _, test := range tests {
+		if got := (&CodeOwnersRule{Pattern: test.pattern}).Match(test.path); got != test.want {
+			t.Errorf("%q matching %q: got %v, want %v", test.pattern, test.path, got, test.want)
+		}
+	}
+}
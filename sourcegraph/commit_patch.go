@@ -0,0 +1,33 @@
+package sourcegraph
+
+import (
+	"io"
+
+	"golang.org/x/net/context"
+)
+
+// CommitPatchReader returns an io.ReadCloser that reads the patch for
+// the commit specified by op. The patch is fetched lazily, one
+// GetCommitPatch call per chunk, so that very large patches need not
+// be held in memory all at once. The op's Opt.Offset is used as the
+// starting offset. Closing the reader stops it from fetching any more
+// chunks.
+func CommitPatchReader(ctx context.Context, c ReposClient, op *ReposGetCommitPatchOp) io.ReadCloser {
+	op2 := *op
+	var opt RepoGetCommitPatchOptions
+	if op.Opt != nil {
+		opt = *op.Opt
+	}
+	op2.Opt = &opt
+	return &chunkReader{
+		offset: opt.Offset,
+		fetch: func(offset int64) ([]byte, bool, error) {
+			op2.Opt.Offset = offset
+			patch, err := c.GetCommitPatch(ctx, &op2)
+			if err != nil {
+				return nil, false, err
+			}
+			return patch.Data, patch.EOF, nil
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package sourcegraph
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// chunkedPatchReposClient serves patch in chunks of at most chunkSize
+// bytes.
+type chunkedPatchReposClient struct {
+	ReposClient
+	patch     string
+	chunkSize int
+	calls     int
+}
+
+func (c *chunkedPatchReposClient) GetCommitPatch(ctx context.Context, op *ReposGetCommitPatchOp, opts ...grpc.CallOption) (*CommitPatch, error) {
+	c.calls++
+	start := int(op.Opt.Offset)
+	end := start + c.chunkSize
+	if end > len(c.patch) {
+		end = len(c.patch)
+	}
+	return &CommitPatch{
+		Data:      []byte(c.patch[start:end]),
+		Offset:    int64(start),
+		TotalSize: int64(len(c.patch)),
+		EOF:       end == len(c.patch),
+	}, nil
+}
+
+func TestCommitPatchReader(t *testing.T) {
+	tests := []struct {
+		patch     string
+		chunkSize int
+		offset    int64
+		want      string
+		wantCalls int
+	}{
+		{patch: "", chunkSize: 3, want: "", wantCalls: 1},
+		{patch: "abc", chunkSize: 3, want: "abc", wantCalls: 1},
+		{patch: "abcdefg", chunkSize: 3, want: "abcdefg", wantCalls: 3},
+		{patch: "abcdefg", chunkSize: 3, offset: 2, want: "cdefg", wantCalls: 2},
+	}
+	for _, test := range tests {
+		c := &chunkedPatchReposClient{patch: test.patch, chunkSize: test.chunkSize}
+		op := &ReposGetCommitPatchOp{Opt: &RepoGetCommitPatchOptions{Offset: test.offset}}
+		data, err := ioutil.ReadAll(CommitPatchReader(context.Background(), c, op))
+		if err != nil {
+			t.Errorf("%q: %s", test.patch, err)
+			continue
+		}
+		if string(data) != test.want {
+			t.Errorf("%q: got %q, want %q", test.patch, data, test.want)
+		}
+		if c.calls != test.wantCalls {
+			t.Errorf("%q: got %d calls, want %d", test.patch, c.calls, test.wantCalls)
+		}
+		if op.Opt.Offset != test.offset {
+			t.Errorf("%q: op.Opt.Offset was modified", test.patch)
+		}
+	}
+}
+
+func TestCommitPatchReader_Close(t *testing.T) {
+	c := &chunkedPatchReposClient{patch: "abcdefg", chunkSize: 2}
+	r := CommitPatchReader(context.Background(), c, &ReposGetCommitPatchOp{})
+	buf := make([]byte, 1)
+	if _, err := r.Read(buf); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Read(buf); err != io.EOF {
+		t.Errorf("got error %v after Close, want io.EOF", err)
+	}
+	if c.calls != 1 {
+		t.Errorf("got %d calls, want 1", c.calls)
+	}
+}
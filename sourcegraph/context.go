@@ -23,6 +23,12 @@ const (
 	httpEndpointKey
 	credentialsKey
 	clientMetadataKey
+	timeoutsKey
+	debugLogKey
+	callOptionsKey
+	serviceGRPCEndpointsKey
+	maxResponseBytesKey
+	authErrorKey
 )
 
 // WithGRPCEndpoint returns a copy of parent whose clients (obtained
@@ -88,7 +94,8 @@ func clientMetadataFromContext(ctx context.Context) map[string]string {
 
 // NewClientFromContext returns a Sourcegraph API client that
 // communicates with the Sourcegraph gRPC endpoint in ctx (i.e.,
-// GRPCEndpoint(ctx)).
+// GRPCEndpoint(ctx)), or with the per-service endpoints set by
+// WithServiceGRPCEndpoints.
 func NewClientFromContext(ctx context.Context) *Client {
 	newClientFromContextMu.RLock()
 	f := newClientFromContext
@@ -119,11 +126,20 @@ var (
 	newClientFromContext   = realNewClientFromContext
 )
 var realNewClientFromContext = func(ctx context.Context) *Client {
+	c := NewClient(dialGRPCEndpoint(ctx, GRPCEndpoint(ctx)))
+	for service, endpoint := range ServiceGRPCEndpoints(ctx) {
+		c.setServiceConn(service, dialGRPCEndpoint(ctx, endpoint))
+	}
+	return c
+}
+
+// dialGRPCEndpoint returns a pooled connection to grpcEndpoint. It
+// panics if the connection can't be dialed.
+func dialGRPCEndpoint(ctx context.Context, grpcEndpoint *url.URL) *grpc.ClientConn {
 	opts := []grpc.DialOption{
 		grpc.WithCodec(GRPCCodec),
 	}
 
-	grpcEndpoint := GRPCEndpoint(ctx)
 	if grpcEndpoint.Scheme == "https" {
 		creds := credentials.NewClientTLSFromCert(nil, "")
 		if host, _, _ := net.SplitHostPort(grpcEndpoint.Host); host == "localhost" {
@@ -134,6 +150,8 @@ var realNewClientFromContext = func(ctx context.Context) *Client {
 		opts = append(opts, grpc.WithInsecure())
 	}
 
+	opts = append(opts, compressionDialOptions()...)
+
 	// Use contextCredentials instead of directly using the cred
 	// so that we can use different credentials for the same
 	// connection (in the pool).
@@ -153,8 +171,34 @@ var realNewClientFromContext = func(ctx context.Context) *Client {
 	if err != nil {
 		panic(err)
 	}
-	c := NewClient(conn)
-	return c
+	return conn
+}
+
+// EnableCompression enables gzip compression of API requests (and
+// decompression of gzip-compressed responses). Compression is not
+// negotiated with the server: when it is enabled, every request is
+// sent gzip-compressed, so only enable it for servers that accept
+// gzip-compressed requests. It only affects clients whose connections
+// are dialed after it is set.
+var EnableCompression bool
+
+// compressors returns the compressor and decompressor that dialed
+// connections use, or nils if EnableCompression is not set.
+func compressors() (grpc.Compressor, grpc.Decompressor) {
+	if !EnableCompression {
+		return nil, nil
+	}
+	return grpc.NewGZIPCompressor(), grpc.NewGZIPDecompressor()
+}
+
+// compressionDialOptions returns the gRPC dial options that enable
+// compression, if EnableCompression is set.
+func compressionDialOptions() []grpc.DialOption {
+	cp, dc := compressors()
+	if cp == nil {
+		return nil
+	}
+	return []grpc.DialOption{grpc.WithCompressor(cp), grpc.WithDecompressor(dc)}
 }
 
 // hostWithExplicitPort returns u's host with an explicit port number
@@ -174,12 +218,15 @@ func hostWithExplicitPort(u *url.URL) string {
 }
 
 // RemovePooledGRPCConn removes the pooled grpc.ClientConnection to the gRPC endpoint
-// in the context. The result of calling this function  is that the pooled connection for
-// this endpoint will be reset, so the subsequent call to NewClientFromContext() would have
-// to dial a new gRPC connection to this endpoint.
+// in the context (and to its per-service endpoints, if any). The result of calling this
+// function  is that the pooled connection for these endpoints will be reset, so the
+// subsequent call to NewClientFromContext() would have to dial new gRPC connections.
 var RemovePooledGRPCConn = func(ctx context.Context) {
 	grpcEndpoint := GRPCEndpoint(ctx)
-	removeConnFromPool(grpcEndpoint.Host)
+	removeConnFromPool(hostWithExplicitPort(grpcEndpoint))
+	for _, endpoint := range ServiceGRPCEndpoints(ctx) {
+		removeConnFromPool(hostWithExplicitPort(endpoint))
+	}
 }
 
 // contextCredentials implements the credentials.Credentials interface.
@@ -188,27 +235,24 @@ type contextCredentials struct{}
 // GetRequestMetadata implements the credentials.Credentials interface. As per the
 // interface definition, it may be called by multiple goroutines concurrently.
 func (contextCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
-	m := clientMetadataFromContext(ctx)
+	// Copy the metadata to avoid a data race writing to the map
+	// returned by clientMetadataFromContext.
+	m := map[string]string{APIVersionMetadataKey: APIVersion}
+	for k, v := range clientMetadataFromContext(ctx) {
+		m[k] = v
+	}
 
 	if cred := CredentialsFromContext(ctx); cred != nil {
 		credMD, err := (oauth.TokenSource{TokenSource: cred}).GetRequestMetadata(ctx)
 		if err != nil {
-			return nil, err
-		}
-
-		if m == nil {
-			m = credMD
-		} else {
-			// Copy the map to avoid a data race writing to the map returned by
-			// clientMetaDataFromContext.
-			cpy := make(map[string]string)
-			for k, v := range m {
-				cpy[k] = v
+			authErr := &AuthError{Err: err}
+			if p, _ := ctx.Value(authErrorKey).(**AuthError); p != nil {
+				*p = authErr
 			}
-			for k, v := range credMD {
-				cpy[k] = v
-			}
-			m = cpy
+			return nil, authErr
+		}
+		for k, v := range credMD {
+			m[k] = v
 		}
 	}
 	return m, nil
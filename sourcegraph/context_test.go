@@ -2,7 +2,9 @@ package sourcegraph
 
 import (
 	"bytes"
+	"compress/gzip"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/url"
 	"os/exec"
@@ -92,3 +94,37 @@ func (s *testMetaServer) Status(ctx context.Context, _ *pbtypes.Void) (*ServerSt
 	}
 	return &ServerStatus{}, nil
 }
+
+func TestCompressors(t *testing.T) {
+	if cp, dc := compressors(); cp != nil || dc != nil {
+		t.Errorf("got (%v, %v) by default, want no compression", cp, dc)
+	}
+
+	EnableCompression = true
+	defer func() { EnableCompression = false }()
+	cp, dc := compressors()
+	if cp == nil || dc == nil {
+		t.Fatal("got no compressor or decompressor with compression enabled")
+	}
+
+	// Requests are gzip-compressed, and gzip-compressed responses
+	// are decompressed.
+	msg := bytes.Repeat([]byte("sourcegraph "), 100)
+	var buf bytes.Buffer
+	if err := cp.Do(&buf, msg); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() >= len(msg) {
+		t.Errorf("got %d compressed bytes, want fewer than %d", buf.Len(), len(msg))
+	}
+	z, err := gzip.NewReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, err := ioutil.ReadAll(z); err != nil || !bytes.Equal(b, msg) {
+		t.Errorf("got gzip-decoded request %q (%v), want %q", b, err, msg)
+	}
+	if b, err := dc.Do(&buf); err != nil || !bytes.Equal(b, msg) {
+		t.Errorf("got decompressed response %q (%v), want %q", b, err, msg)
+	}
+}
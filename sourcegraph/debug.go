@@ -0,0 +1,70 @@
+package sourcegraph
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// DebugLogInterceptor returns an Interceptor that writes a line to w
+// for each API call, with the method name, endpoint URL, status, and
+// duration. If bodies is true, it also writes the call's request and
+// response, with secrets (such as passwords and tokens) redacted.
+//
+// Logging may be enabled or disabled for individual calls using
+// WithDebugLog.
+func DebugLogInterceptor(w io.Writer, bodies bool) Interceptor {
+	var mu sync.Mutex // serializes writes to w
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+			if enabled, ok := ctx.Value(debugLogKey).(bool); ok && !enabled {
+				return next(ctx, method, in)
+			}
+
+			start := time.Now()
+			result, err := next(ctx, method, in)
+			d := time.Since(start)
+
+			var buf strings.Builder
+			fmt.Fprint(&buf, method)
+			if url, _ := ctx.Value(grpcEndpointKey).(*url.URL); url != nil {
+				fmt.Fprintf(&buf, " %s", url)
+			}
+			status := "OK"
+			if err != nil {
+				status = fmt.Sprintf("%s (%s)", ErrorCode(err), ErrorDesc(err))
+			}
+			fmt.Fprintf(&buf, " %s %s\n", status, d)
+			if bodies {
+				fmt.Fprintf(&buf, "\trequest: %s\n", strings.TrimSpace(fmt.Sprint(redact(in))))
+				if err == nil {
+					fmt.Fprintf(&buf, "\tresponse: %s\n", strings.TrimSpace(fmt.Sprint(redact(result))))
+				}
+			}
+
+			mu.Lock()
+			io.WriteString(w, buf.String())
+			mu.Unlock()
+			return result, err
+		}
+	}
+}
+
+// WithDebugLog returns a copy of parent in which calls are logged by
+// DebugLogInterceptor only if enabled is true. It overrides (for
+// calls made using the returned context) the default of logging all
+// calls.
+func WithDebugLog(parent context.Context, enabled bool) context.Context {
+	return context.WithValue(parent, debugLogKey, enabled)
+}
+
+// SetDebugLogger adds an interceptor to c that logs all API calls to
+// w. See DebugLogInterceptor for more information.
+func (c *Client) SetDebugLogger(w io.Writer, bodies bool) {
+	c.UseInterceptor(DebugLogInterceptor(w, bodies))
+}
@@ -0,0 +1,155 @@
+package sourcegraph
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// DefKind is the kind of a def (the Kind field of graph.Def).
+type DefKind string
+
+// Known def kinds.
+const (
+	DefKindPackage   DefKind = "package"
+	DefKindType      DefKind = "type"
+	DefKindInterface DefKind = "interface"
+	DefKindFunc      DefKind = "func"
+	DefKindMethod    DefKind = "method"
+	DefKindField     DefKind = "field"
+	DefKindVar       DefKind = "var"
+	DefKindConst     DefKind = "const"
+)
+
+// DefKinds lists all known def kinds.
+var DefKinds = []DefKind{DefKindPackage, DefKindType, DefKindInterface, DefKindFunc, DefKindMethod, DefKindField, DefKindVar, DefKindConst}
+
+// Valid reports whether k is a known def kind.
+func (k DefKind) Valid() bool {
+	for _, k2 := range DefKinds {
+		if k == k2 {
+			return true
+		}
+	}
+	return false
+}
+
+// DefListFilter is the set of filters in DefListOptions that select
+// defs by their kind and properties. Use it (instead of setting the
+// DefListOptions fields directly) to have invalid filters rejected
+// by the client rather than silently matching no defs.
+type DefListFilter struct {
+	// Kinds, if set, limits the list to defs of these kinds.
+	Kinds []DefKind
+
+	// Exported limits the list to exported defs.
+	Exported bool
+
+	// Nonlocal limits the list to nonlocal defs.
+	Nonlocal bool
+
+	// IncludeTest includes defs in test files in the list.
+	IncludeTest bool
+
+	// Doc includes the defs' docs in the list.
+	Doc bool
+}
+
+// Validate returns an *InvalidOptionsError if f contains an unknown
+// or duplicate def kind.
+func (f DefListFilter) Validate() error {
+	seen := make(map[DefKind]bool, len(f.Kinds))
+	for _, k := range f.Kinds {
+		if !k.Valid() {
+			return &InvalidOptionsError{Reason: fmt.Sprintf("unknown def kind %q", k)}
+		}
+		if seen[k] {
+			return &InvalidOptionsError{Reason: fmt.Sprintf("duplicate def kind %q", k)}
+		}
+		seen[k] = true
+	}
+	return nil
+}
+
+// Apply validates f and sets the corresponding filter fields of o.
+func (f DefListFilter) Apply(o *DefListOptions) error {
+	if err := f.Validate(); err != nil {
+		return err
+	}
+	o.Kinds = nil
+	for _, k := range f.Kinds {
+		o.Kinds = append(o.Kinds, string(k))
+	}
+	o.Exported = f.Exported
+	o.Nonlocal = f.Nonlocal
+	o.IncludeTest = f.IncludeTest
+	o.Doc = f.Doc
+	return nil
+}
+
+// Filter returns the DefListFilter for o's filter fields. It returns
+// an *InvalidOptionsError if they are invalid.
+func (o *DefListOptions) Filter() (DefListFilter, error) {
+	f := DefListFilter{
+		Exported:    o.Exported,
+		Nonlocal:    o.Nonlocal,
+		IncludeTest: o.IncludeTest,
+		Doc:         o.Doc,
+	}
+	for _, k := range o.Kinds {
+		f.Kinds = append(f.Kinds, DefKind(k))
+	}
+	return f, f.Validate()
+}
+
+// Values returns the URL query parameters that represent f, using
+// the same names and encoding as the DefListOptions fields' url
+// struct tags (e.g., "Kinds=func,type&Exported=true"). It is the
+// inverse of ParseDefListFilter.
+func (f DefListFilter) Values() url.Values {
+	v := url.Values{}
+	if len(f.Kinds) > 0 {
+		kinds := make([]string, len(f.Kinds))
+		for i, k := range f.Kinds {
+			kinds[i] = string(k)
+		}
+		v.Set("Kinds", strings.Join(kinds, ","))
+	}
+	for _, b := range []struct {
+		name string
+		val  bool
+	}{{"Exported", f.Exported}, {"Nonlocal", f.Nonlocal}, {"IncludeTest", f.IncludeTest}, {"Doc", f.Doc}} {
+		if b.val {
+			v.Set(b.name, "true")
+		}
+	}
+	return v
+}
+
+// ParseDefListFilter parses the URL query parameters generated by
+// (DefListFilter).Values. It returns an *InvalidOptionsError if they
+// are malformed or specify an invalid filter.
+func ParseDefListFilter(v url.Values) (DefListFilter, error) {
+	var f DefListFilter
+	if kinds := v.Get("Kinds"); kinds != "" {
+		for _, k := range strings.Split(kinds, ",") {
+			f.Kinds = append(f.Kinds, DefKind(k))
+		}
+	}
+	for _, b := range []struct {
+		name string
+		ptr  *bool
+	}{{"Exported", &f.Exported}, {"Nonlocal", &f.Nonlocal}, {"IncludeTest", &f.IncludeTest}, {"Doc", &f.Doc}} {
+		s := v.Get(b.name)
+		if s == "" {
+			continue
+		}
+		val, err := strconv.ParseBool(s)
+		if err != nil {
+			return DefListFilter{}, &InvalidOptionsError{Reason: fmt.Sprintf("invalid %s value %q", b.name, s)}
+		}
+		*b.ptr = val
+	}
+	return f, f.Validate()
+}
@@ -0,0 +1,97 @@
+package sourcegraph
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestDefListFilter_Validate(t *testing.T) {
+	tests := []struct {
+		filter  DefListFilter
+		wantErr bool
+	}{
+		{DefListFilter{}, false},
+		{DefListFilter{Kinds: []DefKind{DefKindFunc, DefKindType}, Exported: true}, false},
+		{DefListFilter{Kinds: []DefKind{"function"}}, true},
+		{DefListFilter{Kinds: []DefKind{DefKindFunc, DefKindFunc}}, true},
+	}
+	for _, test := range tests {
+		err := test.filter.Validate()
+		if (err != nil) != test.wantErr {
+			t.Errorf("%+v: got error %v, want error %v", test.filter, err, test.wantErr)
+		}
+		if _, ok := err.(*InvalidOptionsError); err != nil && !ok {
+			t.Errorf("%+v: got error type %T, want *InvalidOptionsError", test.filter, err)
+		}
+	}
+}
+
+func TestDefListFilter_Apply(t *testing.T) {
+	f := DefListFilter{Kinds: []DefKind{DefKindMethod}, Nonlocal: true, Doc: true}
+	opt := DefListOptions{Name: "n", Kinds: []string{"var"}, Exported: true}
+	if err := f.Apply(&opt); err != nil {
+		t.Fatal(err)
+	}
+	want := DefListOptions{Name: "n", Kinds: []string{"method"}, Nonlocal: true, Doc: true}
+	if !reflect.DeepEqual(opt, want) {
+		t.Errorf("got %+v, want %+v", opt, want)
+	}
+
+	f2, err := opt.Filter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(f2, f) {
+		t.Errorf("got filter %+v, want %+v", f2, f)
+	}
+
+	if err := (DefListFilter{Kinds: []DefKind{"bogus"}}).Apply(&opt); err == nil {
+		t.Error("got nil error for invalid filter")
+	}
+	if !reflect.DeepEqual(opt, want) {
+		t.Errorf("invalid filter modified options: got %+v, want %+v", opt, want)
+	}
+}
+
+func TestDefListFilter_Values(t *testing.T) {
+	tests := []struct {
+		filter DefListFilter
+		query  string
+	}{
+		{DefListFilter{}, ""},
+		{DefListFilter{Kinds: []DefKind{DefKindFunc, DefKindType}}, "Kinds=func%2Ctype"},
+		{DefListFilter{Exported: true, IncludeTest: true}, "Exported=true&IncludeTest=true"},
+		{DefListFilter{Kinds: []DefKind{DefKindConst}, Nonlocal: true, Doc: true}, "Doc=true&Kinds=const&Nonlocal=true"},
+	}
+	for _, test := range tests {
+		if got := test.filter.Values().Encode(); got != test.query {
+			t.Errorf("%+v: got query %q, want %q", test.filter, got, test.query)
+		}
+
+		v, err := url.ParseQuery(test.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := ParseDefListFilter(v)
+		if err != nil {
+			t.Errorf("%q: %s", test.query, err)
+			continue
+		}
+		if !reflect.DeepEqual(f, test.filter) {
+			t.Errorf("%q: got filter %+v, want %+v", test.query, f, test.filter)
+		}
+	}
+}
+
+func TestParseDefListFilter_invalid(t *testing.T) {
+	for _, query := range []string{"Kinds=func,bogus", "Exported=maybe", "Kinds=var,var"} {
+		v, err := url.ParseQuery(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ParseDefListFilter(v); err == nil {
+			t.Errorf("%q: got nil error", query)
+		}
+	}
+}
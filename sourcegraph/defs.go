@@ -1,10 +1,15 @@
 package sourcegraph
 
 import (
+	"bytes"
 	"fmt"
 	"log"
 	"path"
+	"regexp"
+	"strings"
 
+	"golang.org/x/net/context"
+	"sourcegraph.com/sourcegraph/go-sourcegraph/router"
 	"sourcegraph.com/sourcegraph/srclib/graph"
 	"sourcegraph.com/sourcegraph/srclib/store"
 	"sourcegraph.com/sourcegraph/srclib/unit"
@@ -12,12 +17,70 @@ import (
 
 func (s *DefSpec) RouteVars() map[string]string {
 	m := map[string]string{"Repo": s.Repo, "UnitType": s.UnitType, "Unit": s.Unit, "Path": s.Path}
-	if s.CommitID != "" {
+	if s.Rev != "" {
+		m["Rev"] = s.Rev
+		if s.CommitID != "" {
+			m["CommitID"] = s.CommitID
+		}
+	} else if s.CommitID != "" {
 		m["Rev"] = s.CommitID
 	}
 	return m
 }
 
+// RepoRevSpec returns the RepoRevSpec for the repository revision
+// that s is pinned to. If s is not pinned, the returned RepoRevSpec's
+// Rev and CommitID are empty.
+func (s *DefSpec) RepoRevSpec() RepoRevSpec {
+	return RepoRevSpec{RepoSpec: RepoSpec{URI: s.Repo}, Rev: s.Rev, CommitID: s.CommitID}
+}
+
+// SpecString returns the string representation of the DefSpec (e.g.,
+// "repo@commit/.GoPackage/unit/.def/path"). It is the inverse of
+// ParseDefSpec for complete, valid specs; see routeSpecString.
+func (s *DefSpec) SpecString() string {
+	return routeSpecString(router.Def, s, s.RouteVars, s.Repo)
+}
+
+// ParseDefSpec parses a string generated by (*DefSpec).SpecString()
+// and returns the equivalent DefSpec struct.
+func ParseDefSpec(s string) (DefSpec, error) {
+	routeVars, err := parseRouteSpec(router.Def, "DefSpec", s)
+	if err != nil {
+		return DefSpec{}, err
+	}
+	return UnmarshalDefSpec(routeVars)
+}
+
+// UnmarshalDefSpec marshals a map containing route variables
+// generated by (*DefSpec).RouteVars() and returns the equivalent
+// DefSpec struct. If the revision in the route variables is an
+// absolute commit ID (and no other commit ID is given), it is used as
+// the CommitID. Other revisions (e.g., branch names) are returned in
+// Rev, not CommitID as they were before DefSpec had a Rev field, so
+// callers must resolve Rev if CommitID is empty.
+func UnmarshalDefSpec(routeVars map[string]string) (DefSpec, error) {
+	rr, err := UnmarshalRepoRevSpec(routeVars)
+	if err != nil {
+		return DefSpec{}, err
+	}
+	s := DefSpec{
+		Repo:     rr.URI,
+		Rev:      rr.Rev,
+		CommitID: rr.CommitID,
+		UnitType: routeVars["UnitType"],
+		Unit:     routeVars["Unit"],
+		Path:     routeVars["Path"],
+	}
+	if s.CommitID == "" && commitIDPattern.MatchString(s.Rev) {
+		s.Rev, s.CommitID = "", s.Rev
+	}
+	if s.UnitType == "" || s.Unit == "" || s.Path == "" {
+		return DefSpec{}, fmt.Errorf("incomplete def route vars: %v", routeVars)
+	}
+	return s, nil
+}
+
 // DefKey returns the def key specified by s, using the Repo, UnitType,
 // Unit, and Path fields of s.
 func (s *DefSpec) DefKey() graph.DefKey {
@@ -57,6 +120,40 @@ func (s *Def) DefSpec() DefSpec {
 	return spec
 }
 
+// SameRepoRefCount returns the number of refs to s from its own
+// repository. Like RefCount and XRepoRefCount, it is only meaningful
+// if the RefCounts option was set when s was fetched.
+func (s *Def) SameRepoRefCount() int32 {
+	return s.RefCount - s.XRepoRefCount
+}
+
+// Param returns the documentation of the named parameter, or nil if
+// it is not documented.
+func (d *DefDocumentation) Param(name string) *DefDocParam {
+	for _, p := range d.Params {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// DocSummary returns the first sentence of the plain text
+// documentation text (for DefDocumentation.Summary), with whitespace
+// collapsed. A sentence ends at a period followed by whitespace, or
+// at the end of the first paragraph.
+func DocSummary(text string) string {
+	para := strings.TrimSpace(text)
+	if i := strings.Index(para, "\n\n"); i != -1 {
+		para = para[:i]
+	}
+	para = strings.Join(strings.Fields(para), " ")
+	if i := strings.Index(para, ". "); i != -1 {
+		return para[:i+1]
+	}
+	return para
+}
+
 func (o *DefListOptions) DefFilters() []store.DefFilter {
 	var fs []store.DefFilter
 	if o.DefKeys != nil {
@@ -141,6 +238,113 @@ func (o *DefListOptions) DefFilters() []store.DefFilter {
 	return fs
 }
 
+// Resolve returns the current identity of the def whose lineage is
+// l, if old refers to any of the def's identities (past or
+// present). The CommitID of old is ignored. The returned DefSpec's
+// CommitID is the commit at which the def took on its current
+// identity.
+func (l *DefLineage) Resolve(old DefSpec) (DefSpec, bool) {
+	if len(l.Entries) == 0 {
+		return DefSpec{}, false
+	}
+	for _, e := range l.Entries {
+		if e.Def.Repo == old.Repo && e.Def.UnitType == old.UnitType && e.Def.Unit == old.Unit && e.Def.Path == old.Path {
+			return l.Entries[0].Def, true
+		}
+	}
+	return DefSpec{}, false
+}
+
+// MaxGetMultipleDefs is the maximum number of defs that may be
+// requested in a single call to Defs.GetMultiple.
+const MaxGetMultipleDefs = 100
+
+// Validate returns an *InvalidOptionsError if op requests more than
+// MaxGetMultipleDefs defs.
+func (op *DefsGetMultipleOp) Validate() error {
+	if len(op.Defs) > MaxGetMultipleDefs {
+		return &InvalidOptionsError{Reason: fmt.Sprintf("too many defs requested (%d > %d)", len(op.Defs), MaxGetMultipleDefs)}
+	}
+	return nil
+}
+
+// GetMultipleDefs fetches the defs specified by specs using
+// Defs.GetMultiple, splitting them into as many calls as are
+// necessary to stay within MaxGetMultipleDefs per call.
+func GetMultipleDefs(ctx context.Context, c DefsClient, specs []DefSpec, opt *DefGetOptions) ([]*Def, error) {
+	var defs []*Def
+	for len(specs) > 0 {
+		n := len(specs)
+		if n > MaxGetMultipleDefs {
+			n = MaxGetMultipleDefs
+		}
+		list, err := c.GetMultiple(ctx, &DefsGetMultipleOp{Defs: specs[:n], Opt: opt})
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, list.Defs...)
+		specs = specs[n:]
+	}
+	return defs, nil
+}
+
+// Validate returns an *InvalidOptionsError if op's Base or Head
+// revision is empty, or if they are the same.
+func (op *DefsDiffRefsOp) Validate() error {
+	if op.Base == "" || op.Head == "" {
+		return &InvalidOptionsError{Reason: "both base and head revisions must be specified"}
+	}
+	if op.Base == op.Head {
+		return &InvalidOptionsError{Reason: fmt.Sprintf("base and head revisions are the same (%q)", op.Base)}
+	}
+	return nil
+}
+
+// Validate returns an *InvalidOptionsError if o's Query is empty (or
+// is not a valid regexp, in Regexp mode) or if o.Kinds contains an
+// unknown def kind.
+func (o *DefSearchOptions) Validate() error {
+	if o.Query == "" {
+		return &InvalidOptionsError{Reason: "empty def search query"}
+	}
+	if o.Mode == DefSearchMode_Regexp {
+		if _, err := regexp.Compile(o.Query); err != nil {
+			return &InvalidOptionsError{Reason: fmt.Sprintf("invalid def search regexp: %s", err)}
+		}
+	}
+	for _, k := range o.Kinds {
+		if !DefKind(k).Valid() {
+			return &InvalidOptionsError{Reason: fmt.Sprintf("unknown def kind %q", k)}
+		}
+	}
+	return nil
+}
+
+// HighlightedName returns the def's name with each of r.NameMatches
+// surrounded by before and after (e.g., "<b>" and "</b>"). Match
+// ranges that are out of bounds are ignored.
+func (r *DefSearchResult) HighlightedName(before, after string) string {
+	var name string
+	if r.Def != nil {
+		name = r.Def.Name
+	}
+	var buf bytes.Buffer
+	i := 0
+	for _, m := range r.NameMatches {
+		start, end := int(m.Start), int(m.End)
+		if start < i || end < start || end > len(name) {
+			continue
+		}
+		buf.WriteString(name[i:start])
+		buf.WriteString(before)
+		buf.WriteString(name[start:end])
+		buf.WriteString(after)
+		i = end
+	}
+	buf.WriteString(name[i:])
+	return buf.String()
+}
+
 type Refs []*Ref
 
 func (r *Ref) sortKey() string     { return fmt.Sprintf("%+v", r) }
@@ -148,6 +352,35 @@ func (vs Refs) Len() int           { return len(vs) }
 func (vs Refs) Swap(i, j int)      { vs[i], vs[j] = vs[j], vs[i] }
 func (vs Refs) Less(i, j int) bool { return vs[i].sortKey() < vs[j].sortKey() }
 
+const (
+	// DefaultExampleContextLines is the number of lines of context
+	// included around each example if
+	// DefListExamplesOptions.ContextLines is zero.
+	DefaultExampleContextLines = 3
+
+	// MaxExampleContextLines is the maximum value of
+	// DefListExamplesOptions.ContextLines.
+	MaxExampleContextLines = 50
+)
+
+// ContextLinesOrDefault returns o.ContextLines, or
+// DefaultExampleContextLines if it is zero.
+func (o *DefListExamplesOptions) ContextLinesOrDefault() int {
+	if o == nil || o.ContextLines == 0 {
+		return DefaultExampleContextLines
+	}
+	return int(o.ContextLines)
+}
+
+// Validate returns an *InvalidOptionsError if o.ContextLines is
+// negative or exceeds MaxExampleContextLines.
+func (o *DefListExamplesOptions) Validate() error {
+	if o.ContextLines < 0 || o.ContextLines > MaxExampleContextLines {
+		return &InvalidOptionsError{Reason: fmt.Sprintf("context lines must be between 0 and %d (got %d)", MaxExampleContextLines, o.ContextLines)}
+	}
+	return nil
+}
+
 type Examples []*Example
 
 func (r *Example) sortKey() string     { return fmt.Sprintf("%+v", r) }
@@ -160,3 +393,9 @@ type DefAuthorsByBytes []*DefAuthor
 func (v DefAuthorsByBytes) Len() int           { return len(v) }
 func (v DefAuthorsByBytes) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
 func (v DefAuthorsByBytes) Less(i, j int) bool { return v[i].Bytes < v[j].Bytes }
+
+// SignatureChanged reports whether e changed the def's signature. It
+// is always true for additions and deletions.
+func (e *DefHistoryEntry) SignatureChanged() bool {
+	return e.Change != DefChangeType_Modified || e.BeforeSignature != e.AfterSignature
+}
@@ -1,9 +1,14 @@
 package sourcegraph
 
 import (
+	"fmt"
+	"math/rand"
 	"reflect"
 	"testing"
+	"testing/quick"
 
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 	"sourcegraph.com/sourcegraph/srclib/graph"
 	"sourcegraph.com/sourcegraph/srclib/store"
 )
@@ -61,3 +66,270 @@ func TestDefListOptions_Offset(t *testing.T) {
 		t.Errorf("got %+v, want %+v", defs, wantDefs)
 	}
 }
+
+func TestDefLineage_Resolve(t *testing.T) {
+	cur := DefSpec{Repo: "r", CommitID: "c2", UnitType: "t", Unit: "u2", Path: "p2"}
+	l := &DefLineage{
+		Entries: []DefLineageEntry{
+			{Def: cur},
+			{Def: DefSpec{Repo: "r", CommitID: "c1", UnitType: "t", Unit: "u1", Path: "p1"}, EndCommitID: "c2"},
+		},
+	}
+
+	tests := map[string]struct {
+		old    DefSpec
+		want   DefSpec
+		wantOK bool
+	}{
+		"current":        {old: DefSpec{Repo: "r", UnitType: "t", Unit: "u2", Path: "p2"}, want: cur, wantOK: true},
+		"old identity":   {old: DefSpec{Repo: "r", CommitID: "c0", UnitType: "t", Unit: "u1", Path: "p1"}, want: cur, wantOK: true},
+		"different path": {old: DefSpec{Repo: "r", UnitType: "t", Unit: "u1", Path: "p3"}},
+		"different repo": {old: DefSpec{Repo: "r2", UnitType: "t", Unit: "u1", Path: "p1"}},
+	}
+	for label, test := range tests {
+		got, ok := l.Resolve(test.old)
+		if ok != test.wantOK {
+			t.Errorf("%s: got ok == %v, want %v", label, ok, test.wantOK)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %+v, want %+v", label, got, test.want)
+		}
+	}
+}
+
+// getMultipleDefsClient returns a def for each requested spec, and
+// records the number of specs in each call.
+type getMultipleDefsClient struct {
+	DefsClient
+	calls []int
+}
+
+func (c *getMultipleDefsClient) GetMultiple(ctx context.Context, op *DefsGetMultipleOp, opts ...grpc.CallOption) (*DefList, error) {
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+	c.calls = append(c.calls, len(op.Defs))
+	list := &DefList{}
+	for _, spec := range op.Defs {
+		list.Defs = append(list.Defs, &Def{Def: graph.Def{DefKey: spec.DefKey()}})
+	}
+	return list, nil
+}
+
+func TestGetMultipleDefs(t *testing.T) {
+	specs := make([]DefSpec, MaxGetMultipleDefs*2+1)
+	for i := range specs {
+		specs[i] = DefSpec{Repo: "r", UnitType: "t", Unit: "u", Path: fmt.Sprintf("p%d", i)}
+	}
+
+	c := &getMultipleDefsClient{}
+	defs, err := GetMultipleDefs(context.Background(), c, specs, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{MaxGetMultipleDefs, MaxGetMultipleDefs, 1}; !reflect.DeepEqual(c.calls, want) {
+		t.Errorf("got calls %v, want %v", c.calls, want)
+	}
+	if len(defs) != len(specs) {
+		t.Fatalf("got %d defs, want %d", len(defs), len(specs))
+	}
+	for i, def := range defs {
+		if def.Path != specs[i].Path {
+			t.Errorf("def %d: got path %q, want %q", i, def.Path, specs[i].Path)
+		}
+	}
+}
+
+func TestDefHistoryEntry_SignatureChanged(t *testing.T) {
+	tests := []struct {
+		entry DefHistoryEntry
+		want  bool
+	}{
+		{DefHistoryEntry{Change: DefChangeType_Added, AfterSignature: "f()"}, true},
+		{DefHistoryEntry{Change: DefChangeType_Deleted, BeforeSignature: "f()"}, true},
+		{DefHistoryEntry{Change: DefChangeType_Modified, BeforeSignature: "f()", AfterSignature: "f()"}, false},
+		{DefHistoryEntry{Change: DefChangeType_Modified, BeforeSignature: "f()", AfterSignature: "f(x int)"}, true},
+	}
+	for _, test := range tests {
+		if got := test.entry.SignatureChanged(); got != test.want {
+			t.Errorf("%+v: got %v, want %v", test.entry, got, test.want)
+		}
+	}
+}
+
+func TestDef_SameRepoRefCount(t *testing.T) {
+	d := &Def{RefCount: 1234, XRepoRefCount: 1000}
+	if got, want := d.SameRepoRefCount(), int32(234); got != want {
+		t.Errorf("got %d, want %d", got, want)
+	}
+}
+
+func TestDocSummary(t *testing.T) {
+	tests := map[string]string{
+		"":                                  "",
+		"Foo does x.":                       "Foo does x.",
+		"Foo does x. It also does y.":       "Foo does x.",
+		"  Foo does\n  x\tand z.\nMore.":    "Foo does x and z.",
+		"Foo returns\nx\n\nMore text.":      "Foo returns x",
+		"See http://example.com/a.b for x.": "See http://example.com/a.b for x.",
+	}
+	for text, want := range tests {
+		if got := DocSummary(text); got != want {
+			t.Errorf("%q: got %q, want %q", text, got, want)
+		}
+	}
+}
+
+func TestDefsDiffRefsOp_Validate(t *testing.T) {
+	def := DefSpec{Repo: "r", UnitType: "t", Unit: "u", Path: "p"}
+	tests := []struct {
+		op      DefsDiffRefsOp
+		wantErr bool
+	}{
+		{DefsDiffRefsOp{Def: def, Base: "v1", Head: "v2"}, false},
+		{DefsDiffRefsOp{Def: def, Head: "v2"}, true},
+		{DefsDiffRefsOp{Def: def, Base: "v1"}, true},
+		{DefsDiffRefsOp{Def: def, Base: "v1", Head: "v1"}, true},
+	}
+	for _, test := range tests {
+		err := test.op.Validate()
+		if (err != nil) != test.wantErr {
+			t.Errorf("%+v: got error %v, want error %v", test.op, err, test.wantErr)
+		}
+		if _, ok := err.(*InvalidOptionsError); err != nil && !ok {
+			t.Errorf("%+v: got error type %T, want *InvalidOptionsError", test.op, err)
+		}
+	}
+}
+
+func TestDefListExamplesOptions_ContextLines(t *testing.T) {
+	tests := []struct {
+		opt     *DefListExamplesOptions
+		want    int
+		wantErr bool
+	}{
+		{nil, DefaultExampleContextLines, false},
+		{&DefListExamplesOptions{}, DefaultExampleContextLines, false},
+		{&DefListExamplesOptions{ContextLines: 10}, 10, false},
+		{&DefListExamplesOptions{ContextLines: -1}, -1, true},
+		{&DefListExamplesOptions{ContextLines: MaxExampleContextLines + 1}, MaxExampleContextLines + 1, true},
+	}
+	for _, test := range tests {
+		if got := test.opt.ContextLinesOrDefault(); got != test.want {
+			t.Errorf("%+v: got %d context lines, want %d", test.opt, got, test.want)
+		}
+		if test.opt == nil {
+			continue
+		}
+		if err := test.opt.Validate(); (err != nil) != test.wantErr {
+			t.Errorf("%+v: got error %v, want error %v", test.opt, err, test.wantErr)
+		}
+	}
+}
+
+func TestDefSearchOptions_Validate(t *testing.T) {
+	tests := []struct {
+		opt     DefSearchOptions
+		wantErr bool
+	}{
+		{DefSearchOptions{Query: "nwcl"}, false},
+		{DefSearchOptions{Query: "New", Mode: DefSearchMode_Prefix, Kinds: []string{"func"}}, false},
+		{DefSearchOptions{Query: "^New.*Client$", Mode: DefSearchMode_Regexp}, false},
+		{DefSearchOptions{}, true},
+		{DefSearchOptions{Query: "(", Mode: DefSearchMode_Regexp}, true},
+		{DefSearchOptions{Query: "x", Kinds: []string{"function"}}, true},
+	}
+	for _, test := range tests {
+		if err := test.opt.Validate(); (err != nil) != test.wantErr {
+			t.Errorf("%+v: got error %v, want error %v", test.opt, err, test.wantErr)
+		}
+	}
+}
+
+func TestDefSearchResult_HighlightedName(t *testing.T) {
+	tests := []struct {
+		matches []MatchRange
+		want    string
+	}{
+		{nil, "NewClient"},
+		{[]MatchRange{{0, 3}}, "[New]Client"},
+		{[]MatchRange{{0, 1}, {1, 2}, {3, 5}}, "[N][e]w[Cl]ient"},
+		{[]MatchRange{{3, 9}}, "New[Client]"},
+		{[]MatchRange{{2, 4}, {3, 5}, {7, 20}}, "Ne[wC]lient"},
+	}
+	for _, test := range tests {
+		r := &DefSearchResult{Def: &Def{Def: graph.Def{Name: "NewClient"}}, NameMatches: test.matches}
+		if got := r.HighlightedName("[", "]"); got != test.want {
+			t.Errorf("%v: got %q, want %q", test.matches, got, test.want)
+		}
+	}
+}
+
+func (DefSpec) Generate(r *rand.Rand, size int) reflect.Value {
+	s := DefSpec{
+		Repo:     randPath(r),
+		UnitType: randComponent(r),
+		Unit:     randPath(r),
+		Path:     randPath(r),
+	}
+	switch r.Intn(4) {
+	case 1:
+		s.CommitID = randCommitID(r)
+	case 2:
+		s.Rev = randPath(r)
+	case 3:
+		s.Rev = randPath(r)
+		s.CommitID = randCommitID(r)
+	}
+	if r.Intn(4) == 0 {
+		s.Unit = "."
+	}
+	return reflect.ValueOf(s)
+}
+
+func TestDefSpec_SpecString(t *testing.T) {
+	roundTrip := func(s DefSpec) bool {
+		s2, err := ParseDefSpec(s.SpecString())
+		if err != nil {
+			t.Logf("%q: %s", s.SpecString(), err)
+			return false
+		}
+		return s2 == s
+	}
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := ParseDefSpec("r/.tree/a"); err == nil {
+		t.Error("got nil error for invalid DefSpec string")
+	}
+
+	// Incomplete specs must not panic, and their strings must not parse.
+	for _, s := range []DefSpec{{}, {UnitType: "t", Unit: "u", Path: "p"}, {Repo: "r", Path: "p"}} {
+		if _, err := ParseDefSpec(s.SpecString()); err == nil {
+			t.Errorf("%+v: got nil error parsing SpecString %q of incomplete spec", s, s.SpecString())
+		}
+	}
+}
+
+func TestDefSpec_pinned(t *testing.T) {
+	const commitID = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	tests := []struct {
+		spec DefSpec
+		want string
+	}{
+		{DefSpec{Repo: "r", UnitType: "t", Unit: "u", Path: "p"}, "r/.t/u/.def/p"},
+		{DefSpec{Repo: "r", Rev: "v", UnitType: "t", Unit: "u", Path: "p"}, "r@v/.t/u/.def/p"},
+		{DefSpec{Repo: "r", CommitID: commitID, UnitType: "t", Unit: "u", Path: "p"}, "r@" + commitID + "/.t/u/.def/p"},
+		{DefSpec{Repo: "r", Rev: "v", CommitID: commitID, UnitType: "t", Unit: "u", Path: "p"}, "r@v===" + commitID + "/.t/u/.def/p"},
+	}
+	for _, test := range tests {
+		if s := test.spec.SpecString(); s != test.want {
+			t.Errorf("%+v: got %q, want %q", test.spec, s, test.want)
+		}
+		rr := test.spec.RepoRevSpec()
+		if rr.URI != test.spec.Repo || rr.Rev != test.spec.Rev || rr.CommitID != test.spec.CommitID {
+			t.Errorf("%+v: got RepoRevSpec %+v", test.spec, rr)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package sourcegraph
+
+import (
+	"io"
+
+	"golang.org/x/net/context"
+)
+
+// DeltaPatchReader returns an io.ReadCloser that reads the patch for
+// the delta specified by op (e.g., to pipe to "git apply" or "git
+// am"). The patch is fetched lazily, one GetPatch call per chunk. The
+// op's Opt.Offset is used as the starting offset. Closing the reader
+// stops it from fetching any more chunks.
+func DeltaPatchReader(ctx context.Context, c DeltasClient, op *DeltasGetPatchOp) io.ReadCloser {
+	op2 := *op
+	var opt DeltaGetPatchOptions
+	if op.Opt != nil {
+		opt = *op.Opt
+	}
+	op2.Opt = &opt
+	return &chunkReader{
+		offset: opt.Offset,
+		fetch: func(offset int64) ([]byte, bool, error) {
+			op2.Opt.Offset = offset
+			patch, err := c.GetPatch(ctx, &op2)
+			if err != nil {
+				return nil, false, err
+			}
+			return patch.Data, patch.EOF, nil
+		},
+	}
+}
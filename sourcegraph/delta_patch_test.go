@@ -0,0 +1,66 @@
+package sourcegraph
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// chunkedPatchDeltasClient serves a unified diff or a format-patch
+// patch in chunks of at most chunkSize bytes.
+type chunkedPatchDeltasClient struct {
+	DeltasClient
+	diff, formatPatch string
+	chunkSize         int
+}
+
+func (c *chunkedPatchDeltasClient) GetPatch(ctx context.Context, op *DeltasGetPatchOp, opts ...grpc.CallOption) (*DeltaPatch, error) {
+	patch := c.diff
+	if op.Opt.FormatPatch {
+		patch = c.formatPatch
+	}
+	start := int(op.Opt.Offset)
+	end := start + c.chunkSize
+	if end > len(patch) {
+		end = len(patch)
+	}
+	return &DeltaPatch{Data: []byte(patch[start:end]), Offset: int64(start), EOF: end == len(patch)}, nil
+}
+
+func TestDeltaPatchReader(t *testing.T) {
+	c := &chunkedPatchDeltasClient{diff: "diff --git a/f b/f", formatPatch: "From abc Mon Sep 17 00:00:00 2001", chunkSize: 4}
+	tests := []struct {
+		opt  *DeltaGetPatchOptions
+		want string
+	}{
+		{opt: nil, want: c.diff},
+		{opt: &DeltaGetPatchOptions{FormatPatch: true}, want: c.formatPatch},
+	}
+	for _, test := range tests {
+		data, err := ioutil.ReadAll(DeltaPatchReader(context.Background(), c, &DeltasGetPatchOp{Opt: test.opt}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != test.want {
+			t.Errorf("got %q, want %q", data, test.want)
+		}
+	}
+}
+
+func TestDeltaPatchReader_Close(t *testing.T) {
+	c := &chunkedPatchDeltasClient{diff: "abcdefg", chunkSize: 2}
+	r := DeltaPatchReader(context.Background(), c, &DeltasGetPatchOp{})
+	buf := make([]byte, 1)
+	if _, err := r.Read(buf); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Read(buf); err != io.EOF {
+		t.Errorf("got error %v after Close, want io.EOF", err)
+	}
+}
@@ -2,10 +2,16 @@ package sourcegraph
 
 import (
 	"encoding/base64"
+	"fmt"
+	"math"
+	"sort"
 	"strings"
+	"time"
 
 	"sourcegraph.com/sourcegraph/go-diff/diff"
+	"sourcegraph.com/sourcegraph/go-sourcegraph/router"
 	"sourcegraph.com/sourcegraph/go-sourcegraph/spec"
+	"sourcegraph.com/sourcegraph/srclib/graph"
 	"sourcegraph.com/sourcegraph/srclib/store"
 	"sourcegraph.com/sourcegraph/srclib/unit"
 )
@@ -23,6 +29,23 @@ func (s DeltaSpec) RouteVars() map[string]string {
 	return m
 }
 
+// SpecString returns the string representation of the DeltaSpec
+// (e.g., "repo@base/.deltas/head"). It is the inverse of
+// ParseDeltaSpec for complete, valid specs; see routeSpecString.
+func (s DeltaSpec) SpecString() string {
+	return routeSpecString(router.Delta, &s, s.RouteVars, s.Base.URI, s.Head.URI)
+}
+
+// ParseDeltaSpec parses a string generated by (DeltaSpec).SpecString()
+// and returns the equivalent DeltaSpec struct.
+func ParseDeltaSpec(s string) (DeltaSpec, error) {
+	routeVars, err := parseRouteSpec(router.Delta, "DeltaSpec", s)
+	if err != nil {
+		return DeltaSpec{}, err
+	}
+	return UnmarshalDeltaSpec(routeVars)
+}
+
 func encodeCrossRepoRevSpecForDeltaHeadResolvedRev(rr RepoRevSpec) string {
 	return base64.URLEncoding.EncodeToString([]byte(rr.RepoSpec.SpecString())) + ":" + rr.ResolvedRevString()
 }
@@ -79,6 +102,57 @@ func (d *Delta) BaseAndHeadBuildsSuccessful() bool {
 	return d.BaseBuild != nil && d.BaseBuild.Success && d.HeadBuild != nil && d.HeadBuild.Success
 }
 
+// HasLabel reports whether label is one of the delta's labels.
+func (d *Delta) HasLabel(label string) bool {
+	for _, l := range d.Labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
+// HasReviewer reports whether u is one of the delta's reviewers. Users
+// are compared by UID if both have one, and by login and domain
+// otherwise.
+func (d *Delta) HasReviewer(u UserSpec) bool {
+	for _, r := range d.Reviewers {
+		if r.UID != 0 && u.UID != 0 {
+			if r.UID == u.UID {
+				return true
+			}
+		} else if r.Login == u.Login && r.Domain == u.Domain {
+			return true
+		}
+	}
+	return false
+}
+
+// DeltaListIncomingOptions.HeadBuild values.
+const (
+	// DeltaHeadBuildSucceeded matches deltas whose head build
+	// succeeded.
+	DeltaHeadBuildSucceeded = "succeeded"
+
+	// DeltaHeadBuildNotSucceeded matches deltas whose head build
+	// failed or hasn't (yet) succeeded, or that have no head build.
+	DeltaHeadBuildNotSucceeded = "not-succeeded"
+)
+
+// Validate returns an *InvalidOptionsError if o.StaleDays is negative
+// or o.HeadBuild is unknown.
+func (o *DeltaListIncomingOptions) Validate() error {
+	if o.StaleDays < 0 {
+		return &InvalidOptionsError{Reason: fmt.Sprintf("negative stale days %d", o.StaleDays)}
+	}
+	switch o.HeadBuild {
+	case "", DeltaHeadBuildSucceeded, DeltaHeadBuildNotSucceeded:
+	default:
+		return &InvalidOptionsError{Reason: fmt.Sprintf("unknown head build state %q", o.HeadBuild)}
+	}
+	return nil
+}
+
 // Added is whether this represents an added source unit (not present
 // in base, present in head).
 func (ud UnitDelta) Added() bool { return ud.Base == nil && ud.Head != nil }
@@ -108,6 +182,29 @@ func (f DeltaFilter) DefFilters() []store.DefFilter {
 	if f.UnitType != "" && f.Unit != "" {
 		return []store.DefFilter{store.ByUnits(unit.ID2{Type: f.UnitType, Name: f.Unit})}
 	}
+	if f.UnitType != "" {
+		return []store.DefFilter{store.DefFilterFunc(func(def *graph.Def) bool {
+			return def.UnitType == f.UnitType
+		})}
+	}
+	return nil
+}
+
+// DeltaDefSortRefs is the DeltaListDefsOptions.Sort value that sorts
+// by descending RefCount.
+const DeltaDefSortRefs = "refs"
+
+// Validate returns an *InvalidOptionsError if o.Kinds contains an
+// unknown def kind or o.Sort is unknown.
+func (o *DeltaListDefsOptions) Validate() error {
+	for _, k := range o.Kinds {
+		if !DefKind(k).Valid() {
+			return &InvalidOptionsError{Reason: fmt.Sprintf("unknown def kind %q", k)}
+		}
+	}
+	if o.Sort != "" && o.Sort != DeltaDefSortRefs {
+		return &InvalidOptionsError{Reason: fmt.Sprintf("unknown delta defs sort %q", o.Sort)}
+	}
 	return nil
 }
 
@@ -130,6 +227,27 @@ func (v DeltaDefs) Less(i, j int) bool {
 	return (a.Added() && b.Added() && deltaDefLess(a.Head, b.Head)) || (a.Changed() && b.Changed() && deltaDefLess(a.Head, b.Head)) || (a.Deleted() && b.Deleted() && deltaDefLess(a.Base, b.Base)) || (a.Added() && !b.Added()) || (a.Changed() && !b.Added() && !b.Changed())
 }
 
+// DefDeltasByRefCount sorts def deltas by descending RefCount
+// (breaking ties by def key).
+type DefDeltasByRefCount []*DefDelta
+
+func (v DefDeltasByRefCount) Len() int      { return len(v) }
+func (v DefDeltasByRefCount) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v DefDeltasByRefCount) Less(i, j int) bool {
+	if v[i].RefCount != v[j].RefCount {
+		return v[i].RefCount > v[j].RefCount
+	}
+	return deltaDefLess(v[i].def(), v[j].def())
+}
+
+// def returns dd's head def, or its base def if it was deleted.
+func (dd *DefDelta) def() *Def {
+	if dd.Head != nil {
+		return dd.Head
+	}
+	return dd.Base
+}
+
 func deltaDefLess(a, b *Def) bool {
 	return a.UnitType < b.UnitType || (a.UnitType == b.UnitType && a.Unit < b.Unit) || (a.UnitType == b.UnitType && a.Unit == b.Unit && a.Path < b.Path)
 }
@@ -146,3 +264,107 @@ func (d *DeltaFiles) DiffStat() diff.Stat {
 	}
 	return ds
 }
+
+// DiffDependencies returns the changes between the direct
+// dependencies of a delta's base commit (base) and head commit
+// (head), as listed by RepoDependencies.ListDependencies. A
+// dependency is updated if its declared version or its resolved
+// commit changed. The changes in each list are sorted by repository
+// URI.
+func DiffDependencies(base, head []*RepoDependency) *DeltaDependencies {
+	version := func(dep *RepoDependency) *DependencyVersion {
+		return &DependencyVersion{Range: dep.Version, Resolved: dep.To}
+	}
+	baseDeps := make(map[string]*RepoDependency, len(base))
+	for _, dep := range base {
+		baseDeps[dep.To.URI] = dep
+	}
+	headDeps := make(map[string]*RepoDependency, len(head))
+	for _, dep := range head {
+		headDeps[dep.To.URI] = dep
+	}
+
+	dd := &DeltaDependencies{}
+	for uri, h := range headDeps {
+		b, ok := baseDeps[uri]
+		switch {
+		case !ok:
+			dd.Added = append(dd.Added, &DependencyChange{Repo: h.To.RepoSpec, Head: version(h), Direct: true})
+		case b.Version != h.Version || b.To.CommitID != h.To.CommitID:
+			dd.Updated = append(dd.Updated, &DependencyChange{Repo: h.To.RepoSpec, Base: version(b), Head: version(h), Direct: true})
+		}
+	}
+	for uri, b := range baseDeps {
+		if _, ok := headDeps[uri]; !ok {
+			dd.Removed = append(dd.Removed, &DependencyChange{Repo: b.To.RepoSpec, Base: version(b), Direct: true})
+		}
+	}
+	for _, changes := range [][]*DependencyChange{dd.Added, dd.Removed, dd.Updated} {
+		sort.Sort(dependencyChangesByURI(changes))
+	}
+	return dd
+}
+
+type dependencyChangesByURI []*DependencyChange
+
+func (v dependencyChangesByURI) Len() int           { return len(v) }
+func (v dependencyChangesByURI) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
+func (v dependencyChangesByURI) Less(i, j int) bool { return v[i].Repo.URI < v[j].Repo.URI }
+
+const (
+	// DefaultReviewerRecencyHalfLifeDays is the default
+	// DeltaListReviewersOptions.RecencyHalfLifeDays.
+	DefaultReviewerRecencyHalfLifeDays = 180
+
+	// DefaultMaxSuggestedReviewers is the default
+	// DeltaListReviewersOptions.MaxSuggestions.
+	DefaultMaxSuggestedReviewers = 5
+
+	// MaxSuggestedReviewers is the maximum allowed
+	// DeltaListReviewersOptions.MaxSuggestions.
+	MaxSuggestedReviewers = 50
+)
+
+// Validate returns an *InvalidOptionsError if any of o's fields are
+// out of range.
+func (o *DeltaListReviewersOptions) Validate() error {
+	switch {
+	case o.RecencyHalfLifeDays < 0:
+		return &InvalidOptionsError{Reason: fmt.Sprintf("negative recency half-life %d", o.RecencyHalfLifeDays)}
+	case o.MinOwnershipPercent < 0 || o.MinOwnershipPercent > 100:
+		return &InvalidOptionsError{Reason: fmt.Sprintf("min ownership percent %g is not between 0 and 100", o.MinOwnershipPercent)}
+	case o.MaxSuggestions < 0 || o.MaxSuggestions > MaxSuggestedReviewers:
+		return &InvalidOptionsError{Reason: fmt.Sprintf("max suggestions %d is not between 0 and %d", o.MaxSuggestions, MaxSuggestedReviewers)}
+	}
+	return nil
+}
+
+// MaxSuggestionsOrDefault returns o.MaxSuggestions, or
+// DefaultMaxSuggestedReviewers if o is nil or it is zero.
+func (o *DeltaListReviewersOptions) MaxSuggestionsOrDefault() int {
+	if o == nil || o.MaxSuggestions == 0 {
+		return DefaultMaxSuggestedReviewers
+	}
+	return int(o.MaxSuggestions)
+}
+
+// RecencyWeight returns the weight (between 0 and 1) of a change to
+// the code made age ago, according to o.RecencyHalfLifeDays (or its
+// default, if o is nil or it is zero).
+func (o *DeltaListReviewersOptions) RecencyWeight(age time.Duration) float64 {
+	days := DefaultReviewerRecencyHalfLifeDays
+	if o != nil && o.RecencyHalfLifeDays != 0 {
+		days = int(o.RecencyHalfLifeDays)
+	}
+	if age < 0 {
+		age = 0
+	}
+	return math.Pow(0.5, age.Hours()/24/float64(days))
+}
+
+// SuggestedReviewersByScore sorts reviewers by descending Score.
+type SuggestedReviewersByScore []*SuggestedReviewer
+
+func (v SuggestedReviewersByScore) Len() int           { return len(v) }
+func (v SuggestedReviewersByScore) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
+func (v SuggestedReviewersByScore) Less(i, j int) bool { return v[i].Score > v[j].Score }
@@ -1,11 +1,16 @@
 package sourcegraph
 
 import (
+	"math/rand"
 	"reflect"
+	"sort"
 	"strings"
 	"testing"
+	"testing/quick"
+	"time"
 
 	"github.com/kr/pretty"
+	"sourcegraph.com/sourcegraph/srclib/graph"
 )
 
 const (
@@ -64,3 +69,163 @@ func TestDeltas(t *testing.T) {
 		}
 	}
 }
+
+func TestDeltaListIncomingOptions_Validate(t *testing.T) {
+	for _, opt := range []DeltaListIncomingOptions{{}, {BaseRev: "master", StaleDays: 7, HeadBuild: DeltaHeadBuildNotSucceeded}} {
+		if err := opt.Validate(); err != nil {
+			t.Errorf("%+v: %s", opt, err)
+		}
+	}
+	for _, opt := range []DeltaListIncomingOptions{{StaleDays: -1}, {HeadBuild: "green"}} {
+		if err := opt.Validate(); err == nil {
+			t.Errorf("%+v: got nil error", opt)
+		}
+	}
+}
+
+func TestDeltaListDefsOptions_Validate(t *testing.T) {
+	if err := (&DeltaListDefsOptions{Kinds: []string{"func"}, Sort: DeltaDefSortRefs}).Validate(); err != nil {
+		t.Error(err)
+	}
+	if err := (&DeltaListDefsOptions{Kinds: []string{"function"}}).Validate(); err == nil {
+		t.Error("got nil error for unknown kind")
+	}
+	if err := (&DeltaListDefsOptions{Sort: "name"}).Validate(); err == nil {
+		t.Error("got nil error for unknown sort")
+	}
+}
+
+func TestDefDeltasByRefCount(t *testing.T) {
+	def := func(path string) *Def { return &Def{Def: graph.Def{DefKey: graph.DefKey{Path: path}}} }
+	dds := []*DefDelta{
+		{Base: def("a"), RefCount: 1},
+		{Head: def("b"), RefCount: 5},
+		{Base: def("c"), Head: def("c"), RefCount: 5},
+		{Head: def("d")},
+	}
+	sort.Sort(DefDeltasByRefCount(dds))
+	var got []string
+	for _, dd := range dds {
+		got = append(got, dd.def().Path)
+	}
+	if want := []string{"b", "c", "a", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestDiffDependencies(t *testing.T) {
+	dep := func(uri, version, commitID string) *RepoDependency {
+		return &RepoDependency{To: RepoRevSpec{RepoSpec: RepoSpec{URI: uri}, CommitID: commitID}, Version: version}
+	}
+	base := []*RepoDependency{dep("a", "1.0", "c1"), dep("b", "^2", "c2"), dep("c", "", "c3"), dep("d", "", "")}
+	head := []*RepoDependency{dep("e", "3.0", ""), dep("b", "^2", "c4"), dep("c", "", "c3"), dep("d", "1.1", "")}
+
+	got := DiffDependencies(base, head)
+	want := &DeltaDependencies{
+		Added: []*DependencyChange{
+			{Repo: RepoSpec{URI: "e"}, Head: &DependencyVersion{Range: "3.0", Resolved: head[0].To}, Direct: true},
+		},
+		Removed: []*DependencyChange{
+			{Repo: RepoSpec{URI: "a"}, Base: &DependencyVersion{Range: "1.0", Resolved: base[0].To}, Direct: true},
+		},
+		Updated: []*DependencyChange{
+			{Repo: RepoSpec{URI: "b"}, Base: &DependencyVersion{Range: "^2", Resolved: base[1].To}, Head: &DependencyVersion{Range: "^2", Resolved: head[1].To}, Direct: true},
+			{Repo: RepoSpec{URI: "d"}, Base: &DependencyVersion{Resolved: base[3].To}, Head: &DependencyVersion{Range: "1.1", Resolved: head[3].To}, Direct: true},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %s, want %s", pretty.Sprint(got), pretty.Sprint(want))
+	}
+}
+
+func TestDelta_HasReviewer(t *testing.T) {
+	d := &Delta{Reviewers: []UserSpec{{Login: "alice", UID: 1}, {Login: "bob"}}}
+	tests := []struct {
+		u    UserSpec
+		want bool
+	}{
+		{UserSpec{UID: 1}, true},
+		{UserSpec{Login: "alice"}, true},
+		{UserSpec{Login: "alice", UID: 2}, false},
+		{UserSpec{Login: "bob", UID: 3}, true},
+		{UserSpec{Login: "bob", Domain: "example.com"}, false},
+		{UserSpec{Login: "carol"}, false},
+	}
+	for _, test := range tests {
+		if got := d.HasReviewer(test.u); got != test.want {
+			t.Errorf("%+v: got %v, want %v", test.u, got, test.want)
+		}
+	}
+}
+
+func (DeltaSpec) Generate(r *rand.Rand, size int) reflect.Value {
+	s := DeltaSpec{Base: randRepoRevSpec(r), Head: randRepoRevSpec(r)}
+	if s.Base.Rev == "" {
+		s.Base.Rev = randComponent(r)
+	}
+	if s.Head.Rev == "" {
+		s.Head.Rev = randComponent(r)
+	}
+	if r.Intn(2) == 0 {
+		s.Head.RepoSpec = s.Base.RepoSpec
+	}
+	return reflect.ValueOf(s)
+}
+
+func TestDeltaSpec_SpecString(t *testing.T) {
+	roundTrip := func(s DeltaSpec) bool {
+		s2, err := ParseDeltaSpec(s.SpecString())
+		if err != nil {
+			t.Logf("%q: %s", s.SpecString(), err)
+			return false
+		}
+		return s2 == s
+	}
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := ParseDeltaSpec("r@a"); err == nil {
+		t.Error("got nil error for invalid DeltaSpec string")
+	}
+
+	// Incomplete specs must not panic, and their strings must not parse.
+	for _, s := range []DeltaSpec{{}, {Head: RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, Rev: "b"}}, {Base: RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, Rev: "a"}}} {
+		if _, err := ParseDeltaSpec(s.SpecString()); err == nil {
+			t.Errorf("%+v: got nil error parsing SpecString %q of incomplete spec", s, s.SpecString())
+		}
+	}
+}
+
+func TestDeltaListReviewersOptions(t *testing.T) {
+	tests := []struct {
+		opt     DeltaListReviewersOptions
+		wantErr bool
+	}{
+		{DeltaListReviewersOptions{}, false},
+		{DeltaListReviewersOptions{RecencyHalfLifeDays: 30, MinOwnershipPercent: 10, ExcludeDeltaAuthors: true, MaxSuggestions: 3}, false},
+		{DeltaListReviewersOptions{RecencyHalfLifeDays: -1}, true},
+		{DeltaListReviewersOptions{MinOwnershipPercent: 101}, true},
+		{DeltaListReviewersOptions{MaxSuggestions: MaxSuggestedReviewers + 1}, true},
+	}
+	for _, test := range tests {
+		if err := test.opt.Validate(); (err != nil) != test.wantErr {
+			t.Errorf("%+v: got error %v, want error %v", test.opt, err, test.wantErr)
+		}
+	}
+
+	var nilOpt *DeltaListReviewersOptions
+	if got := nilOpt.MaxSuggestionsOrDefault(); got != DefaultMaxSuggestedReviewers {
+		t.Errorf("got max suggestions %d, want default %d", got, DefaultMaxSuggestedReviewers)
+	}
+	day := 24 * time.Hour
+	if got := nilOpt.RecencyWeight(DefaultReviewerRecencyHalfLifeDays * day); got != 0.5 {
+		t.Errorf("got default half-life weight %g, want 0.5", got)
+	}
+	opt := &DeltaListReviewersOptions{RecencyHalfLifeDays: 10}
+	for age, want := range map[time.Duration]float64{-day: 1, 0: 1, 10 * day: 0.5, 20 * day: 0.25} {
+		if got := opt.RecencyWeight(age); got != want {
+			t.Errorf("age %s: got weight %g, want %g", age, got, want)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package sourcegraph
+
+import (
+	"fmt"
+	"net/url"
+
+	"golang.org/x/net/context"
+)
+
+// WithServiceGRPCEndpoints returns a copy of parent whose clients
+// (obtained using NewClientFromContext) communicate with the given
+// gRPC API endpoint URLs for specific services, for deployments that
+// serve different services from different endpoints (e.g., Builds
+// from an internal endpoint). The map is keyed on service name (the
+// name of the service's Client field, such as "Builds"). Services not
+// in the map communicate with GRPCEndpoint(ctx).
+//
+// It returns an *InvalidOptionsError if a service name is unknown.
+func WithServiceGRPCEndpoints(parent context.Context, endpoints map[string]*url.URL) (context.Context, error) {
+	for service := range endpoints {
+		if !isServiceName(service) {
+			return nil, &InvalidOptionsError{Reason: fmt.Sprintf("unknown service %q", service)}
+		}
+	}
+	return context.WithValue(parent, serviceGRPCEndpointsKey, endpoints), nil
+}
+
+// isServiceName reports whether name is the name of one of Client's
+// services.
+func isServiceName(name string) bool {
+	for _, service := range serviceNames {
+		if service == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ServiceGRPCEndpoints returns the context's per-service gRPC
+// endpoint URLs that were previously configured using
+// WithServiceGRPCEndpoints.
+func ServiceGRPCEndpoints(ctx context.Context) map[string]*url.URL {
+	endpoints, _ := ctx.Value(serviceGRPCEndpointsKey).(map[string]*url.URL)
+	return endpoints
+}
+
+// ServiceGRPCEndpoint returns the gRPC endpoint URL that clients
+// obtained from ctx use for the named service.
+func ServiceGRPCEndpoint(ctx context.Context, service string) *url.URL {
+	if url := ServiceGRPCEndpoints(ctx)[service]; url != nil {
+		return url
+	}
+	return GRPCEndpoint(ctx)
+}
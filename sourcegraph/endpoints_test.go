@@ -0,0 +1,54 @@
+package sourcegraph
+
+import (
+	"net/url"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func TestServiceGRPCEndpoint(t *testing.T) {
+	public := &url.URL{Scheme: "https", Host: "example.com"}
+	internal := &url.URL{Scheme: "http", Host: "builds.internal:3100"}
+	ctx := WithGRPCEndpoint(context.Background(), public)
+	ctx, err := WithServiceGRPCEndpoints(ctx, map[string]*url.URL{"Builds": internal})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := ServiceGRPCEndpoint(ctx, "Builds"); got != internal {
+		t.Errorf("Builds: got endpoint %v, want %v", got, internal)
+	}
+	if got := ServiceGRPCEndpoint(ctx, "Repos"); got != public {
+		t.Errorf("Repos: got endpoint %v, want %v", got, public)
+	}
+}
+
+func TestWithServiceGRPCEndpoints_unknownService(t *testing.T) {
+	_, err := WithServiceGRPCEndpoints(context.Background(), map[string]*url.URL{"Bogus": {}})
+	if _, ok := err.(*InvalidOptionsError); !ok {
+		t.Errorf("got error %v (%T), want *InvalidOptionsError", err, err)
+	}
+}
+
+func TestClient_setServiceConn(t *testing.T) {
+	conn1, conn2 := new(grpc.ClientConn), new(grpc.ClientConn)
+	c := NewClient(conn1)
+	if !c.setServiceConn("Builds", conn2) {
+		t.Fatal("got setServiceConn false for Builds")
+	}
+	if c.setServiceConn("Bogus", conn2) {
+		t.Error("got setServiceConn true for unknown service")
+	}
+
+	if cc := c.Builds.(*CachedBuildsClient).BuildsClient.(*InterceptedBuildsClient).BuildsClient.(*buildsClient).cc; cc != conn2 {
+		t.Error("Builds: got default conn, want service conn")
+	}
+	if cc := c.Repos.(*CachedReposClient).ReposClient.(*InterceptedReposClient).ReposClient.(*reposClient).cc; cc != conn1 {
+		t.Error("Repos: got service conn, want default conn")
+	}
+	if c.Conn != conn1 {
+		t.Error("Conn: got service conn, want default conn")
+	}
+}
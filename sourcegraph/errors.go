@@ -1,6 +1,16 @@
 package sourcegraph
 
-import "net/http"
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
 
 // InvalidOptionsError indicates that the provided XxxOptions
 // (RepoListOptions, DefGetOptions, etc.) was invalid.
@@ -25,3 +35,158 @@ type NotImplementedError struct{ What string }
 func (e *NotImplementedError) Error() string { return e.What + " is not implemented" }
 
 func (e *NotImplementedError) HTTPStatusCode() int { return http.StatusNotFound }
+
+// CallError is an error returned by an API method, annotated with the
+// method that was called, the gRPC endpoint it was called on, and a
+// redacted copy of the op (spec and options) that was passed to it,
+// so that logs identify which call failed. Use CallErrorInterceptor
+// to have a client return its methods' errors as *CallErrors, and
+// ErrorCode (not grpc.Code) to get their gRPC error codes.
+type CallError struct {
+	// Method is the name of the API method (e.g., "Repos.Get").
+	Method string
+
+	// Endpoint is the URL of the gRPC endpoint that the method was
+	// called on, if known.
+	Endpoint string
+
+	// Op is a copy of the method's argument with secrets (such as
+	// passwords and tokens) redacted.
+	Op interface{}
+
+	// Err is the underlying error.
+	Err error
+}
+
+// NewCallError returns a *CallError that wraps err, which was returned
+// by calling method with op in ctx. If err is nil, it returns nil.
+func NewCallError(ctx context.Context, method string, op interface{}, err error) error {
+	if err == nil {
+		return nil
+	}
+	e := &CallError{Method: method, Op: redact(op), Err: err}
+	if url, _ := ctx.Value(grpcEndpointKey).(*url.URL); url != nil {
+		e.Endpoint = url.String()
+	}
+	return e
+}
+
+func (e *CallError) Error() string {
+	call := fmt.Sprintf("%s(%s)", e.Method, strings.TrimSpace(fmt.Sprint(e.Op)))
+	if e.Endpoint != "" {
+		call += " at " + e.Endpoint
+	}
+	return call + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *CallError) Unwrap() error { return e.Err }
+
+// CallErrorInterceptor returns an Interceptor that wraps the errors
+// returned by calls in *CallErrors. Clients do not wrap errors unless
+// it is used (with Client.UseInterceptor), because grpc.Code and
+// grpc.ErrorDesc do not look through the wrapping; callers that use
+// it should use ErrorCode and ErrorDesc instead.
+func CallErrorInterceptor() Interceptor {
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+			result, err := next(ctx, method, in)
+			return result, NewCallError(ctx, method, in, err)
+		}
+	}
+}
+
+// ErrorCode returns the gRPC error code of err, which may be wrapped
+// (e.g., in a *CallError). Unlike grpc.Code, it looks through
+// wrapping errors (those with an Unwrap method).
+func ErrorCode(err error) codes.Code { return grpc.Code(errorCause(err)) }
+
+// ErrorDesc returns the gRPC error description of err, which may be
+// wrapped (see ErrorCode).
+func ErrorDesc(err error) string { return grpc.ErrorDesc(errorCause(err)) }
+
+// errorCause returns the innermost error wrapped by err.
+func errorCause(err error) error {
+	for {
+		w, ok := err.(interface{ Unwrap() error })
+		if !ok {
+			return err
+		}
+		err = w.Unwrap()
+	}
+}
+
+// redactedFieldNames are substrings of the names of fields whose
+// values are redacted in a CallError's Op.
+var redactedFieldNames = []string{"Password", "Secret", "Token", "PEM", "PrivateKey"}
+
+// redactedFields are the fields (of the form "Type.Field") whose
+// values are redacted even though their names do not indicate that
+// they hold secrets.
+var redactedFields = map[string]bool{
+	"SiteConfig.Contents": true, // may contain credentials for external services
+}
+
+// redact returns a copy of op (which must be a pointer to a struct to
+// be redacted) with the values of string fields that hold secrets,
+// and of all byte slice fields (which hold keys and other binary
+// data), replaced by "REDACTED".
+func redact(op interface{}) interface{} {
+	v := reflect.ValueOf(op)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return op
+	}
+	return redactPtr(v).Interface()
+}
+
+func redactPtr(v reflect.Value) reflect.Value {
+	cp := reflect.New(v.Elem().Type())
+	cp.Elem().Set(v.Elem())
+	redactStruct(cp.Elem())
+	return cp
+}
+
+func redactStruct(v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).PkgPath != "" {
+			continue // unexported
+		}
+		f := v.Field(i)
+		switch f.Kind() {
+		case reflect.String:
+			if f.String() != "" && (isRedactedFieldName(t.Field(i).Name) || redactedFields[t.Name()+"."+t.Field(i).Name]) {
+				f.SetString("REDACTED")
+			}
+		case reflect.Struct:
+			redactStruct(f)
+		case reflect.Ptr:
+			if !f.IsNil() && f.Elem().Kind() == reflect.Struct {
+				f.Set(redactPtr(f))
+			}
+		case reflect.Slice:
+			if f.Type().Elem().Kind() == reflect.Uint8 {
+				if f.Len() > 0 {
+					f.SetBytes([]byte("REDACTED"))
+				}
+			} else if f.Type().Elem().Kind() == reflect.Ptr && f.Type().Elem().Elem().Kind() == reflect.Struct {
+				cp := reflect.MakeSlice(f.Type(), f.Len(), f.Len())
+				for j := 0; j < f.Len(); j++ {
+					if !f.Index(j).IsNil() {
+						cp.Index(j).Set(redactPtr(f.Index(j)))
+					}
+				}
+				f.Set(cp)
+			}
+		}
+	}
+}
+
+func isRedactedFieldName(name string) bool {
+	for _, s := range redactedFieldNames {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package sourcegraph
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestNewCallError(t *testing.T) {
+	if err := NewCallError(context.Background(), "Repos.Get", &RepoSpec{URI: "r"}, nil); err != nil {
+		t.Errorf("got %v, want nil for nil error", err)
+	}
+
+	ctx := WithGRPCEndpoint(context.Background(), &url.URL{Scheme: "https", Host: "example.com"})
+	orig := errors.New("x")
+	op := &RepoSpec{URI: "r"}
+	err := NewCallError(ctx, "Repos.Get", op, orig)
+	want := &CallError{Method: "Repos.Get", Endpoint: "https://example.com", Op: op, Err: orig}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("got %+v, want %+v", err, want)
+	}
+	if got := err.(*CallError).Unwrap(); got != orig {
+		t.Errorf("got Unwrap %v, want %v", got, orig)
+	}
+	if got, want := err.Error(), `Repos.Get(uri:"r") at https://example.com: x`; got != want {
+		t.Errorf("got Error %q, want %q", got, want)
+	}
+}
+
+func TestNewCallError_redact(t *testing.T) {
+	op := &NewAccount{Login: "alice", Password: "hunter2"}
+	err := NewCallError(context.Background(), "Accounts.Create", op, errors.New("x")).(*CallError)
+	if want := (&NewAccount{Login: "alice", Password: "REDACTED"}); !reflect.DeepEqual(err.Op, want) {
+		t.Errorf("got Op %+v, want %+v", err.Op, want)
+	}
+	if op.Password != "hunter2" {
+		t.Error("original op was modified")
+	}
+}
+
+// notFoundReposClient is a ReposClient whose Get fails with
+// codes.NotFound.
+type notFoundReposClient struct{ ReposClient }
+
+func (notFoundReposClient) Get(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*Repo, error) {
+	return nil, grpc.Errorf(codes.NotFound, "repo %s not found", in.URI)
+}
+
+func TestClient_callError(t *testing.T) {
+	c := NewClient(nil)
+	c.Repos.(*CachedReposClient).ReposClient.(*InterceptedReposClient).ReposClient = notFoundReposClient{}
+
+	ctx := WithGRPCEndpoint(context.Background(), &url.URL{Scheme: "https", Host: "example.com"})
+
+	// Errors are not wrapped by default.
+	if _, err := c.Repos.Get(ctx, &RepoSpec{URI: "r"}); grpc.Code(err) != codes.NotFound {
+		t.Errorf("got error %v (%T), want unwrapped NotFound error", err, err)
+	}
+
+	c.UseInterceptor(CallErrorInterceptor())
+	_, err := c.Repos.Get(ctx, &RepoSpec{URI: "r"})
+	e, ok := err.(*CallError)
+	if !ok {
+		t.Fatalf("got error %v (%T), want *CallError", err, err)
+	}
+	if e.Method != "Repos.Get" || e.Endpoint != "https://example.com" {
+		t.Errorf("got method %q and endpoint %q, want Repos.Get and https://example.com", e.Method, e.Endpoint)
+	}
+	if code := ErrorCode(err); code != codes.NotFound {
+		t.Errorf("got code %v, want NotFound", code)
+	}
+	if desc := ErrorDesc(err); desc != "repo r not found" {
+		t.Errorf("got desc %q, want %q", desc, "repo r not found")
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{errors.New("x"), codes.Unknown},
+		{grpc.Errorf(codes.NotFound, "x"), codes.NotFound},
+		{&CallError{Err: grpc.Errorf(codes.NotFound, "x")}, codes.NotFound},
+		{&RetryAfterError{Err: &CallError{Err: grpc.Errorf(codes.Unavailable, "x")}}, codes.Unavailable},
+	}
+	for _, test := range tests {
+		if got := ErrorCode(test.err); got != test.want {
+			t.Errorf("%v: got %v, want %v", test.err, got, test.want)
+		}
+	}
+}
+
+func TestRedact_slice(t *testing.T) {
+	list := &PersonalAccessTokenList{Tokens: []*PersonalAccessToken{{Note: "a", Token: "t1"}, nil}}
+	got := redact(list).(*PersonalAccessTokenList)
+	if got.Tokens[0].Token != "REDACTED" || got.Tokens[0].Note != "a" || got.Tokens[1] != nil {
+		t.Errorf("got %+v, want redacted tokens", got.Tokens)
+	}
+	if list.Tokens[0].Token != "t1" {
+		t.Error("original list was modified")
+	}
+}
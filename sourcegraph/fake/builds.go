@@ -0,0 +1,186 @@
+package fake
+
+import (
+	"sort"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"sourcegraph.com/sourcegraph/go-sourcegraph/sourcegraph"
+	"sourcegraph.com/sqs/pbtypes"
+)
+
+// BuildsClient is an in-memory implementation of the Builds service.
+// It implements Get, List, ListByRepo, Create, Update, DequeueNext, and
+// Heartbeat.
+type BuildsClient struct {
+	sourcegraph.BuildsClient // unimplemented methods panic
+
+	store
+	builds []*sourcegraph.Build // in creation order
+}
+
+var _ sourcegraph.BuildsClient = (*BuildsClient)(nil)
+
+// get returns the stored build specified by spec. The caller must
+// hold s.mu.
+func (s *BuildsClient) get(spec sourcegraph.BuildSpec) (*sourcegraph.Build, error) {
+	for _, b := range s.builds {
+		if b.Repo == spec.Repo.URI && b.CommitID == spec.CommitID && b.Attempt == spec.Attempt {
+			return b, nil
+		}
+	}
+	return nil, notFound("build %s not found", spec.IDString())
+}
+
+func (s *BuildsClient) Get(ctx context.Context, spec *sourcegraph.BuildSpec, opts ...grpc.CallOption) (*sourcegraph.Build, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.get(*spec)
+	if err != nil {
+		return nil, err
+	}
+	cpy := *b
+	return &cpy, nil
+}
+
+// List lists the builds that match opt (see
+// BuildListOptions.Matches), most recently created first.
+func (s *BuildsClient) List(ctx context.Context, opt *sourcegraph.BuildListOptions, opts ...grpc.CallOption) (*sourcegraph.BuildList, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if opt == nil {
+		opt = &sourcegraph.BuildListOptions{}
+	}
+	var builds []*sourcegraph.Build
+	for i := len(s.builds) - 1; i >= 0; i-- {
+		if opt.Matches(s.builds[i]) {
+			cpy := *s.builds[i]
+			builds = append(builds, &cpy)
+		}
+	}
+	if opt.Sort == sourcegraph.BuildSortStartedAt {
+		sort.Stable(buildsByStartedAt(builds))
+	}
+	if opt.Direction == "asc" {
+		for i, j := 0, len(builds)-1; i < j; i, j = i+1, j-1 {
+			builds[i], builds[j] = builds[j], builds[i]
+		}
+	}
+	start, end := page(len(builds), opt.ListOptions)
+	return &sourcegraph.BuildList{Builds: builds[start:end]}, nil
+}
+
+func (s *BuildsClient) ListByRepo(ctx context.Context, op *sourcegraph.BuildsListByRepoOp, opts ...grpc.CallOption) (*sourcegraph.BuildList, error) {
+	var opt sourcegraph.BuildListOptions
+	if op.Opt != nil {
+		opt = *op.Opt
+	}
+	opt.Repo = op.Repo.URI
+	return s.List(ctx, &opt, opts...)
+}
+
+func (s *BuildsClient) Create(ctx context.Context, op *sourcegraph.BuildsCreateOp, opts ...grpc.CallOption) (*sourcegraph.Build, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(op.RepoRev.CommitID) != 40 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "build commit ID must be a full commit ID")
+	}
+	b := &sourcegraph.Build{
+		Repo:      op.RepoRev.URI,
+		CommitID:  op.RepoRev.CommitID,
+		Branch:    op.RepoRev.Rev,
+		CreatedAt: pbtypes.NewTimestamp(time.Now()),
+	}
+	if b.Branch == b.CommitID {
+		b.Branch = ""
+	}
+	if op.Opt != nil {
+		b.BuildConfig = op.Opt.BuildConfig
+	}
+	for _, b2 := range s.builds {
+		if b2.Repo == b.Repo && b2.CommitID == b.CommitID && b2.Attempt > b.Attempt {
+			b.Attempt = b2.Attempt
+		}
+	}
+	b.Attempt++
+	s.builds = append(s.builds, b)
+	cpy := *b
+	return &cpy, nil
+}
+
+func (s *BuildsClient) Update(ctx context.Context, op *sourcegraph.BuildsUpdateOp, opts ...grpc.CallOption) (*sourcegraph.Build, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.get(op.Build)
+	if err != nil {
+		return nil, err
+	}
+	info := op.Info
+	if info.StartedAt != nil {
+		b.StartedAt = info.StartedAt
+	}
+	if info.EndedAt != nil {
+		b.EndedAt = info.EndedAt
+	}
+	if info.HeartbeatAt != nil {
+		b.HeartbeatAt = info.HeartbeatAt
+	}
+	if info.Host != "" {
+		b.Host = info.Host
+	}
+	if info.Priority != 0 {
+		b.Priority = info.Priority
+	}
+	b.Success = b.Success || info.Success
+	b.Failure = b.Failure || info.Failure
+	b.Killed = b.Killed || info.Killed
+	b.Purged = b.Purged || info.Purged
+	cpy := *b
+	return &cpy, nil
+}
+
+// DequeueNext returns the highest-priority queued build (or the
+// oldest, among builds of equal priority) and marks it as started.
+func (s *BuildsClient) DequeueNext(ctx context.Context, op *sourcegraph.BuildsDequeueNextOp, opts ...grpc.CallOption) (*sourcegraph.Build, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var next *sourcegraph.Build
+	for _, b := range s.builds {
+		if b.Queue && b.StartedAt == nil && (next == nil || b.Priority > next.Priority) {
+			next = b
+		}
+	}
+	if next == nil {
+		return nil, notFound("build queue is empty")
+	}
+	now := pbtypes.NewTimestamp(time.Now())
+	next.StartedAt = &now
+	cpy := *next
+	return &cpy, nil
+}
+
+func (s *BuildsClient) Heartbeat(ctx context.Context, spec *sourcegraph.BuildSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := s.get(*spec)
+	if err != nil {
+		return nil, err
+	}
+	now := pbtypes.NewTimestamp(time.Now())
+	b.HeartbeatAt = &now
+	return &pbtypes.Void{}, nil
+}
+
+type buildsByStartedAt []*sourcegraph.Build
+
+func (v buildsByStartedAt) Len() int      { return len(v) }
+func (v buildsByStartedAt) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v buildsByStartedAt) Less(i, j int) bool {
+	a, b := v[i].StartedAt, v[j].StartedAt
+	if a == nil || b == nil {
+		return a != nil
+	}
+	return a.Time().After(b.Time())
+}
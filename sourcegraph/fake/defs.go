@@ -0,0 +1,120 @@
+package fake
+
+import (
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"sourcegraph.com/sourcegraph/go-sourcegraph/sourcegraph"
+)
+
+// DefsClient is an in-memory implementation of the Defs service. It
+// implements Get and List. Because the Defs service has no method to
+// create defs, use Add to populate it.
+type DefsClient struct {
+	sourcegraph.DefsClient // unimplemented methods panic
+
+	store
+	defs map[sourcegraph.DefSpec]*sourcegraph.Def
+}
+
+var _ sourcegraph.DefsClient = (*DefsClient)(nil)
+
+// Add adds defs to the store, replacing existing defs with the same
+// DefSpec.
+func (s *DefsClient) Add(defs ...*sourcegraph.Def) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.defs == nil {
+		s.defs = map[sourcegraph.DefSpec]*sourcegraph.Def{}
+	}
+	for _, def := range defs {
+		cpy := *def
+		s.defs[def.DefSpec()] = &cpy
+	}
+}
+
+// Get returns the def specified by op.Def. The fake can't resolve
+// revisions, so a def is only found if its CommitID equals
+// op.Def.CommitID or, if that is empty, op.Def.Rev (so that defs
+// specified by strings such as "repo@rev/.t/u/.def/path" are found
+// as they were before DefSpec had a Rev field).
+func (s *DefsClient) Get(ctx context.Context, op *sourcegraph.DefsGetOp, opts ...grpc.CallOption) (*sourcegraph.Def, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	spec := op.Def
+	if spec.CommitID == "" {
+		spec.CommitID = spec.Rev
+	}
+	spec.Rev = ""
+	def, ok := s.defs[spec]
+	if !ok {
+		return nil, notFound("def %+v not found", op.Def)
+	}
+	cpy := *def
+	return &cpy, nil
+}
+
+// List lists defs sorted by repository, unit, and path. It honors the
+// Name, Query, RepoRevs (matching only the repository), UnitType, Unit,
+// Path, File, and ListOptions fields of opt.
+func (s *DefsClient) List(ctx context.Context, opt *sourcegraph.DefListOptions, opts ...grpc.CallOption) (*sourcegraph.DefList, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var defs []*sourcegraph.Def
+	for _, def := range s.defs {
+		if opt.Name != "" && def.Name != opt.Name {
+			continue
+		}
+		if opt.Query != "" && !strings.Contains(strings.ToLower(def.Name), strings.ToLower(opt.Query)) {
+			continue
+		}
+		if len(opt.RepoRevs) > 0 && !repoRevsContain(opt.RepoRevs, def.Repo) {
+			continue
+		}
+		if (opt.UnitType != "" && def.UnitType != opt.UnitType) || (opt.Unit != "" && def.Unit != opt.Unit) {
+			continue
+		}
+		if (opt.Path != "" && def.Path != opt.Path) || (opt.File != "" && def.File != opt.File) {
+			continue
+		}
+		cpy := *def
+		defs = append(defs, &cpy)
+	}
+	sort.Sort(defsByKey(defs))
+	start, end := page(len(defs), opt.ListOptions)
+	return &sourcegraph.DefList{
+		Defs:         defs[start:end],
+		ListResponse: sourcegraph.ListResponse{Total: int32(len(defs))},
+	}, nil
+}
+
+// repoRevsContain reports whether repoRevs (of the form "repo" or
+// "repo@rev") contains repo.
+func repoRevsContain(repoRevs []string, repo string) bool {
+	for _, rr := range repoRevs {
+		if i := strings.Index(rr, "@"); i != -1 {
+			rr = rr[:i]
+		}
+		if rr == repo {
+			return true
+		}
+	}
+	return false
+}
+
+type defsByKey []*sourcegraph.Def
+
+func (v defsByKey) Len() int      { return len(v) }
+func (v defsByKey) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v defsByKey) Less(i, j int) bool {
+	a, b := v[i].DefKey, v[j].DefKey
+	if a.Repo != b.Repo {
+		return a.Repo < b.Repo
+	}
+	if a.UnitType+a.Unit != b.UnitType+b.Unit {
+		return a.UnitType+a.Unit < b.UnitType+b.Unit
+	}
+	return a.Path < b.Path
+}
@@ -0,0 +1,64 @@
+// Package fake provides in-memory implementations of Sourcegraph API
+// services, for integration tests of programs that use the API client
+// but that should run without a Sourcegraph server.
+//
+// Unlike the function stubs in package mock, the fakes have state:
+// repositories, defs, and builds that are created (or added) can be
+// fetched and listed back. Methods that are not implemented by a fake
+// panic; use package mock for those.
+package fake
+
+import (
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"sourcegraph.com/sourcegraph/go-sourcegraph/sourcegraph"
+)
+
+// Server holds the in-memory stores for the fake services.
+type Server struct {
+	Repos  *ReposClient
+	Defs   *DefsClient
+	Builds *BuildsClient
+}
+
+// NewServer returns a new Server with empty stores.
+func NewServer() *Server {
+	return &Server{
+		Repos:  &ReposClient{},
+		Defs:   &DefsClient{},
+		Builds: &BuildsClient{},
+	}
+}
+
+// Client returns an API client whose Repos, Defs, and Builds services
+// are the fakes in s. Its other services are nil.
+func (s *Server) Client() *sourcegraph.Client {
+	return &sourcegraph.Client{
+		Repos:  s.Repos,
+		Defs:   s.Defs,
+		Builds: s.Builds,
+	}
+}
+
+// store is the common mutex for a fake service.
+type store struct {
+	mu sync.Mutex
+}
+
+// page returns the bounds of the page of n items specified by opt.
+func page(n int, opt sourcegraph.ListOptions) (start, end int) {
+	start, end = opt.Offset(), opt.Offset()+opt.Limit()
+	if start > n {
+		start = n
+	}
+	if end > n {
+		end = n
+	}
+	return start, end
+}
+
+func notFound(format string, a ...interface{}) error {
+	return grpc.Errorf(codes.NotFound, format, a...)
+}
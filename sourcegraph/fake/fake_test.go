@@ -0,0 +1,136 @@
+package fake
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"sourcegraph.com/sourcegraph/go-sourcegraph/sourcegraph"
+	"sourcegraph.com/sourcegraph/srclib/graph"
+)
+
+func TestRepos(t *testing.T) {
+	ctx := context.Background()
+	c := NewServer().Client()
+
+	for _, uri := range []string{"github.com/b/b", "github.com/a/a"} {
+		if _, err := c.Repos.Create(ctx, &sourcegraph.ReposCreateOp{URI: uri}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := c.Repos.Create(ctx, &sourcegraph.ReposCreateOp{URI: "github.com/a/a"}); grpc.Code(err) != codes.AlreadyExists {
+		t.Errorf("got error %v creating duplicate repo, want AlreadyExists", err)
+	}
+
+	repo, err := c.Repos.Get(ctx, &sourcegraph.RepoSpec{URI: "github.com/a/a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repo.Name != "a" {
+		t.Errorf("got name %q, want %q", repo.Name, "a")
+	}
+
+	list, err := c.Repos.List(ctx, &sourcegraph.RepoListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Repos) != 2 || list.Repos[0].URI != "github.com/a/a" {
+		t.Errorf("got repos %v, want 2 repos sorted by URI", list.Repos)
+	}
+
+	if _, err := c.Repos.Delete(ctx, &sourcegraph.RepoSpec{URI: "github.com/a/a"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Repos.Get(ctx, &sourcegraph.RepoSpec{URI: "github.com/a/a"}); grpc.Code(err) != codes.NotFound {
+		t.Errorf("got error %v getting deleted repo, want NotFound", err)
+	}
+}
+
+func TestDefs(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	s.Defs.Add(
+		&sourcegraph.Def{Def: graph.Def{DefKey: graph.DefKey{Repo: "r", Path: "b"}, Name: "B"}},
+		&sourcegraph.Def{Def: graph.Def{DefKey: graph.DefKey{Repo: "r", Path: "a"}, Name: "A"}},
+		&sourcegraph.Def{Def: graph.Def{DefKey: graph.DefKey{Repo: "r2", Path: "a"}, Name: "A"}},
+		&sourcegraph.Def{Def: graph.Def{DefKey: graph.DefKey{Repo: "r2", CommitID: "v1", Path: "a"}, Name: "A1"}},
+	)
+	c := s.Client()
+
+	def, err := c.Defs.Get(ctx, &sourcegraph.DefsGetOp{Def: sourcegraph.DefSpec{Repo: "r", Path: "b"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if def.Name != "B" {
+		t.Errorf("got def %q, want %q", def.Name, "B")
+	}
+
+	// A Rev without a CommitID is treated as the commit ID.
+	for _, spec := range []sourcegraph.DefSpec{{Repo: "r2", Rev: "v1", Path: "a"}, {Repo: "r2", Rev: "v2", CommitID: "v1", Path: "a"}} {
+		def, err := c.Defs.Get(ctx, &sourcegraph.DefsGetOp{Def: spec})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if def.Name != "A1" {
+			t.Errorf("%+v: got def %q, want %q", spec, def.Name, "A1")
+		}
+	}
+
+	list, err := c.Defs.List(ctx, &sourcegraph.DefListOptions{RepoRevs: []string{"r@master"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Defs) != 2 || list.Defs[0].Path != "a" || list.Total != 2 {
+		t.Errorf("got defs %v (total %d), want 2 defs in repo r sorted by path", list.Defs, list.Total)
+	}
+}
+
+func TestBuilds(t *testing.T) {
+	ctx := context.Background()
+	c := NewServer().Client()
+
+	commitID := strings.Repeat("a", 40)
+	create := func(priority int32) *sourcegraph.Build {
+		b, err := c.Builds.Create(ctx, &sourcegraph.BuildsCreateOp{
+			RepoRev: sourcegraph.RepoRevSpec{RepoSpec: sourcegraph.RepoSpec{URI: "r"}, Rev: "master", CommitID: commitID},
+			Opt:     &sourcegraph.BuildCreateOptions{BuildConfig: sourcegraph.BuildConfig{Queue: true, Priority: priority}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	b1, b2 := create(0), create(5)
+	if b1.Attempt != 1 || b2.Attempt != 2 || b1.Branch != "master" {
+		t.Errorf("got builds %+v and %+v, want attempts 1 and 2 on branch master", b1, b2)
+	}
+
+	next, err := c.Builds.DequeueNext(ctx, &sourcegraph.BuildsDequeueNextOp{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.Attempt != b2.Attempt || next.StartedAt == nil {
+		t.Errorf("got dequeued build %+v, want started higher-priority build", next)
+	}
+
+	list, err := c.Builds.List(ctx, &sourcegraph.BuildListOptions{Queued: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Builds) != 1 || list.Builds[0].Attempt != b1.Attempt {
+		t.Errorf("got queued builds %v, want only attempt 1", list.Builds)
+	}
+
+	if _, err := c.Builds.Update(ctx, &sourcegraph.BuildsUpdateOp{Build: next.Spec(), Info: sourcegraph.BuildUpdate{Success: true}}); err != nil {
+		t.Fatal(err)
+	}
+	b, err := c.Builds.Get(ctx, &sourcegraph.BuildSpec{Repo: sourcegraph.RepoSpec{URI: "r"}, CommitID: commitID, Attempt: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !b.Success {
+		t.Error("build not updated")
+	}
+}
@@ -0,0 +1,153 @@
+package fake
+
+import (
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"sourcegraph.com/sourcegraph/go-sourcegraph/sourcegraph"
+	"sourcegraph.com/sqs/pbtypes"
+)
+
+// ReposClient is an in-memory implementation of the Repos service. It
+// implements Get, List, Create, Update, and Delete.
+type ReposClient struct {
+	sourcegraph.ReposClient // unimplemented methods panic
+
+	store
+	repos map[string]*sourcegraph.Repo // keyed on URI
+}
+
+var _ sourcegraph.ReposClient = (*ReposClient)(nil)
+
+func (s *ReposClient) Get(ctx context.Context, repo *sourcegraph.RepoSpec, opts ...grpc.CallOption) (*sourcegraph.Repo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.repos[repo.URI]
+	if !ok {
+		return nil, notFound("repo %s not found", repo.URI)
+	}
+	cpy := *r
+	return &cpy, nil
+}
+
+// List lists repositories sorted by opt.Sort (or by URI, if it is
+// empty). It honors the Query, Fields, and ListOptions fields of opt
+// and the filters checked by (*RepoListOptions).Matches.
+func (s *ReposClient) List(ctx context.Context, opt *sourcegraph.RepoListOptions, opts ...grpc.CallOption) (*sourcegraph.RepoList, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var repos []*sourcegraph.Repo
+	for _, r := range s.repos {
+		if opt.Query != "" && !strings.Contains(strings.ToLower(r.URI), strings.ToLower(opt.Query)) {
+			continue
+		}
+		if !opt.Matches(r) {
+			continue
+		}
+		repos = append(repos, r)
+	}
+	sort.Sort(reposByURI(repos))
+	if keys, err := opt.SortKeys(); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err)
+	} else if len(keys) > 0 {
+		sort.Stable(reposBy{repos, keys})
+	}
+	start, end := page(len(repos), opt.ListOptions)
+	repos = repos[start:end]
+	for i, r := range repos {
+		cpy, err := sourcegraph.SelectRepoFields(r, opt.Fields)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "%s", err)
+		}
+		repos[i] = cpy
+	}
+	return &sourcegraph.RepoList{Repos: repos}, nil
+}
+
+func (s *ReposClient) Create(ctx context.Context, op *sourcegraph.ReposCreateOp, opts ...grpc.CallOption) (*sourcegraph.Repo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if op.URI == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "repo URI is empty")
+	}
+	if err := op.Validate(); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	if _, ok := s.repos[op.URI]; ok {
+		return nil, grpc.Errorf(codes.AlreadyExists, "repo %s already exists", op.URI)
+	}
+	now := pbtypes.NewTimestamp(time.Now())
+	r := &sourcegraph.Repo{
+		URI:          op.URI,
+		Name:         path.Base(op.URI),
+		Description:  op.Description,
+		VCS:          op.VCS,
+		HTTPCloneURL: op.CloneURL,
+		Language:     op.Language,
+		Mirror:       op.Mirror,
+		Private:      op.Private,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if provider := op.ProviderOrDefault(); provider != "" && provider != sourcegraph.ProviderLocal {
+		r.External = &sourcegraph.ExternalRepo{
+			Provider:       provider,
+			ID:             op.ExternalID,
+			InstallationID: op.InstallationID,
+		}
+	}
+	if s.repos == nil {
+		s.repos = map[string]*sourcegraph.Repo{}
+	}
+	s.repos[r.URI] = r
+	cpy := *r
+	return &cpy, nil
+}
+
+func (s *ReposClient) Update(ctx context.Context, op *sourcegraph.ReposUpdateOp, opts ...grpc.CallOption) (*sourcegraph.Repo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.repos[op.Repo.URI]
+	if !ok {
+		return nil, notFound("repo %s not found", op.Repo.URI)
+	}
+	if op.Description != "" {
+		r.Description = op.Description
+	}
+	if op.Language != "" {
+		r.Language = op.Language
+	}
+	r.UpdatedAt = pbtypes.NewTimestamp(time.Now())
+	cpy := *r
+	return &cpy, nil
+}
+
+func (s *ReposClient) Delete(ctx context.Context, repo *sourcegraph.RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.repos[repo.URI]; !ok {
+		return nil, notFound("repo %s not found", repo.URI)
+	}
+	delete(s.repos, repo.URI)
+	return &pbtypes.Void{}, nil
+}
+
+type reposByURI []*sourcegraph.Repo
+
+func (v reposByURI) Len() int           { return len(v) }
+func (v reposByURI) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
+func (v reposByURI) Less(i, j int) bool { return v[i].URI < v[j].URI }
+
+type reposBy struct {
+	repos []*sourcegraph.Repo
+	keys  sourcegraph.RepoSortKeys
+}
+
+func (v reposBy) Len() int           { return len(v.repos) }
+func (v reposBy) Swap(i, j int)      { v.repos[i], v.repos[j] = v.repos[j], v.repos[i] }
+func (v reposBy) Less(i, j int) bool { return v.keys.Less(v.repos[i], v.repos[j]) }
@@ -13,4 +13,6 @@ package sourcegraph
 
 //go:generate goimports -w cached_grpc.pb.go mock/sourcegraph.pb_mock.go
 
+//go:generate go run gen/gen_intercepted.go -o intercepted_grpc.pb.go
+
 //go:generate go generate ./mock
@@ -0,0 +1,214 @@
+// +build generate
+
+// Command gen_intercepted generates InterceptedXxxClient wrappers for
+// each gRPC client interface (XxxClient) in a Go source file, a
+// Client.UseInterceptor method that wraps all of the services in the
+// Client struct, and a Client.setServiceConn method that constructs
+// them.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	pbFile     = flag.String("pb", "sourcegraph.pb.go", "Go file containing the gRPC client interfaces")
+	clientFile = flag.String("client", "client.go", "Go file containing the Client struct")
+	outFile    = flag.String("o", "intercepted_grpc.pb.go", "output file")
+	pkgName    = flag.String("pkg", "sourcegraph", "output package name")
+
+	fset = token.NewFileSet()
+)
+
+type method struct {
+	name, in, out string
+}
+
+type service struct {
+	name    string
+	methods []method
+}
+
+func main() {
+	flag.Parse()
+	log.SetFlags(0)
+
+	pbAST, err := parser.ParseFile(fset, *pbFile, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	clientAST, err := parser.ParseFile(fset, *clientFile, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Map import names (including duplicates such as "pbtypes1") to
+	// their canonical package names.
+	imports := map[string]string{} // canonical name -> import path
+	rename := map[string]string{}  // import name -> canonical name
+	for _, imp := range pbAST.Imports {
+		p, _ := strconv.Unquote(imp.Path.Value)
+		canon := path.Base(p)
+		imports[canon] = p
+		if imp.Name != nil {
+			rename[imp.Name.Name] = canon
+		}
+	}
+
+	services := map[string]*service{}
+	for _, decl := range pbAST.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			it, ok := ts.Type.(*ast.InterfaceType)
+			if !ok || !strings.HasSuffix(ts.Name.Name, "Client") {
+				continue
+			}
+			svc := &service{name: strings.TrimSuffix(ts.Name.Name, "Client")}
+			for _, m := range it.Methods.List {
+				ft := m.Type.(*ast.FuncType)
+				if len(ft.Params.List) != 3 || len(ft.Results.List) != 2 {
+					log.Fatalf("%s.%s: not a unary RPC method", ts.Name.Name, m.Names[0].Name)
+				}
+				svc.methods = append(svc.methods, method{
+					name: m.Names[0].Name,
+					in:   typeString(ft.Params.List[1].Type, rename),
+					out:  typeString(ft.Results.List[0].Type, rename),
+				})
+			}
+			services[svc.name] = svc
+		}
+	}
+
+	// Find the services in the Client struct, in order.
+	type field struct{ name, svc string }
+	var fields []field
+	ast.Inspect(clientAST, func(n ast.Node) bool {
+		ts, ok := n.(*ast.TypeSpec)
+		if !ok || ts.Name.Name != "Client" {
+			return true
+		}
+		for _, f := range ts.Type.(*ast.StructType).Fields.List {
+			id, ok := f.Type.(*ast.Ident)
+			if !ok || !strings.HasSuffix(id.Name, "Client") {
+				continue
+			}
+			svc := strings.TrimSuffix(id.Name, "Client")
+			if _, ok := services[svc]; !ok {
+				log.Fatalf("Client field %s: unknown service %s", f.Names[0].Name, svc)
+			}
+			fields = append(fields, field{f.Names[0].Name, svc})
+		}
+		return false
+	})
+
+	var names []string
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var body bytes.Buffer
+	fmt.Fprintln(&body, "// UseInterceptor wraps each of c's services so that all of their")
+	fmt.Fprintln(&body, "// method calls are intercepted by i. If it is called multiple times,")
+	fmt.Fprintln(&body, "// the interceptor added last is outermost (i.e., it is called first).")
+	fmt.Fprintln(&body, "func (c *Client) UseInterceptor(i Interceptor) {")
+	for _, f := range fields {
+		fmt.Fprintf(&body, "\tc.%s = &Intercepted%sClient{c.%s, i}\n", f.name, f.svc, f.name)
+	}
+	fmt.Fprint(&body, "}\n\n")
+	fmt.Fprintln(&body, "// serviceNames are the names of the Client struct's service fields.")
+	fmt.Fprintln(&body, "var serviceNames = []string{")
+	for _, f := range fields {
+		fmt.Fprintf(&body, "\t%q,\n", f.name)
+	}
+	fmt.Fprint(&body, "}\n\n")
+	fmt.Fprintln(&body, "// setServiceConn sets c's named service to communicate using conn")
+	fmt.Fprintln(&body, "// (see NewClient). It returns false if there is no such service.")
+	fmt.Fprintln(&body, "func (c *Client) setServiceConn(service string, conn *grpc.ClientConn) bool {")
+	fmt.Fprintln(&body, "\tswitch service {")
+	for _, f := range fields {
+		fmt.Fprintf(&body, "\tcase %q:\n", f.name)
+		fmt.Fprintf(&body, "\t\tc.%s = &Cached%sClient{&Intercepted%sClient{New%sClient(conn), c.baseInterceptor}, Cache}\n", f.name, f.svc, f.svc, f.svc)
+	}
+	fmt.Fprint(&body, "\tdefault:\n\t\treturn false\n\t}\n\treturn true\n}\n\n")
+	for _, name := range names {
+		svc := services[name]
+		fmt.Fprintf(&body, "type Intercepted%sClient struct {\n\t%sClient\n\tInterceptor Interceptor\n}\n\n", name, name)
+		for _, m := range svc.methods {
+			fmt.Fprintf(&body, "func (s *Intercepted%sClient) %s(ctx context.Context, in %s, opts ...grpc.CallOption) (%s, error) {\n", name, m.name, m.in, m.out)
+			fmt.Fprintf(&body, "\tresult, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {\n")
+			fmt.Fprintf(&body, "\t\treturn s.%sClient.%s(ctx, in.(%s), callOptions(ctx, opts)...)\n", name, m.name, m.in)
+			fmt.Fprintf(&body, "\t})(ctx, %q, in)\n", name+"."+m.name)
+			fmt.Fprintf(&body, "\tr, _ := result.(%s)\n\treturn r, err\n}\n\n", m.out)
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintln(&out, "// GENERATED CODE - DO NOT EDIT!")
+	fmt.Fprintln(&out, "//")
+	fmt.Fprintln(&out, "// Generated by:")
+	fmt.Fprintln(&out, "//")
+	fmt.Fprintf(&out, "//   go run gen/gen_intercepted.go -o %s\n", *outFile)
+	fmt.Fprintln(&out, "//")
+	fmt.Fprintln(&out, "// Called via:")
+	fmt.Fprintln(&out, "//")
+	fmt.Fprintln(&out, "//   go generate")
+	fmt.Fprintln(&out, "//")
+	fmt.Fprintln(&out)
+	fmt.Fprintf(&out, "package %s\n\nimport (\n", *pkgName)
+	var used []string
+	for canon, p := range imports {
+		if canon == "context" || canon == "grpc" || bytes.Contains(body.Bytes(), []byte(canon+".")) {
+			used = append(used, p)
+		}
+	}
+	sort.Strings(used)
+	for _, p := range used {
+		fmt.Fprintf(&out, "\t%q\n", p)
+	}
+	fmt.Fprint(&out, ")\n\n")
+	out.Write(body.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*outFile, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+var selectorRx = regexp.MustCompile(`\b([a-z][a-z0-9]*)\.`)
+
+// typeString returns the Go source for the type expression x, with
+// duplicate import names replaced by their canonical package names.
+func typeString(x ast.Expr, rename map[string]string) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, x); err != nil {
+		log.Fatal(err)
+	}
+	return selectorRx.ReplaceAllStringFunc(buf.String(), func(s string) string {
+		if canon, ok := rename[strings.TrimSuffix(s, ".")]; ok {
+			return canon + "."
+		}
+		return s
+	})
+}
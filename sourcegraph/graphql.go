@@ -0,0 +1,54 @@
+package sourcegraph
+
+import (
+	"encoding/json"
+	"strings"
+
+	"golang.org/x/net/context"
+)
+
+func (e *GraphQLError) Error() string {
+	if len(e.Path) == 0 {
+		return e.Message
+	}
+	return strings.Join(e.Path, ".") + ": " + e.Message
+}
+
+// GraphQLErrors are the errors returned by a GraphQL query.
+type GraphQLErrors []*GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return "graphql: " + strings.Join(msgs, "; ")
+}
+
+// QueryGraphQL executes a GraphQL query with the given variables (which
+// may be nil) and JSON-decodes the response data into result. If the
+// query returns errors, they are returned as GraphQLErrors (after
+// decoding any partial data into result).
+func QueryGraphQL(ctx context.Context, c GraphQLClient, query string, vars map[string]interface{}, result interface{}) error {
+	op := &GraphQLRequest{Query: query}
+	if vars != nil {
+		b, err := json.Marshal(vars)
+		if err != nil {
+			return err
+		}
+		op.Variables = b
+	}
+	resp, err := c.Query(ctx, op)
+	if err != nil {
+		return err
+	}
+	if len(resp.Data) > 0 && result != nil {
+		if err := json.Unmarshal(resp.Data, result); err != nil {
+			return err
+		}
+	}
+	if len(resp.Errors) > 0 {
+		return GraphQLErrors(resp.Errors)
+	}
+	return nil
+}
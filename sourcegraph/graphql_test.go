@@ -0,0 +1,51 @@
+package sourcegraph
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+type fakeGraphQLClient struct {
+	GraphQLClient
+	req  *GraphQLRequest
+	resp *GraphQLResponse
+}
+
+func (c *fakeGraphQLClient) Query(ctx context.Context, req *GraphQLRequest, opts ...grpc.CallOption) (*GraphQLResponse, error) {
+	c.req = req
+	return c.resp, nil
+}
+
+func TestQueryGraphQL(t *testing.T) {
+	c := &fakeGraphQLClient{resp: &GraphQLResponse{Data: []byte(`{"repository":{"uri":"r"}}`)}}
+
+	var result struct {
+		Repository struct{ URI string }
+	}
+	if err := QueryGraphQL(context.Background(), c, "query($uri: String!) { repository(uri: $uri) { uri } }", map[string]interface{}{"uri": "r"}, &result); err != nil {
+		t.Fatal(err)
+	}
+	if result.Repository.URI != "r" {
+		t.Errorf("got URI %q, want %q", result.Repository.URI, "r")
+	}
+	var vars map[string]interface{}
+	if err := json.Unmarshal(c.req.Variables, &vars); err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]interface{}{"uri": "r"}; !reflect.DeepEqual(vars, want) {
+		t.Errorf("got variables %v, want %v", vars, want)
+	}
+
+	c.resp = &GraphQLResponse{Errors: []*GraphQLError{{Message: "not found", Path: []string{"repository"}}}}
+	err := QueryGraphQL(context.Background(), c, "{ repository { uri } }", nil, &result)
+	if _, ok := err.(GraphQLErrors); !ok {
+		t.Fatalf("got error %v (%T), want GraphQLErrors", err, err)
+	}
+	if got, want := err.Error(), "graphql: repository: not found"; got != want {
+		t.Errorf("got error %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,180 @@
+package sourcegraph
+
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+)
+
+// A Group runs API calls concurrently and collects the first error,
+// like golang.org/x/sync/errgroup.Group. The first call to return an
+// error cancels the group's context (so that the other calls can stop
+// early), and Wait returns that error. Unlike Batch, it is suited to
+// fan-out fetches whose results are all needed (e.g., fetching each
+// def in a list), where one failure makes the rest pointless.
+//
+// A Group limits the number of its calls that run concurrently (see
+// MaxParallel). To also limit the number of concurrent calls across
+// all of a client's Groups and other callers, use
+// Client.SetMaxConcurrentCalls.
+//
+// The zero value is a valid Group whose calls are passed a background
+// context.
+//
+//	g, ctx := NewGroup(ctx)
+//	defs := make([]*Def, len(specs))
+//	for i, spec := range specs {
+//		i, spec := i, spec
+//		g.Go(func(ctx context.Context) (err error) {
+//			defs[i], err = c.Defs.Get(ctx, &DefsGetOp{Def: spec})
+//			return
+//		})
+//	}
+//	if err := g.Wait(); err != nil {
+//		return err
+//	}
+type Group struct {
+	// MaxParallel is the maximum number of calls that are run
+	// concurrently. If zero, DefaultBatchParallelism is used. It must
+	// not be changed after the first call to Go.
+	MaxParallel int
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	initOnce sync.Once
+	sem      chan struct{}
+	wg       sync.WaitGroup
+
+	errOnce sync.Once
+	err     error
+}
+
+// NewGroup returns a new Group and a context derived from ctx that is
+// canceled when a call in the group fails or when Wait returns.
+func NewGroup(ctx context.Context) (*Group, context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Group{ctx: ctx, cancel: cancel}, ctx
+}
+
+func (g *Group) init() {
+	g.initOnce.Do(func() {
+		if g.ctx == nil {
+			g.ctx, g.cancel = context.WithCancel(context.Background())
+		}
+		par := g.MaxParallel
+		if par <= 0 {
+			par = DefaultBatchParallelism
+		}
+		g.sem = make(chan struct{}, par)
+	})
+}
+
+// Go runs call in a new goroutine, passing it the group's context. If
+// MaxParallel calls are already running, it blocks until one of them
+// completes. If the group's context is done before call can be run,
+// call is not run.
+func (g *Group) Go(call func(ctx context.Context) error) {
+	g.init()
+	var acquired bool
+	select {
+	case g.sem <- struct{}{}:
+		acquired = true
+	case <-g.ctx.Done():
+	}
+	// Check the context even if the semaphore was acquired, since
+	// both may have been ready at once.
+	if err := g.ctx.Err(); err != nil {
+		if acquired {
+			<-g.sem
+		}
+		g.setErr(err)
+		return
+	}
+	g.wg.Add(1)
+	go func() {
+		defer func() {
+			<-g.sem
+			g.wg.Done()
+		}()
+		if err := call(g.ctx); err != nil {
+			g.setErr(err)
+		}
+	}()
+}
+
+func (g *Group) setErr(err error) {
+	g.errOnce.Do(func() {
+		g.err = err
+		g.cancel()
+	})
+}
+
+// Wait waits for all calls started with Go to complete, and then
+// returns the first error that any of them returned (or nil).
+func (g *Group) Wait() error {
+	g.init()
+	g.wg.Wait()
+	g.cancel()
+	return g.err
+}
+
+// ConcurrencyLimitInterceptor returns an Interceptor that limits the
+// number of calls through it that are in progress at once to max. A
+// call made while max calls are in progress blocks until one of them
+// completes, or fails with ctx.Err() if its context is done first. If
+// max is zero or negative, calls are not limited.
+func ConcurrencyLimitInterceptor(max int) Interceptor {
+	l := &concurrencyLimit{}
+	l.set(max)
+	return l.interceptor
+}
+
+// concurrencyLimit limits the number of calls that are in progress at
+// once. Its limit may be changed while calls are in progress.
+type concurrencyLimit struct {
+	mu  sync.Mutex
+	sem chan struct{} // nil if unlimited
+}
+
+// set sets the limit to max (or removes it if max is zero or
+// negative). Calls in progress do not count against the new limit.
+func (l *concurrencyLimit) set(max int) {
+	var sem chan struct{}
+	if max > 0 {
+		sem = make(chan struct{}, max)
+	}
+	l.mu.Lock()
+	l.sem = sem
+	l.mu.Unlock()
+}
+
+func (l *concurrencyLimit) interceptor(next Invoker) Invoker {
+	return func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		l.mu.Lock()
+		sem := l.sem
+		l.mu.Unlock()
+		if sem == nil {
+			return next(ctx, method, in)
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		defer func() { <-sem }()
+		return next(ctx, method, in)
+	}
+}
+
+// SetMaxConcurrentCalls limits the number of c's API calls that are
+// in progress at once (across all of its services) to max, replacing
+// any limit previously set. If max is zero or negative, calls are not
+// limited. See ConcurrencyLimitInterceptor for more information.
+func (c *Client) SetMaxConcurrentCalls(max int) {
+	if c.concurrencyLimit == nil {
+		c.concurrencyLimit = &concurrencyLimit{}
+		c.UseInterceptor(c.concurrencyLimit.interceptor)
+	}
+	c.concurrencyLimit.set(max)
+}
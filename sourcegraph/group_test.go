@@ -0,0 +1,183 @@
+package sourcegraph
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// maxActiveCounter records the maximum number of concurrently active
+// calls.
+type maxActiveCounter struct{ active, max int32 }
+
+func (c *maxActiveCounter) run(d time.Duration) {
+	n := atomic.AddInt32(&c.active, 1)
+	for {
+		m := atomic.LoadInt32(&c.max)
+		if n <= m || atomic.CompareAndSwapInt32(&c.max, m, n) {
+			break
+		}
+	}
+	time.Sleep(d)
+	atomic.AddInt32(&c.active, -1)
+}
+
+func TestGroup(t *testing.T) {
+	var counter maxActiveCounter
+	g, ctx := NewGroup(context.Background())
+	g.MaxParallel = 2
+	var calls int32
+	for i := 0; i < 6; i++ {
+		g.Go(func(context.Context) error {
+			atomic.AddInt32(&calls, 1)
+			counter.run(time.Millisecond)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 6 {
+		t.Errorf("got %d calls, want 6", calls)
+	}
+	if counter.max > 2 {
+		t.Errorf("got %d concurrent calls, want at most 2", counter.max)
+	}
+	if ctx.Err() == nil {
+		t.Error("group context not canceled after Wait")
+	}
+}
+
+func TestGroup_firstErrorCancels(t *testing.T) {
+	g, _ := NewGroup(context.Background())
+	g.MaxParallel = 1
+	wantErr := errors.New("x")
+	g.Go(func(context.Context) error { return wantErr })
+
+	var ran bool
+	g.Go(func(ctx context.Context) error {
+		ran = true
+		return ctx.Err()
+	})
+	if err := g.Wait(); err != wantErr {
+		t.Errorf("got error %v, want %v", err, wantErr)
+	}
+	if ran {
+		t.Error("call ran after group context was canceled")
+	}
+}
+
+func TestConcurrencyLimitInterceptor(t *testing.T) {
+	var counter maxActiveCounter
+	invoke := ConcurrencyLimitInterceptor(2)(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		counter.run(time.Millisecond)
+		return nil, nil
+	})
+
+	g, ctx := NewGroup(context.Background())
+	g.MaxParallel = 5
+	for i := 0; i < 10; i++ {
+		g.Go(func(ctx context.Context) error {
+			_, err := invoke(ctx, "Repos.Get", nil)
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if counter.max > 2 {
+		t.Errorf("got %d concurrent calls, want at most 2", counter.max)
+	}
+
+	// A call that can't start before its context is done fails.
+	block := make(chan struct{})
+	limited := ConcurrencyLimitInterceptor(1)(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		<-block
+		return nil, nil
+	})
+	go limited(context.Background(), "Repos.Get", nil)
+	time.Sleep(5 * time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := limited(ctx, "Repos.Get", nil); err != context.Canceled {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+	close(block)
+}
+
+func TestGroup_zero(t *testing.T) {
+	var g Group
+	var ran bool
+	g.Go(func(ctx context.Context) error {
+		ran = ctx != nil
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if !ran {
+		t.Error("call did not run with a context")
+	}
+	if err := new(Group).Wait(); err != nil {
+		t.Errorf("got error %v from empty group, want nil", err)
+	}
+}
+
+// blockingReposClient is a ReposClient whose Get blocks until release
+// is closed.
+type blockingReposClient struct {
+	ReposClient
+	release chan struct{}
+	started int32 // number of calls to Get
+}
+
+func (c *blockingReposClient) Get(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*Repo, error) {
+	atomic.AddInt32(&c.started, 1)
+	<-c.release
+	return &Repo{URI: in.URI}, nil
+}
+
+func TestClient_SetMaxConcurrentCalls(t *testing.T) {
+	c := NewClient(nil)
+	repos := &blockingReposClient{release: make(chan struct{})}
+	c.Repos.(*CachedReposClient).ReposClient.(*InterceptedReposClient).ReposClient = repos
+
+	// A non-positive limit does not block calls.
+	c.SetMaxConcurrentCalls(0)
+	close(repos.release)
+	if _, err := c.Repos.Get(context.Background(), &RepoSpec{URI: "r"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Setting the limit again replaces it instead of adding another.
+	c.SetMaxConcurrentCalls(1)
+	c.SetMaxConcurrentCalls(2)
+	repos.release = make(chan struct{})
+	repos.started = 0
+	done := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := c.Repos.Get(context.Background(), &RepoSpec{URI: "r"})
+			done <- err
+		}()
+	}
+	time.Sleep(5 * time.Millisecond)
+	if n := atomic.LoadInt32(&repos.started); n != 2 {
+		t.Errorf("got %d calls in progress, want 2", n)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if _, err := c.Repos.Get(ctx, &RepoSpec{URI: "r"}); err != context.DeadlineExceeded {
+		t.Errorf("got error %v for call over limit, want %v", err, context.DeadlineExceeded)
+	}
+	close(repos.release)
+	for i := 0; i < 2; i++ {
+		if err := <-done; err != nil {
+			t.Error(err)
+		}
+	}
+}
@@ -0,0 +1,1920 @@
+// GENERATED CODE - DO NOT EDIT!
+//
+// Generated by:
+//
+//   go run gen/gen_intercepted.go -o intercepted_grpc.pb.go
+//
+// Called via:
+//
+//   go generate
+//
+
+package sourcegraph
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"sourcegraph.com/sourcegraph/go-vcs/vcs"
+	"sourcegraph.com/sourcegraph/srclib/unit"
+	"sourcegraph.com/sqs/pbtypes"
+)
+
+// UseInterceptor wraps each of c's services so that all of their
+// method calls are intercepted by i. If it is called multiple times,
+// the interceptor added last is outermost (i.e., it is called first).
+func (c *Client) UseInterceptor(i Interceptor) {
+	c.Accounts = &InterceptedAccountsClient{c.Accounts, i}
+	c.Admin = &InterceptedAdminClient{c.Admin, i}
+	c.AdminStats = &InterceptedAdminStatsClient{c.AdminStats, i}
+	c.Annotations = &InterceptedAnnotationsClient{c.Annotations, i}
+	c.Auth = &InterceptedAuthClient{c.Auth, i}
+	c.Builds = &InterceptedBuildsClient{c.Builds, i}
+	c.Defs = &InterceptedDefsClient{c.Defs, i}
+	c.Deltas = &InterceptedDeltasClient{c.Deltas, i}
+	c.Discussions = &InterceptedDiscussionsClient{c.Discussions, i}
+	c.GraphQL = &InterceptedGraphQLClient{c.GraphQL, i}
+	c.GraphUplink = &InterceptedGraphUplinkClient{c.GraphUplink, i}
+	c.Issues = &InterceptedIssuesClient{c.Issues, i}
+	c.Markdown = &InterceptedMarkdownClient{c.Markdown, i}
+	c.Meta = &InterceptedMetaClient{c.Meta, i}
+	c.MirrorRepos = &InterceptedMirrorReposClient{c.MirrorRepos, i}
+	c.MirroredRepoSSHKeys = &InterceptedMirroredRepoSSHKeysClient{c.MirroredRepoSSHKeys, i}
+	c.Notify = &InterceptedNotifyClient{c.Notify, i}
+	c.Orgs = &InterceptedOrgsClient{c.Orgs, i}
+	c.People = &InterceptedPeopleClient{c.People, i}
+	c.RegisteredClients = &InterceptedRegisteredClientsClient{c.RegisteredClients, i}
+	c.RepoBadges = &InterceptedRepoBadgesClient{c.RepoBadges, i}
+	c.RepoDependencies = &InterceptedRepoDependenciesClient{c.RepoDependencies, i}
+	c.RepoStatuses = &InterceptedRepoStatusesClient{c.RepoStatuses, i}
+	c.RepoTree = &InterceptedRepoTreeClient{c.RepoTree, i}
+	c.Repos = &InterceptedReposClient{c.Repos, i}
+	c.SavedSearches = &InterceptedSavedSearchesClient{c.SavedSearches, i}
+	c.Storage = &InterceptedStorageClient{c.Storage, i}
+	c.Changesets = &InterceptedChangesetsClient{c.Changesets, i}
+	c.Search = &InterceptedSearchClient{c.Search, i}
+	c.Units = &InterceptedUnitsClient{c.Units, i}
+	c.Users = &InterceptedUsersClient{c.Users, i}
+	c.UserKeys = &InterceptedUserKeysClient{c.UserKeys, i}
+}
+
+// serviceNames are the names of the Client struct's service fields.
+var serviceNames = []string{
+	"Accounts",
+	"Admin",
+	"AdminStats",
+	"Annotations",
+	"Auth",
+	"Builds",
+	"Defs",
+	"Deltas",
+	"Discussions",
+	"GraphQL",
+	"GraphUplink",
+	"Issues",
+	"Markdown",
+	"Meta",
+	"MirrorRepos",
+	"MirroredRepoSSHKeys",
+	"Notify",
+	"Orgs",
+	"People",
+	"RegisteredClients",
+	"RepoBadges",
+	"RepoDependencies",
+	"RepoStatuses",
+	"RepoTree",
+	"Repos",
+	"SavedSearches",
+	"Storage",
+	"Changesets",
+	"Search",
+	"Units",
+	"Users",
+	"UserKeys",
+}
+
+// setServiceConn sets c's named service to communicate using conn
+// (see NewClient). It returns false if there is no such service.
+func (c *Client) setServiceConn(service string, conn *grpc.ClientConn) bool {
+	switch service {
+	case "Accounts":
+		c.Accounts = &CachedAccountsClient{&InterceptedAccountsClient{NewAccountsClient(conn), c.baseInterceptor}, Cache}
+	case "Admin":
+		c.Admin = &CachedAdminClient{&InterceptedAdminClient{NewAdminClient(conn), c.baseInterceptor}, Cache}
+	case "AdminStats":
+		c.AdminStats = &CachedAdminStatsClient{&InterceptedAdminStatsClient{NewAdminStatsClient(conn), c.baseInterceptor}, Cache}
+	case "Annotations":
+		c.Annotations = &CachedAnnotationsClient{&InterceptedAnnotationsClient{NewAnnotationsClient(conn), c.baseInterceptor}, Cache}
+	case "Auth":
+		c.Auth = &CachedAuthClient{&InterceptedAuthClient{NewAuthClient(conn), c.baseInterceptor}, Cache}
+	case "Builds":
+		c.Builds = &CachedBuildsClient{&InterceptedBuildsClient{NewBuildsClient(conn), c.baseInterceptor}, Cache}
+	case "Defs":
+		c.Defs = &CachedDefsClient{&InterceptedDefsClient{NewDefsClient(conn), c.baseInterceptor}, Cache}
+	case "Deltas":
+		c.Deltas = &CachedDeltasClient{&InterceptedDeltasClient{NewDeltasClient(conn), c.baseInterceptor}, Cache}
+	case "Discussions":
+		c.Discussions = &CachedDiscussionsClient{&InterceptedDiscussionsClient{NewDiscussionsClient(conn), c.baseInterceptor}, Cache}
+	case "GraphQL":
+		c.GraphQL = &CachedGraphQLClient{&InterceptedGraphQLClient{NewGraphQLClient(conn), c.baseInterceptor}, Cache}
+	case "GraphUplink":
+		c.GraphUplink = &CachedGraphUplinkClient{&InterceptedGraphUplinkClient{NewGraphUplinkClient(conn), c.baseInterceptor}, Cache}
+	case "Issues":
+		c.Issues = &CachedIssuesClient{&InterceptedIssuesClient{NewIssuesClient(conn), c.baseInterceptor}, Cache}
+	case "Markdown":
+		c.Markdown = &CachedMarkdownClient{&InterceptedMarkdownClient{NewMarkdownClient(conn), c.baseInterceptor}, Cache}
+	case "Meta":
+		c.Meta = &CachedMetaClient{&InterceptedMetaClient{NewMetaClient(conn), c.baseInterceptor}, Cache}
+	case "MirrorRepos":
+		c.MirrorRepos = &CachedMirrorReposClient{&InterceptedMirrorReposClient{NewMirrorReposClient(conn), c.baseInterceptor}, Cache}
+	case "MirroredRepoSSHKeys":
+		c.MirroredRepoSSHKeys = &CachedMirroredRepoSSHKeysClient{&InterceptedMirroredRepoSSHKeysClient{NewMirroredRepoSSHKeysClient(conn), c.baseInterceptor}, Cache}
+	case "Notify":
+		c.Notify = &CachedNotifyClient{&InterceptedNotifyClient{NewNotifyClient(conn), c.baseInterceptor}, Cache}
+	case "Orgs":
+		c.Orgs = &CachedOrgsClient{&InterceptedOrgsClient{NewOrgsClient(conn), c.baseInterceptor}, Cache}
+	case "People":
+		c.People = &CachedPeopleClient{&InterceptedPeopleClient{NewPeopleClient(conn), c.baseInterceptor}, Cache}
+	case "RegisteredClients":
+		c.RegisteredClients = &CachedRegisteredClientsClient{&InterceptedRegisteredClientsClient{NewRegisteredClientsClient(conn), c.baseInterceptor}, Cache}
+	case "RepoBadges":
+		c.RepoBadges = &CachedRepoBadgesClient{&InterceptedRepoBadgesClient{NewRepoBadgesClient(conn), c.baseInterceptor}, Cache}
+	case "RepoDependencies":
+		c.RepoDependencies = &CachedRepoDependenciesClient{&InterceptedRepoDependenciesClient{NewRepoDependenciesClient(conn), c.baseInterceptor}, Cache}
+	case "RepoStatuses":
+		c.RepoStatuses = &CachedRepoStatusesClient{&InterceptedRepoStatusesClient{NewRepoStatusesClient(conn), c.baseInterceptor}, Cache}
+	case "RepoTree":
+		c.RepoTree = &CachedRepoTreeClient{&InterceptedRepoTreeClient{NewRepoTreeClient(conn), c.baseInterceptor}, Cache}
+	case "Repos":
+		c.Repos = &CachedReposClient{&InterceptedReposClient{NewReposClient(conn), c.baseInterceptor}, Cache}
+	case "SavedSearches":
+		c.SavedSearches = &CachedSavedSearchesClient{&InterceptedSavedSearchesClient{NewSavedSearchesClient(conn), c.baseInterceptor}, Cache}
+	case "Storage":
+		c.Storage = &CachedStorageClient{&InterceptedStorageClient{NewStorageClient(conn), c.baseInterceptor}, Cache}
+	case "Changesets":
+		c.Changesets = &CachedChangesetsClient{&InterceptedChangesetsClient{NewChangesetsClient(conn), c.baseInterceptor}, Cache}
+	case "Search":
+		c.Search = &CachedSearchClient{&InterceptedSearchClient{NewSearchClient(conn), c.baseInterceptor}, Cache}
+	case "Units":
+		c.Units = &CachedUnitsClient{&InterceptedUnitsClient{NewUnitsClient(conn), c.baseInterceptor}, Cache}
+	case "Users":
+		c.Users = &CachedUsersClient{&InterceptedUsersClient{NewUsersClient(conn), c.baseInterceptor}, Cache}
+	case "UserKeys":
+		c.UserKeys = &CachedUserKeysClient{&InterceptedUserKeysClient{NewUserKeysClient(conn), c.baseInterceptor}, Cache}
+	default:
+		return false
+	}
+	return true
+}
+
+type InterceptedAccountsClient struct {
+	AccountsClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedAccountsClient) Create(ctx context.Context, in *NewAccount, opts ...grpc.CallOption) (*UserSpec, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AccountsClient.Create(ctx, in.(*NewAccount), callOptions(ctx, opts)...)
+	})(ctx, "Accounts.Create", in)
+	r, _ := result.(*UserSpec)
+	return r, err
+}
+
+func (s *InterceptedAccountsClient) RequestPasswordReset(ctx context.Context, in *EmailAddr, opts ...grpc.CallOption) (*User, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AccountsClient.RequestPasswordReset(ctx, in.(*EmailAddr), callOptions(ctx, opts)...)
+	})(ctx, "Accounts.RequestPasswordReset", in)
+	r, _ := result.(*User)
+	return r, err
+}
+
+func (s *InterceptedAccountsClient) ResetPassword(ctx context.Context, in *NewPassword, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AccountsClient.ResetPassword(ctx, in.(*NewPassword), callOptions(ctx, opts)...)
+	})(ctx, "Accounts.ResetPassword", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedAccountsClient) Update(ctx context.Context, in *User, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AccountsClient.Update(ctx, in.(*User), callOptions(ctx, opts)...)
+	})(ctx, "Accounts.Update", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+type InterceptedAdminClient struct {
+	AdminClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedAdminClient) GetSiteConfig(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*SiteConfig, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AdminClient.GetSiteConfig(ctx, in.(*pbtypes.Void), callOptions(ctx, opts)...)
+	})(ctx, "Admin.GetSiteConfig", in)
+	r, _ := result.(*SiteConfig)
+	return r, err
+}
+
+func (s *InterceptedAdminClient) UpdateSiteConfig(ctx context.Context, in *SiteConfig, opts ...grpc.CallOption) (*SiteConfig, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AdminClient.UpdateSiteConfig(ctx, in.(*SiteConfig), callOptions(ctx, opts)...)
+	})(ctx, "Admin.UpdateSiteConfig", in)
+	r, _ := result.(*SiteConfig)
+	return r, err
+}
+
+func (s *InterceptedAdminClient) ReindexAllRepos(ctx context.Context, in *AdminReindexAllReposOp, opts ...grpc.CallOption) (*AdminReindexResult, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AdminClient.ReindexAllRepos(ctx, in.(*AdminReindexAllReposOp), callOptions(ctx, opts)...)
+	})(ctx, "Admin.ReindexAllRepos", in)
+	r, _ := result.(*AdminReindexResult)
+	return r, err
+}
+
+func (s *InterceptedAdminClient) DeactivateUser(ctx context.Context, in *UserSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AdminClient.DeactivateUser(ctx, in.(*UserSpec), callOptions(ctx, opts)...)
+	})(ctx, "Admin.DeactivateUser", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedAdminClient) GetJobQueueStats(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*JobQueueStats, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AdminClient.GetJobQueueStats(ctx, in.(*pbtypes.Void), callOptions(ctx, opts)...)
+	})(ctx, "Admin.GetJobQueueStats", in)
+	r, _ := result.(*JobQueueStats)
+	return r, err
+}
+
+type InterceptedAdminStatsClient struct {
+	AdminStatsClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedAdminStatsClient) GetUsage(ctx context.Context, in *AdminStatsGetUsageOp, opts ...grpc.CallOption) (*UsageStats, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AdminStatsClient.GetUsage(ctx, in.(*AdminStatsGetUsageOp), callOptions(ctx, opts)...)
+	})(ctx, "AdminStats.GetUsage", in)
+	r, _ := result.(*UsageStats)
+	return r, err
+}
+
+type InterceptedAnnotationsClient struct {
+	AnnotationsClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedAnnotationsClient) List(ctx context.Context, in *AnnotationsListOptions, opts ...grpc.CallOption) (*AnnotationList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AnnotationsClient.List(ctx, in.(*AnnotationsListOptions), callOptions(ctx, opts)...)
+	})(ctx, "Annotations.List", in)
+	r, _ := result.(*AnnotationList)
+	return r, err
+}
+
+type InterceptedAuthClient struct {
+	AuthClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedAuthClient) GetAuthorizationCode(ctx context.Context, in *AuthorizationCodeRequest, opts ...grpc.CallOption) (*AuthorizationCode, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AuthClient.GetAuthorizationCode(ctx, in.(*AuthorizationCodeRequest), callOptions(ctx, opts)...)
+	})(ctx, "Auth.GetAuthorizationCode", in)
+	r, _ := result.(*AuthorizationCode)
+	return r, err
+}
+
+func (s *InterceptedAuthClient) GetAccessToken(ctx context.Context, in *AccessTokenRequest, opts ...grpc.CallOption) (*AccessTokenResponse, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AuthClient.GetAccessToken(ctx, in.(*AccessTokenRequest), callOptions(ctx, opts)...)
+	})(ctx, "Auth.GetAccessToken", in)
+	r, _ := result.(*AccessTokenResponse)
+	return r, err
+}
+
+func (s *InterceptedAuthClient) Identify(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*AuthInfo, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AuthClient.Identify(ctx, in.(*pbtypes.Void), callOptions(ctx, opts)...)
+	})(ctx, "Auth.Identify", in)
+	r, _ := result.(*AuthInfo)
+	return r, err
+}
+
+func (s *InterceptedAuthClient) GetPermissions(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*UserPermissions, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AuthClient.GetPermissions(ctx, in.(*pbtypes.Void), callOptions(ctx, opts)...)
+	})(ctx, "Auth.GetPermissions", in)
+	r, _ := result.(*UserPermissions)
+	return r, err
+}
+
+func (s *InterceptedAuthClient) CreateToken(ctx context.Context, in *AuthCreateTokenOp, opts ...grpc.CallOption) (*PersonalAccessToken, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AuthClient.CreateToken(ctx, in.(*AuthCreateTokenOp), callOptions(ctx, opts)...)
+	})(ctx, "Auth.CreateToken", in)
+	r, _ := result.(*PersonalAccessToken)
+	return r, err
+}
+
+func (s *InterceptedAuthClient) ListTokens(ctx context.Context, in *AuthListTokensOp, opts ...grpc.CallOption) (*PersonalAccessTokenList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AuthClient.ListTokens(ctx, in.(*AuthListTokensOp), callOptions(ctx, opts)...)
+	})(ctx, "Auth.ListTokens", in)
+	r, _ := result.(*PersonalAccessTokenList)
+	return r, err
+}
+
+func (s *InterceptedAuthClient) RevokeToken(ctx context.Context, in *AuthRevokeTokenOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.AuthClient.RevokeToken(ctx, in.(*AuthRevokeTokenOp), callOptions(ctx, opts)...)
+	})(ctx, "Auth.RevokeToken", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+type InterceptedBuildsClient struct {
+	BuildsClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedBuildsClient) Get(ctx context.Context, in *BuildSpec, opts ...grpc.CallOption) (*Build, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.BuildsClient.Get(ctx, in.(*BuildSpec), callOptions(ctx, opts)...)
+	})(ctx, "Builds.Get", in)
+	r, _ := result.(*Build)
+	return r, err
+}
+
+func (s *InterceptedBuildsClient) GetRepoBuildInfo(ctx context.Context, in *BuildsGetRepoBuildInfoOp, opts ...grpc.CallOption) (*RepoBuildInfo, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.BuildsClient.GetRepoBuildInfo(ctx, in.(*BuildsGetRepoBuildInfoOp), callOptions(ctx, opts)...)
+	})(ctx, "Builds.GetRepoBuildInfo", in)
+	r, _ := result.(*RepoBuildInfo)
+	return r, err
+}
+
+func (s *InterceptedBuildsClient) GetMultipleRepoBuildInfo(ctx context.Context, in *BuildsGetMultipleRepoBuildInfoOp, opts ...grpc.CallOption) (*RepoBuildInfoList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.BuildsClient.GetMultipleRepoBuildInfo(ctx, in.(*BuildsGetMultipleRepoBuildInfoOp), callOptions(ctx, opts)...)
+	})(ctx, "Builds.GetMultipleRepoBuildInfo", in)
+	r, _ := result.(*RepoBuildInfoList)
+	return r, err
+}
+
+func (s *InterceptedBuildsClient) List(ctx context.Context, in *BuildListOptions, opts ...grpc.CallOption) (*BuildList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.BuildsClient.List(ctx, in.(*BuildListOptions), callOptions(ctx, opts)...)
+	})(ctx, "Builds.List", in)
+	r, _ := result.(*BuildList)
+	return r, err
+}
+
+func (s *InterceptedBuildsClient) ListByRepo(ctx context.Context, in *BuildsListByRepoOp, opts ...grpc.CallOption) (*BuildList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.BuildsClient.ListByRepo(ctx, in.(*BuildsListByRepoOp), callOptions(ctx, opts)...)
+	})(ctx, "Builds.ListByRepo", in)
+	r, _ := result.(*BuildList)
+	return r, err
+}
+
+func (s *InterceptedBuildsClient) Create(ctx context.Context, in *BuildsCreateOp, opts ...grpc.CallOption) (*Build, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.BuildsClient.Create(ctx, in.(*BuildsCreateOp), callOptions(ctx, opts)...)
+	})(ctx, "Builds.Create", in)
+	r, _ := result.(*Build)
+	return r, err
+}
+
+func (s *InterceptedBuildsClient) Update(ctx context.Context, in *BuildsUpdateOp, opts ...grpc.CallOption) (*Build, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.BuildsClient.Update(ctx, in.(*BuildsUpdateOp), callOptions(ctx, opts)...)
+	})(ctx, "Builds.Update", in)
+	r, _ := result.(*Build)
+	return r, err
+}
+
+func (s *InterceptedBuildsClient) ListBuildTasks(ctx context.Context, in *BuildsListBuildTasksOp, opts ...grpc.CallOption) (*BuildTaskList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.BuildsClient.ListBuildTasks(ctx, in.(*BuildsListBuildTasksOp), callOptions(ctx, opts)...)
+	})(ctx, "Builds.ListBuildTasks", in)
+	r, _ := result.(*BuildTaskList)
+	return r, err
+}
+
+func (s *InterceptedBuildsClient) CreateTasks(ctx context.Context, in *BuildsCreateTasksOp, opts ...grpc.CallOption) (*BuildTaskList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.BuildsClient.CreateTasks(ctx, in.(*BuildsCreateTasksOp), callOptions(ctx, opts)...)
+	})(ctx, "Builds.CreateTasks", in)
+	r, _ := result.(*BuildTaskList)
+	return r, err
+}
+
+func (s *InterceptedBuildsClient) UpdateTask(ctx context.Context, in *BuildsUpdateTaskOp, opts ...grpc.CallOption) (*BuildTask, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.BuildsClient.UpdateTask(ctx, in.(*BuildsUpdateTaskOp), callOptions(ctx, opts)...)
+	})(ctx, "Builds.UpdateTask", in)
+	r, _ := result.(*BuildTask)
+	return r, err
+}
+
+func (s *InterceptedBuildsClient) GetLog(ctx context.Context, in *BuildsGetLogOp, opts ...grpc.CallOption) (*LogEntries, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.BuildsClient.GetLog(ctx, in.(*BuildsGetLogOp), callOptions(ctx, opts)...)
+	})(ctx, "Builds.GetLog", in)
+	r, _ := result.(*LogEntries)
+	return r, err
+}
+
+func (s *InterceptedBuildsClient) GetTaskLog(ctx context.Context, in *BuildsGetTaskLogOp, opts ...grpc.CallOption) (*LogEntries, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.BuildsClient.GetTaskLog(ctx, in.(*BuildsGetTaskLogOp), callOptions(ctx, opts)...)
+	})(ctx, "Builds.GetTaskLog", in)
+	r, _ := result.(*LogEntries)
+	return r, err
+}
+
+func (s *InterceptedBuildsClient) DequeueNext(ctx context.Context, in *BuildsDequeueNextOp, opts ...grpc.CallOption) (*Build, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.BuildsClient.DequeueNext(ctx, in.(*BuildsDequeueNextOp), callOptions(ctx, opts)...)
+	})(ctx, "Builds.DequeueNext", in)
+	r, _ := result.(*Build)
+	return r, err
+}
+
+func (s *InterceptedBuildsClient) Heartbeat(ctx context.Context, in *BuildSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.BuildsClient.Heartbeat(ctx, in.(*BuildSpec), callOptions(ctx, opts)...)
+	})(ctx, "Builds.Heartbeat", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedBuildsClient) Cancel(ctx context.Context, in *BuildsCancelOp, opts ...grpc.CallOption) (*Build, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.BuildsClient.Cancel(ctx, in.(*BuildsCancelOp), callOptions(ctx, opts)...)
+	})(ctx, "Builds.Cancel", in)
+	r, _ := result.(*Build)
+	return r, err
+}
+
+func (s *InterceptedBuildsClient) Restart(ctx context.Context, in *BuildsRestartOp, opts ...grpc.CallOption) (*Build, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.BuildsClient.Restart(ctx, in.(*BuildsRestartOp), callOptions(ctx, opts)...)
+	})(ctx, "Builds.Restart", in)
+	r, _ := result.(*Build)
+	return r, err
+}
+
+type InterceptedChangesetsClient struct {
+	ChangesetsClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedChangesetsClient) Create(ctx context.Context, in *ChangesetCreateOp, opts ...grpc.CallOption) (*Changeset, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ChangesetsClient.Create(ctx, in.(*ChangesetCreateOp), callOptions(ctx, opts)...)
+	})(ctx, "Changesets.Create", in)
+	r, _ := result.(*Changeset)
+	return r, err
+}
+
+func (s *InterceptedChangesetsClient) Get(ctx context.Context, in *ChangesetSpec, opts ...grpc.CallOption) (*Changeset, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ChangesetsClient.Get(ctx, in.(*ChangesetSpec), callOptions(ctx, opts)...)
+	})(ctx, "Changesets.Get", in)
+	r, _ := result.(*Changeset)
+	return r, err
+}
+
+func (s *InterceptedChangesetsClient) List(ctx context.Context, in *ChangesetListOp, opts ...grpc.CallOption) (*ChangesetList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ChangesetsClient.List(ctx, in.(*ChangesetListOp), callOptions(ctx, opts)...)
+	})(ctx, "Changesets.List", in)
+	r, _ := result.(*ChangesetList)
+	return r, err
+}
+
+func (s *InterceptedChangesetsClient) Update(ctx context.Context, in *ChangesetUpdateOp, opts ...grpc.CallOption) (*ChangesetEvent, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ChangesetsClient.Update(ctx, in.(*ChangesetUpdateOp), callOptions(ctx, opts)...)
+	})(ctx, "Changesets.Update", in)
+	r, _ := result.(*ChangesetEvent)
+	return r, err
+}
+
+func (s *InterceptedChangesetsClient) Merge(ctx context.Context, in *ChangesetMergeOp, opts ...grpc.CallOption) (*ChangesetEvent, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ChangesetsClient.Merge(ctx, in.(*ChangesetMergeOp), callOptions(ctx, opts)...)
+	})(ctx, "Changesets.Merge", in)
+	r, _ := result.(*ChangesetEvent)
+	return r, err
+}
+
+func (s *InterceptedChangesetsClient) UpdateAffected(ctx context.Context, in *ChangesetUpdateAffectedOp, opts ...grpc.CallOption) (*ChangesetEventList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ChangesetsClient.UpdateAffected(ctx, in.(*ChangesetUpdateAffectedOp), callOptions(ctx, opts)...)
+	})(ctx, "Changesets.UpdateAffected", in)
+	r, _ := result.(*ChangesetEventList)
+	return r, err
+}
+
+func (s *InterceptedChangesetsClient) CreateReview(ctx context.Context, in *ChangesetCreateReviewOp, opts ...grpc.CallOption) (*ChangesetReview, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ChangesetsClient.CreateReview(ctx, in.(*ChangesetCreateReviewOp), callOptions(ctx, opts)...)
+	})(ctx, "Changesets.CreateReview", in)
+	r, _ := result.(*ChangesetReview)
+	return r, err
+}
+
+func (s *InterceptedChangesetsClient) ListReviews(ctx context.Context, in *ChangesetListReviewsOp, opts ...grpc.CallOption) (*ChangesetReviewList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ChangesetsClient.ListReviews(ctx, in.(*ChangesetListReviewsOp), callOptions(ctx, opts)...)
+	})(ctx, "Changesets.ListReviews", in)
+	r, _ := result.(*ChangesetReviewList)
+	return r, err
+}
+
+func (s *InterceptedChangesetsClient) ListEvents(ctx context.Context, in *ChangesetSpec, opts ...grpc.CallOption) (*ChangesetEventList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ChangesetsClient.ListEvents(ctx, in.(*ChangesetSpec), callOptions(ctx, opts)...)
+	})(ctx, "Changesets.ListEvents", in)
+	r, _ := result.(*ChangesetEventList)
+	return r, err
+}
+
+type InterceptedDefsClient struct {
+	DefsClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedDefsClient) Get(ctx context.Context, in *DefsGetOp, opts ...grpc.CallOption) (*Def, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DefsClient.Get(ctx, in.(*DefsGetOp), callOptions(ctx, opts)...)
+	})(ctx, "Defs.Get", in)
+	r, _ := result.(*Def)
+	return r, err
+}
+
+func (s *InterceptedDefsClient) GetByPosition(ctx context.Context, in *DefsGetByPositionOp, opts ...grpc.CallOption) (*Def, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DefsClient.GetByPosition(ctx, in.(*DefsGetByPositionOp), callOptions(ctx, opts)...)
+	})(ctx, "Defs.GetByPosition", in)
+	r, _ := result.(*Def)
+	return r, err
+}
+
+func (s *InterceptedDefsClient) Hover(ctx context.Context, in *DefsGetByPositionOp, opts ...grpc.CallOption) (*Hover, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DefsClient.Hover(ctx, in.(*DefsGetByPositionOp), callOptions(ctx, opts)...)
+	})(ctx, "Defs.Hover", in)
+	r, _ := result.(*Hover)
+	return r, err
+}
+
+func (s *InterceptedDefsClient) GetMultiple(ctx context.Context, in *DefsGetMultipleOp, opts ...grpc.CallOption) (*DefList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DefsClient.GetMultiple(ctx, in.(*DefsGetMultipleOp), callOptions(ctx, opts)...)
+	})(ctx, "Defs.GetMultiple", in)
+	r, _ := result.(*DefList)
+	return r, err
+}
+
+func (s *InterceptedDefsClient) List(ctx context.Context, in *DefListOptions, opts ...grpc.CallOption) (*DefList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DefsClient.List(ctx, in.(*DefListOptions), callOptions(ctx, opts)...)
+	})(ctx, "Defs.List", in)
+	r, _ := result.(*DefList)
+	return r, err
+}
+
+func (s *InterceptedDefsClient) ListRefs(ctx context.Context, in *DefsListRefsOp, opts ...grpc.CallOption) (*RefList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DefsClient.ListRefs(ctx, in.(*DefsListRefsOp), callOptions(ctx, opts)...)
+	})(ctx, "Defs.ListRefs", in)
+	r, _ := result.(*RefList)
+	return r, err
+}
+
+func (s *InterceptedDefsClient) ListExamples(ctx context.Context, in *DefsListExamplesOp, opts ...grpc.CallOption) (*ExampleList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DefsClient.ListExamples(ctx, in.(*DefsListExamplesOp), callOptions(ctx, opts)...)
+	})(ctx, "Defs.ListExamples", in)
+	r, _ := result.(*ExampleList)
+	return r, err
+}
+
+func (s *InterceptedDefsClient) ListAuthors(ctx context.Context, in *DefsListAuthorsOp, opts ...grpc.CallOption) (*DefAuthorList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DefsClient.ListAuthors(ctx, in.(*DefsListAuthorsOp), callOptions(ctx, opts)...)
+	})(ctx, "Defs.ListAuthors", in)
+	r, _ := result.(*DefAuthorList)
+	return r, err
+}
+
+func (s *InterceptedDefsClient) ListClients(ctx context.Context, in *DefsListClientsOp, opts ...grpc.CallOption) (*DefClientList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DefsClient.ListClients(ctx, in.(*DefsListClientsOp), callOptions(ctx, opts)...)
+	})(ctx, "Defs.ListClients", in)
+	r, _ := result.(*DefClientList)
+	return r, err
+}
+
+func (s *InterceptedDefsClient) ListDependents(ctx context.Context, in *DefsListDependentsOp, opts ...grpc.CallOption) (*DefDependentList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DefsClient.ListDependents(ctx, in.(*DefsListDependentsOp), callOptions(ctx, opts)...)
+	})(ctx, "Defs.ListDependents", in)
+	r, _ := result.(*DefDependentList)
+	return r, err
+}
+
+func (s *InterceptedDefsClient) ListHistory(ctx context.Context, in *DefsListHistoryOp, opts ...grpc.CallOption) (*DefHistory, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DefsClient.ListHistory(ctx, in.(*DefsListHistoryOp), callOptions(ctx, opts)...)
+	})(ctx, "Defs.ListHistory", in)
+	r, _ := result.(*DefHistory)
+	return r, err
+}
+
+func (s *InterceptedDefsClient) ListTop(ctx context.Context, in *DefsListTopOp, opts ...grpc.CallOption) (*DefList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DefsClient.ListTop(ctx, in.(*DefsListTopOp), callOptions(ctx, opts)...)
+	})(ctx, "Defs.ListTop", in)
+	r, _ := result.(*DefList)
+	return r, err
+}
+
+func (s *InterceptedDefsClient) GetLineage(ctx context.Context, in *DefsGetLineageOp, opts ...grpc.CallOption) (*DefLineage, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DefsClient.GetLineage(ctx, in.(*DefsGetLineageOp), callOptions(ctx, opts)...)
+	})(ctx, "Defs.GetLineage", in)
+	r, _ := result.(*DefLineage)
+	return r, err
+}
+
+func (s *InterceptedDefsClient) ListCallers(ctx context.Context, in *DefsListCallersOp, opts ...grpc.CallOption) (*DefList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DefsClient.ListCallers(ctx, in.(*DefsListCallersOp), callOptions(ctx, opts)...)
+	})(ctx, "Defs.ListCallers", in)
+	r, _ := result.(*DefList)
+	return r, err
+}
+
+func (s *InterceptedDefsClient) ListCallees(ctx context.Context, in *DefsListCalleesOp, opts ...grpc.CallOption) (*DefList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DefsClient.ListCallees(ctx, in.(*DefsListCalleesOp), callOptions(ctx, opts)...)
+	})(ctx, "Defs.ListCallees", in)
+	r, _ := result.(*DefList)
+	return r, err
+}
+
+func (s *InterceptedDefsClient) DiffRefs(ctx context.Context, in *DefsDiffRefsOp, opts ...grpc.CallOption) (*RefDiff, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DefsClient.DiffRefs(ctx, in.(*DefsDiffRefsOp), callOptions(ctx, opts)...)
+	})(ctx, "Defs.DiffRefs", in)
+	r, _ := result.(*RefDiff)
+	return r, err
+}
+
+func (s *InterceptedDefsClient) Search(ctx context.Context, in *DefSearchOptions, opts ...grpc.CallOption) (*DefSearchResultList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DefsClient.Search(ctx, in.(*DefSearchOptions), callOptions(ctx, opts)...)
+	})(ctx, "Defs.Search", in)
+	r, _ := result.(*DefSearchResultList)
+	return r, err
+}
+
+type InterceptedDeltasClient struct {
+	DeltasClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedDeltasClient) Get(ctx context.Context, in *DeltaSpec, opts ...grpc.CallOption) (*Delta, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DeltasClient.Get(ctx, in.(*DeltaSpec), callOptions(ctx, opts)...)
+	})(ctx, "Deltas.Get", in)
+	r, _ := result.(*Delta)
+	return r, err
+}
+
+func (s *InterceptedDeltasClient) GetMergeBase(ctx context.Context, in *DeltaSpec, opts ...grpc.CallOption) (*DeltaMergeBase, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DeltasClient.GetMergeBase(ctx, in.(*DeltaSpec), callOptions(ctx, opts)...)
+	})(ctx, "Deltas.GetMergeBase", in)
+	r, _ := result.(*DeltaMergeBase)
+	return r, err
+}
+
+func (s *InterceptedDeltasClient) ListUnits(ctx context.Context, in *DeltasListUnitsOp, opts ...grpc.CallOption) (*UnitDeltaList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DeltasClient.ListUnits(ctx, in.(*DeltasListUnitsOp), callOptions(ctx, opts)...)
+	})(ctx, "Deltas.ListUnits", in)
+	r, _ := result.(*UnitDeltaList)
+	return r, err
+}
+
+func (s *InterceptedDeltasClient) ListDefs(ctx context.Context, in *DeltasListDefsOp, opts ...grpc.CallOption) (*DeltaDefs, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DeltasClient.ListDefs(ctx, in.(*DeltasListDefsOp), callOptions(ctx, opts)...)
+	})(ctx, "Deltas.ListDefs", in)
+	r, _ := result.(*DeltaDefs)
+	return r, err
+}
+
+func (s *InterceptedDeltasClient) ListFiles(ctx context.Context, in *DeltasListFilesOp, opts ...grpc.CallOption) (*DeltaFiles, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DeltasClient.ListFiles(ctx, in.(*DeltasListFilesOp), callOptions(ctx, opts)...)
+	})(ctx, "Deltas.ListFiles", in)
+	r, _ := result.(*DeltaFiles)
+	return r, err
+}
+
+func (s *InterceptedDeltasClient) GetPatch(ctx context.Context, in *DeltasGetPatchOp, opts ...grpc.CallOption) (*DeltaPatch, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DeltasClient.GetPatch(ctx, in.(*DeltasGetPatchOp), callOptions(ctx, opts)...)
+	})(ctx, "Deltas.GetPatch", in)
+	r, _ := result.(*DeltaPatch)
+	return r, err
+}
+
+func (s *InterceptedDeltasClient) ListAffectedAuthors(ctx context.Context, in *DeltasListAffectedAuthorsOp, opts ...grpc.CallOption) (*DeltaAffectedPersonList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DeltasClient.ListAffectedAuthors(ctx, in.(*DeltasListAffectedAuthorsOp), callOptions(ctx, opts)...)
+	})(ctx, "Deltas.ListAffectedAuthors", in)
+	r, _ := result.(*DeltaAffectedPersonList)
+	return r, err
+}
+
+func (s *InterceptedDeltasClient) ListAffectedClients(ctx context.Context, in *DeltasListAffectedClientsOp, opts ...grpc.CallOption) (*DeltaAffectedPersonList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DeltasClient.ListAffectedClients(ctx, in.(*DeltasListAffectedClientsOp), callOptions(ctx, opts)...)
+	})(ctx, "Deltas.ListAffectedClients", in)
+	r, _ := result.(*DeltaAffectedPersonList)
+	return r, err
+}
+
+func (s *InterceptedDeltasClient) GetImpact(ctx context.Context, in *DeltaSpec, opts ...grpc.CallOption) (*DeltaImpact, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DeltasClient.GetImpact(ctx, in.(*DeltaSpec), callOptions(ctx, opts)...)
+	})(ctx, "Deltas.GetImpact", in)
+	r, _ := result.(*DeltaImpact)
+	return r, err
+}
+
+func (s *InterceptedDeltasClient) ListDependencies(ctx context.Context, in *DeltasListDependenciesOp, opts ...grpc.CallOption) (*DeltaDependencies, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DeltasClient.ListDependencies(ctx, in.(*DeltasListDependenciesOp), callOptions(ctx, opts)...)
+	})(ctx, "Deltas.ListDependencies", in)
+	r, _ := result.(*DeltaDependencies)
+	return r, err
+}
+
+func (s *InterceptedDeltasClient) ListIncoming(ctx context.Context, in *DeltasListIncomingOp, opts ...grpc.CallOption) (*DeltaList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DeltasClient.ListIncoming(ctx, in.(*DeltasListIncomingOp), callOptions(ctx, opts)...)
+	})(ctx, "Deltas.ListIncoming", in)
+	r, _ := result.(*DeltaList)
+	return r, err
+}
+
+func (s *InterceptedDeltasClient) SetLabels(ctx context.Context, in *DeltasSetLabelsOp, opts ...grpc.CallOption) (*Delta, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DeltasClient.SetLabels(ctx, in.(*DeltasSetLabelsOp), callOptions(ctx, opts)...)
+	})(ctx, "Deltas.SetLabels", in)
+	r, _ := result.(*Delta)
+	return r, err
+}
+
+func (s *InterceptedDeltasClient) SetMilestone(ctx context.Context, in *DeltasSetMilestoneOp, opts ...grpc.CallOption) (*Delta, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DeltasClient.SetMilestone(ctx, in.(*DeltasSetMilestoneOp), callOptions(ctx, opts)...)
+	})(ctx, "Deltas.SetMilestone", in)
+	r, _ := result.(*Delta)
+	return r, err
+}
+
+func (s *InterceptedDeltasClient) AssignReviewer(ctx context.Context, in *DeltasReviewerOp, opts ...grpc.CallOption) (*Delta, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DeltasClient.AssignReviewer(ctx, in.(*DeltasReviewerOp), callOptions(ctx, opts)...)
+	})(ctx, "Deltas.AssignReviewer", in)
+	r, _ := result.(*Delta)
+	return r, err
+}
+
+func (s *InterceptedDeltasClient) UnassignReviewer(ctx context.Context, in *DeltasReviewerOp, opts ...grpc.CallOption) (*Delta, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DeltasClient.UnassignReviewer(ctx, in.(*DeltasReviewerOp), callOptions(ctx, opts)...)
+	})(ctx, "Deltas.UnassignReviewer", in)
+	r, _ := result.(*Delta)
+	return r, err
+}
+
+func (s *InterceptedDeltasClient) ListSuggestedReviewers(ctx context.Context, in *DeltasListSuggestedReviewersOp, opts ...grpc.CallOption) (*SuggestedReviewerList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DeltasClient.ListSuggestedReviewers(ctx, in.(*DeltasListSuggestedReviewersOp), callOptions(ctx, opts)...)
+	})(ctx, "Deltas.ListSuggestedReviewers", in)
+	r, _ := result.(*SuggestedReviewerList)
+	return r, err
+}
+
+type InterceptedDiscussionsClient struct {
+	DiscussionsClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedDiscussionsClient) Create(ctx context.Context, in *Discussion, opts ...grpc.CallOption) (*Discussion, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DiscussionsClient.Create(ctx, in.(*Discussion), callOptions(ctx, opts)...)
+	})(ctx, "Discussions.Create", in)
+	r, _ := result.(*Discussion)
+	return r, err
+}
+
+func (s *InterceptedDiscussionsClient) Get(ctx context.Context, in *DiscussionSpec, opts ...grpc.CallOption) (*Discussion, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DiscussionsClient.Get(ctx, in.(*DiscussionSpec), callOptions(ctx, opts)...)
+	})(ctx, "Discussions.Get", in)
+	r, _ := result.(*Discussion)
+	return r, err
+}
+
+func (s *InterceptedDiscussionsClient) List(ctx context.Context, in *DiscussionListOp, opts ...grpc.CallOption) (*DiscussionList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DiscussionsClient.List(ctx, in.(*DiscussionListOp), callOptions(ctx, opts)...)
+	})(ctx, "Discussions.List", in)
+	r, _ := result.(*DiscussionList)
+	return r, err
+}
+
+func (s *InterceptedDiscussionsClient) CreateComment(ctx context.Context, in *DiscussionCommentCreateOp, opts ...grpc.CallOption) (*DiscussionComment, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DiscussionsClient.CreateComment(ctx, in.(*DiscussionCommentCreateOp), callOptions(ctx, opts)...)
+	})(ctx, "Discussions.CreateComment", in)
+	r, _ := result.(*DiscussionComment)
+	return r, err
+}
+
+func (s *InterceptedDiscussionsClient) UpdateRating(ctx context.Context, in *DiscussionRatingUpdateOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.DiscussionsClient.UpdateRating(ctx, in.(*DiscussionRatingUpdateOp), callOptions(ctx, opts)...)
+	})(ctx, "Discussions.UpdateRating", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+type InterceptedGraphQLClient struct {
+	GraphQLClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedGraphQLClient) Query(ctx context.Context, in *GraphQLRequest, opts ...grpc.CallOption) (*GraphQLResponse, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.GraphQLClient.Query(ctx, in.(*GraphQLRequest), callOptions(ctx, opts)...)
+	})(ctx, "GraphQL.Query", in)
+	r, _ := result.(*GraphQLResponse)
+	return r, err
+}
+
+type InterceptedGraphUplinkClient struct {
+	GraphUplinkClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedGraphUplinkClient) Push(ctx context.Context, in *MetricsSnapshot, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.GraphUplinkClient.Push(ctx, in.(*MetricsSnapshot), callOptions(ctx, opts)...)
+	})(ctx, "GraphUplink.Push", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedGraphUplinkClient) PushEvents(ctx context.Context, in *UserEventList, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.GraphUplinkClient.PushEvents(ctx, in.(*UserEventList), callOptions(ctx, opts)...)
+	})(ctx, "GraphUplink.PushEvents", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+type InterceptedIssuesClient struct {
+	IssuesClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedIssuesClient) Get(ctx context.Context, in *IssueSpec, opts ...grpc.CallOption) (*Issue, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.IssuesClient.Get(ctx, in.(*IssueSpec), callOptions(ctx, opts)...)
+	})(ctx, "Issues.Get", in)
+	r, _ := result.(*Issue)
+	return r, err
+}
+
+func (s *InterceptedIssuesClient) List(ctx context.Context, in *IssuesListOp, opts ...grpc.CallOption) (*IssueList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.IssuesClient.List(ctx, in.(*IssuesListOp), callOptions(ctx, opts)...)
+	})(ctx, "Issues.List", in)
+	r, _ := result.(*IssueList)
+	return r, err
+}
+
+func (s *InterceptedIssuesClient) Create(ctx context.Context, in *IssuesCreateOp, opts ...grpc.CallOption) (*Issue, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.IssuesClient.Create(ctx, in.(*IssuesCreateOp), callOptions(ctx, opts)...)
+	})(ctx, "Issues.Create", in)
+	r, _ := result.(*Issue)
+	return r, err
+}
+
+func (s *InterceptedIssuesClient) CreateComment(ctx context.Context, in *IssuesCreateCommentOp, opts ...grpc.CallOption) (*IssueComment, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.IssuesClient.CreateComment(ctx, in.(*IssuesCreateCommentOp), callOptions(ctx, opts)...)
+	})(ctx, "Issues.CreateComment", in)
+	r, _ := result.(*IssueComment)
+	return r, err
+}
+
+type InterceptedMarkdownClient struct {
+	MarkdownClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedMarkdownClient) Render(ctx context.Context, in *MarkdownRenderOp, opts ...grpc.CallOption) (*MarkdownData, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.MarkdownClient.Render(ctx, in.(*MarkdownRenderOp), callOptions(ctx, opts)...)
+	})(ctx, "Markdown.Render", in)
+	r, _ := result.(*MarkdownData)
+	return r, err
+}
+
+type InterceptedMetaClient struct {
+	MetaClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedMetaClient) Status(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*ServerStatus, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.MetaClient.Status(ctx, in.(*pbtypes.Void), callOptions(ctx, opts)...)
+	})(ctx, "Meta.Status", in)
+	r, _ := result.(*ServerStatus)
+	return r, err
+}
+
+func (s *InterceptedMetaClient) Config(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*ServerConfig, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.MetaClient.Config(ctx, in.(*pbtypes.Void), callOptions(ctx, opts)...)
+	})(ctx, "Meta.Config", in)
+	r, _ := result.(*ServerConfig)
+	return r, err
+}
+
+func (s *InterceptedMetaClient) PubKey(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*ServerPubKey, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.MetaClient.PubKey(ctx, in.(*pbtypes.Void), callOptions(ctx, opts)...)
+	})(ctx, "Meta.PubKey", in)
+	r, _ := result.(*ServerPubKey)
+	return r, err
+}
+
+type InterceptedMirrorReposClient struct {
+	MirrorReposClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedMirrorReposClient) RefreshVCS(ctx context.Context, in *MirrorReposRefreshVCSOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.MirrorReposClient.RefreshVCS(ctx, in.(*MirrorReposRefreshVCSOp), callOptions(ctx, opts)...)
+	})(ctx, "MirrorRepos.RefreshVCS", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedMirrorReposClient) Enable(ctx context.Context, in *MirrorReposEnableOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.MirrorReposClient.Enable(ctx, in.(*MirrorReposEnableOp), callOptions(ctx, opts)...)
+	})(ctx, "MirrorRepos.Enable", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedMirrorReposClient) Disable(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.MirrorReposClient.Disable(ctx, in.(*RepoSpec), callOptions(ctx, opts)...)
+	})(ctx, "MirrorRepos.Disable", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedMirrorReposClient) GetStatus(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*MirrorStatus, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.MirrorReposClient.GetStatus(ctx, in.(*RepoSpec), callOptions(ctx, opts)...)
+	})(ctx, "MirrorRepos.GetStatus", in)
+	r, _ := result.(*MirrorStatus)
+	return r, err
+}
+
+type InterceptedMirroredRepoSSHKeysClient struct {
+	MirroredRepoSSHKeysClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedMirroredRepoSSHKeysClient) Create(ctx context.Context, in *MirroredRepoSSHKeysCreateOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.MirroredRepoSSHKeysClient.Create(ctx, in.(*MirroredRepoSSHKeysCreateOp), callOptions(ctx, opts)...)
+	})(ctx, "MirroredRepoSSHKeys.Create", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedMirroredRepoSSHKeysClient) Get(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*SSHPrivateKey, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.MirroredRepoSSHKeysClient.Get(ctx, in.(*RepoSpec), callOptions(ctx, opts)...)
+	})(ctx, "MirroredRepoSSHKeys.Get", in)
+	r, _ := result.(*SSHPrivateKey)
+	return r, err
+}
+
+func (s *InterceptedMirroredRepoSSHKeysClient) Delete(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.MirroredRepoSSHKeysClient.Delete(ctx, in.(*RepoSpec), callOptions(ctx, opts)...)
+	})(ctx, "MirroredRepoSSHKeys.Delete", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+type InterceptedNotifyClient struct {
+	NotifyClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedNotifyClient) GenericEvent(ctx context.Context, in *NotifyGenericEvent, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.NotifyClient.GenericEvent(ctx, in.(*NotifyGenericEvent), callOptions(ctx, opts)...)
+	})(ctx, "Notify.GenericEvent", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+type InterceptedOrgsClient struct {
+	OrgsClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedOrgsClient) Get(ctx context.Context, in *OrgSpec, opts ...grpc.CallOption) (*Org, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.OrgsClient.Get(ctx, in.(*OrgSpec), callOptions(ctx, opts)...)
+	})(ctx, "Orgs.Get", in)
+	r, _ := result.(*Org)
+	return r, err
+}
+
+func (s *InterceptedOrgsClient) List(ctx context.Context, in *OrgsListOp, opts ...grpc.CallOption) (*OrgList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.OrgsClient.List(ctx, in.(*OrgsListOp), callOptions(ctx, opts)...)
+	})(ctx, "Orgs.List", in)
+	r, _ := result.(*OrgList)
+	return r, err
+}
+
+func (s *InterceptedOrgsClient) ListMembers(ctx context.Context, in *OrgsListMembersOp, opts ...grpc.CallOption) (*UserList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.OrgsClient.ListMembers(ctx, in.(*OrgsListMembersOp), callOptions(ctx, opts)...)
+	})(ctx, "Orgs.ListMembers", in)
+	r, _ := result.(*UserList)
+	return r, err
+}
+
+func (s *InterceptedOrgsClient) ListTeams(ctx context.Context, in *OrgsListTeamsOp, opts ...grpc.CallOption) (*TeamList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.OrgsClient.ListTeams(ctx, in.(*OrgsListTeamsOp), callOptions(ctx, opts)...)
+	})(ctx, "Orgs.ListTeams", in)
+	r, _ := result.(*TeamList)
+	return r, err
+}
+
+func (s *InterceptedOrgsClient) GetTeam(ctx context.Context, in *TeamSpec, opts ...grpc.CallOption) (*Team, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.OrgsClient.GetTeam(ctx, in.(*TeamSpec), callOptions(ctx, opts)...)
+	})(ctx, "Orgs.GetTeam", in)
+	r, _ := result.(*Team)
+	return r, err
+}
+
+func (s *InterceptedOrgsClient) ListTeamMembers(ctx context.Context, in *OrgsListTeamMembersOp, opts ...grpc.CallOption) (*UserList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.OrgsClient.ListTeamMembers(ctx, in.(*OrgsListTeamMembersOp), callOptions(ctx, opts)...)
+	})(ctx, "Orgs.ListTeamMembers", in)
+	r, _ := result.(*UserList)
+	return r, err
+}
+
+func (s *InterceptedOrgsClient) AddTeamRepo(ctx context.Context, in *OrgsAddTeamRepoOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.OrgsClient.AddTeamRepo(ctx, in.(*OrgsAddTeamRepoOp), callOptions(ctx, opts)...)
+	})(ctx, "Orgs.AddTeamRepo", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+type InterceptedPeopleClient struct {
+	PeopleClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedPeopleClient) Get(ctx context.Context, in *PersonSpec, opts ...grpc.CallOption) (*Person, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.PeopleClient.Get(ctx, in.(*PersonSpec), callOptions(ctx, opts)...)
+	})(ctx, "People.Get", in)
+	r, _ := result.(*Person)
+	return r, err
+}
+
+type InterceptedRegisteredClientsClient struct {
+	RegisteredClientsClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedRegisteredClientsClient) Get(ctx context.Context, in *RegisteredClientSpec, opts ...grpc.CallOption) (*RegisteredClient, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RegisteredClientsClient.Get(ctx, in.(*RegisteredClientSpec), callOptions(ctx, opts)...)
+	})(ctx, "RegisteredClients.Get", in)
+	r, _ := result.(*RegisteredClient)
+	return r, err
+}
+
+func (s *InterceptedRegisteredClientsClient) GetCurrent(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*RegisteredClient, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RegisteredClientsClient.GetCurrent(ctx, in.(*pbtypes.Void), callOptions(ctx, opts)...)
+	})(ctx, "RegisteredClients.GetCurrent", in)
+	r, _ := result.(*RegisteredClient)
+	return r, err
+}
+
+func (s *InterceptedRegisteredClientsClient) Create(ctx context.Context, in *RegisteredClient, opts ...grpc.CallOption) (*RegisteredClient, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RegisteredClientsClient.Create(ctx, in.(*RegisteredClient), callOptions(ctx, opts)...)
+	})(ctx, "RegisteredClients.Create", in)
+	r, _ := result.(*RegisteredClient)
+	return r, err
+}
+
+func (s *InterceptedRegisteredClientsClient) Update(ctx context.Context, in *RegisteredClient, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RegisteredClientsClient.Update(ctx, in.(*RegisteredClient), callOptions(ctx, opts)...)
+	})(ctx, "RegisteredClients.Update", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedRegisteredClientsClient) Delete(ctx context.Context, in *RegisteredClientSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RegisteredClientsClient.Delete(ctx, in.(*RegisteredClientSpec), callOptions(ctx, opts)...)
+	})(ctx, "RegisteredClients.Delete", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedRegisteredClientsClient) List(ctx context.Context, in *RegisteredClientListOptions, opts ...grpc.CallOption) (*RegisteredClientList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RegisteredClientsClient.List(ctx, in.(*RegisteredClientListOptions), callOptions(ctx, opts)...)
+	})(ctx, "RegisteredClients.List", in)
+	r, _ := result.(*RegisteredClientList)
+	return r, err
+}
+
+func (s *InterceptedRegisteredClientsClient) GetUserPermissions(ctx context.Context, in *UserPermissionsOptions, opts ...grpc.CallOption) (*UserPermissions, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RegisteredClientsClient.GetUserPermissions(ctx, in.(*UserPermissionsOptions), callOptions(ctx, opts)...)
+	})(ctx, "RegisteredClients.GetUserPermissions", in)
+	r, _ := result.(*UserPermissions)
+	return r, err
+}
+
+func (s *InterceptedRegisteredClientsClient) SetUserPermissions(ctx context.Context, in *UserPermissions, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RegisteredClientsClient.SetUserPermissions(ctx, in.(*UserPermissions), callOptions(ctx, opts)...)
+	})(ctx, "RegisteredClients.SetUserPermissions", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedRegisteredClientsClient) ListUserPermissions(ctx context.Context, in *RegisteredClientSpec, opts ...grpc.CallOption) (*UserPermissionsList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RegisteredClientsClient.ListUserPermissions(ctx, in.(*RegisteredClientSpec), callOptions(ctx, opts)...)
+	})(ctx, "RegisteredClients.ListUserPermissions", in)
+	r, _ := result.(*UserPermissionsList)
+	return r, err
+}
+
+type InterceptedRepoBadgesClient struct {
+	RepoBadgesClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedRepoBadgesClient) ListBadges(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*BadgeList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoBadgesClient.ListBadges(ctx, in.(*RepoSpec), callOptions(ctx, opts)...)
+	})(ctx, "RepoBadges.ListBadges", in)
+	r, _ := result.(*BadgeList)
+	return r, err
+}
+
+func (s *InterceptedRepoBadgesClient) ListCounters(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*CounterList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoBadgesClient.ListCounters(ctx, in.(*RepoSpec), callOptions(ctx, opts)...)
+	})(ctx, "RepoBadges.ListCounters", in)
+	r, _ := result.(*CounterList)
+	return r, err
+}
+
+func (s *InterceptedRepoBadgesClient) RecordHit(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoBadgesClient.RecordHit(ctx, in.(*RepoSpec), callOptions(ctx, opts)...)
+	})(ctx, "RepoBadges.RecordHit", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedRepoBadgesClient) CountHits(ctx context.Context, in *RepoBadgesCountHitsOp, opts ...grpc.CallOption) (*RepoBadgesCountHitsResult, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoBadgesClient.CountHits(ctx, in.(*RepoBadgesCountHitsOp), callOptions(ctx, opts)...)
+	})(ctx, "RepoBadges.CountHits", in)
+	r, _ := result.(*RepoBadgesCountHitsResult)
+	return r, err
+}
+
+func (s *InterceptedRepoBadgesClient) CreateBadge(ctx context.Context, in *RepoBadgesCreateOp, opts ...grpc.CallOption) (*Badge, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoBadgesClient.CreateBadge(ctx, in.(*RepoBadgesCreateOp), callOptions(ctx, opts)...)
+	})(ctx, "RepoBadges.CreateBadge", in)
+	r, _ := result.(*Badge)
+	return r, err
+}
+
+func (s *InterceptedRepoBadgesClient) DeleteBadge(ctx context.Context, in *RepoBadgesDeleteOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoBadgesClient.DeleteBadge(ctx, in.(*RepoBadgesDeleteOp), callOptions(ctx, opts)...)
+	})(ctx, "RepoBadges.DeleteBadge", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedRepoBadgesClient) CreateCounter(ctx context.Context, in *RepoBadgesCreateOp, opts ...grpc.CallOption) (*Counter, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoBadgesClient.CreateCounter(ctx, in.(*RepoBadgesCreateOp), callOptions(ctx, opts)...)
+	})(ctx, "RepoBadges.CreateCounter", in)
+	r, _ := result.(*Counter)
+	return r, err
+}
+
+func (s *InterceptedRepoBadgesClient) DeleteCounter(ctx context.Context, in *RepoBadgesDeleteOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoBadgesClient.DeleteCounter(ctx, in.(*RepoBadgesDeleteOp), callOptions(ctx, opts)...)
+	})(ctx, "RepoBadges.DeleteCounter", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+type InterceptedRepoDependenciesClient struct {
+	RepoDependenciesClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedRepoDependenciesClient) ListDependencies(ctx context.Context, in *RepoDependenciesListOp, opts ...grpc.CallOption) (*RepoDependencyList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoDependenciesClient.ListDependencies(ctx, in.(*RepoDependenciesListOp), callOptions(ctx, opts)...)
+	})(ctx, "RepoDependencies.ListDependencies", in)
+	r, _ := result.(*RepoDependencyList)
+	return r, err
+}
+
+func (s *InterceptedRepoDependenciesClient) ListDependents(ctx context.Context, in *RepoDependenciesListOp, opts ...grpc.CallOption) (*RepoDependencyList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoDependenciesClient.ListDependents(ctx, in.(*RepoDependenciesListOp), callOptions(ctx, opts)...)
+	})(ctx, "RepoDependencies.ListDependents", in)
+	r, _ := result.(*RepoDependencyList)
+	return r, err
+}
+
+type InterceptedRepoStatusesClient struct {
+	RepoStatusesClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedRepoStatusesClient) GetCombined(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*CombinedStatus, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoStatusesClient.GetCombined(ctx, in.(*RepoRevSpec), callOptions(ctx, opts)...)
+	})(ctx, "RepoStatuses.GetCombined", in)
+	r, _ := result.(*CombinedStatus)
+	return r, err
+}
+
+func (s *InterceptedRepoStatusesClient) Create(ctx context.Context, in *RepoStatusesCreateOp, opts ...grpc.CallOption) (*RepoStatus, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoStatusesClient.Create(ctx, in.(*RepoStatusesCreateOp), callOptions(ctx, opts)...)
+	})(ctx, "RepoStatuses.Create", in)
+	r, _ := result.(*RepoStatus)
+	return r, err
+}
+
+func (s *InterceptedRepoStatusesClient) GetRollup(ctx context.Context, in *RepoStatusesGetRollupOp, opts ...grpc.CallOption) (*CombinedStatus, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoStatusesClient.GetRollup(ctx, in.(*RepoStatusesGetRollupOp), callOptions(ctx, opts)...)
+	})(ctx, "RepoStatuses.GetRollup", in)
+	r, _ := result.(*CombinedStatus)
+	return r, err
+}
+
+func (s *InterceptedRepoStatusesClient) List(ctx context.Context, in *RepoStatusesListOp, opts ...grpc.CallOption) (*RepoStatusList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoStatusesClient.List(ctx, in.(*RepoStatusesListOp), callOptions(ctx, opts)...)
+	})(ctx, "RepoStatuses.List", in)
+	r, _ := result.(*RepoStatusList)
+	return r, err
+}
+
+type InterceptedRepoTreeClient struct {
+	RepoTreeClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedRepoTreeClient) Get(ctx context.Context, in *RepoTreeGetOp, opts ...grpc.CallOption) (*TreeEntry, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoTreeClient.Get(ctx, in.(*RepoTreeGetOp), callOptions(ctx, opts)...)
+	})(ctx, "RepoTree.Get", in)
+	r, _ := result.(*TreeEntry)
+	return r, err
+}
+
+func (s *InterceptedRepoTreeClient) Search(ctx context.Context, in *RepoTreeSearchOp, opts ...grpc.CallOption) (*VCSSearchResultList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoTreeClient.Search(ctx, in.(*RepoTreeSearchOp), callOptions(ctx, opts)...)
+	})(ctx, "RepoTree.Search", in)
+	r, _ := result.(*VCSSearchResultList)
+	return r, err
+}
+
+func (s *InterceptedRepoTreeClient) List(ctx context.Context, in *RepoTreeListOp, opts ...grpc.CallOption) (*RepoTreeListResult, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoTreeClient.List(ctx, in.(*RepoTreeListOp), callOptions(ctx, opts)...)
+	})(ctx, "RepoTree.List", in)
+	r, _ := result.(*RepoTreeListResult)
+	return r, err
+}
+
+func (s *InterceptedRepoTreeClient) ListFileDefs(ctx context.Context, in *RepoTreeListFileDefsOp, opts ...grpc.CallOption) (*FileDefList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.RepoTreeClient.ListFileDefs(ctx, in.(*RepoTreeListFileDefsOp), callOptions(ctx, opts)...)
+	})(ctx, "RepoTree.ListFileDefs", in)
+	r, _ := result.(*FileDefList)
+	return r, err
+}
+
+type InterceptedReposClient struct {
+	ReposClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedReposClient) Get(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*Repo, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.Get(ctx, in.(*RepoSpec), callOptions(ctx, opts)...)
+	})(ctx, "Repos.Get", in)
+	r, _ := result.(*Repo)
+	return r, err
+}
+
+func (s *InterceptedReposClient) List(ctx context.Context, in *RepoListOptions, opts ...grpc.CallOption) (*RepoList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.List(ctx, in.(*RepoListOptions), callOptions(ctx, opts)...)
+	})(ctx, "Repos.List", in)
+	r, _ := result.(*RepoList)
+	return r, err
+}
+
+func (s *InterceptedReposClient) Create(ctx context.Context, in *ReposCreateOp, opts ...grpc.CallOption) (*Repo, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.Create(ctx, in.(*ReposCreateOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.Create", in)
+	r, _ := result.(*Repo)
+	return r, err
+}
+
+func (s *InterceptedReposClient) Update(ctx context.Context, in *ReposUpdateOp, opts ...grpc.CallOption) (*Repo, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.Update(ctx, in.(*ReposUpdateOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.Update", in)
+	r, _ := result.(*Repo)
+	return r, err
+}
+
+func (s *InterceptedReposClient) Delete(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.Delete(ctx, in.(*RepoSpec), callOptions(ctx, opts)...)
+	})(ctx, "Repos.Delete", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedReposClient) GetReadme(ctx context.Context, in *ReposGetReadmeOp, opts ...grpc.CallOption) (*Readme, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.GetReadme(ctx, in.(*ReposGetReadmeOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.GetReadme", in)
+	r, _ := result.(*Readme)
+	return r, err
+}
+
+func (s *InterceptedReposClient) GetInventory(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*Inventory, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.GetInventory(ctx, in.(*RepoRevSpec), callOptions(ctx, opts)...)
+	})(ctx, "Repos.GetInventory", in)
+	r, _ := result.(*Inventory)
+	return r, err
+}
+
+func (s *InterceptedReposClient) GetStatsHistory(ctx context.Context, in *ReposGetStatsHistoryOp, opts ...grpc.CallOption) (*RepoStatsHistory, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.GetStatsHistory(ctx, in.(*ReposGetStatsHistoryOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.GetStatsHistory", in)
+	r, _ := result.(*RepoStatsHistory)
+	return r, err
+}
+
+func (s *InterceptedReposClient) Enable(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.Enable(ctx, in.(*RepoSpec), callOptions(ctx, opts)...)
+	})(ctx, "Repos.Enable", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedReposClient) Disable(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.Disable(ctx, in.(*RepoSpec), callOptions(ctx, opts)...)
+	})(ctx, "Repos.Disable", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedReposClient) GetConfig(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*RepoConfig, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.GetConfig(ctx, in.(*RepoSpec), callOptions(ctx, opts)...)
+	})(ctx, "Repos.GetConfig", in)
+	r, _ := result.(*RepoConfig)
+	return r, err
+}
+
+func (s *InterceptedReposClient) ListCollaborators(ctx context.Context, in *ReposListCollaboratorsOp, opts ...grpc.CallOption) (*CollaboratorList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.ListCollaborators(ctx, in.(*ReposListCollaboratorsOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.ListCollaborators", in)
+	r, _ := result.(*CollaboratorList)
+	return r, err
+}
+
+func (s *InterceptedReposClient) AddCollaborator(ctx context.Context, in *ReposAddCollaboratorOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.AddCollaborator(ctx, in.(*ReposAddCollaboratorOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.AddCollaborator", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedReposClient) RemoveCollaborator(ctx context.Context, in *ReposRemoveCollaboratorOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.RemoveCollaborator(ctx, in.(*ReposRemoveCollaboratorOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.RemoveCollaborator", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedReposClient) GetPermissions(ctx context.Context, in *ReposGetPermissionsOp, opts ...grpc.CallOption) (*RepoPermissions, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.GetPermissions(ctx, in.(*ReposGetPermissionsOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.GetPermissions", in)
+	r, _ := result.(*RepoPermissions)
+	return r, err
+}
+
+func (s *InterceptedReposClient) ListKeys(ctx context.Context, in *ReposListKeysOp, opts ...grpc.CallOption) (*DeployKeyList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.ListKeys(ctx, in.(*ReposListKeysOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.ListKeys", in)
+	r, _ := result.(*DeployKeyList)
+	return r, err
+}
+
+func (s *InterceptedReposClient) AddKey(ctx context.Context, in *ReposAddKeyOp, opts ...grpc.CallOption) (*DeployKey, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.AddKey(ctx, in.(*ReposAddKeyOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.AddKey", in)
+	r, _ := result.(*DeployKey)
+	return r, err
+}
+
+func (s *InterceptedReposClient) DeleteKey(ctx context.Context, in *ReposDeleteKeyOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.DeleteKey(ctx, in.(*ReposDeleteKeyOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.DeleteKey", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedReposClient) Watch(ctx context.Context, in *ReposWatchOp, opts ...grpc.CallOption) (*RepoSubscription, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.Watch(ctx, in.(*ReposWatchOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.Watch", in)
+	r, _ := result.(*RepoSubscription)
+	return r, err
+}
+
+func (s *InterceptedReposClient) Unwatch(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.Unwatch(ctx, in.(*RepoSpec), callOptions(ctx, opts)...)
+	})(ctx, "Repos.Unwatch", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedReposClient) GetSubscription(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*RepoSubscription, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.GetSubscription(ctx, in.(*RepoSpec), callOptions(ctx, opts)...)
+	})(ctx, "Repos.GetSubscription", in)
+	r, _ := result.(*RepoSubscription)
+	return r, err
+}
+
+func (s *InterceptedReposClient) ListWatched(ctx context.Context, in *ReposListWatchedOp, opts ...grpc.CallOption) (*RepoList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.ListWatched(ctx, in.(*ReposListWatchedOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.ListWatched", in)
+	r, _ := result.(*RepoList)
+	return r, err
+}
+
+func (s *InterceptedReposClient) GetCommit(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*vcs.Commit, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.GetCommit(ctx, in.(*RepoRevSpec), callOptions(ctx, opts)...)
+	})(ctx, "Repos.GetCommit", in)
+	r, _ := result.(*vcs.Commit)
+	return r, err
+}
+
+func (s *InterceptedReposClient) GetCommitDetail(ctx context.Context, in *ReposGetCommitOp, opts ...grpc.CallOption) (*CommitDetail, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.GetCommitDetail(ctx, in.(*ReposGetCommitOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.GetCommitDetail", in)
+	r, _ := result.(*CommitDetail)
+	return r, err
+}
+
+func (s *InterceptedReposClient) GetCommitPatch(ctx context.Context, in *ReposGetCommitPatchOp, opts ...grpc.CallOption) (*CommitPatch, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.GetCommitPatch(ctx, in.(*ReposGetCommitPatchOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.GetCommitPatch", in)
+	r, _ := result.(*CommitPatch)
+	return r, err
+}
+
+func (s *InterceptedReposClient) GetArchive(ctx context.Context, in *ReposGetArchiveOp, opts ...grpc.CallOption) (*RepoArchive, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.GetArchive(ctx, in.(*ReposGetArchiveOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.GetArchive", in)
+	r, _ := result.(*RepoArchive)
+	return r, err
+}
+
+func (s *InterceptedReposClient) ListCommits(ctx context.Context, in *ReposListCommitsOp, opts ...grpc.CallOption) (*CommitList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.ListCommits(ctx, in.(*ReposListCommitsOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.ListCommits", in)
+	r, _ := result.(*CommitList)
+	return r, err
+}
+
+func (s *InterceptedReposClient) ListBranches(ctx context.Context, in *ReposListBranchesOp, opts ...grpc.CallOption) (*BranchList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.ListBranches(ctx, in.(*ReposListBranchesOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.ListBranches", in)
+	r, _ := result.(*BranchList)
+	return r, err
+}
+
+func (s *InterceptedReposClient) ListTags(ctx context.Context, in *ReposListTagsOp, opts ...grpc.CallOption) (*TagList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.ListTags(ctx, in.(*ReposListTagsOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.ListTags", in)
+	r, _ := result.(*TagList)
+	return r, err
+}
+
+func (s *InterceptedReposClient) ListCommitters(ctx context.Context, in *ReposListCommittersOp, opts ...grpc.CallOption) (*CommitterList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.ListCommitters(ctx, in.(*ReposListCommittersOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.ListCommitters", in)
+	r, _ := result.(*CommitterList)
+	return r, err
+}
+
+func (s *InterceptedReposClient) ListContributors(ctx context.Context, in *ReposListContributorsOp, opts ...grpc.CallOption) (*ContributorList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.ListContributors(ctx, in.(*ReposListContributorsOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.ListContributors", in)
+	r, _ := result.(*ContributorList)
+	return r, err
+}
+
+func (s *InterceptedReposClient) SearchCode(ctx context.Context, in *ReposSearchCodeOp, opts ...grpc.CallOption) (*CodeSearchResultList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.SearchCode(ctx, in.(*ReposSearchCodeOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.SearchCode", in)
+	r, _ := result.(*CodeSearchResultList)
+	return r, err
+}
+
+func (s *InterceptedReposClient) GetCodeOwners(ctx context.Context, in *ReposGetCodeOwnersOp, opts ...grpc.CallOption) (*CodeOwners, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.ReposClient.GetCodeOwners(ctx, in.(*ReposGetCodeOwnersOp), callOptions(ctx, opts)...)
+	})(ctx, "Repos.GetCodeOwners", in)
+	r, _ := result.(*CodeOwners)
+	return r, err
+}
+
+type InterceptedSavedSearchesClient struct {
+	SavedSearchesClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedSavedSearchesClient) Create(ctx context.Context, in *SavedSearch, opts ...grpc.CallOption) (*SavedSearch, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.SavedSearchesClient.Create(ctx, in.(*SavedSearch), callOptions(ctx, opts)...)
+	})(ctx, "SavedSearches.Create", in)
+	r, _ := result.(*SavedSearch)
+	return r, err
+}
+
+func (s *InterceptedSavedSearchesClient) List(ctx context.Context, in *SavedSearchListOptions, opts ...grpc.CallOption) (*SavedSearchList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.SavedSearchesClient.List(ctx, in.(*SavedSearchListOptions), callOptions(ctx, opts)...)
+	})(ctx, "SavedSearches.List", in)
+	r, _ := result.(*SavedSearchList)
+	return r, err
+}
+
+func (s *InterceptedSavedSearchesClient) Delete(ctx context.Context, in *SavedSearchSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.SavedSearchesClient.Delete(ctx, in.(*SavedSearchSpec), callOptions(ctx, opts)...)
+	})(ctx, "SavedSearches.Delete", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedSavedSearchesClient) UpdateNotifications(ctx context.Context, in *SavedSearchesUpdateNotificationsOp, opts ...grpc.CallOption) (*SavedSearch, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.SavedSearchesClient.UpdateNotifications(ctx, in.(*SavedSearchesUpdateNotificationsOp), callOptions(ctx, opts)...)
+	})(ctx, "SavedSearches.UpdateNotifications", in)
+	r, _ := result.(*SavedSearch)
+	return r, err
+}
+
+type InterceptedSearchClient struct {
+	SearchClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedSearchClient) Search(ctx context.Context, in *SearchOptions, opts ...grpc.CallOption) (*SearchResults, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.SearchClient.Search(ctx, in.(*SearchOptions), callOptions(ctx, opts)...)
+	})(ctx, "Search.Search", in)
+	r, _ := result.(*SearchResults)
+	return r, err
+}
+
+func (s *InterceptedSearchClient) SearchTokens(ctx context.Context, in *TokenSearchOptions, opts ...grpc.CallOption) (*DefList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.SearchClient.SearchTokens(ctx, in.(*TokenSearchOptions), callOptions(ctx, opts)...)
+	})(ctx, "Search.SearchTokens", in)
+	r, _ := result.(*DefList)
+	return r, err
+}
+
+func (s *InterceptedSearchClient) SearchText(ctx context.Context, in *TextSearchOptions, opts ...grpc.CallOption) (*VCSSearchResultList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.SearchClient.SearchText(ctx, in.(*TextSearchOptions), callOptions(ctx, opts)...)
+	})(ctx, "Search.SearchText", in)
+	r, _ := result.(*VCSSearchResultList)
+	return r, err
+}
+
+func (s *InterceptedSearchClient) Complete(ctx context.Context, in *RawQuery, opts ...grpc.CallOption) (*Completions, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.SearchClient.Complete(ctx, in.(*RawQuery), callOptions(ctx, opts)...)
+	})(ctx, "Search.Complete", in)
+	r, _ := result.(*Completions)
+	return r, err
+}
+
+func (s *InterceptedSearchClient) Suggest(ctx context.Context, in *RawQuery, opts ...grpc.CallOption) (*SuggestionList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.SearchClient.Suggest(ctx, in.(*RawQuery), callOptions(ctx, opts)...)
+	})(ctx, "Search.Suggest", in)
+	r, _ := result.(*SuggestionList)
+	return r, err
+}
+
+type InterceptedStorageClient struct {
+	StorageClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedStorageClient) Create(ctx context.Context, in *StorageName, opts ...grpc.CallOption) (*StorageError, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.StorageClient.Create(ctx, in.(*StorageName), callOptions(ctx, opts)...)
+	})(ctx, "Storage.Create", in)
+	r, _ := result.(*StorageError)
+	return r, err
+}
+
+func (s *InterceptedStorageClient) RemoveAll(ctx context.Context, in *StorageName, opts ...grpc.CallOption) (*StorageError, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.StorageClient.RemoveAll(ctx, in.(*StorageName), callOptions(ctx, opts)...)
+	})(ctx, "Storage.RemoveAll", in)
+	r, _ := result.(*StorageError)
+	return r, err
+}
+
+func (s *InterceptedStorageClient) Read(ctx context.Context, in *StorageReadOp, opts ...grpc.CallOption) (*StorageRead, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.StorageClient.Read(ctx, in.(*StorageReadOp), callOptions(ctx, opts)...)
+	})(ctx, "Storage.Read", in)
+	r, _ := result.(*StorageRead)
+	return r, err
+}
+
+func (s *InterceptedStorageClient) Write(ctx context.Context, in *StorageWriteOp, opts ...grpc.CallOption) (*StorageWrite, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.StorageClient.Write(ctx, in.(*StorageWriteOp), callOptions(ctx, opts)...)
+	})(ctx, "Storage.Write", in)
+	r, _ := result.(*StorageWrite)
+	return r, err
+}
+
+func (s *InterceptedStorageClient) Stat(ctx context.Context, in *StorageName, opts ...grpc.CallOption) (*StorageStat, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.StorageClient.Stat(ctx, in.(*StorageName), callOptions(ctx, opts)...)
+	})(ctx, "Storage.Stat", in)
+	r, _ := result.(*StorageStat)
+	return r, err
+}
+
+func (s *InterceptedStorageClient) ReadDir(ctx context.Context, in *StorageName, opts ...grpc.CallOption) (*StorageReadDir, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.StorageClient.ReadDir(ctx, in.(*StorageName), callOptions(ctx, opts)...)
+	})(ctx, "Storage.ReadDir", in)
+	r, _ := result.(*StorageReadDir)
+	return r, err
+}
+
+func (s *InterceptedStorageClient) Close(ctx context.Context, in *StorageName, opts ...grpc.CallOption) (*StorageError, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.StorageClient.Close(ctx, in.(*StorageName), callOptions(ctx, opts)...)
+	})(ctx, "Storage.Close", in)
+	r, _ := result.(*StorageError)
+	return r, err
+}
+
+type InterceptedUnitsClient struct {
+	UnitsClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedUnitsClient) Get(ctx context.Context, in *UnitSpec, opts ...grpc.CallOption) (*unit.RepoSourceUnit, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.UnitsClient.Get(ctx, in.(*UnitSpec), callOptions(ctx, opts)...)
+	})(ctx, "Units.Get", in)
+	r, _ := result.(*unit.RepoSourceUnit)
+	return r, err
+}
+
+func (s *InterceptedUnitsClient) List(ctx context.Context, in *UnitListOptions, opts ...grpc.CallOption) (*RepoSourceUnitList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.UnitsClient.List(ctx, in.(*UnitListOptions), callOptions(ctx, opts)...)
+	})(ctx, "Units.List", in)
+	r, _ := result.(*RepoSourceUnitList)
+	return r, err
+}
+
+type InterceptedUserKeysClient struct {
+	UserKeysClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedUserKeysClient) AddKey(ctx context.Context, in *SSHPublicKey, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.UserKeysClient.AddKey(ctx, in.(*SSHPublicKey), callOptions(ctx, opts)...)
+	})(ctx, "UserKeys.AddKey", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+func (s *InterceptedUserKeysClient) LookupUser(ctx context.Context, in *SSHPublicKey, opts ...grpc.CallOption) (*UserSpec, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.UserKeysClient.LookupUser(ctx, in.(*SSHPublicKey), callOptions(ctx, opts)...)
+	})(ctx, "UserKeys.LookupUser", in)
+	r, _ := result.(*UserSpec)
+	return r, err
+}
+
+func (s *InterceptedUserKeysClient) DeleteKey(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.UserKeysClient.DeleteKey(ctx, in.(*pbtypes.Void), callOptions(ctx, opts)...)
+	})(ctx, "UserKeys.DeleteKey", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
+
+type InterceptedUsersClient struct {
+	UsersClient
+	Interceptor Interceptor
+}
+
+func (s *InterceptedUsersClient) Get(ctx context.Context, in *UserSpec, opts ...grpc.CallOption) (*User, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.UsersClient.Get(ctx, in.(*UserSpec), callOptions(ctx, opts)...)
+	})(ctx, "Users.Get", in)
+	r, _ := result.(*User)
+	return r, err
+}
+
+func (s *InterceptedUsersClient) GetWithEmail(ctx context.Context, in *EmailAddr, opts ...grpc.CallOption) (*User, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.UsersClient.GetWithEmail(ctx, in.(*EmailAddr), callOptions(ctx, opts)...)
+	})(ctx, "Users.GetWithEmail", in)
+	r, _ := result.(*User)
+	return r, err
+}
+
+func (s *InterceptedUsersClient) GetByExternal(ctx context.Context, in *ExternalAccountSpec, opts ...grpc.CallOption) (*User, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.UsersClient.GetByExternal(ctx, in.(*ExternalAccountSpec), callOptions(ctx, opts)...)
+	})(ctx, "Users.GetByExternal", in)
+	r, _ := result.(*User)
+	return r, err
+}
+
+func (s *InterceptedUsersClient) ListAuthoredDefs(ctx context.Context, in *UsersListAuthoredDefsOp, opts ...grpc.CallOption) (*AuthoredDefList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.UsersClient.ListAuthoredDefs(ctx, in.(*UsersListAuthoredDefsOp), callOptions(ctx, opts)...)
+	})(ctx, "Users.ListAuthoredDefs", in)
+	r, _ := result.(*AuthoredDefList)
+	return r, err
+}
+
+func (s *InterceptedUsersClient) ListContributions(ctx context.Context, in *UsersListContributionsOp, opts ...grpc.CallOption) (*UserContributionList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.UsersClient.ListContributions(ctx, in.(*UsersListContributionsOp), callOptions(ctx, opts)...)
+	})(ctx, "Users.ListContributions", in)
+	r, _ := result.(*UserContributionList)
+	return r, err
+}
+
+func (s *InterceptedUsersClient) ListEmails(ctx context.Context, in *UserSpec, opts ...grpc.CallOption) (*EmailAddrList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.UsersClient.ListEmails(ctx, in.(*UserSpec), callOptions(ctx, opts)...)
+	})(ctx, "Users.ListEmails", in)
+	r, _ := result.(*EmailAddrList)
+	return r, err
+}
+
+func (s *InterceptedUsersClient) List(ctx context.Context, in *UsersListOptions, opts ...grpc.CallOption) (*UserList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.UsersClient.List(ctx, in.(*UsersListOptions), callOptions(ctx, opts)...)
+	})(ctx, "Users.List", in)
+	r, _ := result.(*UserList)
+	return r, err
+}
+
+func (s *InterceptedUsersClient) ListKeys(ctx context.Context, in *UsersListKeysOp, opts ...grpc.CallOption) (*UserKeyList, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.UsersClient.ListKeys(ctx, in.(*UsersListKeysOp), callOptions(ctx, opts)...)
+	})(ctx, "Users.ListKeys", in)
+	r, _ := result.(*UserKeyList)
+	return r, err
+}
+
+func (s *InterceptedUsersClient) AddKey(ctx context.Context, in *UsersAddKeyOp, opts ...grpc.CallOption) (*UserKey, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.UsersClient.AddKey(ctx, in.(*UsersAddKeyOp), callOptions(ctx, opts)...)
+	})(ctx, "Users.AddKey", in)
+	r, _ := result.(*UserKey)
+	return r, err
+}
+
+func (s *InterceptedUsersClient) DeleteKey(ctx context.Context, in *UsersDeleteKeyOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	result, err := s.Interceptor(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return s.UsersClient.DeleteKey(ctx, in.(*UsersDeleteKeyOp), callOptions(ctx, opts)...)
+	})(ctx, "Users.DeleteKey", in)
+	r, _ := result.(*pbtypes.Void)
+	return r, err
+}
@@ -0,0 +1,55 @@
+package sourcegraph
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// An Invoker calls an API method. Method is the name of the method
+// (e.g., "Repos.Get"), and in is its argument (e.g., *RepoSpec).
+type Invoker func(ctx context.Context, method string, in interface{}) (interface{}, error)
+
+// An Interceptor wraps an Invoker to add behavior (such as logging,
+// metrics, authentication, or caching) to API calls. It is analogous
+// to HTTP client middleware that wraps an http.RoundTripper.
+//
+// Use Client.UseInterceptor to apply an interceptor to all of a
+// client's services.
+type Interceptor func(next Invoker) Invoker
+
+// ChainInterceptors returns an Interceptor that applies each of
+// interceptors in order, with the first being outermost.
+func ChainInterceptors(interceptors ...Interceptor) Interceptor {
+	return func(next Invoker) Invoker {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			next = interceptors[i](next)
+		}
+		return next
+	}
+}
+
+// WithCallOption returns a copy of parent that adds opt to the gRPC
+// call options of calls made with it through clients created by
+// NewClient. It lets interceptors set call options, such
+// as grpc.Trailer, that are not otherwise available to them.
+func WithCallOption(parent context.Context, opt grpc.CallOption) context.Context {
+	opts, _ := parent.Value(callOptionsKey).([]grpc.CallOption)
+	opts = append(opts[:len(opts):len(opts)], opt)
+	return context.WithValue(parent, callOptionsKey, opts)
+}
+
+// callOptions returns opts plus the call options added to ctx using
+// WithCallOption.
+func callOptions(ctx context.Context, opts []grpc.CallOption) []grpc.CallOption {
+	extra, _ := ctx.Value(callOptionsKey).([]grpc.CallOption)
+	if len(extra) == 0 {
+		return opts
+	}
+	return append(opts[:len(opts):len(opts)], extra...)
+}
+
+// baseInterceptor is the interceptor that NewClient installs beneath
+// each of c's services' caches, directly above the gRPC client.
+func (c *Client) baseInterceptor(next Invoker) Invoker {
+	return ChainInterceptors(authErrorInterceptor, c.responseSizeInterceptor, timeoutInterceptor)(next)
+}
@@ -0,0 +1,102 @@
+package sourcegraph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+type interceptorReposClient struct {
+	ReposClient
+	err error
+}
+
+func (c *interceptorReposClient) Get(ctx context.Context, repo *RepoSpec, opts ...grpc.CallOption) (*Repo, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &Repo{URI: repo.URI}, nil
+}
+
+func TestClient_UseInterceptor(t *testing.T) {
+	var calls []string
+	record := func(name string) Interceptor {
+		return func(next Invoker) Invoker {
+			return func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+				calls = append(calls, name+":"+method)
+				return next(ctx, method, in)
+			}
+		}
+	}
+
+	fake := &interceptorReposClient{}
+	c := &Client{Repos: fake}
+	c.UseInterceptor(record("inner"))
+	c.UseInterceptor(record("outer"))
+
+	repo, err := c.Repos.Get(context.Background(), &RepoSpec{URI: "r"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repo.URI != "r" {
+		t.Errorf("got repo %q, want %q", repo.URI, "r")
+	}
+	if want := []string{"outer:Repos.Get", "inner:Repos.Get"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("got calls %v, want %v", calls, want)
+	}
+
+	fake.err = errors.New("x")
+	if repo, err := c.Repos.Get(context.Background(), &RepoSpec{URI: "r"}); err != fake.err || repo != nil {
+		t.Errorf("got (%v, %v), want (nil, %v)", repo, err, fake.err)
+	}
+}
+
+func TestChainInterceptors(t *testing.T) {
+	var calls []int
+	ic := func(n int) Interceptor {
+		return func(next Invoker) Invoker {
+			return func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+				calls = append(calls, n)
+				return next(ctx, method, in)
+			}
+		}
+	}
+	invoke := ChainInterceptors(ic(1), ic(2), ic(3))(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return in, nil
+	})
+	if out, _ := invoke(context.Background(), "m", "x"); out != "x" {
+		t.Errorf("got %v, want %q", out, "x")
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("got calls %v, want %v", calls, want)
+	}
+}
+
+type optsReposClient struct {
+	ReposClient
+	opts []grpc.CallOption
+}
+
+func (c *optsReposClient) Get(ctx context.Context, repo *RepoSpec, opts ...grpc.CallOption) (*Repo, error) {
+	c.opts = opts
+	return &Repo{}, nil
+}
+
+func TestWithCallOption(t *testing.T) {
+	fake := &optsReposClient{}
+	c := &Client{Repos: fake}
+	c.UseInterceptor(func(next Invoker) Invoker {
+		return func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+			return next(WithCallOption(ctx, grpc.Trailer(nil)), method, in)
+		}
+	})
+	if _, err := c.Repos.Get(context.Background(), &RepoSpec{}, grpc.Header(nil)); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(fake.opts), 2; got != want {
+		t.Errorf("got %d call options, want %d", got, want)
+	}
+}
@@ -0,0 +1,23 @@
+package sourcegraph
+
+import (
+	"html/template"
+
+	"golang.org/x/net/context"
+)
+
+// HTML returns the rendered HTML. It is safe to include in a web page
+// without escaping because the server sanitizes it.
+func (d *MarkdownData) HTML() template.HTML {
+	return template.HTML(d.Rendered)
+}
+
+// RenderMarkdown renders markdown to sanitized HTML using the
+// Markdown service.
+func RenderMarkdown(ctx context.Context, c MarkdownClient, markdown string, opt MarkdownOpt) (template.HTML, error) {
+	data, err := c.Render(ctx, &MarkdownRenderOp{Markdown: []byte(markdown), Opt: opt})
+	if err != nil {
+		return "", err
+	}
+	return data.HTML(), nil
+}
@@ -0,0 +1,34 @@
+package sourcegraph
+
+import (
+	"html/template"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+type recordingMarkdownClient struct {
+	op *MarkdownRenderOp
+}
+
+func (c *recordingMarkdownClient) Render(ctx context.Context, op *MarkdownRenderOp, opts ...grpc.CallOption) (*MarkdownData, error) {
+	c.op = op
+	return &MarkdownData{Rendered: []byte("<p>" + string(op.Markdown) + "</p>")}, nil
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	c := &recordingMarkdownClient{}
+	opt := MarkdownOpt{EnableCheckboxes: true, HighlightCode: true}
+	html, err := RenderMarkdown(context.Background(), c, "hi", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := template.HTML("<p>hi</p>"); html != want {
+		t.Errorf("got %q, want %q", html, want)
+	}
+	if want := (&MarkdownRenderOp{Markdown: []byte("hi"), Opt: opt}); !reflect.DeepEqual(c.op, want) {
+		t.Errorf("got op %+v, want %+v", c.op, want)
+	}
+}
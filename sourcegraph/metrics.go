@@ -0,0 +1,177 @@
+package sourcegraph
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// DefaultLatencyBuckets are the default upper bounds (in seconds) of
+// the buckets of the call latency histograms recorded by CallMetrics.
+var DefaultLatencyBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// CallMetrics records the number of API calls, their latencies, and
+// their errors, labeled by method name (e.g., "Repos.Get"). Add it to
+// a client with:
+//
+//	c.UseInterceptor(metrics.Interceptor())
+//
+// CallMetrics is an http.Handler that exposes the metrics in the
+// Prometheus text format, so it may be registered as (or alongside) a
+// Prometheus scrape endpoint.
+type CallMetrics struct {
+	// Buckets are the upper bounds (in seconds) of the latency
+	// histogram buckets. If nil, DefaultLatencyBuckets is used. They
+	// are copied when the first call is observed, so later changes
+	// (to Buckets or DefaultLatencyBuckets) have no effect.
+	Buckets []float64
+
+	mu      sync.Mutex
+	bounds  []float64 // copy of the buckets, made on first use
+	methods map[string]*MethodMetrics
+}
+
+// MethodMetrics are the metrics for calls to a single API method.
+type MethodMetrics struct {
+	Calls  int64 // number of calls
+	Errors int64 // number of calls that returned an error
+
+	// Duration is the total duration of all calls.
+	Duration time.Duration
+
+	// BucketCounts[i] is the number of calls that took at most
+	// Buckets[i] seconds (cumulative, as in a Prometheus histogram).
+	BucketCounts []int64
+}
+
+// Interceptor returns an Interceptor that records metrics for each
+// call in m.
+func (m *CallMetrics) Interceptor() Interceptor {
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+			start := time.Now()
+			result, err := next(ctx, method, in)
+			m.Observe(method, time.Since(start), err)
+			return result, err
+		}
+	}
+}
+
+// buckets returns the latency histogram buckets, copying them on
+// first use. The caller must hold m.mu.
+func (m *CallMetrics) buckets() []float64 {
+	if m.bounds == nil {
+		b := m.Buckets
+		if b == nil {
+			b = DefaultLatencyBuckets
+		}
+		m.bounds = append([]float64{}, b...)
+	}
+	return m.bounds
+}
+
+// Observe records a call to method that took d and returned err.
+func (m *CallMetrics) Observe(method string, d time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.methods == nil {
+		m.methods = map[string]*MethodMetrics{}
+	}
+	mm, ok := m.methods[method]
+	if !ok {
+		mm = &MethodMetrics{BucketCounts: make([]int64, len(m.buckets()))}
+		m.methods[method] = mm
+	}
+	mm.Calls++
+	if err != nil {
+		mm.Errors++
+	}
+	mm.Duration += d
+	for i, le := range m.buckets() {
+		if d.Seconds() <= le {
+			mm.BucketCounts[i]++
+		}
+	}
+}
+
+// Snapshot returns a copy of the current metrics, keyed by method
+// name.
+func (m *CallMetrics) Snapshot() map[string]MethodMetrics {
+	s, _ := m.snapshot()
+	return s
+}
+
+// snapshot returns a copy of the current metrics and the buckets
+// that their BucketCounts correspond to.
+func (m *CallMetrics) snapshot() (map[string]MethodMetrics, []float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := make(map[string]MethodMetrics, len(m.methods))
+	for method, mm := range m.methods {
+		cpy := *mm
+		cpy.BucketCounts = append([]int64(nil), mm.BucketCounts...)
+		s[method] = cpy
+	}
+	return s, m.buckets()
+}
+
+// WritePrometheus writes the metrics to w in the Prometheus text
+// exposition format (version 0.0.4).
+func (m *CallMetrics) WritePrometheus(w io.Writer) error {
+	snap, buckets := m.snapshot()
+	methods := make([]string, 0, len(snap))
+	for method := range snap {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+
+	ew := &errWriter{w: w}
+	ew.printf("# HELP sourcegraph_client_requests_total Number of Sourcegraph API calls.\n")
+	ew.printf("# TYPE sourcegraph_client_requests_total counter\n")
+	for _, method := range methods {
+		ew.printf("sourcegraph_client_requests_total{method=%q} %d\n", method, snap[method].Calls)
+	}
+	ew.printf("# HELP sourcegraph_client_request_errors_total Number of Sourcegraph API calls that returned an error.\n")
+	ew.printf("# TYPE sourcegraph_client_request_errors_total counter\n")
+	for _, method := range methods {
+		ew.printf("sourcegraph_client_request_errors_total{method=%q} %d\n", method, snap[method].Errors)
+	}
+	ew.printf("# HELP sourcegraph_client_request_duration_seconds Latency of Sourcegraph API calls.\n")
+	ew.printf("# TYPE sourcegraph_client_request_duration_seconds histogram\n")
+	for _, method := range methods {
+		mm := snap[method]
+		for i, le := range buckets {
+			ew.printf("sourcegraph_client_request_duration_seconds_bucket{method=%q,le=%q} %d\n", method, strconv.FormatFloat(le, 'g', -1, 64), mm.BucketCounts[i])
+		}
+		ew.printf("sourcegraph_client_request_duration_seconds_bucket{method=%q,le=\"+Inf\"} %d\n", method, mm.Calls)
+		ew.printf("sourcegraph_client_request_duration_seconds_sum{method=%q} %g\n", method, mm.Duration.Seconds())
+		ew.printf("sourcegraph_client_request_duration_seconds_count{method=%q} %d\n", method, mm.Calls)
+	}
+	return ew.err
+}
+
+// ServeHTTP implements http.Handler by writing the metrics in the
+// Prometheus text exposition format.
+func (m *CallMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	m.WritePrometheus(w)
+}
+
+// errWriter is an io.Writer wrapper that records the first write
+// error and skips all subsequent writes.
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (w *errWriter) printf(format string, args ...interface{}) {
+	if w.err == nil {
+		_, w.err = fmt.Fprintf(w.w, format, args...)
+	}
+}
@@ -0,0 +1,75 @@
+package sourcegraph
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+func TestCallMetrics(t *testing.T) {
+	m := &CallMetrics{Buckets: []float64{0.1, 1}}
+	m.Observe("Repos.Get", 50*time.Millisecond, nil)
+	m.Observe("Repos.Get", 500*time.Millisecond, errors.New("x"))
+	m.Observe("Repos.List", 2*time.Second, nil)
+
+	snap := m.Snapshot()
+	get := snap["Repos.Get"]
+	if get.Calls != 2 || get.Errors != 1 || get.Duration != 550*time.Millisecond {
+		t.Errorf("got Repos.Get metrics %+v, want 2 calls, 1 error, 550ms", get)
+	}
+	if got, want := get.BucketCounts, []int64{1, 2}; got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("got Repos.Get buckets %v, want %v", got, want)
+	}
+
+	var buf bytes.Buffer
+	if err := m.WritePrometheus(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`sourcegraph_client_requests_total{method="Repos.Get"} 2`,
+		`sourcegraph_client_request_errors_total{method="Repos.Get"} 1`,
+		`sourcegraph_client_request_duration_seconds_bucket{method="Repos.List",le="1"} 0`,
+		`sourcegraph_client_request_duration_seconds_bucket{method="Repos.List",le="+Inf"} 1`,
+		`sourcegraph_client_request_duration_seconds_count{method="Repos.List"} 1`,
+	} {
+		if !strings.Contains(buf.String(), want+"\n") {
+			t.Errorf("output does not contain %q:\n%s", want, buf.String())
+		}
+	}
+}
+
+func TestCallMetrics_Interceptor(t *testing.T) {
+	m := &CallMetrics{}
+	invoke := m.Interceptor()(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	invoke(context.Background(), "Builds.Get", nil)
+	if got := m.Snapshot()["Builds.Get"].Calls; got != 1 {
+		t.Errorf("got %d calls, want 1", got)
+	}
+}
+
+func TestCallMetrics_bucketsChanged(t *testing.T) {
+	m := &CallMetrics{Buckets: []float64{0.1, 1}}
+	m.Observe("Repos.Get", 50*time.Millisecond, nil)
+
+	// Changes to the buckets after the first observation are ignored.
+	m.Buckets = append(m.Buckets, 10)
+	m.Observe("Repos.Get", 5*time.Second, nil)
+	m.Observe("Repos.List", 5*time.Second, nil)
+
+	if got := m.Snapshot()["Repos.List"].BucketCounts; len(got) != 2 {
+		t.Errorf("got %d buckets, want 2", len(got))
+	}
+	var buf bytes.Buffer
+	if err := m.WritePrometheus(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), `le="10"`) {
+		t.Errorf("output contains bucket added after first observation:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,83 @@
+package mock
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"golang.org/x/net/context"
+	"sourcegraph.com/sourcegraph/go-sourcegraph/sourcegraph"
+)
+
+// Call is a call to an API method recorded by a Recorder.
+type Call struct {
+	Method string      // the method name (e.g., "Repos.Get")
+	Arg    interface{} // the method's argument (e.g., *sourcegraph.RepoSpec)
+}
+
+// A Recorder records the API calls made using a client, for
+// assertions in tests. Because it intercepts calls to every service
+// (using Client.UseInterceptor), it stays in sync with the service
+// interfaces without any per-method code.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// RecordCalls returns a Recorder that records all calls made using c
+// (whose services are typically the mocks in this package).
+func RecordCalls(c *sourcegraph.Client) *Recorder {
+	r := &Recorder{}
+	c.UseInterceptor(func(next sourcegraph.Invoker) sourcegraph.Invoker {
+		return func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+			r.mu.Lock()
+			r.calls = append(r.calls, Call{Method: method, Arg: in})
+			r.mu.Unlock()
+			return next(ctx, method, in)
+		}
+	})
+	return r
+}
+
+// Calls returns the recorded calls, in the order they were made.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the recorded calls to method.
+func (r *Recorder) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range r.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// AssertCalled reports a test error unless method was called with an
+// argument equal (by reflect.DeepEqual) to wantArg.
+func (r *Recorder) AssertCalled(t *testing.T, method string, wantArg interface{}) {
+	calls := r.CallsTo(method)
+	for _, c := range calls {
+		if reflect.DeepEqual(c.Arg, wantArg) {
+			return
+		}
+	}
+	if len(calls) == 0 {
+		t.Errorf("%s was not called, want call with %+v", method, wantArg)
+		return
+	}
+	for _, c := range calls {
+		t.Errorf("%s was called with %+v, want %+v", method, c.Arg, wantArg)
+	}
+}
+
+// AssertNotCalled reports a test error if method was called.
+func (r *Recorder) AssertNotCalled(t *testing.T, method string) {
+	if calls := r.CallsTo(method); len(calls) > 0 {
+		t.Errorf("%s was called %d times, want 0", method, len(calls))
+	}
+}
@@ -0,0 +1,28 @@
+package mock
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+	"sourcegraph.com/sourcegraph/go-sourcegraph/sourcegraph"
+)
+
+func TestRecorder(t *testing.T) {
+	repos := &ReposClient{
+		Get_: func(ctx context.Context, repo *sourcegraph.RepoSpec) (*sourcegraph.Repo, error) {
+			return &sourcegraph.Repo{URI: repo.URI}, nil
+		},
+	}
+	c := &sourcegraph.Client{Repos: repos}
+	r := RecordCalls(c)
+
+	if _, err := c.Repos.Get(context.Background(), &sourcegraph.RepoSpec{URI: "r"}); err != nil {
+		t.Fatal(err)
+	}
+
+	r.AssertCalled(t, "Repos.Get", &sourcegraph.RepoSpec{URI: "r"})
+	r.AssertNotCalled(t, "Repos.List")
+	if calls := r.Calls(); len(calls) != 1 {
+		t.Errorf("got %d calls, want 1", len(calls))
+	}
+}
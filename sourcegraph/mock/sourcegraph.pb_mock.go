@@ -12,10 +12,14 @@ import (
 )
 
 type RepoBadgesClient struct {
-	ListBadges_   func(ctx context.Context, in *sourcegraph.RepoSpec) (*sourcegraph.BadgeList, error)
-	ListCounters_ func(ctx context.Context, in *sourcegraph.RepoSpec) (*sourcegraph.CounterList, error)
-	RecordHit_    func(ctx context.Context, in *sourcegraph.RepoSpec) (*pbtypes.Void, error)
-	CountHits_    func(ctx context.Context, in *sourcegraph.RepoBadgesCountHitsOp) (*sourcegraph.RepoBadgesCountHitsResult, error)
+	ListBadges_    func(ctx context.Context, in *sourcegraph.RepoSpec) (*sourcegraph.BadgeList, error)
+	ListCounters_  func(ctx context.Context, in *sourcegraph.RepoSpec) (*sourcegraph.CounterList, error)
+	RecordHit_     func(ctx context.Context, in *sourcegraph.RepoSpec) (*pbtypes.Void, error)
+	CountHits_     func(ctx context.Context, in *sourcegraph.RepoBadgesCountHitsOp) (*sourcegraph.RepoBadgesCountHitsResult, error)
+	CreateBadge_   func(ctx context.Context, in *sourcegraph.RepoBadgesCreateOp) (*sourcegraph.Badge, error)
+	DeleteBadge_   func(ctx context.Context, in *sourcegraph.RepoBadgesDeleteOp) (*pbtypes.Void, error)
+	CreateCounter_ func(ctx context.Context, in *sourcegraph.RepoBadgesCreateOp) (*sourcegraph.Counter, error)
+	DeleteCounter_ func(ctx context.Context, in *sourcegraph.RepoBadgesDeleteOp) (*pbtypes.Void, error)
 }
 
 func (s *RepoBadgesClient) ListBadges(ctx context.Context, in *sourcegraph.RepoSpec, opts ...grpc.CallOption) (*sourcegraph.BadgeList, error) {
@@ -34,13 +38,33 @@ func (s *RepoBadgesClient) CountHits(ctx context.Context, in *sourcegraph.RepoBa
 	return s.CountHits_(ctx, in)
 }
 
+func (s *RepoBadgesClient) CreateBadge(ctx context.Context, in *sourcegraph.RepoBadgesCreateOp, opts ...grpc.CallOption) (*sourcegraph.Badge, error) {
+	return s.CreateBadge_(ctx, in)
+}
+
+func (s *RepoBadgesClient) DeleteBadge(ctx context.Context, in *sourcegraph.RepoBadgesDeleteOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	return s.DeleteBadge_(ctx, in)
+}
+
+func (s *RepoBadgesClient) CreateCounter(ctx context.Context, in *sourcegraph.RepoBadgesCreateOp, opts ...grpc.CallOption) (*sourcegraph.Counter, error) {
+	return s.CreateCounter_(ctx, in)
+}
+
+func (s *RepoBadgesClient) DeleteCounter(ctx context.Context, in *sourcegraph.RepoBadgesDeleteOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	return s.DeleteCounter_(ctx, in)
+}
+
 var _ sourcegraph.RepoBadgesClient = (*RepoBadgesClient)(nil)
 
 type RepoBadgesServer struct {
-	ListBadges_   func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*sourcegraph.BadgeList, error)
-	ListCounters_ func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*sourcegraph.CounterList, error)
-	RecordHit_    func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*pbtypes.Void, error)
-	CountHits_    func(v0 context.Context, v1 *sourcegraph.RepoBadgesCountHitsOp) (*sourcegraph.RepoBadgesCountHitsResult, error)
+	ListBadges_    func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*sourcegraph.BadgeList, error)
+	ListCounters_  func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*sourcegraph.CounterList, error)
+	RecordHit_     func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*pbtypes.Void, error)
+	CountHits_     func(v0 context.Context, v1 *sourcegraph.RepoBadgesCountHitsOp) (*sourcegraph.RepoBadgesCountHitsResult, error)
+	CreateBadge_   func(v0 context.Context, v1 *sourcegraph.RepoBadgesCreateOp) (*sourcegraph.Badge, error)
+	DeleteBadge_   func(v0 context.Context, v1 *sourcegraph.RepoBadgesDeleteOp) (*pbtypes.Void, error)
+	CreateCounter_ func(v0 context.Context, v1 *sourcegraph.RepoBadgesCreateOp) (*sourcegraph.Counter, error)
+	DeleteCounter_ func(v0 context.Context, v1 *sourcegraph.RepoBadgesDeleteOp) (*pbtypes.Void, error)
 }
 
 func (s *RepoBadgesServer) ListBadges(v0 context.Context, v1 *sourcegraph.RepoSpec) (*sourcegraph.BadgeList, error) {
@@ -59,11 +83,29 @@ func (s *RepoBadgesServer) CountHits(v0 context.Context, v1 *sourcegraph.RepoBad
 	return s.CountHits_(v0, v1)
 }
 
+func (s *RepoBadgesServer) CreateBadge(v0 context.Context, v1 *sourcegraph.RepoBadgesCreateOp) (*sourcegraph.Badge, error) {
+	return s.CreateBadge_(v0, v1)
+}
+
+func (s *RepoBadgesServer) DeleteBadge(v0 context.Context, v1 *sourcegraph.RepoBadgesDeleteOp) (*pbtypes.Void, error) {
+	return s.DeleteBadge_(v0, v1)
+}
+
+func (s *RepoBadgesServer) CreateCounter(v0 context.Context, v1 *sourcegraph.RepoBadgesCreateOp) (*sourcegraph.Counter, error) {
+	return s.CreateCounter_(v0, v1)
+}
+
+func (s *RepoBadgesServer) DeleteCounter(v0 context.Context, v1 *sourcegraph.RepoBadgesDeleteOp) (*pbtypes.Void, error) {
+	return s.DeleteCounter_(v0, v1)
+}
+
 var _ sourcegraph.RepoBadgesServer = (*RepoBadgesServer)(nil)
 
 type RepoStatusesClient struct {
 	GetCombined_ func(ctx context.Context, in *sourcegraph.RepoRevSpec) (*sourcegraph.CombinedStatus, error)
 	Create_      func(ctx context.Context, in *sourcegraph.RepoStatusesCreateOp) (*sourcegraph.RepoStatus, error)
+	GetRollup_   func(ctx context.Context, in *sourcegraph.RepoStatusesGetRollupOp) (*sourcegraph.CombinedStatus, error)
+	List_        func(ctx context.Context, in *sourcegraph.RepoStatusesListOp) (*sourcegraph.RepoStatusList, error)
 }
 
 func (s *RepoStatusesClient) GetCombined(ctx context.Context, in *sourcegraph.RepoRevSpec, opts ...grpc.CallOption) (*sourcegraph.CombinedStatus, error) {
@@ -74,11 +116,21 @@ func (s *RepoStatusesClient) Create(ctx context.Context, in *sourcegraph.RepoSta
 	return s.Create_(ctx, in)
 }
 
+func (s *RepoStatusesClient) GetRollup(ctx context.Context, in *sourcegraph.RepoStatusesGetRollupOp, opts ...grpc.CallOption) (*sourcegraph.CombinedStatus, error) {
+	return s.GetRollup_(ctx, in)
+}
+
+func (s *RepoStatusesClient) List(ctx context.Context, in *sourcegraph.RepoStatusesListOp, opts ...grpc.CallOption) (*sourcegraph.RepoStatusList, error) {
+	return s.List_(ctx, in)
+}
+
 var _ sourcegraph.RepoStatusesClient = (*RepoStatusesClient)(nil)
 
 type RepoStatusesServer struct {
 	GetCombined_ func(v0 context.Context, v1 *sourcegraph.RepoRevSpec) (*sourcegraph.CombinedStatus, error)
 	Create_      func(v0 context.Context, v1 *sourcegraph.RepoStatusesCreateOp) (*sourcegraph.RepoStatus, error)
+	GetRollup_   func(v0 context.Context, v1 *sourcegraph.RepoStatusesGetRollupOp) (*sourcegraph.CombinedStatus, error)
+	List_        func(v0 context.Context, v1 *sourcegraph.RepoStatusesListOp) (*sourcegraph.RepoStatusList, error)
 }
 
 func (s *RepoStatusesServer) GetCombined(v0 context.Context, v1 *sourcegraph.RepoRevSpec) (*sourcegraph.CombinedStatus, error) {
@@ -89,23 +141,50 @@ func (s *RepoStatusesServer) Create(v0 context.Context, v1 *sourcegraph.RepoStat
 	return s.Create_(v0, v1)
 }
 
+func (s *RepoStatusesServer) GetRollup(v0 context.Context, v1 *sourcegraph.RepoStatusesGetRollupOp) (*sourcegraph.CombinedStatus, error) {
+	return s.GetRollup_(v0, v1)
+}
+
+func (s *RepoStatusesServer) List(v0 context.Context, v1 *sourcegraph.RepoStatusesListOp) (*sourcegraph.RepoStatusList, error) {
+	return s.List_(v0, v1)
+}
+
 var _ sourcegraph.RepoStatusesServer = (*RepoStatusesServer)(nil)
 
 type ReposClient struct {
-	Get_            func(ctx context.Context, in *sourcegraph.RepoSpec) (*sourcegraph.Repo, error)
-	List_           func(ctx context.Context, in *sourcegraph.RepoListOptions) (*sourcegraph.RepoList, error)
-	Create_         func(ctx context.Context, in *sourcegraph.ReposCreateOp) (*sourcegraph.Repo, error)
-	Update_         func(ctx context.Context, in *sourcegraph.ReposUpdateOp) (*sourcegraph.Repo, error)
-	Delete_         func(ctx context.Context, in *sourcegraph.RepoSpec) (*pbtypes.Void, error)
-	GetReadme_      func(ctx context.Context, in *sourcegraph.RepoRevSpec) (*sourcegraph.Readme, error)
-	Enable_         func(ctx context.Context, in *sourcegraph.RepoSpec) (*pbtypes.Void, error)
-	Disable_        func(ctx context.Context, in *sourcegraph.RepoSpec) (*pbtypes.Void, error)
-	GetConfig_      func(ctx context.Context, in *sourcegraph.RepoSpec) (*sourcegraph.RepoConfig, error)
-	GetCommit_      func(ctx context.Context, in *sourcegraph.RepoRevSpec) (*vcs.Commit, error)
-	ListCommits_    func(ctx context.Context, in *sourcegraph.ReposListCommitsOp) (*sourcegraph.CommitList, error)
-	ListBranches_   func(ctx context.Context, in *sourcegraph.ReposListBranchesOp) (*sourcegraph.BranchList, error)
-	ListTags_       func(ctx context.Context, in *sourcegraph.ReposListTagsOp) (*sourcegraph.TagList, error)
-	ListCommitters_ func(ctx context.Context, in *sourcegraph.ReposListCommittersOp) (*sourcegraph.CommitterList, error)
+	Get_                func(ctx context.Context, in *sourcegraph.RepoSpec) (*sourcegraph.Repo, error)
+	List_               func(ctx context.Context, in *sourcegraph.RepoListOptions) (*sourcegraph.RepoList, error)
+	Create_             func(ctx context.Context, in *sourcegraph.ReposCreateOp) (*sourcegraph.Repo, error)
+	Update_             func(ctx context.Context, in *sourcegraph.ReposUpdateOp) (*sourcegraph.Repo, error)
+	Delete_             func(ctx context.Context, in *sourcegraph.RepoSpec) (*pbtypes.Void, error)
+	GetReadme_          func(ctx context.Context, in *sourcegraph.ReposGetReadmeOp) (*sourcegraph.Readme, error)
+	GetInventory_       func(ctx context.Context, in *sourcegraph.RepoRevSpec) (*sourcegraph.Inventory, error)
+	GetStatsHistory_    func(ctx context.Context, in *sourcegraph.ReposGetStatsHistoryOp) (*sourcegraph.RepoStatsHistory, error)
+	Enable_             func(ctx context.Context, in *sourcegraph.RepoSpec) (*pbtypes.Void, error)
+	Disable_            func(ctx context.Context, in *sourcegraph.RepoSpec) (*pbtypes.Void, error)
+	GetConfig_          func(ctx context.Context, in *sourcegraph.RepoSpec) (*sourcegraph.RepoConfig, error)
+	ListCollaborators_  func(ctx context.Context, in *sourcegraph.ReposListCollaboratorsOp) (*sourcegraph.CollaboratorList, error)
+	AddCollaborator_    func(ctx context.Context, in *sourcegraph.ReposAddCollaboratorOp) (*pbtypes.Void, error)
+	RemoveCollaborator_ func(ctx context.Context, in *sourcegraph.ReposRemoveCollaboratorOp) (*pbtypes.Void, error)
+	GetPermissions_     func(ctx context.Context, in *sourcegraph.ReposGetPermissionsOp) (*sourcegraph.RepoPermissions, error)
+	ListKeys_           func(ctx context.Context, in *sourcegraph.ReposListKeysOp) (*sourcegraph.DeployKeyList, error)
+	AddKey_             func(ctx context.Context, in *sourcegraph.ReposAddKeyOp) (*sourcegraph.DeployKey, error)
+	DeleteKey_          func(ctx context.Context, in *sourcegraph.ReposDeleteKeyOp) (*pbtypes.Void, error)
+	Watch_              func(ctx context.Context, in *sourcegraph.ReposWatchOp) (*sourcegraph.RepoSubscription, error)
+	Unwatch_            func(ctx context.Context, in *sourcegraph.RepoSpec) (*pbtypes.Void, error)
+	GetSubscription_    func(ctx context.Context, in *sourcegraph.RepoSpec) (*sourcegraph.RepoSubscription, error)
+	ListWatched_        func(ctx context.Context, in *sourcegraph.ReposListWatchedOp) (*sourcegraph.RepoList, error)
+	GetCommit_          func(ctx context.Context, in *sourcegraph.RepoRevSpec) (*vcs.Commit, error)
+	GetCommitDetail_    func(ctx context.Context, in *sourcegraph.ReposGetCommitOp) (*sourcegraph.CommitDetail, error)
+	GetCommitPatch_     func(ctx context.Context, in *sourcegraph.ReposGetCommitPatchOp) (*sourcegraph.CommitPatch, error)
+	GetArchive_         func(ctx context.Context, in *sourcegraph.ReposGetArchiveOp) (*sourcegraph.RepoArchive, error)
+	ListCommits_        func(ctx context.Context, in *sourcegraph.ReposListCommitsOp) (*sourcegraph.CommitList, error)
+	ListBranches_       func(ctx context.Context, in *sourcegraph.ReposListBranchesOp) (*sourcegraph.BranchList, error)
+	ListTags_           func(ctx context.Context, in *sourcegraph.ReposListTagsOp) (*sourcegraph.TagList, error)
+	ListCommitters_     func(ctx context.Context, in *sourcegraph.ReposListCommittersOp) (*sourcegraph.CommitterList, error)
+	ListContributors_   func(ctx context.Context, in *sourcegraph.ReposListContributorsOp) (*sourcegraph.ContributorList, error)
+	SearchCode_         func(ctx context.Context, in *sourcegraph.ReposSearchCodeOp) (*sourcegraph.CodeSearchResultList, error)
+	GetCodeOwners_      func(ctx context.Context, in *sourcegraph.ReposGetCodeOwnersOp) (*sourcegraph.CodeOwners, error)
 }
 
 func (s *ReposClient) Get(ctx context.Context, in *sourcegraph.RepoSpec, opts ...grpc.CallOption) (*sourcegraph.Repo, error) {
@@ -128,10 +207,18 @@ func (s *ReposClient) Delete(ctx context.Context, in *sourcegraph.RepoSpec, opts
 	return s.Delete_(ctx, in)
 }
 
-func (s *ReposClient) GetReadme(ctx context.Context, in *sourcegraph.RepoRevSpec, opts ...grpc.CallOption) (*sourcegraph.Readme, error) {
+func (s *ReposClient) GetReadme(ctx context.Context, in *sourcegraph.ReposGetReadmeOp, opts ...grpc.CallOption) (*sourcegraph.Readme, error) {
 	return s.GetReadme_(ctx, in)
 }
 
+func (s *ReposClient) GetInventory(ctx context.Context, in *sourcegraph.RepoRevSpec, opts ...grpc.CallOption) (*sourcegraph.Inventory, error) {
+	return s.GetInventory_(ctx, in)
+}
+
+func (s *ReposClient) GetStatsHistory(ctx context.Context, in *sourcegraph.ReposGetStatsHistoryOp, opts ...grpc.CallOption) (*sourcegraph.RepoStatsHistory, error) {
+	return s.GetStatsHistory_(ctx, in)
+}
+
 func (s *ReposClient) Enable(ctx context.Context, in *sourcegraph.RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
 	return s.Enable_(ctx, in)
 }
@@ -144,10 +231,66 @@ func (s *ReposClient) GetConfig(ctx context.Context, in *sourcegraph.RepoSpec, o
 	return s.GetConfig_(ctx, in)
 }
 
+func (s *ReposClient) ListCollaborators(ctx context.Context, in *sourcegraph.ReposListCollaboratorsOp, opts ...grpc.CallOption) (*sourcegraph.CollaboratorList, error) {
+	return s.ListCollaborators_(ctx, in)
+}
+
+func (s *ReposClient) AddCollaborator(ctx context.Context, in *sourcegraph.ReposAddCollaboratorOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	return s.AddCollaborator_(ctx, in)
+}
+
+func (s *ReposClient) RemoveCollaborator(ctx context.Context, in *sourcegraph.ReposRemoveCollaboratorOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	return s.RemoveCollaborator_(ctx, in)
+}
+
+func (s *ReposClient) GetPermissions(ctx context.Context, in *sourcegraph.ReposGetPermissionsOp, opts ...grpc.CallOption) (*sourcegraph.RepoPermissions, error) {
+	return s.GetPermissions_(ctx, in)
+}
+
+func (s *ReposClient) ListKeys(ctx context.Context, in *sourcegraph.ReposListKeysOp, opts ...grpc.CallOption) (*sourcegraph.DeployKeyList, error) {
+	return s.ListKeys_(ctx, in)
+}
+
+func (s *ReposClient) AddKey(ctx context.Context, in *sourcegraph.ReposAddKeyOp, opts ...grpc.CallOption) (*sourcegraph.DeployKey, error) {
+	return s.AddKey_(ctx, in)
+}
+
+func (s *ReposClient) DeleteKey(ctx context.Context, in *sourcegraph.ReposDeleteKeyOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	return s.DeleteKey_(ctx, in)
+}
+
+func (s *ReposClient) Watch(ctx context.Context, in *sourcegraph.ReposWatchOp, opts ...grpc.CallOption) (*sourcegraph.RepoSubscription, error) {
+	return s.Watch_(ctx, in)
+}
+
+func (s *ReposClient) Unwatch(ctx context.Context, in *sourcegraph.RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	return s.Unwatch_(ctx, in)
+}
+
+func (s *ReposClient) GetSubscription(ctx context.Context, in *sourcegraph.RepoSpec, opts ...grpc.CallOption) (*sourcegraph.RepoSubscription, error) {
+	return s.GetSubscription_(ctx, in)
+}
+
+func (s *ReposClient) ListWatched(ctx context.Context, in *sourcegraph.ReposListWatchedOp, opts ...grpc.CallOption) (*sourcegraph.RepoList, error) {
+	return s.ListWatched_(ctx, in)
+}
+
 func (s *ReposClient) GetCommit(ctx context.Context, in *sourcegraph.RepoRevSpec, opts ...grpc.CallOption) (*vcs.Commit, error) {
 	return s.GetCommit_(ctx, in)
 }
 
+func (s *ReposClient) GetCommitDetail(ctx context.Context, in *sourcegraph.ReposGetCommitOp, opts ...grpc.CallOption) (*sourcegraph.CommitDetail, error) {
+	return s.GetCommitDetail_(ctx, in)
+}
+
+func (s *ReposClient) GetCommitPatch(ctx context.Context, in *sourcegraph.ReposGetCommitPatchOp, opts ...grpc.CallOption) (*sourcegraph.CommitPatch, error) {
+	return s.GetCommitPatch_(ctx, in)
+}
+
+func (s *ReposClient) GetArchive(ctx context.Context, in *sourcegraph.ReposGetArchiveOp, opts ...grpc.CallOption) (*sourcegraph.RepoArchive, error) {
+	return s.GetArchive_(ctx, in)
+}
+
 func (s *ReposClient) ListCommits(ctx context.Context, in *sourcegraph.ReposListCommitsOp, opts ...grpc.CallOption) (*sourcegraph.CommitList, error) {
 	return s.ListCommits_(ctx, in)
 }
@@ -164,23 +307,54 @@ func (s *ReposClient) ListCommitters(ctx context.Context, in *sourcegraph.ReposL
 	return s.ListCommitters_(ctx, in)
 }
 
+func (s *ReposClient) ListContributors(ctx context.Context, in *sourcegraph.ReposListContributorsOp, opts ...grpc.CallOption) (*sourcegraph.ContributorList, error) {
+	return s.ListContributors_(ctx, in)
+}
+
+func (s *ReposClient) SearchCode(ctx context.Context, in *sourcegraph.ReposSearchCodeOp, opts ...grpc.CallOption) (*sourcegraph.CodeSearchResultList, error) {
+	return s.SearchCode_(ctx, in)
+}
+
+func (s *ReposClient) GetCodeOwners(ctx context.Context, in *sourcegraph.ReposGetCodeOwnersOp, opts ...grpc.CallOption) (*sourcegraph.CodeOwners, error) {
+	return s.GetCodeOwners_(ctx, in)
+}
+
 var _ sourcegraph.ReposClient = (*ReposClient)(nil)
 
 type ReposServer struct {
-	Get_            func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*sourcegraph.Repo, error)
-	List_           func(v0 context.Context, v1 *sourcegraph.RepoListOptions) (*sourcegraph.RepoList, error)
-	Create_         func(v0 context.Context, v1 *sourcegraph.ReposCreateOp) (*sourcegraph.Repo, error)
-	Update_         func(v0 context.Context, v1 *sourcegraph.ReposUpdateOp) (*sourcegraph.Repo, error)
-	Delete_         func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*pbtypes.Void, error)
-	GetReadme_      func(v0 context.Context, v1 *sourcegraph.RepoRevSpec) (*sourcegraph.Readme, error)
-	Enable_         func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*pbtypes.Void, error)
-	Disable_        func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*pbtypes.Void, error)
-	GetConfig_      func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*sourcegraph.RepoConfig, error)
-	GetCommit_      func(v0 context.Context, v1 *sourcegraph.RepoRevSpec) (*vcs.Commit, error)
-	ListCommits_    func(v0 context.Context, v1 *sourcegraph.ReposListCommitsOp) (*sourcegraph.CommitList, error)
-	ListBranches_   func(v0 context.Context, v1 *sourcegraph.ReposListBranchesOp) (*sourcegraph.BranchList, error)
-	ListTags_       func(v0 context.Context, v1 *sourcegraph.ReposListTagsOp) (*sourcegraph.TagList, error)
-	ListCommitters_ func(v0 context.Context, v1 *sourcegraph.ReposListCommittersOp) (*sourcegraph.CommitterList, error)
+	Get_                func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*sourcegraph.Repo, error)
+	List_               func(v0 context.Context, v1 *sourcegraph.RepoListOptions) (*sourcegraph.RepoList, error)
+	Create_             func(v0 context.Context, v1 *sourcegraph.ReposCreateOp) (*sourcegraph.Repo, error)
+	Update_             func(v0 context.Context, v1 *sourcegraph.ReposUpdateOp) (*sourcegraph.Repo, error)
+	Delete_             func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*pbtypes.Void, error)
+	GetReadme_          func(v0 context.Context, v1 *sourcegraph.ReposGetReadmeOp) (*sourcegraph.Readme, error)
+	GetInventory_       func(v0 context.Context, v1 *sourcegraph.RepoRevSpec) (*sourcegraph.Inventory, error)
+	GetStatsHistory_    func(v0 context.Context, v1 *sourcegraph.ReposGetStatsHistoryOp) (*sourcegraph.RepoStatsHistory, error)
+	Enable_             func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*pbtypes.Void, error)
+	Disable_            func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*pbtypes.Void, error)
+	GetConfig_          func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*sourcegraph.RepoConfig, error)
+	ListCollaborators_  func(v0 context.Context, v1 *sourcegraph.ReposListCollaboratorsOp) (*sourcegraph.CollaboratorList, error)
+	AddCollaborator_    func(v0 context.Context, v1 *sourcegraph.ReposAddCollaboratorOp) (*pbtypes.Void, error)
+	RemoveCollaborator_ func(v0 context.Context, v1 *sourcegraph.ReposRemoveCollaboratorOp) (*pbtypes.Void, error)
+	GetPermissions_     func(v0 context.Context, v1 *sourcegraph.ReposGetPermissionsOp) (*sourcegraph.RepoPermissions, error)
+	ListKeys_           func(v0 context.Context, v1 *sourcegraph.ReposListKeysOp) (*sourcegraph.DeployKeyList, error)
+	AddKey_             func(v0 context.Context, v1 *sourcegraph.ReposAddKeyOp) (*sourcegraph.DeployKey, error)
+	DeleteKey_          func(v0 context.Context, v1 *sourcegraph.ReposDeleteKeyOp) (*pbtypes.Void, error)
+	Watch_              func(v0 context.Context, v1 *sourcegraph.ReposWatchOp) (*sourcegraph.RepoSubscription, error)
+	Unwatch_            func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*pbtypes.Void, error)
+	GetSubscription_    func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*sourcegraph.RepoSubscription, error)
+	ListWatched_        func(v0 context.Context, v1 *sourcegraph.ReposListWatchedOp) (*sourcegraph.RepoList, error)
+	GetCommit_          func(v0 context.Context, v1 *sourcegraph.RepoRevSpec) (*vcs.Commit, error)
+	GetCommitDetail_    func(v0 context.Context, v1 *sourcegraph.ReposGetCommitOp) (*sourcegraph.CommitDetail, error)
+	GetCommitPatch_     func(v0 context.Context, v1 *sourcegraph.ReposGetCommitPatchOp) (*sourcegraph.CommitPatch, error)
+	GetArchive_         func(v0 context.Context, v1 *sourcegraph.ReposGetArchiveOp) (*sourcegraph.RepoArchive, error)
+	ListCommits_        func(v0 context.Context, v1 *sourcegraph.ReposListCommitsOp) (*sourcegraph.CommitList, error)
+	ListBranches_       func(v0 context.Context, v1 *sourcegraph.ReposListBranchesOp) (*sourcegraph.BranchList, error)
+	ListTags_           func(v0 context.Context, v1 *sourcegraph.ReposListTagsOp) (*sourcegraph.TagList, error)
+	ListCommitters_     func(v0 context.Context, v1 *sourcegraph.ReposListCommittersOp) (*sourcegraph.CommitterList, error)
+	ListContributors_   func(v0 context.Context, v1 *sourcegraph.ReposListContributorsOp) (*sourcegraph.ContributorList, error)
+	SearchCode_         func(v0 context.Context, v1 *sourcegraph.ReposSearchCodeOp) (*sourcegraph.CodeSearchResultList, error)
+	GetCodeOwners_      func(v0 context.Context, v1 *sourcegraph.ReposGetCodeOwnersOp) (*sourcegraph.CodeOwners, error)
 }
 
 func (s *ReposServer) Get(v0 context.Context, v1 *sourcegraph.RepoSpec) (*sourcegraph.Repo, error) {
@@ -203,10 +377,18 @@ func (s *ReposServer) Delete(v0 context.Context, v1 *sourcegraph.RepoSpec) (*pbt
 	return s.Delete_(v0, v1)
 }
 
-func (s *ReposServer) GetReadme(v0 context.Context, v1 *sourcegraph.RepoRevSpec) (*sourcegraph.Readme, error) {
+func (s *ReposServer) GetReadme(v0 context.Context, v1 *sourcegraph.ReposGetReadmeOp) (*sourcegraph.Readme, error) {
 	return s.GetReadme_(v0, v1)
 }
 
+func (s *ReposServer) GetInventory(v0 context.Context, v1 *sourcegraph.RepoRevSpec) (*sourcegraph.Inventory, error) {
+	return s.GetInventory_(v0, v1)
+}
+
+func (s *ReposServer) GetStatsHistory(v0 context.Context, v1 *sourcegraph.ReposGetStatsHistoryOp) (*sourcegraph.RepoStatsHistory, error) {
+	return s.GetStatsHistory_(v0, v1)
+}
+
 func (s *ReposServer) Enable(v0 context.Context, v1 *sourcegraph.RepoSpec) (*pbtypes.Void, error) {
 	return s.Enable_(v0, v1)
 }
@@ -219,10 +401,66 @@ func (s *ReposServer) GetConfig(v0 context.Context, v1 *sourcegraph.RepoSpec) (*
 	return s.GetConfig_(v0, v1)
 }
 
+func (s *ReposServer) ListCollaborators(v0 context.Context, v1 *sourcegraph.ReposListCollaboratorsOp) (*sourcegraph.CollaboratorList, error) {
+	return s.ListCollaborators_(v0, v1)
+}
+
+func (s *ReposServer) AddCollaborator(v0 context.Context, v1 *sourcegraph.ReposAddCollaboratorOp) (*pbtypes.Void, error) {
+	return s.AddCollaborator_(v0, v1)
+}
+
+func (s *ReposServer) RemoveCollaborator(v0 context.Context, v1 *sourcegraph.ReposRemoveCollaboratorOp) (*pbtypes.Void, error) {
+	return s.RemoveCollaborator_(v0, v1)
+}
+
+func (s *ReposServer) GetPermissions(v0 context.Context, v1 *sourcegraph.ReposGetPermissionsOp) (*sourcegraph.RepoPermissions, error) {
+	return s.GetPermissions_(v0, v1)
+}
+
+func (s *ReposServer) ListKeys(v0 context.Context, v1 *sourcegraph.ReposListKeysOp) (*sourcegraph.DeployKeyList, error) {
+	return s.ListKeys_(v0, v1)
+}
+
+func (s *ReposServer) AddKey(v0 context.Context, v1 *sourcegraph.ReposAddKeyOp) (*sourcegraph.DeployKey, error) {
+	return s.AddKey_(v0, v1)
+}
+
+func (s *ReposServer) DeleteKey(v0 context.Context, v1 *sourcegraph.ReposDeleteKeyOp) (*pbtypes.Void, error) {
+	return s.DeleteKey_(v0, v1)
+}
+
+func (s *ReposServer) Watch(v0 context.Context, v1 *sourcegraph.ReposWatchOp) (*sourcegraph.RepoSubscription, error) {
+	return s.Watch_(v0, v1)
+}
+
+func (s *ReposServer) Unwatch(v0 context.Context, v1 *sourcegraph.RepoSpec) (*pbtypes.Void, error) {
+	return s.Unwatch_(v0, v1)
+}
+
+func (s *ReposServer) GetSubscription(v0 context.Context, v1 *sourcegraph.RepoSpec) (*sourcegraph.RepoSubscription, error) {
+	return s.GetSubscription_(v0, v1)
+}
+
+func (s *ReposServer) ListWatched(v0 context.Context, v1 *sourcegraph.ReposListWatchedOp) (*sourcegraph.RepoList, error) {
+	return s.ListWatched_(v0, v1)
+}
+
 func (s *ReposServer) GetCommit(v0 context.Context, v1 *sourcegraph.RepoRevSpec) (*vcs.Commit, error) {
 	return s.GetCommit_(v0, v1)
 }
 
+func (s *ReposServer) GetCommitDetail(v0 context.Context, v1 *sourcegraph.ReposGetCommitOp) (*sourcegraph.CommitDetail, error) {
+	return s.GetCommitDetail_(v0, v1)
+}
+
+func (s *ReposServer) GetCommitPatch(v0 context.Context, v1 *sourcegraph.ReposGetCommitPatchOp) (*sourcegraph.CommitPatch, error) {
+	return s.GetCommitPatch_(v0, v1)
+}
+
+func (s *ReposServer) GetArchive(v0 context.Context, v1 *sourcegraph.ReposGetArchiveOp) (*sourcegraph.RepoArchive, error) {
+	return s.GetArchive_(v0, v1)
+}
+
 func (s *ReposServer) ListCommits(v0 context.Context, v1 *sourcegraph.ReposListCommitsOp) (*sourcegraph.CommitList, error) {
 	return s.ListCommits_(v0, v1)
 }
@@ -239,6 +477,18 @@ func (s *ReposServer) ListCommitters(v0 context.Context, v1 *sourcegraph.ReposLi
 	return s.ListCommitters_(v0, v1)
 }
 
+func (s *ReposServer) ListContributors(v0 context.Context, v1 *sourcegraph.ReposListContributorsOp) (*sourcegraph.ContributorList, error) {
+	return s.ListContributors_(v0, v1)
+}
+
+func (s *ReposServer) SearchCode(v0 context.Context, v1 *sourcegraph.ReposSearchCodeOp) (*sourcegraph.CodeSearchResultList, error) {
+	return s.SearchCode_(v0, v1)
+}
+
+func (s *ReposServer) GetCodeOwners(v0 context.Context, v1 *sourcegraph.ReposGetCodeOwnersOp) (*sourcegraph.CodeOwners, error) {
+	return s.GetCodeOwners_(v0, v1)
+}
+
 var _ sourcegraph.ReposServer = (*ReposServer)(nil)
 
 type StorageClient struct {
@@ -481,24 +731,104 @@ func (s *DiscussionsServer) UpdateRating(v0 context.Context, v1 *sourcegraph.Dis
 
 var _ sourcegraph.DiscussionsServer = (*DiscussionsServer)(nil)
 
+type IssuesClient struct {
+	Get_           func(ctx context.Context, in *sourcegraph.IssueSpec) (*sourcegraph.Issue, error)
+	List_          func(ctx context.Context, in *sourcegraph.IssuesListOp) (*sourcegraph.IssueList, error)
+	Create_        func(ctx context.Context, in *sourcegraph.IssuesCreateOp) (*sourcegraph.Issue, error)
+	CreateComment_ func(ctx context.Context, in *sourcegraph.IssuesCreateCommentOp) (*sourcegraph.IssueComment, error)
+}
+
+func (s *IssuesClient) Get(ctx context.Context, in *sourcegraph.IssueSpec, opts ...grpc.CallOption) (*sourcegraph.Issue, error) {
+	return s.Get_(ctx, in)
+}
+
+func (s *IssuesClient) List(ctx context.Context, in *sourcegraph.IssuesListOp, opts ...grpc.CallOption) (*sourcegraph.IssueList, error) {
+	return s.List_(ctx, in)
+}
+
+func (s *IssuesClient) Create(ctx context.Context, in *sourcegraph.IssuesCreateOp, opts ...grpc.CallOption) (*sourcegraph.Issue, error) {
+	return s.Create_(ctx, in)
+}
+
+func (s *IssuesClient) CreateComment(ctx context.Context, in *sourcegraph.IssuesCreateCommentOp, opts ...grpc.CallOption) (*sourcegraph.IssueComment, error) {
+	return s.CreateComment_(ctx, in)
+}
+
+var _ sourcegraph.IssuesClient = (*IssuesClient)(nil)
+
+type IssuesServer struct {
+	Get_           func(v0 context.Context, v1 *sourcegraph.IssueSpec) (*sourcegraph.Issue, error)
+	List_          func(v0 context.Context, v1 *sourcegraph.IssuesListOp) (*sourcegraph.IssueList, error)
+	Create_        func(v0 context.Context, v1 *sourcegraph.IssuesCreateOp) (*sourcegraph.Issue, error)
+	CreateComment_ func(v0 context.Context, v1 *sourcegraph.IssuesCreateCommentOp) (*sourcegraph.IssueComment, error)
+}
+
+func (s *IssuesServer) Get(v0 context.Context, v1 *sourcegraph.IssueSpec) (*sourcegraph.Issue, error) {
+	return s.Get_(v0, v1)
+}
+
+func (s *IssuesServer) List(v0 context.Context, v1 *sourcegraph.IssuesListOp) (*sourcegraph.IssueList, error) {
+	return s.List_(v0, v1)
+}
+
+func (s *IssuesServer) Create(v0 context.Context, v1 *sourcegraph.IssuesCreateOp) (*sourcegraph.Issue, error) {
+	return s.Create_(v0, v1)
+}
+
+func (s *IssuesServer) CreateComment(v0 context.Context, v1 *sourcegraph.IssuesCreateCommentOp) (*sourcegraph.IssueComment, error) {
+	return s.CreateComment_(v0, v1)
+}
+
+var _ sourcegraph.IssuesServer = (*IssuesServer)(nil)
+
 type MirrorReposClient struct {
 	RefreshVCS_ func(ctx context.Context, in *sourcegraph.MirrorReposRefreshVCSOp) (*pbtypes.Void, error)
+	Enable_     func(ctx context.Context, in *sourcegraph.MirrorReposEnableOp) (*pbtypes.Void, error)
+	Disable_    func(ctx context.Context, in *sourcegraph.RepoSpec) (*pbtypes.Void, error)
+	GetStatus_  func(ctx context.Context, in *sourcegraph.RepoSpec) (*sourcegraph.MirrorStatus, error)
 }
 
 func (s *MirrorReposClient) RefreshVCS(ctx context.Context, in *sourcegraph.MirrorReposRefreshVCSOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
 	return s.RefreshVCS_(ctx, in)
 }
 
+func (s *MirrorReposClient) Enable(ctx context.Context, in *sourcegraph.MirrorReposEnableOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	return s.Enable_(ctx, in)
+}
+
+func (s *MirrorReposClient) Disable(ctx context.Context, in *sourcegraph.RepoSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	return s.Disable_(ctx, in)
+}
+
+func (s *MirrorReposClient) GetStatus(ctx context.Context, in *sourcegraph.RepoSpec, opts ...grpc.CallOption) (*sourcegraph.MirrorStatus, error) {
+	return s.GetStatus_(ctx, in)
+}
+
 var _ sourcegraph.MirrorReposClient = (*MirrorReposClient)(nil)
 
 type MirrorReposServer struct {
 	RefreshVCS_ func(v0 context.Context, v1 *sourcegraph.MirrorReposRefreshVCSOp) (*pbtypes.Void, error)
+	Enable_     func(v0 context.Context, v1 *sourcegraph.MirrorReposEnableOp) (*pbtypes.Void, error)
+	Disable_    func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*pbtypes.Void, error)
+	GetStatus_  func(v0 context.Context, v1 *sourcegraph.RepoSpec) (*sourcegraph.MirrorStatus, error)
 }
 
 func (s *MirrorReposServer) RefreshVCS(v0 context.Context, v1 *sourcegraph.MirrorReposRefreshVCSOp) (*pbtypes.Void, error) {
 	return s.RefreshVCS_(v0, v1)
 }
 
+func (s *MirrorReposServer) Enable(v0 context.Context, v1 *sourcegraph.MirrorReposEnableOp) (*pbtypes.Void, error) {
+	return s.Enable_(v0, v1)
+}
+
+func (s *MirrorReposServer) Disable(v0 context.Context, v1 *sourcegraph.RepoSpec) (*pbtypes.Void, error) {
+	return s.Disable_(v0, v1)
+}
+
+func (s *MirrorReposServer) GetStatus(v0 context.Context, v1 *sourcegraph.RepoSpec) (*sourcegraph.MirrorStatus, error) {
+	return s.GetStatus_(v0, v1)
+}
+
 var _ sourcegraph.MirrorReposServer = (*MirrorReposServer)(nil)
 
 type MirroredRepoSSHKeysClient struct {
@@ -542,17 +872,22 @@ func (s *MirroredRepoSSHKeysServer) Delete(v0 context.Context, v1 *sourcegraph.R
 var _ sourcegraph.MirroredRepoSSHKeysServer = (*MirroredRepoSSHKeysServer)(nil)
 
 type BuildsClient struct {
-	Get_              func(ctx context.Context, in *sourcegraph.BuildSpec) (*sourcegraph.Build, error)
-	GetRepoBuildInfo_ func(ctx context.Context, in *sourcegraph.BuildsGetRepoBuildInfoOp) (*sourcegraph.RepoBuildInfo, error)
-	List_             func(ctx context.Context, in *sourcegraph.BuildListOptions) (*sourcegraph.BuildList, error)
-	Create_           func(ctx context.Context, in *sourcegraph.BuildsCreateOp) (*sourcegraph.Build, error)
-	Update_           func(ctx context.Context, in *sourcegraph.BuildsUpdateOp) (*sourcegraph.Build, error)
-	ListBuildTasks_   func(ctx context.Context, in *sourcegraph.BuildsListBuildTasksOp) (*sourcegraph.BuildTaskList, error)
-	CreateTasks_      func(ctx context.Context, in *sourcegraph.BuildsCreateTasksOp) (*sourcegraph.BuildTaskList, error)
-	UpdateTask_       func(ctx context.Context, in *sourcegraph.BuildsUpdateTaskOp) (*sourcegraph.BuildTask, error)
-	GetLog_           func(ctx context.Context, in *sourcegraph.BuildsGetLogOp) (*sourcegraph.LogEntries, error)
-	GetTaskLog_       func(ctx context.Context, in *sourcegraph.BuildsGetTaskLogOp) (*sourcegraph.LogEntries, error)
-	DequeueNext_      func(ctx context.Context, in *sourcegraph.BuildsDequeueNextOp) (*sourcegraph.Build, error)
+	Get_                      func(ctx context.Context, in *sourcegraph.BuildSpec) (*sourcegraph.Build, error)
+	GetRepoBuildInfo_         func(ctx context.Context, in *sourcegraph.BuildsGetRepoBuildInfoOp) (*sourcegraph.RepoBuildInfo, error)
+	GetMultipleRepoBuildInfo_ func(ctx context.Context, in *sourcegraph.BuildsGetMultipleRepoBuildInfoOp) (*sourcegraph.RepoBuildInfoList, error)
+	List_                     func(ctx context.Context, in *sourcegraph.BuildListOptions) (*sourcegraph.BuildList, error)
+	ListByRepo_               func(ctx context.Context, in *sourcegraph.BuildsListByRepoOp) (*sourcegraph.BuildList, error)
+	Create_                   func(ctx context.Context, in *sourcegraph.BuildsCreateOp) (*sourcegraph.Build, error)
+	Update_                   func(ctx context.Context, in *sourcegraph.BuildsUpdateOp) (*sourcegraph.Build, error)
+	ListBuildTasks_           func(ctx context.Context, in *sourcegraph.BuildsListBuildTasksOp) (*sourcegraph.BuildTaskList, error)
+	CreateTasks_              func(ctx context.Context, in *sourcegraph.BuildsCreateTasksOp) (*sourcegraph.BuildTaskList, error)
+	UpdateTask_               func(ctx context.Context, in *sourcegraph.BuildsUpdateTaskOp) (*sourcegraph.BuildTask, error)
+	GetLog_                   func(ctx context.Context, in *sourcegraph.BuildsGetLogOp) (*sourcegraph.LogEntries, error)
+	GetTaskLog_               func(ctx context.Context, in *sourcegraph.BuildsGetTaskLogOp) (*sourcegraph.LogEntries, error)
+	DequeueNext_              func(ctx context.Context, in *sourcegraph.BuildsDequeueNextOp) (*sourcegraph.Build, error)
+	Heartbeat_                func(ctx context.Context, in *sourcegraph.BuildSpec) (*pbtypes.Void, error)
+	Cancel_                   func(ctx context.Context, in *sourcegraph.BuildsCancelOp) (*sourcegraph.Build, error)
+	Restart_                  func(ctx context.Context, in *sourcegraph.BuildsRestartOp) (*sourcegraph.Build, error)
 }
 
 func (s *BuildsClient) Get(ctx context.Context, in *sourcegraph.BuildSpec, opts ...grpc.CallOption) (*sourcegraph.Build, error) {
@@ -563,10 +898,18 @@ func (s *BuildsClient) GetRepoBuildInfo(ctx context.Context, in *sourcegraph.Bui
 	return s.GetRepoBuildInfo_(ctx, in)
 }
 
+func (s *BuildsClient) GetMultipleRepoBuildInfo(ctx context.Context, in *sourcegraph.BuildsGetMultipleRepoBuildInfoOp, opts ...grpc.CallOption) (*sourcegraph.RepoBuildInfoList, error) {
+	return s.GetMultipleRepoBuildInfo_(ctx, in)
+}
+
 func (s *BuildsClient) List(ctx context.Context, in *sourcegraph.BuildListOptions, opts ...grpc.CallOption) (*sourcegraph.BuildList, error) {
 	return s.List_(ctx, in)
 }
 
+func (s *BuildsClient) ListByRepo(ctx context.Context, in *sourcegraph.BuildsListByRepoOp, opts ...grpc.CallOption) (*sourcegraph.BuildList, error) {
+	return s.ListByRepo_(ctx, in)
+}
+
 func (s *BuildsClient) Create(ctx context.Context, in *sourcegraph.BuildsCreateOp, opts ...grpc.CallOption) (*sourcegraph.Build, error) {
 	return s.Create_(ctx, in)
 }
@@ -599,20 +942,37 @@ func (s *BuildsClient) DequeueNext(ctx context.Context, in *sourcegraph.BuildsDe
 	return s.DequeueNext_(ctx, in)
 }
 
+func (s *BuildsClient) Heartbeat(ctx context.Context, in *sourcegraph.BuildSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	return s.Heartbeat_(ctx, in)
+}
+
+func (s *BuildsClient) Cancel(ctx context.Context, in *sourcegraph.BuildsCancelOp, opts ...grpc.CallOption) (*sourcegraph.Build, error) {
+	return s.Cancel_(ctx, in)
+}
+
+func (s *BuildsClient) Restart(ctx context.Context, in *sourcegraph.BuildsRestartOp, opts ...grpc.CallOption) (*sourcegraph.Build, error) {
+	return s.Restart_(ctx, in)
+}
+
 var _ sourcegraph.BuildsClient = (*BuildsClient)(nil)
 
 type BuildsServer struct {
-	Get_              func(v0 context.Context, v1 *sourcegraph.BuildSpec) (*sourcegraph.Build, error)
-	GetRepoBuildInfo_ func(v0 context.Context, v1 *sourcegraph.BuildsGetRepoBuildInfoOp) (*sourcegraph.RepoBuildInfo, error)
-	List_             func(v0 context.Context, v1 *sourcegraph.BuildListOptions) (*sourcegraph.BuildList, error)
-	Create_           func(v0 context.Context, v1 *sourcegraph.BuildsCreateOp) (*sourcegraph.Build, error)
-	Update_           func(v0 context.Context, v1 *sourcegraph.BuildsUpdateOp) (*sourcegraph.Build, error)
-	ListBuildTasks_   func(v0 context.Context, v1 *sourcegraph.BuildsListBuildTasksOp) (*sourcegraph.BuildTaskList, error)
-	CreateTasks_      func(v0 context.Context, v1 *sourcegraph.BuildsCreateTasksOp) (*sourcegraph.BuildTaskList, error)
-	UpdateTask_       func(v0 context.Context, v1 *sourcegraph.BuildsUpdateTaskOp) (*sourcegraph.BuildTask, error)
-	GetLog_           func(v0 context.Context, v1 *sourcegraph.BuildsGetLogOp) (*sourcegraph.LogEntries, error)
-	GetTaskLog_       func(v0 context.Context, v1 *sourcegraph.BuildsGetTaskLogOp) (*sourcegraph.LogEntries, error)
-	DequeueNext_      func(v0 context.Context, v1 *sourcegraph.BuildsDequeueNextOp) (*sourcegraph.Build, error)
+	Get_                      func(v0 context.Context, v1 *sourcegraph.BuildSpec) (*sourcegraph.Build, error)
+	GetRepoBuildInfo_         func(v0 context.Context, v1 *sourcegraph.BuildsGetRepoBuildInfoOp) (*sourcegraph.RepoBuildInfo, error)
+	GetMultipleRepoBuildInfo_ func(v0 context.Context, v1 *sourcegraph.BuildsGetMultipleRepoBuildInfoOp) (*sourcegraph.RepoBuildInfoList, error)
+	List_                     func(v0 context.Context, v1 *sourcegraph.BuildListOptions) (*sourcegraph.BuildList, error)
+	ListByRepo_               func(v0 context.Context, v1 *sourcegraph.BuildsListByRepoOp) (*sourcegraph.BuildList, error)
+	Create_                   func(v0 context.Context, v1 *sourcegraph.BuildsCreateOp) (*sourcegraph.Build, error)
+	Update_                   func(v0 context.Context, v1 *sourcegraph.BuildsUpdateOp) (*sourcegraph.Build, error)
+	ListBuildTasks_           func(v0 context.Context, v1 *sourcegraph.BuildsListBuildTasksOp) (*sourcegraph.BuildTaskList, error)
+	CreateTasks_              func(v0 context.Context, v1 *sourcegraph.BuildsCreateTasksOp) (*sourcegraph.BuildTaskList, error)
+	UpdateTask_               func(v0 context.Context, v1 *sourcegraph.BuildsUpdateTaskOp) (*sourcegraph.BuildTask, error)
+	GetLog_                   func(v0 context.Context, v1 *sourcegraph.BuildsGetLogOp) (*sourcegraph.LogEntries, error)
+	GetTaskLog_               func(v0 context.Context, v1 *sourcegraph.BuildsGetTaskLogOp) (*sourcegraph.LogEntries, error)
+	DequeueNext_              func(v0 context.Context, v1 *sourcegraph.BuildsDequeueNextOp) (*sourcegraph.Build, error)
+	Heartbeat_                func(v0 context.Context, v1 *sourcegraph.BuildSpec) (*pbtypes.Void, error)
+	Cancel_                   func(v0 context.Context, v1 *sourcegraph.BuildsCancelOp) (*sourcegraph.Build, error)
+	Restart_                  func(v0 context.Context, v1 *sourcegraph.BuildsRestartOp) (*sourcegraph.Build, error)
 }
 
 func (s *BuildsServer) Get(v0 context.Context, v1 *sourcegraph.BuildSpec) (*sourcegraph.Build, error) {
@@ -623,10 +983,18 @@ func (s *BuildsServer) GetRepoBuildInfo(v0 context.Context, v1 *sourcegraph.Buil
 	return s.GetRepoBuildInfo_(v0, v1)
 }
 
+func (s *BuildsServer) GetMultipleRepoBuildInfo(v0 context.Context, v1 *sourcegraph.BuildsGetMultipleRepoBuildInfoOp) (*sourcegraph.RepoBuildInfoList, error) {
+	return s.GetMultipleRepoBuildInfo_(v0, v1)
+}
+
 func (s *BuildsServer) List(v0 context.Context, v1 *sourcegraph.BuildListOptions) (*sourcegraph.BuildList, error) {
 	return s.List_(v0, v1)
 }
 
+func (s *BuildsServer) ListByRepo(v0 context.Context, v1 *sourcegraph.BuildsListByRepoOp) (*sourcegraph.BuildList, error) {
+	return s.ListByRepo_(v0, v1)
+}
+
 func (s *BuildsServer) Create(v0 context.Context, v1 *sourcegraph.BuildsCreateOp) (*sourcegraph.Build, error) {
 	return s.Create_(v0, v1)
 }
@@ -659,12 +1027,28 @@ func (s *BuildsServer) DequeueNext(v0 context.Context, v1 *sourcegraph.BuildsDeq
 	return s.DequeueNext_(v0, v1)
 }
 
+func (s *BuildsServer) Heartbeat(v0 context.Context, v1 *sourcegraph.BuildSpec) (*pbtypes.Void, error) {
+	return s.Heartbeat_(v0, v1)
+}
+
+func (s *BuildsServer) Cancel(v0 context.Context, v1 *sourcegraph.BuildsCancelOp) (*sourcegraph.Build, error) {
+	return s.Cancel_(v0, v1)
+}
+
+func (s *BuildsServer) Restart(v0 context.Context, v1 *sourcegraph.BuildsRestartOp) (*sourcegraph.Build, error) {
+	return s.Restart_(v0, v1)
+}
+
 var _ sourcegraph.BuildsServer = (*BuildsServer)(nil)
 
 type OrgsClient struct {
-	Get_         func(ctx context.Context, in *sourcegraph.OrgSpec) (*sourcegraph.Org, error)
-	List_        func(ctx context.Context, in *sourcegraph.OrgsListOp) (*sourcegraph.OrgList, error)
-	ListMembers_ func(ctx context.Context, in *sourcegraph.OrgsListMembersOp) (*sourcegraph.UserList, error)
+	Get_             func(ctx context.Context, in *sourcegraph.OrgSpec) (*sourcegraph.Org, error)
+	List_            func(ctx context.Context, in *sourcegraph.OrgsListOp) (*sourcegraph.OrgList, error)
+	ListMembers_     func(ctx context.Context, in *sourcegraph.OrgsListMembersOp) (*sourcegraph.UserList, error)
+	ListTeams_       func(ctx context.Context, in *sourcegraph.OrgsListTeamsOp) (*sourcegraph.TeamList, error)
+	GetTeam_         func(ctx context.Context, in *sourcegraph.TeamSpec) (*sourcegraph.Team, error)
+	ListTeamMembers_ func(ctx context.Context, in *sourcegraph.OrgsListTeamMembersOp) (*sourcegraph.UserList, error)
+	AddTeamRepo_     func(ctx context.Context, in *sourcegraph.OrgsAddTeamRepoOp) (*pbtypes.Void, error)
 }
 
 func (s *OrgsClient) Get(ctx context.Context, in *sourcegraph.OrgSpec, opts ...grpc.CallOption) (*sourcegraph.Org, error) {
@@ -679,12 +1063,32 @@ func (s *OrgsClient) ListMembers(ctx context.Context, in *sourcegraph.OrgsListMe
 	return s.ListMembers_(ctx, in)
 }
 
+func (s *OrgsClient) ListTeams(ctx context.Context, in *sourcegraph.OrgsListTeamsOp, opts ...grpc.CallOption) (*sourcegraph.TeamList, error) {
+	return s.ListTeams_(ctx, in)
+}
+
+func (s *OrgsClient) GetTeam(ctx context.Context, in *sourcegraph.TeamSpec, opts ...grpc.CallOption) (*sourcegraph.Team, error) {
+	return s.GetTeam_(ctx, in)
+}
+
+func (s *OrgsClient) ListTeamMembers(ctx context.Context, in *sourcegraph.OrgsListTeamMembersOp, opts ...grpc.CallOption) (*sourcegraph.UserList, error) {
+	return s.ListTeamMembers_(ctx, in)
+}
+
+func (s *OrgsClient) AddTeamRepo(ctx context.Context, in *sourcegraph.OrgsAddTeamRepoOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	return s.AddTeamRepo_(ctx, in)
+}
+
 var _ sourcegraph.OrgsClient = (*OrgsClient)(nil)
 
 type OrgsServer struct {
-	Get_         func(v0 context.Context, v1 *sourcegraph.OrgSpec) (*sourcegraph.Org, error)
-	List_        func(v0 context.Context, v1 *sourcegraph.OrgsListOp) (*sourcegraph.OrgList, error)
-	ListMembers_ func(v0 context.Context, v1 *sourcegraph.OrgsListMembersOp) (*sourcegraph.UserList, error)
+	Get_             func(v0 context.Context, v1 *sourcegraph.OrgSpec) (*sourcegraph.Org, error)
+	List_            func(v0 context.Context, v1 *sourcegraph.OrgsListOp) (*sourcegraph.OrgList, error)
+	ListMembers_     func(v0 context.Context, v1 *sourcegraph.OrgsListMembersOp) (*sourcegraph.UserList, error)
+	ListTeams_       func(v0 context.Context, v1 *sourcegraph.OrgsListTeamsOp) (*sourcegraph.TeamList, error)
+	GetTeam_         func(v0 context.Context, v1 *sourcegraph.TeamSpec) (*sourcegraph.Team, error)
+	ListTeamMembers_ func(v0 context.Context, v1 *sourcegraph.OrgsListTeamMembersOp) (*sourcegraph.UserList, error)
+	AddTeamRepo_     func(v0 context.Context, v1 *sourcegraph.OrgsAddTeamRepoOp) (*pbtypes.Void, error)
 }
 
 func (s *OrgsServer) Get(v0 context.Context, v1 *sourcegraph.OrgSpec) (*sourcegraph.Org, error) {
@@ -699,6 +1103,22 @@ func (s *OrgsServer) ListMembers(v0 context.Context, v1 *sourcegraph.OrgsListMem
 	return s.ListMembers_(v0, v1)
 }
 
+func (s *OrgsServer) ListTeams(v0 context.Context, v1 *sourcegraph.OrgsListTeamsOp) (*sourcegraph.TeamList, error) {
+	return s.ListTeams_(v0, v1)
+}
+
+func (s *OrgsServer) GetTeam(v0 context.Context, v1 *sourcegraph.TeamSpec) (*sourcegraph.Team, error) {
+	return s.GetTeam_(v0, v1)
+}
+
+func (s *OrgsServer) ListTeamMembers(v0 context.Context, v1 *sourcegraph.OrgsListTeamMembersOp) (*sourcegraph.UserList, error) {
+	return s.ListTeamMembers_(v0, v1)
+}
+
+func (s *OrgsServer) AddTeamRepo(v0 context.Context, v1 *sourcegraph.OrgsAddTeamRepoOp) (*pbtypes.Void, error) {
+	return s.AddTeamRepo_(v0, v1)
+}
+
 var _ sourcegraph.OrgsServer = (*OrgsServer)(nil)
 
 type PeopleClient struct {
@@ -772,10 +1192,16 @@ func (s *AccountsServer) Update(v0 context.Context, v1 *sourcegraph.User) (*pbty
 var _ sourcegraph.AccountsServer = (*AccountsServer)(nil)
 
 type UsersClient struct {
-	Get_          func(ctx context.Context, in *sourcegraph.UserSpec) (*sourcegraph.User, error)
-	GetWithEmail_ func(ctx context.Context, in *sourcegraph.EmailAddr) (*sourcegraph.User, error)
-	ListEmails_   func(ctx context.Context, in *sourcegraph.UserSpec) (*sourcegraph.EmailAddrList, error)
-	List_         func(ctx context.Context, in *sourcegraph.UsersListOptions) (*sourcegraph.UserList, error)
+	Get_               func(ctx context.Context, in *sourcegraph.UserSpec) (*sourcegraph.User, error)
+	GetWithEmail_      func(ctx context.Context, in *sourcegraph.EmailAddr) (*sourcegraph.User, error)
+	GetByExternal_     func(ctx context.Context, in *sourcegraph.ExternalAccountSpec) (*sourcegraph.User, error)
+	ListAuthoredDefs_  func(ctx context.Context, in *sourcegraph.UsersListAuthoredDefsOp) (*sourcegraph.AuthoredDefList, error)
+	ListContributions_ func(ctx context.Context, in *sourcegraph.UsersListContributionsOp) (*sourcegraph.UserContributionList, error)
+	ListEmails_        func(ctx context.Context, in *sourcegraph.UserSpec) (*sourcegraph.EmailAddrList, error)
+	List_              func(ctx context.Context, in *sourcegraph.UsersListOptions) (*sourcegraph.UserList, error)
+	ListKeys_          func(ctx context.Context, in *sourcegraph.UsersListKeysOp) (*sourcegraph.UserKeyList, error)
+	AddKey_            func(ctx context.Context, in *sourcegraph.UsersAddKeyOp) (*sourcegraph.UserKey, error)
+	DeleteKey_         func(ctx context.Context, in *sourcegraph.UsersDeleteKeyOp) (*pbtypes.Void, error)
 }
 
 func (s *UsersClient) Get(ctx context.Context, in *sourcegraph.UserSpec, opts ...grpc.CallOption) (*sourcegraph.User, error) {
@@ -786,6 +1212,18 @@ func (s *UsersClient) GetWithEmail(ctx context.Context, in *sourcegraph.EmailAdd
 	return s.GetWithEmail_(ctx, in)
 }
 
+func (s *UsersClient) GetByExternal(ctx context.Context, in *sourcegraph.ExternalAccountSpec, opts ...grpc.CallOption) (*sourcegraph.User, error) {
+	return s.GetByExternal_(ctx, in)
+}
+
+func (s *UsersClient) ListAuthoredDefs(ctx context.Context, in *sourcegraph.UsersListAuthoredDefsOp, opts ...grpc.CallOption) (*sourcegraph.AuthoredDefList, error) {
+	return s.ListAuthoredDefs_(ctx, in)
+}
+
+func (s *UsersClient) ListContributions(ctx context.Context, in *sourcegraph.UsersListContributionsOp, opts ...grpc.CallOption) (*sourcegraph.UserContributionList, error) {
+	return s.ListContributions_(ctx, in)
+}
+
 func (s *UsersClient) ListEmails(ctx context.Context, in *sourcegraph.UserSpec, opts ...grpc.CallOption) (*sourcegraph.EmailAddrList, error) {
 	return s.ListEmails_(ctx, in)
 }
@@ -794,13 +1232,31 @@ func (s *UsersClient) List(ctx context.Context, in *sourcegraph.UsersListOptions
 	return s.List_(ctx, in)
 }
 
+func (s *UsersClient) ListKeys(ctx context.Context, in *sourcegraph.UsersListKeysOp, opts ...grpc.CallOption) (*sourcegraph.UserKeyList, error) {
+	return s.ListKeys_(ctx, in)
+}
+
+func (s *UsersClient) AddKey(ctx context.Context, in *sourcegraph.UsersAddKeyOp, opts ...grpc.CallOption) (*sourcegraph.UserKey, error) {
+	return s.AddKey_(ctx, in)
+}
+
+func (s *UsersClient) DeleteKey(ctx context.Context, in *sourcegraph.UsersDeleteKeyOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	return s.DeleteKey_(ctx, in)
+}
+
 var _ sourcegraph.UsersClient = (*UsersClient)(nil)
 
 type UsersServer struct {
-	Get_          func(v0 context.Context, v1 *sourcegraph.UserSpec) (*sourcegraph.User, error)
-	GetWithEmail_ func(v0 context.Context, v1 *sourcegraph.EmailAddr) (*sourcegraph.User, error)
-	ListEmails_   func(v0 context.Context, v1 *sourcegraph.UserSpec) (*sourcegraph.EmailAddrList, error)
-	List_         func(v0 context.Context, v1 *sourcegraph.UsersListOptions) (*sourcegraph.UserList, error)
+	Get_               func(v0 context.Context, v1 *sourcegraph.UserSpec) (*sourcegraph.User, error)
+	GetWithEmail_      func(v0 context.Context, v1 *sourcegraph.EmailAddr) (*sourcegraph.User, error)
+	GetByExternal_     func(v0 context.Context, v1 *sourcegraph.ExternalAccountSpec) (*sourcegraph.User, error)
+	ListAuthoredDefs_  func(v0 context.Context, v1 *sourcegraph.UsersListAuthoredDefsOp) (*sourcegraph.AuthoredDefList, error)
+	ListContributions_ func(v0 context.Context, v1 *sourcegraph.UsersListContributionsOp) (*sourcegraph.UserContributionList, error)
+	ListEmails_        func(v0 context.Context, v1 *sourcegraph.UserSpec) (*sourcegraph.EmailAddrList, error)
+	List_              func(v0 context.Context, v1 *sourcegraph.UsersListOptions) (*sourcegraph.UserList, error)
+	ListKeys_          func(v0 context.Context, v1 *sourcegraph.UsersListKeysOp) (*sourcegraph.UserKeyList, error)
+	AddKey_            func(v0 context.Context, v1 *sourcegraph.UsersAddKeyOp) (*sourcegraph.UserKey, error)
+	DeleteKey_         func(v0 context.Context, v1 *sourcegraph.UsersDeleteKeyOp) (*pbtypes.Void, error)
 }
 
 func (s *UsersServer) Get(v0 context.Context, v1 *sourcegraph.UserSpec) (*sourcegraph.User, error) {
@@ -811,6 +1267,18 @@ func (s *UsersServer) GetWithEmail(v0 context.Context, v1 *sourcegraph.EmailAddr
 	return s.GetWithEmail_(v0, v1)
 }
 
+func (s *UsersServer) GetByExternal(v0 context.Context, v1 *sourcegraph.ExternalAccountSpec) (*sourcegraph.User, error) {
+	return s.GetByExternal_(v0, v1)
+}
+
+func (s *UsersServer) ListAuthoredDefs(v0 context.Context, v1 *sourcegraph.UsersListAuthoredDefsOp) (*sourcegraph.AuthoredDefList, error) {
+	return s.ListAuthoredDefs_(v0, v1)
+}
+
+func (s *UsersServer) ListContributions(v0 context.Context, v1 *sourcegraph.UsersListContributionsOp) (*sourcegraph.UserContributionList, error) {
+	return s.ListContributions_(v0, v1)
+}
+
 func (s *UsersServer) ListEmails(v0 context.Context, v1 *sourcegraph.UserSpec) (*sourcegraph.EmailAddrList, error) {
 	return s.ListEmails_(v0, v1)
 }
@@ -819,6 +1287,18 @@ func (s *UsersServer) List(v0 context.Context, v1 *sourcegraph.UsersListOptions)
 	return s.List_(v0, v1)
 }
 
+func (s *UsersServer) ListKeys(v0 context.Context, v1 *sourcegraph.UsersListKeysOp) (*sourcegraph.UserKeyList, error) {
+	return s.ListKeys_(v0, v1)
+}
+
+func (s *UsersServer) AddKey(v0 context.Context, v1 *sourcegraph.UsersAddKeyOp) (*sourcegraph.UserKey, error) {
+	return s.AddKey_(v0, v1)
+}
+
+func (s *UsersServer) DeleteKey(v0 context.Context, v1 *sourcegraph.UsersDeleteKeyOp) (*pbtypes.Void, error) {
+	return s.DeleteKey_(v0, v1)
+}
+
 var _ sourcegraph.UsersServer = (*UsersServer)(nil)
 
 type UserKeysClient struct {
@@ -866,6 +1346,9 @@ type AuthClient struct {
 	GetAccessToken_       func(ctx context.Context, in *sourcegraph.AccessTokenRequest) (*sourcegraph.AccessTokenResponse, error)
 	Identify_             func(ctx context.Context, in *pbtypes.Void) (*sourcegraph.AuthInfo, error)
 	GetPermissions_       func(ctx context.Context, in *pbtypes.Void) (*sourcegraph.UserPermissions, error)
+	CreateToken_          func(ctx context.Context, in *sourcegraph.AuthCreateTokenOp) (*sourcegraph.PersonalAccessToken, error)
+	ListTokens_           func(ctx context.Context, in *sourcegraph.AuthListTokensOp) (*sourcegraph.PersonalAccessTokenList, error)
+	RevokeToken_          func(ctx context.Context, in *sourcegraph.AuthRevokeTokenOp) (*pbtypes.Void, error)
 }
 
 func (s *AuthClient) GetAuthorizationCode(ctx context.Context, in *sourcegraph.AuthorizationCodeRequest, opts ...grpc.CallOption) (*sourcegraph.AuthorizationCode, error) {
@@ -884,6 +1367,18 @@ func (s *AuthClient) GetPermissions(ctx context.Context, in *pbtypes.Void, opts
 	return s.GetPermissions_(ctx, in)
 }
 
+func (s *AuthClient) CreateToken(ctx context.Context, in *sourcegraph.AuthCreateTokenOp, opts ...grpc.CallOption) (*sourcegraph.PersonalAccessToken, error) {
+	return s.CreateToken_(ctx, in)
+}
+
+func (s *AuthClient) ListTokens(ctx context.Context, in *sourcegraph.AuthListTokensOp, opts ...grpc.CallOption) (*sourcegraph.PersonalAccessTokenList, error) {
+	return s.ListTokens_(ctx, in)
+}
+
+func (s *AuthClient) RevokeToken(ctx context.Context, in *sourcegraph.AuthRevokeTokenOp, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	return s.RevokeToken_(ctx, in)
+}
+
 var _ sourcegraph.AuthClient = (*AuthClient)(nil)
 
 type AuthServer struct {
@@ -891,6 +1386,9 @@ type AuthServer struct {
 	GetAccessToken_       func(v0 context.Context, v1 *sourcegraph.AccessTokenRequest) (*sourcegraph.AccessTokenResponse, error)
 	Identify_             func(v0 context.Context, v1 *pbtypes.Void) (*sourcegraph.AuthInfo, error)
 	GetPermissions_       func(v0 context.Context, v1 *pbtypes.Void) (*sourcegraph.UserPermissions, error)
+	CreateToken_          func(v0 context.Context, v1 *sourcegraph.AuthCreateTokenOp) (*sourcegraph.PersonalAccessToken, error)
+	ListTokens_           func(v0 context.Context, v1 *sourcegraph.AuthListTokensOp) (*sourcegraph.PersonalAccessTokenList, error)
+	RevokeToken_          func(v0 context.Context, v1 *sourcegraph.AuthRevokeTokenOp) (*pbtypes.Void, error)
 }
 
 func (s *AuthServer) GetAuthorizationCode(v0 context.Context, v1 *sourcegraph.AuthorizationCodeRequest) (*sourcegraph.AuthorizationCode, error) {
@@ -909,21 +1407,56 @@ func (s *AuthServer) GetPermissions(v0 context.Context, v1 *pbtypes.Void) (*sour
 	return s.GetPermissions_(v0, v1)
 }
 
+func (s *AuthServer) CreateToken(v0 context.Context, v1 *sourcegraph.AuthCreateTokenOp) (*sourcegraph.PersonalAccessToken, error) {
+	return s.CreateToken_(v0, v1)
+}
+
+func (s *AuthServer) ListTokens(v0 context.Context, v1 *sourcegraph.AuthListTokensOp) (*sourcegraph.PersonalAccessTokenList, error) {
+	return s.ListTokens_(v0, v1)
+}
+
+func (s *AuthServer) RevokeToken(v0 context.Context, v1 *sourcegraph.AuthRevokeTokenOp) (*pbtypes.Void, error) {
+	return s.RevokeToken_(v0, v1)
+}
+
 var _ sourcegraph.AuthServer = (*AuthServer)(nil)
 
 type DefsClient struct {
-	Get_          func(ctx context.Context, in *sourcegraph.DefsGetOp) (*sourcegraph.Def, error)
-	List_         func(ctx context.Context, in *sourcegraph.DefListOptions) (*sourcegraph.DefList, error)
-	ListRefs_     func(ctx context.Context, in *sourcegraph.DefsListRefsOp) (*sourcegraph.RefList, error)
-	ListExamples_ func(ctx context.Context, in *sourcegraph.DefsListExamplesOp) (*sourcegraph.ExampleList, error)
-	ListAuthors_  func(ctx context.Context, in *sourcegraph.DefsListAuthorsOp) (*sourcegraph.DefAuthorList, error)
-	ListClients_  func(ctx context.Context, in *sourcegraph.DefsListClientsOp) (*sourcegraph.DefClientList, error)
+	Get_            func(ctx context.Context, in *sourcegraph.DefsGetOp) (*sourcegraph.Def, error)
+	GetByPosition_  func(ctx context.Context, in *sourcegraph.DefsGetByPositionOp) (*sourcegraph.Def, error)
+	Hover_          func(ctx context.Context, in *sourcegraph.DefsGetByPositionOp) (*sourcegraph.Hover, error)
+	GetMultiple_    func(ctx context.Context, in *sourcegraph.DefsGetMultipleOp) (*sourcegraph.DefList, error)
+	List_           func(ctx context.Context, in *sourcegraph.DefListOptions) (*sourcegraph.DefList, error)
+	ListRefs_       func(ctx context.Context, in *sourcegraph.DefsListRefsOp) (*sourcegraph.RefList, error)
+	ListExamples_   func(ctx context.Context, in *sourcegraph.DefsListExamplesOp) (*sourcegraph.ExampleList, error)
+	ListAuthors_    func(ctx context.Context, in *sourcegraph.DefsListAuthorsOp) (*sourcegraph.DefAuthorList, error)
+	ListClients_    func(ctx context.Context, in *sourcegraph.DefsListClientsOp) (*sourcegraph.DefClientList, error)
+	ListDependents_ func(ctx context.Context, in *sourcegraph.DefsListDependentsOp) (*sourcegraph.DefDependentList, error)
+	ListHistory_    func(ctx context.Context, in *sourcegraph.DefsListHistoryOp) (*sourcegraph.DefHistory, error)
+	ListTop_        func(ctx context.Context, in *sourcegraph.DefsListTopOp) (*sourcegraph.DefList, error)
+	GetLineage_     func(ctx context.Context, in *sourcegraph.DefsGetLineageOp) (*sourcegraph.DefLineage, error)
+	ListCallers_    func(ctx context.Context, in *sourcegraph.DefsListCallersOp) (*sourcegraph.DefList, error)
+	ListCallees_    func(ctx context.Context, in *sourcegraph.DefsListCalleesOp) (*sourcegraph.DefList, error)
+	DiffRefs_       func(ctx context.Context, in *sourcegraph.DefsDiffRefsOp) (*sourcegraph.RefDiff, error)
+	Search_         func(ctx context.Context, in *sourcegraph.DefSearchOptions) (*sourcegraph.DefSearchResultList, error)
 }
 
 func (s *DefsClient) Get(ctx context.Context, in *sourcegraph.DefsGetOp, opts ...grpc.CallOption) (*sourcegraph.Def, error) {
 	return s.Get_(ctx, in)
 }
 
+func (s *DefsClient) GetByPosition(ctx context.Context, in *sourcegraph.DefsGetByPositionOp, opts ...grpc.CallOption) (*sourcegraph.Def, error) {
+	return s.GetByPosition_(ctx, in)
+}
+
+func (s *DefsClient) Hover(ctx context.Context, in *sourcegraph.DefsGetByPositionOp, opts ...grpc.CallOption) (*sourcegraph.Hover, error) {
+	return s.Hover_(ctx, in)
+}
+
+func (s *DefsClient) GetMultiple(ctx context.Context, in *sourcegraph.DefsGetMultipleOp, opts ...grpc.CallOption) (*sourcegraph.DefList, error) {
+	return s.GetMultiple_(ctx, in)
+}
+
 func (s *DefsClient) List(ctx context.Context, in *sourcegraph.DefListOptions, opts ...grpc.CallOption) (*sourcegraph.DefList, error) {
 	return s.List_(ctx, in)
 }
@@ -944,21 +1477,76 @@ func (s *DefsClient) ListClients(ctx context.Context, in *sourcegraph.DefsListCl
 	return s.ListClients_(ctx, in)
 }
 
+func (s *DefsClient) ListDependents(ctx context.Context, in *sourcegraph.DefsListDependentsOp, opts ...grpc.CallOption) (*sourcegraph.DefDependentList, error) {
+	return s.ListDependents_(ctx, in)
+}
+
+func (s *DefsClient) ListHistory(ctx context.Context, in *sourcegraph.DefsListHistoryOp, opts ...grpc.CallOption) (*sourcegraph.DefHistory, error) {
+	return s.ListHistory_(ctx, in)
+}
+
+func (s *DefsClient) ListTop(ctx context.Context, in *sourcegraph.DefsListTopOp, opts ...grpc.CallOption) (*sourcegraph.DefList, error) {
+	return s.ListTop_(ctx, in)
+}
+
+func (s *DefsClient) GetLineage(ctx context.Context, in *sourcegraph.DefsGetLineageOp, opts ...grpc.CallOption) (*sourcegraph.DefLineage, error) {
+	return s.GetLineage_(ctx, in)
+}
+
+func (s *DefsClient) ListCallers(ctx context.Context, in *sourcegraph.DefsListCallersOp, opts ...grpc.CallOption) (*sourcegraph.DefList, error) {
+	return s.ListCallers_(ctx, in)
+}
+
+func (s *DefsClient) ListCallees(ctx context.Context, in *sourcegraph.DefsListCalleesOp, opts ...grpc.CallOption) (*sourcegraph.DefList, error) {
+	return s.ListCallees_(ctx, in)
+}
+
+func (s *DefsClient) DiffRefs(ctx context.Context, in *sourcegraph.DefsDiffRefsOp, opts ...grpc.CallOption) (*sourcegraph.RefDiff, error) {
+	return s.DiffRefs_(ctx, in)
+}
+
+func (s *DefsClient) Search(ctx context.Context, in *sourcegraph.DefSearchOptions, opts ...grpc.CallOption) (*sourcegraph.DefSearchResultList, error) {
+	return s.Search_(ctx, in)
+}
+
 var _ sourcegraph.DefsClient = (*DefsClient)(nil)
 
 type DefsServer struct {
-	Get_          func(v0 context.Context, v1 *sourcegraph.DefsGetOp) (*sourcegraph.Def, error)
-	List_         func(v0 context.Context, v1 *sourcegraph.DefListOptions) (*sourcegraph.DefList, error)
-	ListRefs_     func(v0 context.Context, v1 *sourcegraph.DefsListRefsOp) (*sourcegraph.RefList, error)
-	ListExamples_ func(v0 context.Context, v1 *sourcegraph.DefsListExamplesOp) (*sourcegraph.ExampleList, error)
-	ListAuthors_  func(v0 context.Context, v1 *sourcegraph.DefsListAuthorsOp) (*sourcegraph.DefAuthorList, error)
-	ListClients_  func(v0 context.Context, v1 *sourcegraph.DefsListClientsOp) (*sourcegraph.DefClientList, error)
+	Get_            func(v0 context.Context, v1 *sourcegraph.DefsGetOp) (*sourcegraph.Def, error)
+	GetByPosition_  func(v0 context.Context, v1 *sourcegraph.DefsGetByPositionOp) (*sourcegraph.Def, error)
+	Hover_          func(v0 context.Context, v1 *sourcegraph.DefsGetByPositionOp) (*sourcegraph.Hover, error)
+	GetMultiple_    func(v0 context.Context, v1 *sourcegraph.DefsGetMultipleOp) (*sourcegraph.DefList, error)
+	List_           func(v0 context.Context, v1 *sourcegraph.DefListOptions) (*sourcegraph.DefList, error)
+	ListRefs_       func(v0 context.Context, v1 *sourcegraph.DefsListRefsOp) (*sourcegraph.RefList, error)
+	ListExamples_   func(v0 context.Context, v1 *sourcegraph.DefsListExamplesOp) (*sourcegraph.ExampleList, error)
+	ListAuthors_    func(v0 context.Context, v1 *sourcegraph.DefsListAuthorsOp) (*sourcegraph.DefAuthorList, error)
+	ListClients_    func(v0 context.Context, v1 *sourcegraph.DefsListClientsOp) (*sourcegraph.DefClientList, error)
+	ListDependents_ func(v0 context.Context, v1 *sourcegraph.DefsListDependentsOp) (*sourcegraph.DefDependentList, error)
+	ListHistory_    func(v0 context.Context, v1 *sourcegraph.DefsListHistoryOp) (*sourcegraph.DefHistory, error)
+	ListTop_        func(v0 context.Context, v1 *sourcegraph.DefsListTopOp) (*sourcegraph.DefList, error)
+	GetLineage_     func(v0 context.Context, v1 *sourcegraph.DefsGetLineageOp) (*sourcegraph.DefLineage, error)
+	ListCallers_    func(v0 context.Context, v1 *sourcegraph.DefsListCallersOp) (*sourcegraph.DefList, error)
+	ListCallees_    func(v0 context.Context, v1 *sourcegraph.DefsListCalleesOp) (*sourcegraph.DefList, error)
+	DiffRefs_       func(v0 context.Context, v1 *sourcegraph.DefsDiffRefsOp) (*sourcegraph.RefDiff, error)
+	Search_         func(v0 context.Context, v1 *sourcegraph.DefSearchOptions) (*sourcegraph.DefSearchResultList, error)
 }
 
 func (s *DefsServer) Get(v0 context.Context, v1 *sourcegraph.DefsGetOp) (*sourcegraph.Def, error) {
 	return s.Get_(v0, v1)
 }
 
+func (s *DefsServer) GetByPosition(v0 context.Context, v1 *sourcegraph.DefsGetByPositionOp) (*sourcegraph.Def, error) {
+	return s.GetByPosition_(v0, v1)
+}
+
+func (s *DefsServer) Hover(v0 context.Context, v1 *sourcegraph.DefsGetByPositionOp) (*sourcegraph.Hover, error) {
+	return s.Hover_(v0, v1)
+}
+
+func (s *DefsServer) GetMultiple(v0 context.Context, v1 *sourcegraph.DefsGetMultipleOp) (*sourcegraph.DefList, error) {
+	return s.GetMultiple_(v0, v1)
+}
+
 func (s *DefsServer) List(v0 context.Context, v1 *sourcegraph.DefListOptions) (*sourcegraph.DefList, error) {
 	return s.List_(v0, v1)
 }
@@ -979,21 +1567,67 @@ func (s *DefsServer) ListClients(v0 context.Context, v1 *sourcegraph.DefsListCli
 	return s.ListClients_(v0, v1)
 }
 
+func (s *DefsServer) ListDependents(v0 context.Context, v1 *sourcegraph.DefsListDependentsOp) (*sourcegraph.DefDependentList, error) {
+	return s.ListDependents_(v0, v1)
+}
+
+func (s *DefsServer) ListHistory(v0 context.Context, v1 *sourcegraph.DefsListHistoryOp) (*sourcegraph.DefHistory, error) {
+	return s.ListHistory_(v0, v1)
+}
+
+func (s *DefsServer) ListTop(v0 context.Context, v1 *sourcegraph.DefsListTopOp) (*sourcegraph.DefList, error) {
+	return s.ListTop_(v0, v1)
+}
+
+func (s *DefsServer) GetLineage(v0 context.Context, v1 *sourcegraph.DefsGetLineageOp) (*sourcegraph.DefLineage, error) {
+	return s.GetLineage_(v0, v1)
+}
+
+func (s *DefsServer) ListCallers(v0 context.Context, v1 *sourcegraph.DefsListCallersOp) (*sourcegraph.DefList, error) {
+	return s.ListCallers_(v0, v1)
+}
+
+func (s *DefsServer) ListCallees(v0 context.Context, v1 *sourcegraph.DefsListCalleesOp) (*sourcegraph.DefList, error) {
+	return s.ListCallees_(v0, v1)
+}
+
+func (s *DefsServer) DiffRefs(v0 context.Context, v1 *sourcegraph.DefsDiffRefsOp) (*sourcegraph.RefDiff, error) {
+	return s.DiffRefs_(v0, v1)
+}
+
+func (s *DefsServer) Search(v0 context.Context, v1 *sourcegraph.DefSearchOptions) (*sourcegraph.DefSearchResultList, error) {
+	return s.Search_(v0, v1)
+}
+
 var _ sourcegraph.DefsServer = (*DefsServer)(nil)
 
 type DeltasClient struct {
-	Get_                 func(ctx context.Context, in *sourcegraph.DeltaSpec) (*sourcegraph.Delta, error)
-	ListUnits_           func(ctx context.Context, in *sourcegraph.DeltasListUnitsOp) (*sourcegraph.UnitDeltaList, error)
-	ListDefs_            func(ctx context.Context, in *sourcegraph.DeltasListDefsOp) (*sourcegraph.DeltaDefs, error)
-	ListFiles_           func(ctx context.Context, in *sourcegraph.DeltasListFilesOp) (*sourcegraph.DeltaFiles, error)
-	ListAffectedAuthors_ func(ctx context.Context, in *sourcegraph.DeltasListAffectedAuthorsOp) (*sourcegraph.DeltaAffectedPersonList, error)
-	ListAffectedClients_ func(ctx context.Context, in *sourcegraph.DeltasListAffectedClientsOp) (*sourcegraph.DeltaAffectedPersonList, error)
+	Get_                    func(ctx context.Context, in *sourcegraph.DeltaSpec) (*sourcegraph.Delta, error)
+	GetMergeBase_           func(ctx context.Context, in *sourcegraph.DeltaSpec) (*sourcegraph.DeltaMergeBase, error)
+	ListUnits_              func(ctx context.Context, in *sourcegraph.DeltasListUnitsOp) (*sourcegraph.UnitDeltaList, error)
+	ListDefs_               func(ctx context.Context, in *sourcegraph.DeltasListDefsOp) (*sourcegraph.DeltaDefs, error)
+	ListFiles_              func(ctx context.Context, in *sourcegraph.DeltasListFilesOp) (*sourcegraph.DeltaFiles, error)
+	GetPatch_               func(ctx context.Context, in *sourcegraph.DeltasGetPatchOp) (*sourcegraph.DeltaPatch, error)
+	ListAffectedAuthors_    func(ctx context.Context, in *sourcegraph.DeltasListAffectedAuthorsOp) (*sourcegraph.DeltaAffectedPersonList, error)
+	ListAffectedClients_    func(ctx context.Context, in *sourcegraph.DeltasListAffectedClientsOp) (*sourcegraph.DeltaAffectedPersonList, error)
+	GetImpact_              func(ctx context.Context, in *sourcegraph.DeltaSpec) (*sourcegraph.DeltaImpact, error)
+	ListDependencies_       func(ctx context.Context, in *sourcegraph.DeltasListDependenciesOp) (*sourcegraph.DeltaDependencies, error)
+	ListIncoming_           func(ctx context.Context, in *sourcegraph.DeltasListIncomingOp) (*sourcegraph.DeltaList, error)
+	SetLabels_              func(ctx context.Context, in *sourcegraph.DeltasSetLabelsOp) (*sourcegraph.Delta, error)
+	SetMilestone_           func(ctx context.Context, in *sourcegraph.DeltasSetMilestoneOp) (*sourcegraph.Delta, error)
+	AssignReviewer_         func(ctx context.Context, in *sourcegraph.DeltasReviewerOp) (*sourcegraph.Delta, error)
+	UnassignReviewer_       func(ctx context.Context, in *sourcegraph.DeltasReviewerOp) (*sourcegraph.Delta, error)
+	ListSuggestedReviewers_ func(ctx context.Context, in *sourcegraph.DeltasListSuggestedReviewersOp) (*sourcegraph.SuggestedReviewerList, error)
 }
 
 func (s *DeltasClient) Get(ctx context.Context, in *sourcegraph.DeltaSpec, opts ...grpc.CallOption) (*sourcegraph.Delta, error) {
 	return s.Get_(ctx, in)
 }
 
+func (s *DeltasClient) GetMergeBase(ctx context.Context, in *sourcegraph.DeltaSpec, opts ...grpc.CallOption) (*sourcegraph.DeltaMergeBase, error) {
+	return s.GetMergeBase_(ctx, in)
+}
+
 func (s *DeltasClient) ListUnits(ctx context.Context, in *sourcegraph.DeltasListUnitsOp, opts ...grpc.CallOption) (*sourcegraph.UnitDeltaList, error) {
 	return s.ListUnits_(ctx, in)
 }
@@ -1006,6 +1640,10 @@ func (s *DeltasClient) ListFiles(ctx context.Context, in *sourcegraph.DeltasList
 	return s.ListFiles_(ctx, in)
 }
 
+func (s *DeltasClient) GetPatch(ctx context.Context, in *sourcegraph.DeltasGetPatchOp, opts ...grpc.CallOption) (*sourcegraph.DeltaPatch, error) {
+	return s.GetPatch_(ctx, in)
+}
+
 func (s *DeltasClient) ListAffectedAuthors(ctx context.Context, in *sourcegraph.DeltasListAffectedAuthorsOp, opts ...grpc.CallOption) (*sourcegraph.DeltaAffectedPersonList, error) {
 	return s.ListAffectedAuthors_(ctx, in)
 }
@@ -1014,21 +1652,67 @@ func (s *DeltasClient) ListAffectedClients(ctx context.Context, in *sourcegraph.
 	return s.ListAffectedClients_(ctx, in)
 }
 
+func (s *DeltasClient) GetImpact(ctx context.Context, in *sourcegraph.DeltaSpec, opts ...grpc.CallOption) (*sourcegraph.DeltaImpact, error) {
+	return s.GetImpact_(ctx, in)
+}
+
+func (s *DeltasClient) ListDependencies(ctx context.Context, in *sourcegraph.DeltasListDependenciesOp, opts ...grpc.CallOption) (*sourcegraph.DeltaDependencies, error) {
+	return s.ListDependencies_(ctx, in)
+}
+
+func (s *DeltasClient) ListIncoming(ctx context.Context, in *sourcegraph.DeltasListIncomingOp, opts ...grpc.CallOption) (*sourcegraph.DeltaList, error) {
+	return s.ListIncoming_(ctx, in)
+}
+
+func (s *DeltasClient) SetLabels(ctx context.Context, in *sourcegraph.DeltasSetLabelsOp, opts ...grpc.CallOption) (*sourcegraph.Delta, error) {
+	return s.SetLabels_(ctx, in)
+}
+
+func (s *DeltasClient) SetMilestone(ctx context.Context, in *sourcegraph.DeltasSetMilestoneOp, opts ...grpc.CallOption) (*sourcegraph.Delta, error) {
+	return s.SetMilestone_(ctx, in)
+}
+
+func (s *DeltasClient) AssignReviewer(ctx context.Context, in *sourcegraph.DeltasReviewerOp, opts ...grpc.CallOption) (*sourcegraph.Delta, error) {
+	return s.AssignReviewer_(ctx, in)
+}
+
+func (s *DeltasClient) UnassignReviewer(ctx context.Context, in *sourcegraph.DeltasReviewerOp, opts ...grpc.CallOption) (*sourcegraph.Delta, error) {
+	return s.UnassignReviewer_(ctx, in)
+}
+
+func (s *DeltasClient) ListSuggestedReviewers(ctx context.Context, in *sourcegraph.DeltasListSuggestedReviewersOp, opts ...grpc.CallOption) (*sourcegraph.SuggestedReviewerList, error) {
+	return s.ListSuggestedReviewers_(ctx, in)
+}
+
 var _ sourcegraph.DeltasClient = (*DeltasClient)(nil)
 
 type DeltasServer struct {
-	Get_                 func(v0 context.Context, v1 *sourcegraph.DeltaSpec) (*sourcegraph.Delta, error)
-	ListUnits_           func(v0 context.Context, v1 *sourcegraph.DeltasListUnitsOp) (*sourcegraph.UnitDeltaList, error)
-	ListDefs_            func(v0 context.Context, v1 *sourcegraph.DeltasListDefsOp) (*sourcegraph.DeltaDefs, error)
-	ListFiles_           func(v0 context.Context, v1 *sourcegraph.DeltasListFilesOp) (*sourcegraph.DeltaFiles, error)
-	ListAffectedAuthors_ func(v0 context.Context, v1 *sourcegraph.DeltasListAffectedAuthorsOp) (*sourcegraph.DeltaAffectedPersonList, error)
-	ListAffectedClients_ func(v0 context.Context, v1 *sourcegraph.DeltasListAffectedClientsOp) (*sourcegraph.DeltaAffectedPersonList, error)
+	Get_                    func(v0 context.Context, v1 *sourcegraph.DeltaSpec) (*sourcegraph.Delta, error)
+	GetMergeBase_           func(v0 context.Context, v1 *sourcegraph.DeltaSpec) (*sourcegraph.DeltaMergeBase, error)
+	ListUnits_              func(v0 context.Context, v1 *sourcegraph.DeltasListUnitsOp) (*sourcegraph.UnitDeltaList, error)
+	ListDefs_               func(v0 context.Context, v1 *sourcegraph.DeltasListDefsOp) (*sourcegraph.DeltaDefs, error)
+	ListFiles_              func(v0 context.Context, v1 *sourcegraph.DeltasListFilesOp) (*sourcegraph.DeltaFiles, error)
+	GetPatch_               func(v0 context.Context, v1 *sourcegraph.DeltasGetPatchOp) (*sourcegraph.DeltaPatch, error)
+	ListAffectedAuthors_    func(v0 context.Context, v1 *sourcegraph.DeltasListAffectedAuthorsOp) (*sourcegraph.DeltaAffectedPersonList, error)
+	ListAffectedClients_    func(v0 context.Context, v1 *sourcegraph.DeltasListAffectedClientsOp) (*sourcegraph.DeltaAffectedPersonList, error)
+	GetImpact_              func(v0 context.Context, v1 *sourcegraph.DeltaSpec) (*sourcegraph.DeltaImpact, error)
+	ListDependencies_       func(v0 context.Context, v1 *sourcegraph.DeltasListDependenciesOp) (*sourcegraph.DeltaDependencies, error)
+	ListIncoming_           func(v0 context.Context, v1 *sourcegraph.DeltasListIncomingOp) (*sourcegraph.DeltaList, error)
+	SetLabels_              func(v0 context.Context, v1 *sourcegraph.DeltasSetLabelsOp) (*sourcegraph.Delta, error)
+	SetMilestone_           func(v0 context.Context, v1 *sourcegraph.DeltasSetMilestoneOp) (*sourcegraph.Delta, error)
+	AssignReviewer_         func(v0 context.Context, v1 *sourcegraph.DeltasReviewerOp) (*sourcegraph.Delta, error)
+	UnassignReviewer_       func(v0 context.Context, v1 *sourcegraph.DeltasReviewerOp) (*sourcegraph.Delta, error)
+	ListSuggestedReviewers_ func(v0 context.Context, v1 *sourcegraph.DeltasListSuggestedReviewersOp) (*sourcegraph.SuggestedReviewerList, error)
 }
 
 func (s *DeltasServer) Get(v0 context.Context, v1 *sourcegraph.DeltaSpec) (*sourcegraph.Delta, error) {
 	return s.Get_(v0, v1)
 }
 
+func (s *DeltasServer) GetMergeBase(v0 context.Context, v1 *sourcegraph.DeltaSpec) (*sourcegraph.DeltaMergeBase, error) {
+	return s.GetMergeBase_(v0, v1)
+}
+
 func (s *DeltasServer) ListUnits(v0 context.Context, v1 *sourcegraph.DeltasListUnitsOp) (*sourcegraph.UnitDeltaList, error) {
 	return s.ListUnits_(v0, v1)
 }
@@ -1041,6 +1725,10 @@ func (s *DeltasServer) ListFiles(v0 context.Context, v1 *sourcegraph.DeltasListF
 	return s.ListFiles_(v0, v1)
 }
 
+func (s *DeltasServer) GetPatch(v0 context.Context, v1 *sourcegraph.DeltasGetPatchOp) (*sourcegraph.DeltaPatch, error) {
+	return s.GetPatch_(v0, v1)
+}
+
 func (s *DeltasServer) ListAffectedAuthors(v0 context.Context, v1 *sourcegraph.DeltasListAffectedAuthorsOp) (*sourcegraph.DeltaAffectedPersonList, error) {
 	return s.ListAffectedAuthors_(v0, v1)
 }
@@ -1049,6 +1737,38 @@ func (s *DeltasServer) ListAffectedClients(v0 context.Context, v1 *sourcegraph.D
 	return s.ListAffectedClients_(v0, v1)
 }
 
+func (s *DeltasServer) GetImpact(v0 context.Context, v1 *sourcegraph.DeltaSpec) (*sourcegraph.DeltaImpact, error) {
+	return s.GetImpact_(v0, v1)
+}
+
+func (s *DeltasServer) ListDependencies(v0 context.Context, v1 *sourcegraph.DeltasListDependenciesOp) (*sourcegraph.DeltaDependencies, error) {
+	return s.ListDependencies_(v0, v1)
+}
+
+func (s *DeltasServer) ListIncoming(v0 context.Context, v1 *sourcegraph.DeltasListIncomingOp) (*sourcegraph.DeltaList, error) {
+	return s.ListIncoming_(v0, v1)
+}
+
+func (s *DeltasServer) SetLabels(v0 context.Context, v1 *sourcegraph.DeltasSetLabelsOp) (*sourcegraph.Delta, error) {
+	return s.SetLabels_(v0, v1)
+}
+
+func (s *DeltasServer) SetMilestone(v0 context.Context, v1 *sourcegraph.DeltasSetMilestoneOp) (*sourcegraph.Delta, error) {
+	return s.SetMilestone_(v0, v1)
+}
+
+func (s *DeltasServer) AssignReviewer(v0 context.Context, v1 *sourcegraph.DeltasReviewerOp) (*sourcegraph.Delta, error) {
+	return s.AssignReviewer_(v0, v1)
+}
+
+func (s *DeltasServer) UnassignReviewer(v0 context.Context, v1 *sourcegraph.DeltasReviewerOp) (*sourcegraph.Delta, error) {
+	return s.UnassignReviewer_(v0, v1)
+}
+
+func (s *DeltasServer) ListSuggestedReviewers(v0 context.Context, v1 *sourcegraph.DeltasListSuggestedReviewersOp) (*sourcegraph.SuggestedReviewerList, error) {
+	return s.ListSuggestedReviewers_(v0, v1)
+}
+
 var _ sourcegraph.DeltasServer = (*DeltasServer)(nil)
 
 type MarkdownClient struct {
@@ -1071,10 +1791,81 @@ func (s *MarkdownServer) Render(v0 context.Context, v1 *sourcegraph.MarkdownRend
 
 var _ sourcegraph.MarkdownServer = (*MarkdownServer)(nil)
 
+type GraphQLClient struct {
+	Query_ func(ctx context.Context, in *sourcegraph.GraphQLRequest) (*sourcegraph.GraphQLResponse, error)
+}
+
+func (s *GraphQLClient) Query(ctx context.Context, in *sourcegraph.GraphQLRequest, opts ...grpc.CallOption) (*sourcegraph.GraphQLResponse, error) {
+	return s.Query_(ctx, in)
+}
+
+var _ sourcegraph.GraphQLClient = (*GraphQLClient)(nil)
+
+type GraphQLServer struct {
+	Query_ func(v0 context.Context, v1 *sourcegraph.GraphQLRequest) (*sourcegraph.GraphQLResponse, error)
+}
+
+func (s *GraphQLServer) Query(v0 context.Context, v1 *sourcegraph.GraphQLRequest) (*sourcegraph.GraphQLResponse, error) {
+	return s.Query_(v0, v1)
+}
+
+var _ sourcegraph.GraphQLServer = (*GraphQLServer)(nil)
+
+type RepoDependenciesClient struct {
+	ListDependencies_ func(ctx context.Context, in *sourcegraph.RepoDependenciesListOp) (*sourcegraph.RepoDependencyList, error)
+	ListDependents_   func(ctx context.Context, in *sourcegraph.RepoDependenciesListOp) (*sourcegraph.RepoDependencyList, error)
+}
+
+func (s *RepoDependenciesClient) ListDependencies(ctx context.Context, in *sourcegraph.RepoDependenciesListOp, opts ...grpc.CallOption) (*sourcegraph.RepoDependencyList, error) {
+	return s.ListDependencies_(ctx, in)
+}
+
+func (s *RepoDependenciesClient) ListDependents(ctx context.Context, in *sourcegraph.RepoDependenciesListOp, opts ...grpc.CallOption) (*sourcegraph.RepoDependencyList, error) {
+	return s.ListDependents_(ctx, in)
+}
+
+var _ sourcegraph.RepoDependenciesClient = (*RepoDependenciesClient)(nil)
+
+type RepoDependenciesServer struct {
+	ListDependencies_ func(v0 context.Context, v1 *sourcegraph.RepoDependenciesListOp) (*sourcegraph.RepoDependencyList, error)
+	ListDependents_   func(v0 context.Context, v1 *sourcegraph.RepoDependenciesListOp) (*sourcegraph.RepoDependencyList, error)
+}
+
+func (s *RepoDependenciesServer) ListDependencies(v0 context.Context, v1 *sourcegraph.RepoDependenciesListOp) (*sourcegraph.RepoDependencyList, error) {
+	return s.ListDependencies_(v0, v1)
+}
+
+func (s *RepoDependenciesServer) ListDependents(v0 context.Context, v1 *sourcegraph.RepoDependenciesListOp) (*sourcegraph.RepoDependencyList, error) {
+	return s.ListDependents_(v0, v1)
+}
+
+var _ sourcegraph.RepoDependenciesServer = (*RepoDependenciesServer)(nil)
+
+type AnnotationsClient struct {
+	List_ func(ctx context.Context, in *sourcegraph.AnnotationsListOptions) (*sourcegraph.AnnotationList, error)
+}
+
+func (s *AnnotationsClient) List(ctx context.Context, in *sourcegraph.AnnotationsListOptions, opts ...grpc.CallOption) (*sourcegraph.AnnotationList, error) {
+	return s.List_(ctx, in)
+}
+
+var _ sourcegraph.AnnotationsClient = (*AnnotationsClient)(nil)
+
+type AnnotationsServer struct {
+	List_ func(v0 context.Context, v1 *sourcegraph.AnnotationsListOptions) (*sourcegraph.AnnotationList, error)
+}
+
+func (s *AnnotationsServer) List(v0 context.Context, v1 *sourcegraph.AnnotationsListOptions) (*sourcegraph.AnnotationList, error) {
+	return s.List_(v0, v1)
+}
+
+var _ sourcegraph.AnnotationsServer = (*AnnotationsServer)(nil)
+
 type RepoTreeClient struct {
-	Get_    func(ctx context.Context, in *sourcegraph.RepoTreeGetOp) (*sourcegraph.TreeEntry, error)
-	Search_ func(ctx context.Context, in *sourcegraph.RepoTreeSearchOp) (*sourcegraph.VCSSearchResultList, error)
-	List_   func(ctx context.Context, in *sourcegraph.RepoTreeListOp) (*sourcegraph.RepoTreeListResult, error)
+	Get_          func(ctx context.Context, in *sourcegraph.RepoTreeGetOp) (*sourcegraph.TreeEntry, error)
+	Search_       func(ctx context.Context, in *sourcegraph.RepoTreeSearchOp) (*sourcegraph.VCSSearchResultList, error)
+	List_         func(ctx context.Context, in *sourcegraph.RepoTreeListOp) (*sourcegraph.RepoTreeListResult, error)
+	ListFileDefs_ func(ctx context.Context, in *sourcegraph.RepoTreeListFileDefsOp) (*sourcegraph.FileDefList, error)
 }
 
 func (s *RepoTreeClient) Get(ctx context.Context, in *sourcegraph.RepoTreeGetOp, opts ...grpc.CallOption) (*sourcegraph.TreeEntry, error) {
@@ -1089,12 +1880,17 @@ func (s *RepoTreeClient) List(ctx context.Context, in *sourcegraph.RepoTreeListO
 	return s.List_(ctx, in)
 }
 
+func (s *RepoTreeClient) ListFileDefs(ctx context.Context, in *sourcegraph.RepoTreeListFileDefsOp, opts ...grpc.CallOption) (*sourcegraph.FileDefList, error) {
+	return s.ListFileDefs_(ctx, in)
+}
+
 var _ sourcegraph.RepoTreeClient = (*RepoTreeClient)(nil)
 
 type RepoTreeServer struct {
-	Get_    func(v0 context.Context, v1 *sourcegraph.RepoTreeGetOp) (*sourcegraph.TreeEntry, error)
-	Search_ func(v0 context.Context, v1 *sourcegraph.RepoTreeSearchOp) (*sourcegraph.VCSSearchResultList, error)
-	List_   func(v0 context.Context, v1 *sourcegraph.RepoTreeListOp) (*sourcegraph.RepoTreeListResult, error)
+	Get_          func(v0 context.Context, v1 *sourcegraph.RepoTreeGetOp) (*sourcegraph.TreeEntry, error)
+	Search_       func(v0 context.Context, v1 *sourcegraph.RepoTreeSearchOp) (*sourcegraph.VCSSearchResultList, error)
+	List_         func(v0 context.Context, v1 *sourcegraph.RepoTreeListOp) (*sourcegraph.RepoTreeListResult, error)
+	ListFileDefs_ func(v0 context.Context, v1 *sourcegraph.RepoTreeListFileDefsOp) (*sourcegraph.FileDefList, error)
 }
 
 func (s *RepoTreeServer) Get(v0 context.Context, v1 *sourcegraph.RepoTreeGetOp) (*sourcegraph.TreeEntry, error) {
@@ -1109,6 +1905,10 @@ func (s *RepoTreeServer) List(v0 context.Context, v1 *sourcegraph.RepoTreeListOp
 	return s.List_(v0, v1)
 }
 
+func (s *RepoTreeServer) ListFileDefs(v0 context.Context, v1 *sourcegraph.RepoTreeListFileDefsOp) (*sourcegraph.FileDefList, error) {
+	return s.ListFileDefs_(v0, v1)
+}
+
 var _ sourcegraph.RepoTreeServer = (*RepoTreeServer)(nil)
 
 type SearchClient struct {
@@ -1241,6 +2041,86 @@ func (s *MetaServer) PubKey(v0 context.Context, v1 *pbtypes.Void) (*sourcegraph.
 
 var _ sourcegraph.MetaServer = (*MetaServer)(nil)
 
+type AdminClient struct {
+	GetSiteConfig_    func(ctx context.Context, in *pbtypes.Void) (*sourcegraph.SiteConfig, error)
+	UpdateSiteConfig_ func(ctx context.Context, in *sourcegraph.SiteConfig) (*sourcegraph.SiteConfig, error)
+	ReindexAllRepos_  func(ctx context.Context, in *sourcegraph.AdminReindexAllReposOp) (*sourcegraph.AdminReindexResult, error)
+	DeactivateUser_   func(ctx context.Context, in *sourcegraph.UserSpec) (*pbtypes.Void, error)
+	GetJobQueueStats_ func(ctx context.Context, in *pbtypes.Void) (*sourcegraph.JobQueueStats, error)
+}
+
+func (s *AdminClient) GetSiteConfig(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*sourcegraph.SiteConfig, error) {
+	return s.GetSiteConfig_(ctx, in)
+}
+
+func (s *AdminClient) UpdateSiteConfig(ctx context.Context, in *sourcegraph.SiteConfig, opts ...grpc.CallOption) (*sourcegraph.SiteConfig, error) {
+	return s.UpdateSiteConfig_(ctx, in)
+}
+
+func (s *AdminClient) ReindexAllRepos(ctx context.Context, in *sourcegraph.AdminReindexAllReposOp, opts ...grpc.CallOption) (*sourcegraph.AdminReindexResult, error) {
+	return s.ReindexAllRepos_(ctx, in)
+}
+
+func (s *AdminClient) DeactivateUser(ctx context.Context, in *sourcegraph.UserSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	return s.DeactivateUser_(ctx, in)
+}
+
+func (s *AdminClient) GetJobQueueStats(ctx context.Context, in *pbtypes.Void, opts ...grpc.CallOption) (*sourcegraph.JobQueueStats, error) {
+	return s.GetJobQueueStats_(ctx, in)
+}
+
+var _ sourcegraph.AdminClient = (*AdminClient)(nil)
+
+type AdminServer struct {
+	GetSiteConfig_    func(v0 context.Context, v1 *pbtypes.Void) (*sourcegraph.SiteConfig, error)
+	UpdateSiteConfig_ func(v0 context.Context, v1 *sourcegraph.SiteConfig) (*sourcegraph.SiteConfig, error)
+	ReindexAllRepos_  func(v0 context.Context, v1 *sourcegraph.AdminReindexAllReposOp) (*sourcegraph.AdminReindexResult, error)
+	DeactivateUser_   func(v0 context.Context, v1 *sourcegraph.UserSpec) (*pbtypes.Void, error)
+	GetJobQueueStats_ func(v0 context.Context, v1 *pbtypes.Void) (*sourcegraph.JobQueueStats, error)
+}
+
+func (s *AdminServer) GetSiteConfig(v0 context.Context, v1 *pbtypes.Void) (*sourcegraph.SiteConfig, error) {
+	return s.GetSiteConfig_(v0, v1)
+}
+
+func (s *AdminServer) UpdateSiteConfig(v0 context.Context, v1 *sourcegraph.SiteConfig) (*sourcegraph.SiteConfig, error) {
+	return s.UpdateSiteConfig_(v0, v1)
+}
+
+func (s *AdminServer) ReindexAllRepos(v0 context.Context, v1 *sourcegraph.AdminReindexAllReposOp) (*sourcegraph.AdminReindexResult, error) {
+	return s.ReindexAllRepos_(v0, v1)
+}
+
+func (s *AdminServer) DeactivateUser(v0 context.Context, v1 *sourcegraph.UserSpec) (*pbtypes.Void, error) {
+	return s.DeactivateUser_(v0, v1)
+}
+
+func (s *AdminServer) GetJobQueueStats(v0 context.Context, v1 *pbtypes.Void) (*sourcegraph.JobQueueStats, error) {
+	return s.GetJobQueueStats_(v0, v1)
+}
+
+var _ sourcegraph.AdminServer = (*AdminServer)(nil)
+
+type AdminStatsClient struct {
+	GetUsage_ func(ctx context.Context, in *sourcegraph.AdminStatsGetUsageOp) (*sourcegraph.UsageStats, error)
+}
+
+func (s *AdminStatsClient) GetUsage(ctx context.Context, in *sourcegraph.AdminStatsGetUsageOp, opts ...grpc.CallOption) (*sourcegraph.UsageStats, error) {
+	return s.GetUsage_(ctx, in)
+}
+
+var _ sourcegraph.AdminStatsClient = (*AdminStatsClient)(nil)
+
+type AdminStatsServer struct {
+	GetUsage_ func(v0 context.Context, v1 *sourcegraph.AdminStatsGetUsageOp) (*sourcegraph.UsageStats, error)
+}
+
+func (s *AdminStatsServer) GetUsage(v0 context.Context, v1 *sourcegraph.AdminStatsGetUsageOp) (*sourcegraph.UsageStats, error) {
+	return s.GetUsage_(v0, v1)
+}
+
+var _ sourcegraph.AdminStatsServer = (*AdminStatsServer)(nil)
+
 type RegisteredClientsClient struct {
 	Get_                 func(ctx context.Context, in *sourcegraph.RegisteredClientSpec) (*sourcegraph.RegisteredClient, error)
 	GetCurrent_          func(ctx context.Context, in *pbtypes.Void) (*sourcegraph.RegisteredClient, error)
@@ -1341,6 +2221,56 @@ func (s *RegisteredClientsServer) ListUserPermissions(v0 context.Context, v1 *so
 
 var _ sourcegraph.RegisteredClientsServer = (*RegisteredClientsServer)(nil)
 
+type SavedSearchesClient struct {
+	Create_              func(ctx context.Context, in *sourcegraph.SavedSearch) (*sourcegraph.SavedSearch, error)
+	List_                func(ctx context.Context, in *sourcegraph.SavedSearchListOptions) (*sourcegraph.SavedSearchList, error)
+	Delete_              func(ctx context.Context, in *sourcegraph.SavedSearchSpec) (*pbtypes.Void, error)
+	UpdateNotifications_ func(ctx context.Context, in *sourcegraph.SavedSearchesUpdateNotificationsOp) (*sourcegraph.SavedSearch, error)
+}
+
+func (s *SavedSearchesClient) Create(ctx context.Context, in *sourcegraph.SavedSearch, opts ...grpc.CallOption) (*sourcegraph.SavedSearch, error) {
+	return s.Create_(ctx, in)
+}
+
+func (s *SavedSearchesClient) List(ctx context.Context, in *sourcegraph.SavedSearchListOptions, opts ...grpc.CallOption) (*sourcegraph.SavedSearchList, error) {
+	return s.List_(ctx, in)
+}
+
+func (s *SavedSearchesClient) Delete(ctx context.Context, in *sourcegraph.SavedSearchSpec, opts ...grpc.CallOption) (*pbtypes.Void, error) {
+	return s.Delete_(ctx, in)
+}
+
+func (s *SavedSearchesClient) UpdateNotifications(ctx context.Context, in *sourcegraph.SavedSearchesUpdateNotificationsOp, opts ...grpc.CallOption) (*sourcegraph.SavedSearch, error) {
+	return s.UpdateNotifications_(ctx, in)
+}
+
+var _ sourcegraph.SavedSearchesClient = (*SavedSearchesClient)(nil)
+
+type SavedSearchesServer struct {
+	Create_              func(v0 context.Context, v1 *sourcegraph.SavedSearch) (*sourcegraph.SavedSearch, error)
+	List_                func(v0 context.Context, v1 *sourcegraph.SavedSearchListOptions) (*sourcegraph.SavedSearchList, error)
+	Delete_              func(v0 context.Context, v1 *sourcegraph.SavedSearchSpec) (*pbtypes.Void, error)
+	UpdateNotifications_ func(v0 context.Context, v1 *sourcegraph.SavedSearchesUpdateNotificationsOp) (*sourcegraph.SavedSearch, error)
+}
+
+func (s *SavedSearchesServer) Create(v0 context.Context, v1 *sourcegraph.SavedSearch) (*sourcegraph.SavedSearch, error) {
+	return s.Create_(v0, v1)
+}
+
+func (s *SavedSearchesServer) List(v0 context.Context, v1 *sourcegraph.SavedSearchListOptions) (*sourcegraph.SavedSearchList, error) {
+	return s.List_(v0, v1)
+}
+
+func (s *SavedSearchesServer) Delete(v0 context.Context, v1 *sourcegraph.SavedSearchSpec) (*pbtypes.Void, error) {
+	return s.Delete_(v0, v1)
+}
+
+func (s *SavedSearchesServer) UpdateNotifications(v0 context.Context, v1 *sourcegraph.SavedSearchesUpdateNotificationsOp) (*sourcegraph.SavedSearch, error) {
+	return s.UpdateNotifications_(v0, v1)
+}
+
+var _ sourcegraph.SavedSearchesServer = (*SavedSearchesServer)(nil)
+
 type GraphUplinkClient struct {
 	Push_       func(ctx context.Context, in *sourcegraph.MetricsSnapshot) (*pbtypes.Void, error)
 	PushEvents_ func(ctx context.Context, in *sourcegraph.UserEventList) (*pbtypes.Void, error)
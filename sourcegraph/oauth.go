@@ -0,0 +1,61 @@
+package sourcegraph
+
+import (
+	"golang.org/x/net/context"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+)
+
+// NewClientWithOAuth returns a copy of parent that authenticates API
+// requests using OAuth2 access tokens obtained from ts, and a client
+// constructed from it (with NewClientFromContext). Tokens are cached
+// until they expire, at which point ts is called again to refresh
+// them (e.g., ts may be an oauth2.Config's TokenSource, which uses a
+// refresh token).
+//
+// The returned context must be used for calls made with the returned
+// client. Errors obtaining a token are returned as *AuthError.
+func NewClientWithOAuth(parent context.Context, ts oauth2.TokenSource) (context.Context, *Client) {
+	ctx := WithCredentials(parent, oauth2.ReuseTokenSource(nil, ts))
+	return ctx, NewClientFromContext(ctx)
+}
+
+// AuthError indicates that the client's credentials could not be
+// obtained or refreshed (e.g., because the refresh token was
+// revoked).
+type AuthError struct {
+	Err error // the error returned by the credentials' token source
+}
+
+func (e *AuthError) Error() string { return "authentication failed: " + e.Err.Error() }
+
+// IsAuthError reports whether err indicates an authentication or
+// authorization failure: either an *AuthError (possibly wrapped, e.g.
+// in a *CallError) or a gRPC error with code Unauthenticated or
+// PermissionDenied.
+func IsAuthError(err error) bool {
+	if _, ok := errorCause(err).(*AuthError); ok {
+		return true
+	}
+	switch ErrorCode(err) {
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return true
+	}
+	return false
+}
+
+// authErrorInterceptor returns the *AuthError that a call's
+// credentials failed with, if any. gRPC reports errors obtaining
+// per-call credentials as status errors (with code Unauthenticated or,
+// in older versions, another code) that do not carry the *AuthError,
+// so contextCredentials records it in the call's context.
+func authErrorInterceptor(next Invoker) Invoker {
+	return func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		var authErr *AuthError
+		result, err := next(context.WithValue(ctx, authErrorKey, &authErr), method, in)
+		if err != nil && authErr != nil {
+			return nil, authErr
+		}
+		return result, err
+	}
+}
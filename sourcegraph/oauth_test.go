@@ -0,0 +1,106 @@
+package sourcegraph
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/net/context"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+type countingTokenSource struct {
+	calls int
+	err   error
+}
+
+func (ts *countingTokenSource) Token() (*oauth2.Token, error) {
+	ts.calls++
+	if ts.err != nil {
+		return nil, ts.err
+	}
+	return &oauth2.Token{AccessToken: "t", TokenType: "Bearer"}, nil
+}
+
+func TestNewClientWithOAuth(t *testing.T) {
+	MockNewClientFromContext(func(ctx context.Context) *Client { return &Client{} })
+	defer RestoreNewClientFromContext()
+
+	ts := &countingTokenSource{}
+	ctx, c := NewClientWithOAuth(context.Background(), ts)
+	if c == nil {
+		t.Fatal("got nil client")
+	}
+	for i := 0; i < 2; i++ {
+		md, err := (contextCredentials{}).GetRequestMetadata(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := md["authorization"], "Bearer t"; got != want {
+			t.Errorf("got authorization %q, want %q", got, want)
+		}
+	}
+	if ts.calls != 1 {
+		t.Errorf("got %d token fetches, want 1 (token should be reused)", ts.calls)
+	}
+
+	ts.err = errors.New("x")
+	_, err := (contextCredentials{}).GetRequestMetadata(WithCredentials(context.Background(), ts))
+	if _, ok := err.(*AuthError); !ok {
+		t.Errorf("got error %v (%T), want *AuthError", err, err)
+	}
+}
+
+// credentialsReposClient is a ReposClient whose Get obtains the call's
+// credentials and fails the way gRPC does if they can't be obtained.
+type credentialsReposClient struct{ ReposClient }
+
+func (credentialsReposClient) Get(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*Repo, error) {
+	if _, err := (contextCredentials{}).GetRequestMetadata(ctx); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "transport: %v", err)
+	}
+	return &Repo{URI: in.URI}, nil
+}
+
+func TestClient_authError(t *testing.T) {
+	c := NewClient(nil)
+	c.Repos.(*CachedReposClient).ReposClient.(*InterceptedReposClient).ReposClient = credentialsReposClient{}
+
+	ts := &countingTokenSource{}
+	ctx := WithCredentials(context.Background(), ts)
+	if _, err := c.Repos.Get(ctx, &RepoSpec{URI: "r"}); err != nil {
+		t.Fatal(err)
+	}
+
+	ts.err = errors.New("x")
+	ctx = WithCredentials(context.Background(), ts)
+	_, err := c.Repos.Get(ctx, &RepoSpec{URI: "r"})
+	if _, ok := err.(*AuthError); !ok {
+		t.Errorf("got error %v (%T), want *AuthError", err, err)
+	}
+	if !IsAuthError(err) {
+		t.Error("got IsAuthError false")
+	}
+}
+
+func TestIsAuthError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{errors.New("x"), false},
+		{&AuthError{Err: errors.New("x")}, true},
+		{&CallError{Err: &AuthError{Err: errors.New("x")}}, true},
+		{grpc.Errorf(codes.Unauthenticated, "x"), true},
+		{grpc.Errorf(codes.PermissionDenied, "x"), true},
+		{&CallError{Err: grpc.Errorf(codes.NotFound, "x")}, false},
+		{&RetryAfterError{Err: &CallError{Err: &AuthError{Err: errors.New("x")}}}, true},
+	}
+	for _, test := range tests {
+		if got := IsAuthError(test.err); got != test.want {
+			t.Errorf("%v: got %v, want %v", test.err, got, test.want)
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package sourcegraph
 
+import (
+	"encoding/base64"
+	"fmt"
+)
+
 const DefaultPerPage = 10
 
 func (o ListOptions) PageOrDefault() int {
@@ -25,3 +30,25 @@ func (o ListOptions) Limit() int { return o.PerPageOrDefault() }
 func (o ListOptions) Offset() int {
 	return (o.PageOrDefault() - 1) * o.PerPageOrDefault()
 }
+
+// UsesCursor reports whether o specifies cursor-based pagination
+// (i.e., whether o.Cursor is set).
+func (o ListOptions) UsesCursor() bool { return o.Cursor != "" }
+
+// EncodeCursor returns an opaque cursor (for use as a NextCursor
+// value) that encodes key, which is typically the sort key of the
+// last item on a page. The server then lists the items after key when
+// it receives the cursor. DecodeCursor is its inverse.
+func EncodeCursor(key string) string {
+	return base64.URLEncoding.EncodeToString([]byte(key))
+}
+
+// DecodeCursor returns the key encoded in a cursor returned by
+// EncodeCursor.
+func DecodeCursor(cursor string) (key string, err error) {
+	b, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", fmt.Errorf("invalid cursor %q", cursor)
+	}
+	return string(b), nil
+}
@@ -0,0 +1,28 @@
+package sourcegraph
+
+import "testing"
+
+func TestCursor(t *testing.T) {
+	for _, key := range []string{"", "github.com/foo/bar", "a\x00b/c?d=e"} {
+		cursor := EncodeCursor(key)
+		key2, err := DecodeCursor(cursor)
+		if err != nil {
+			t.Errorf("%q: %s", key, err)
+			continue
+		}
+		if key2 != key {
+			t.Errorf("%q: got key %q after round-trip", key, key2)
+		}
+	}
+
+	if _, err := DecodeCursor("!"); err == nil {
+		t.Error("got nil error for invalid cursor")
+	}
+
+	if (ListOptions{Page: 2}).UsesCursor() {
+		t.Error("got UsesCursor() == true for page-based options")
+	}
+	if !(ListOptions{Cursor: EncodeCursor("k")}).UsesCursor() {
+		t.Error("got UsesCursor() == false for cursor-based options")
+	}
+}
@@ -1,6 +1,7 @@
 package sourcegraph
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 )
@@ -32,3 +33,26 @@ func ParseOrgSpec(pathComponent string) (OrgSpec, error) {
 	}
 	return OrgSpec{Org: pathComponent}, nil
 }
+
+// Spec returns the TeamSpec that specifies t.
+func (t *Team) Spec() TeamSpec { return TeamSpec{Org: t.Org, Slug: t.Slug} }
+
+// SpecString returns the string representation of the TeamSpec
+// (e.g., "myorg/core-devs"). It is the inverse of ParseTeamSpec.
+func (s *TeamSpec) SpecString() string {
+	return s.Org.SpecString() + "/" + s.Slug
+}
+
+// ParseTeamSpec parses a string generated by (*TeamSpec).SpecString()
+// and returns the equivalent TeamSpec struct.
+func ParseTeamSpec(s string) (TeamSpec, error) {
+	i := strings.LastIndex(s, "/")
+	if i <= 0 || i == len(s)-1 {
+		return TeamSpec{}, fmt.Errorf("invalid team spec %q (want org/team)", s)
+	}
+	org, err := ParseOrgSpec(s[:i])
+	if err != nil {
+		return TeamSpec{}, err
+	}
+	return TeamSpec{Org: org, Slug: s[i+1:]}, nil
+}
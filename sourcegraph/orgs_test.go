@@ -29,3 +29,32 @@ func TestOrgSpec(t *testing.T) {
 		}
 	}
 }
+
+func TestTeamSpec(t *testing.T) {
+	tests := []struct {
+		str  string
+		spec TeamSpec
+	}{
+		{"a/t", TeamSpec{Org: OrgSpec{Org: "a"}, Slug: "t"}},
+		{"$1/core-devs", TeamSpec{Org: OrgSpec{UID: 1}, Slug: "core-devs"}},
+	}
+	for _, test := range tests {
+		spec, err := ParseTeamSpec(test.str)
+		if err != nil {
+			t.Errorf("%q: ParseTeamSpec failed: %s", test.str, err)
+			continue
+		}
+		if spec != test.spec {
+			t.Errorf("%q: got spec %+v, want %+v", test.str, spec, test.spec)
+		}
+		if str := test.spec.SpecString(); str != test.str {
+			t.Errorf("%+v: got str %q, want %q", test.spec, str, test.str)
+		}
+	}
+
+	for _, str := range []string{"", "a", "a/", "/t", "$x/t"} {
+		if _, err := ParseTeamSpec(str); err == nil {
+			t.Errorf("%q: got nil error", str)
+		}
+	}
+}
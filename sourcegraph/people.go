@@ -1,6 +1,11 @@
 package sourcegraph
 
-import "strings"
+import (
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
 
 // ShortName returns the person's Login if nonempty and otherwise
 // returns the portion of Email before the '@'.
@@ -28,3 +33,33 @@ func (p *Person) HasProfile() bool { return !p.Transient() }
 func (p *Person) AvatarURLOfSize(width int) string {
 	return avatarURLOfSize(p.AvatarURL, width)
 }
+
+// ResolvePersonByEmail returns the Person for the registered user
+// whose primary email is email (using Users.GetWithEmail), or a
+// transient Person with only the email set if there is no such user.
+// It is useful for resolving commit author emails (such as those in
+// a delta's affected authors).
+func ResolvePersonByEmail(ctx context.Context, c UsersClient, email string) (*Person, error) {
+	op := &EmailAddr{Email: email}
+	user, err := c.GetWithEmail(ctx, op)
+	if ErrorCode(err) == codes.NotFound {
+		return &Person{PersonSpec: PersonSpec{Email: email}}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	p := user.Person()
+	p.Email = email
+	return p, nil
+}
+
+// Validate returns an *InvalidOptionsError if s's Provider is empty
+// or if neither its ID nor its Login is set.
+func (s *ExternalAccountSpec) Validate() error {
+	if s.Provider == "" {
+		return &InvalidOptionsError{Reason: "external account provider is empty"}
+	}
+	if s.ID == "" && s.Login == "" {
+		return &InvalidOptionsError{Reason: "external account ID or login must be specified"}
+	}
+	return nil
+}
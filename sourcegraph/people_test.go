@@ -1,6 +1,13 @@
 package sourcegraph
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
 
 func TestPersonShortName(t *testing.T) {
 	tests := []struct {
@@ -35,3 +42,52 @@ func TestPersonShortName(t *testing.T) {
 		}
 	}
 }
+
+type getWithEmailUsersClient struct {
+	UsersClient
+	users map[string]*User // email -> user
+}
+
+func (c *getWithEmailUsersClient) GetWithEmail(ctx context.Context, op *EmailAddr, opts ...grpc.CallOption) (*User, error) {
+	if u, ok := c.users[op.Email]; ok {
+		return u, nil
+	}
+	return nil, grpc.Errorf(codes.NotFound, "no user with email %q", op.Email)
+}
+
+func TestResolvePersonByEmail(t *testing.T) {
+	c := &getWithEmailUsersClient{users: map[string]*User{
+		"a@example.com": {UID: 1, Login: "a", Name: "A"},
+	}}
+	tests := map[string]*Person{
+		"a@example.com": {PersonSpec: PersonSpec{UID: 1, Login: "a", Email: "a@example.com"}, FullName: "A"},
+		"b@example.com": {PersonSpec: PersonSpec{Email: "b@example.com"}},
+	}
+	for email, want := range tests {
+		p, err := ResolvePersonByEmail(context.Background(), c, email)
+		if err != nil {
+			t.Errorf("%s: %s", email, err)
+			continue
+		}
+		if !reflect.DeepEqual(p, want) {
+			t.Errorf("%s: got %+v, want %+v", email, p, want)
+		}
+	}
+}
+
+func TestExternalAccountSpec_Validate(t *testing.T) {
+	tests := []struct {
+		spec    ExternalAccountSpec
+		wantErr bool
+	}{
+		{ExternalAccountSpec{Provider: ProviderGitHub, ID: "123"}, false},
+		{ExternalAccountSpec{Provider: ProviderGitHub, Login: "alice"}, false},
+		{ExternalAccountSpec{Provider: ProviderGitHub}, true},
+		{ExternalAccountSpec{ID: "123"}, true},
+	}
+	for _, test := range tests {
+		if err := test.spec.Validate(); (err != nil) != test.wantErr {
+			t.Errorf("%+v: got error %v, want error %v", test.spec, err, test.wantErr)
+		}
+	}
+}
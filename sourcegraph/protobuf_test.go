@@ -73,3 +73,32 @@ func TestProtobuf_Example(t *testing.T) {
 		t.Errorf("got %+v, want %+v", v2, v)
 	}
 }
+
+// TestProtobuf_coreTypes checks that the core API types round-trip
+// through protobuf encoding, which is used for all API calls.
+func TestProtobuf_coreTypes(t *testing.T) {
+	msgs := []proto.Message{
+		&Repo{URI: "r", Name: "n", Description: "d"},
+		&Def{Def: graph.Def{DefKey: graph.DefKey{Repo: "r", Path: "p"}, Name: "n"}, Score: 1.5},
+		&Ref{Ref: graph.Ref{File: "f"}},
+		&Delta{Base: RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, Rev: "a"}, Head: RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, Rev: "b"}, Labels: []string{"l"}},
+		&Build{Repo: "r", CommitID: "c", Attempt: 2, BuildConfig: BuildConfig{Queue: true, Priority: 3}},
+	}
+	for _, v := range msgs {
+		b, err := proto.Marshal(v)
+		if err != nil {
+			t.Errorf("%T: %s", v, err)
+			continue
+		}
+
+		v2 := reflect.New(reflect.TypeOf(v).Elem()).Interface().(proto.Message)
+		if err := proto.Unmarshal(b, v2); err != nil {
+			t.Errorf("%T: %s", v, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(v, v2) {
+			t.Errorf("%T: got %+v, want %+v", v, v2, v)
+		}
+	}
+}
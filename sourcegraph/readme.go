@@ -0,0 +1,72 @@
+package sourcegraph
+
+import (
+	"path"
+	"strings"
+)
+
+// Readme formats, for Readme.Format.
+const (
+	ReadmeFormatMarkdown = "markdown"
+	ReadmeFormatRST      = "rst"
+	ReadmeFormatAsciiDoc = "asciidoc"
+	ReadmeFormatOrg      = "org"
+	ReadmeFormatText     = "text"
+)
+
+// readmeFormatsByExt maps readme file extensions (lowercased) to
+// their formats.
+var readmeFormatsByExt = map[string]string{
+	".md":       ReadmeFormatMarkdown,
+	".markdown": ReadmeFormatMarkdown,
+	".mdown":    ReadmeFormatMarkdown,
+	".rst":      ReadmeFormatRST,
+	".adoc":     ReadmeFormatAsciiDoc,
+	".asciidoc": ReadmeFormatAsciiDoc,
+	".org":      ReadmeFormatOrg,
+	".txt":      ReadmeFormatText,
+	"":          ReadmeFormatText,
+}
+
+// ReadmeFormatForPath returns the readme format of the file at p, and
+// whether p is the path of a readme file at all (i.e., its base name
+// is "README", case-insensitively, with a known extension).
+func ReadmeFormatForPath(p string) (format string, ok bool) {
+	name := strings.ToLower(path.Base(p))
+	ext := path.Ext(name)
+	if strings.TrimSuffix(name, ext) != "readme" {
+		return "", false
+	}
+	format, ok = readmeFormatsByExt[ext]
+	return format, ok
+}
+
+// FindReadme returns the path of the readme file among the given
+// file paths (typically the entries of a repository's root
+// directory), or the empty string if there is none. If there are
+// multiple readme files, it prefers the one with the richest format
+// (e.g., README.md over README.txt), then the first one.
+func FindReadme(paths []string) string {
+	var best string
+	bestRank := len(readmeFormatRank)
+	for _, p := range paths {
+		format, ok := ReadmeFormatForPath(p)
+		if !ok {
+			continue
+		}
+		if rank := readmeFormatRank[format]; rank < bestRank {
+			best, bestRank = p, rank
+		}
+	}
+	return best
+}
+
+// readmeFormatRank ranks readme formats by preference (lowest first)
+// for FindReadme.
+var readmeFormatRank = map[string]int{
+	ReadmeFormatMarkdown: 0,
+	ReadmeFormatRST:      1,
+	ReadmeFormatAsciiDoc: 2,
+	ReadmeFormatOrg:      3,
+	ReadmeFormatText:     4,
+}
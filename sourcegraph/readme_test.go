@@ -0,0 +1,45 @@
+package sourcegraph
+
+import "testing"
+
+func TestReadmeFormatForPath(t *testing.T) {
+	tests := []struct {
+		path   string
+		format string
+		ok     bool
+	}{
+		{"README.md", ReadmeFormatMarkdown, true},
+		{"docs/Readme.markdown", ReadmeFormatMarkdown, true},
+		{"README.rst", ReadmeFormatRST, true},
+		{"readme.adoc", ReadmeFormatAsciiDoc, true},
+		{"README", ReadmeFormatText, true},
+		{"README.txt", ReadmeFormatText, true},
+		{"README.html", "", false},
+		{"READYOU.md", "", false},
+		{"main.go", "", false},
+	}
+	for _, test := range tests {
+		format, ok := ReadmeFormatForPath(test.path)
+		if format != test.format || ok != test.ok {
+			t.Errorf("%q: got (%q, %v), want (%q, %v)", test.path, format, ok, test.format, test.ok)
+		}
+	}
+}
+
+func TestFindReadme(t *testing.T) {
+	tests := []struct {
+		paths []string
+		want  string
+	}{
+		{nil, ""},
+		{[]string{"main.go", "LICENSE"}, ""},
+		{[]string{"README.txt", "README.md", "main.go"}, "README.md"},
+		{[]string{"README", "readme.rst"}, "readme.rst"},
+		{[]string{"README.md", "Readme.markdown"}, "README.md"},
+	}
+	for _, test := range tests {
+		if got := FindReadme(test.paths); got != test.want {
+			t.Errorf("%v: got %q, want %q", test.paths, got, test.want)
+		}
+	}
+}
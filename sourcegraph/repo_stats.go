@@ -0,0 +1,86 @@
+package sourcegraph
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// RepoStatType is the name of a repository stat.
+type RepoStatType string
+
+// Known repository stats.
+const (
+	// StatCommits is the number of commits.
+	StatCommits RepoStatType = "commits"
+
+	// StatAuthors is the number of distinct commit authors.
+	StatAuthors RepoStatType = "authors"
+
+	// StatDefs is the number of defs defined in the repository.
+	StatDefs RepoStatType = "defs"
+
+	// StatRefs is the number of refs in the repository (to defs in
+	// the same repository or in others).
+	StatRefs RepoStatType = "refs"
+
+	// StatXRefs is the number of refs from other repositories to defs
+	// in the repository.
+	StatXRefs RepoStatType = "xrefs"
+)
+
+// RepoStatTypes lists all known repository stats.
+var RepoStatTypes = []RepoStatType{StatCommits, StatAuthors, StatDefs, StatRefs, StatXRefs}
+
+// Valid reports whether t is a known repository stat.
+func (t RepoStatType) Valid() bool {
+	for _, t2 := range RepoStatTypes {
+		if t == t2 {
+			return true
+		}
+	}
+	return false
+}
+
+// RepoStats maps repository stat names to their values. It has the
+// same underlying type as the Stats field of RepoStatsPeriod, so
+// either may be assigned to the other.
+type RepoStats map[string]int64
+
+// Get returns the value of stat t, or 0 if it is not set.
+func (s RepoStats) Get(t RepoStatType) int64 { return s[string(t)] }
+
+// Set sets the value of stat t.
+func (s RepoStats) Set(t RepoStatType, v int64) { s[string(t)] = v }
+
+// Validate returns an error if s contains an unknown stat.
+func (s RepoStats) Validate() error {
+	for k := range s {
+		if !RepoStatType(k).Valid() {
+			return fmt.Errorf("unknown repo stat %q", k)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. It returns an error if s
+// contains an unknown stat.
+func (s RepoStats) MarshalJSON() ([]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(map[string]int64(s))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It returns an error if
+// the data contains an unknown stat.
+func (s *RepoStats) UnmarshalJSON(data []byte) error {
+	var m map[string]int64
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	if err := RepoStats(m).Validate(); err != nil {
+		return err
+	}
+	*s = m
+	return nil
+}
@@ -0,0 +1,44 @@
+package sourcegraph
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRepoStats(t *testing.T) {
+	p := RepoStatsPeriod{Stats: map[string]int64{"commits": 3}}
+	var s RepoStats = p.Stats
+	if got, want := s.Get(StatCommits), int64(3); got != want {
+		t.Errorf("got commits %d, want %d", got, want)
+	}
+	if got := s.Get(StatXRefs); got != 0 {
+		t.Errorf("got xrefs %d, want 0", got)
+	}
+	s.Set(StatXRefs, 7)
+	if got, want := p.Stats["xrefs"], int64(7); got != want {
+		t.Errorf("got period xrefs %d, want %d", got, want)
+	}
+}
+
+func TestRepoStats_JSON(t *testing.T) {
+	s := RepoStats{"defs": 1, "refs": 2}
+	b, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s2 RepoStats
+	if err := json.Unmarshal(b, &s2); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(s2, s) {
+		t.Errorf("got %v, want %v", s2, s)
+	}
+
+	if _, err := json.Marshal(RepoStats{"bogus": 1}); err == nil {
+		t.Error("got nil error marshaling unknown stat, want error")
+	}
+	if err := json.Unmarshal([]byte(`{"bogus":1}`), &s2); err == nil {
+		t.Error("got nil error unmarshaling unknown stat, want error")
+	}
+}
@@ -0,0 +1,42 @@
+package sourcegraph
+
+// Rollup computes the combined state of a commit's statuses,
+// accounting only for the statuses whose context is in required (or
+// all statuses, if required is empty). If there are multiple statuses
+// for a context, the most recently updated one is used.
+//
+// The combined state is "failure" if any of the statuses is "failure"
+// or "error"; otherwise it is "pending" if any of the statuses is
+// "pending", if any required context has no status, or if there are
+// no statuses at all; otherwise it is "success". Rollup also returns
+// the required contexts that have no status.
+func Rollup(statuses []*RepoStatus, required []string) (state string, missing []string) {
+	latest := map[string]*RepoStatus{}
+	for _, st := range statuses {
+		if len(required) > 0 && !stringInSlice(st.Context, required) {
+			continue
+		}
+		if prev, ok := latest[st.Context]; !ok || prev.UpdatedAt.Time().Before(st.UpdatedAt.Time()) {
+			latest[st.Context] = st
+		}
+	}
+	for _, ctx := range required {
+		if _, ok := latest[ctx]; !ok && !stringInSlice(ctx, missing) {
+			missing = append(missing, ctx)
+		}
+	}
+
+	state = "success"
+	if len(latest) == 0 || len(missing) > 0 {
+		state = "pending"
+	}
+	for _, st := range latest {
+		switch st.State {
+		case "failure", "error":
+			return "failure", missing
+		case "pending":
+			state = "pending"
+		}
+	}
+	return state, missing
+}
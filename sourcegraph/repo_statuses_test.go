@@ -0,0 +1,69 @@
+package sourcegraph
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"sourcegraph.com/sqs/pbtypes"
+)
+
+func TestRollup(t *testing.T) {
+	at := func(sec int64) pbtypes.Timestamp { return pbtypes.NewTimestamp(time.Unix(sec, 0)) }
+	status := func(ctx, state string, updated int64) *RepoStatus {
+		return &RepoStatus{Context: ctx, State: state, UpdatedAt: at(updated)}
+	}
+
+	tests := map[string]struct {
+		statuses    []*RepoStatus
+		required    []string
+		wantState   string
+		wantMissing []string
+	}{
+		"no statuses": {
+			wantState: "pending",
+		},
+		"all success": {
+			statuses:  []*RepoStatus{status("a", "success", 1), status("b", "success", 1)},
+			wantState: "success",
+		},
+		"any failure": {
+			statuses:  []*RepoStatus{status("a", "success", 1), status("b", "error", 1), status("c", "pending", 1)},
+			wantState: "failure",
+		},
+		"any pending": {
+			statuses:  []*RepoStatus{status("a", "success", 1), status("b", "pending", 1)},
+			wantState: "pending",
+		},
+		"latest status per context": {
+			statuses:  []*RepoStatus{status("a", "success", 2), status("a", "failure", 1)},
+			wantState: "success",
+		},
+		"only required contexts": {
+			statuses:  []*RepoStatus{status("a", "success", 1), status("b", "failure", 1)},
+			required:  []string{"a"},
+			wantState: "success",
+		},
+		"missing required context": {
+			statuses:    []*RepoStatus{status("a", "success", 1)},
+			required:    []string{"a", "b"},
+			wantState:   "pending",
+			wantMissing: []string{"b"},
+		},
+		"failure takes precedence over missing": {
+			statuses:    []*RepoStatus{status("a", "failure", 1)},
+			required:    []string{"a", "b"},
+			wantState:   "failure",
+			wantMissing: []string{"b"},
+		},
+	}
+	for label, test := range tests {
+		state, missing := Rollup(test.statuses, test.required)
+		if state != test.wantState {
+			t.Errorf("%s: got state %q, want %q", label, state, test.wantState)
+		}
+		if !reflect.DeepEqual(missing, test.wantMissing) {
+			t.Errorf("%s: got missing %v, want %v", label, missing, test.wantMissing)
+		}
+	}
+}
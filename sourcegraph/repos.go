@@ -1,11 +1,18 @@
 package sourcegraph
 
 import (
+	"errors"
+	"fmt"
 	"net/url"
-
+	"reflect"
+	"regexp"
 	"strings"
+	"sync"
+	"time"
 
+	"golang.org/x/net/context"
 	"sourcegraph.com/sourcegraph/go-sourcegraph/spec"
+	"sourcegraph.com/sourcegraph/go-vcs/vcs"
 )
 
 // IsGitHubRepo returns true iff this repository is hosted on GitHub.
@@ -35,6 +42,16 @@ func (r *Repo) GitHubHTMLURL() string {
 	return ""
 }
 
+// ProviderHTMLURL returns the URL to the repository's page on its
+// code host (e.g., https://gitlab.com/foo/bar), if it's on a known
+// code host (see SetProviderHost). Otherwise it returns the empty string.
+func (r *Repo) ProviderHTMLURL() string {
+	if RepoProvider(r.URI) == "" {
+		return ""
+	}
+	return "https://" + r.URI
+}
+
 // RepoSpec returns the RepoSpec that specifies r.
 func (r *Repo) RepoSpec() RepoSpec {
 	return RepoSpec{URI: r.URI}
@@ -58,16 +75,74 @@ func (s RepoSpec) RouteVars() map[string]string {
 	return map[string]string{"Repo": s.SpecString()}
 }
 
+// Validate returns an error if s.URI is not a valid repository URI.
+func (s RepoSpec) Validate() error {
+	_, err := spec.ParseRepo(s.URI)
+	return err
+}
+
 // ParseRepoSpec parses a string generated by (RepoSpec).SpecString()
 // and returns the equivalent RepoSpec struct.
+//
+// It also accepts clone URLs, such as
+// "https://github.com/foo/bar.git", "ssh://git@github.com/foo/bar",
+// and "git@github.com:foo/bar.git", and returns the RepoSpec for the
+// repository's URI ("github.com/foo/bar").
+//
+// Repository URIs are returned as is; use CanonicalRepoURI to compare
+// URIs on code hosts that are not case-sensitive.
 func ParseRepoSpec(s string) (RepoSpec, error) {
-	repo, err := spec.ParseRepo(s)
+	repo, err := spec.ParseRepo(repoURIFromCloneURL(s))
 	if err != nil {
 		return RepoSpec{}, err
 	}
 	return RepoSpec{URI: repo}, nil
 }
 
+// scpCloneURL matches SCP-style clone URLs ("user@host:path").
+var scpCloneURL = regexp.MustCompile(`^[\w.-]+@([\w.-]+):(.+)$`)
+
+// repoURIFromCloneURL returns the repository URI ("host/path") for a
+// clone URL. If s is not a clone URL, it is returned unchanged.
+func repoURIFromCloneURL(s string) string {
+	var host, path string
+	if m := scpCloneURL.FindStringSubmatch(s); m != nil {
+		host, path = m[1], m[2]
+	} else if strings.Contains(s, "://") {
+		u, err := url.Parse(s)
+		if err != nil {
+			return s
+		}
+		host, path = u.Host, u.Path
+	} else {
+		return s
+	}
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	return host + "/" + path
+}
+
+// CanonicalRepoURI returns the canonical form of a repository URI on a
+// known code host (see SetProviderHost): the host is lowercased and a
+// ".git" suffix is removed (e.g., "GitLab.com/foo/bar.git" becomes
+// "gitlab.com/foo/bar"). Bitbucket URIs are lowercased entirely,
+// because Bitbucket repository names are case-insensitive. Other URIs
+// are returned unchanged.
+func CanonicalRepoURI(uri string) string {
+	provider := RepoProvider(uri)
+	if provider == "" {
+		return uri
+	}
+	host, path := uri, ""
+	if i := strings.Index(uri, "/"); i != -1 {
+		host, path = uri[:i], uri[i:]
+	}
+	path = strings.TrimSuffix(path, ".git")
+	if provider == ProviderBitbucket {
+		path = strings.ToLower(path)
+	}
+	return strings.ToLower(host) + path
+}
+
 // UnmarshalRepoSpec marshals a map containing route variables
 // generated by (*RepoSpec).RouteVars() and returns the
 // equivalent RepoSpec struct.
@@ -101,15 +176,60 @@ func (s RepoRevSpec) Resolved() bool {
 	return s.Rev != "" && len(s.CommitID) == 40
 }
 
+var (
+	revPattern      = regexp.MustCompile("^" + spec.RevPattern + "$")
+	commitIDPattern = regexp.MustCompile("^" + spec.CommitPattern + "$")
+)
+
+// Validate returns an error if s is malformed: if its URI is invalid,
+// if its Rev is not a valid revision specifier (e.g., if it contains
+// "==="), or if its CommitID is set but is not an absolute (40-char)
+// commit ID.
+func (s RepoRevSpec) Validate() error {
+	if err := s.RepoSpec.Validate(); err != nil {
+		return err
+	}
+	if s.Rev != "" && !revPattern.MatchString(s.Rev) {
+		return spec.InvalidError{Type: "RepoRevSpec", Input: s.Rev, Err: errors.New("invalid revision")}
+	}
+	if s.CommitID != "" && !commitIDPattern.MatchString(s.CommitID) {
+		return spec.InvalidError{Type: "RepoRevSpec", Input: s.CommitID, Err: errors.New("invalid commit ID")}
+	}
+	return nil
+}
+
+// Resolve returns a copy of s whose CommitID is the absolute commit
+// ID that s.Rev (or the default branch, if s.Rev is empty) currently
+// resolves to, according to c. If s already has a CommitID, it is
+// returned unchanged.
+func (s RepoRevSpec) Resolve(ctx context.Context, c ReposClient) (RepoRevSpec, error) {
+	if s.CommitID != "" {
+		return s, s.Validate()
+	}
+	commit, err := c.GetCommit(ctx, &s)
+	if err != nil {
+		return RepoRevSpec{}, err
+	}
+	s.CommitID = string(commit.ID)
+	return s, nil
+}
+
 // UnmarshalRepoRevSpec marshals a map containing route variables
 // generated by (RepoRevSpec).RouteVars() and returns the equivalent
-// RepoRevSpec struct.
+// RepoRevSpec struct. It returns an error if the route variables
+// specify a malformed RepoRevSpec (e.g., "repo@rev===badcommit").
 func UnmarshalRepoRevSpec(routeVars map[string]string) (RepoRevSpec, error) {
 	repo, err := UnmarshalRepoSpec(routeVars)
 	if err != nil {
 		return RepoRevSpec{}, err
 	}
 
+	// FixResolvedRevVars leaves the ResolvedRev var in place if it
+	// couldn't be parsed.
+	if rrev, ok := routeVars["ResolvedRev"]; ok && rrev != "" {
+		return RepoRevSpec{}, spec.InvalidError{Type: "ResolvedRevSpec", Input: rrev}
+	}
+
 	rrspec := RepoRevSpec{RepoSpec: repo}
 	if revStr, ok := routeVars["Rev"]; ok {
 		rrspec.Rev = revStr
@@ -117,5 +237,357 @@ func UnmarshalRepoRevSpec(routeVars map[string]string) (RepoRevSpec, error) {
 	if commitStr, ok := routeVars["CommitID"]; ok {
 		rrspec.CommitID = commitStr
 	}
-	return rrspec, err
+	if err := rrspec.Validate(); err != nil {
+		return RepoRevSpec{}, err
+	}
+	return rrspec, nil
+}
+
+// Repository providers (code hosts), for ReposCreateOp.Provider and
+// ExternalRepo.Provider.
+const (
+	ProviderGitHub    = "github"
+	ProviderGitLab    = "gitlab"
+	ProviderBitbucket = "bitbucket"
+	ProviderLocal     = "local"
+)
+
+var (
+	providerHostsMu sync.RWMutex
+
+	// providerHosts maps the hosts of known code hosts to their
+	// providers.
+	providerHosts = map[string]string{
+		"github.com":    ProviderGitHub,
+		"gitlab.com":    ProviderGitLab,
+		"bitbucket.org": ProviderBitbucket,
+	}
+)
+
+// SetProviderHost registers host as a code host of the given provider
+// (e.g., SetProviderHost("gitlab.example.com", ProviderGitLab) for a
+// self-hosted GitLab instance). If provider is empty, host is no
+// longer a known code host. It is safe to call concurrently with
+// RepoProvider and CanonicalRepoURI.
+func SetProviderHost(host, provider string) {
+	providerHostsMu.Lock()
+	defer providerHostsMu.Unlock()
+	if provider == "" {
+		delete(providerHosts, strings.ToLower(host))
+	} else {
+		providerHosts[strings.ToLower(host)] = provider
+	}
+}
+
+// RepoProvider returns the provider of the repository with the given
+// URI, according to its host. It returns the empty string if the host
+// is not a known code host (see SetProviderHost).
+func RepoProvider(uri string) string {
+	host := uri
+	if i := strings.Index(uri, "/"); i != -1 {
+		host = uri[:i]
+	}
+	providerHostsMu.RLock()
+	defer providerHostsMu.RUnlock()
+	return providerHosts[strings.ToLower(host)]
+}
+
+// ProviderOrDefault returns op.Provider if it is set. Otherwise it
+// returns ProviderLocal for hosted repositories and the provider
+// determined from the host of op.CloneURL for mirrors (or the empty
+// string if the host is not a known code host).
+func (op *ReposCreateOp) ProviderOrDefault() string {
+	if op.Provider != "" {
+		return op.Provider
+	}
+	if op.CloneURL == "" {
+		return ProviderLocal
+	}
+	return RepoProvider(repoURIFromCloneURL(op.CloneURL))
+}
+
+// Validate returns an *InvalidOptionsError if op is inconsistent:
+// if its provider is unknown, if a provider other than "local" has no
+// ExternalID, if an InstallationID is given for a provider other than
+// GitHub, or if a mirror has no CloneURL.
+func (op *ReposCreateOp) Validate() error {
+	if op.Mirror && op.CloneURL == "" {
+		return &InvalidOptionsError{Reason: "mirror repository must have a clone URL"}
+	}
+	switch op.Provider {
+	case "", ProviderLocal:
+	case ProviderGitHub, ProviderGitLab, ProviderBitbucket:
+		if op.ExternalID == "" {
+			return &InvalidOptionsError{Reason: fmt.Sprintf("repository from provider %q must have an external ID", op.Provider)}
+		}
+	default:
+		return &InvalidOptionsError{Reason: fmt.Sprintf("unknown repository provider %q", op.Provider)}
+	}
+	if op.InstallationID != 0 && op.ProviderOrDefault() != ProviderGitHub {
+		return &InvalidOptionsError{Reason: "installation ID is only valid for GitHub repositories"}
+	}
+	return nil
+}
+
+// Sort fields for RepoListOptions.Sort.
+const (
+	RepoSortURI     = "uri"
+	RepoSortName    = "name"
+	RepoSortCreated = "created"
+	RepoSortUpdated = "updated"
+	RepoSortPushed  = "pushed"
+)
+
+// A RepoSortKey is a single field of a multi-field repository sort
+// order.
+type RepoSortKey struct {
+	Field string // one of the RepoSort* constants
+	Desc  bool   // sort in descending order
+}
+
+// RepoSortKeys is a multi-field repository sort order.
+type RepoSortKeys []RepoSortKey
+
+// SortKeys returns the sort keys specified by o.Sort and o.Direction.
+// It returns an error if o.Sort contains an unknown field.
+func (o *RepoListOptions) SortKeys() (RepoSortKeys, error) {
+	if o.Sort == "" {
+		return nil, nil
+	}
+	desc := o.Direction == "desc"
+	var keys RepoSortKeys
+	for _, f := range strings.Split(o.Sort, ",") {
+		k := RepoSortKey{Field: strings.TrimPrefix(f, "-"), Desc: desc}
+		if strings.HasPrefix(f, "-") {
+			k.Desc = !desc
+		}
+		switch k.Field {
+		case RepoSortURI, RepoSortName, RepoSortCreated, RepoSortUpdated, RepoSortPushed:
+		default:
+			return nil, fmt.Errorf("unknown repo sort field %q", k.Field)
+		}
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
+
+// Less reports whether a sorts before b according to keys.
+func (keys RepoSortKeys) Less(a, b *Repo) bool {
+	for _, k := range keys {
+		var c int
+		switch k.Field {
+		case RepoSortURI:
+			c = strings.Compare(a.URI, b.URI)
+		case RepoSortName:
+			c = strings.Compare(a.Name, b.Name)
+		case RepoSortCreated:
+			c = compareTimes(a.CreatedAt.Time(), b.CreatedAt.Time())
+		case RepoSortUpdated:
+			c = compareTimes(a.UpdatedAt.Time(), b.UpdatedAt.Time())
+		case RepoSortPushed:
+			c = compareTimes(a.PushedAt.Time(), b.PushedAt.Time())
+		}
+		if c != 0 {
+			return (c < 0) != k.Desc
+		}
+	}
+	return false
+}
+
+func compareTimes(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
+
+// Host returns the host component of the repository's URI (e.g.,
+// "github.com" for "github.com/foo/bar"). If the URI has only one
+// path component, it returns the empty string.
+func (r *Repo) Host() string {
+	if i := strings.Index(r.URI, "/"); i != -1 {
+		return r.URI[:i]
+	}
+	return ""
+}
+
+// Matches reports whether r satisfies the Name, URIs, NoFork,
+// Language, Host, PushedAfter, and PushedBefore filters in o. The
+// other filters (such as Query) depend on the server's data and are
+// ignored.
+func (o *RepoListOptions) Matches(r *Repo) bool {
+	if o == nil {
+		return true
+	}
+	if o.Name != "" && o.Name != r.Name {
+		return false
+	}
+	if len(o.URIs) > 0 {
+		found := false
+		for _, uri := range o.URIs {
+			if uri == r.URI {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if o.NoFork && r.Fork {
+		return false
+	}
+	if o.Language != "" && !strings.EqualFold(o.Language, r.Language) {
+		return false
+	}
+	if o.Host != "" && o.Host != r.Host() {
+		return false
+	}
+	if o.PushedAfter != nil && !r.PushedAt.Time().After(o.PushedAfter.Time()) {
+		return false
+	}
+	if o.PushedBefore != nil && !r.PushedAt.Time().Before(o.PushedBefore.Time()) {
+		return false
+	}
+	return true
+}
+
+// SelectRepoFields returns a copy of r with only the given fields
+// (and the URI) set, as specified by RepoListOptions.Fields. Fields
+// are named by their JSON names (e.g., "default_branch"). If fields
+// is empty, a copy of r with all fields is returned. It returns an
+// error if a field name is unknown.
+func SelectRepoFields(r *Repo, fields []string) (*Repo, error) {
+	if len(fields) == 0 {
+		cpy := *r
+		return &cpy, nil
+	}
+	src := reflect.ValueOf(r).Elem()
+	sel := &Repo{URI: r.URI}
+	dst := reflect.ValueOf(sel).Elem()
+	for _, f := range fields {
+		i, ok := repoFieldIndex[f]
+		if !ok {
+			return nil, fmt.Errorf("unknown repo field %q", f)
+		}
+		dst.Field(i).Set(src.Field(i))
+	}
+	return sel, nil
+}
+
+// repoFieldIndex maps the JSON names of Repo's fields to their
+// indexes.
+var repoFieldIndex = func() map[string]int {
+	m := map[string]int{}
+	t := reflect.TypeOf(Repo{})
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			m[name] = i
+		}
+	}
+	return m
+}()
+
+// NextPageOptions returns a copy of opt that lists the page of
+// commits after l (using opt.AfterCommit), or nil if l is the last
+// page.
+func (l *CommitList) NextPageOptions(opt RepoListCommitsOptions) *RepoListCommitsOptions {
+	if !l.HasMore || len(l.Commits) == 0 {
+		return nil
+	}
+	opt.AfterCommit = string(l.Commits[len(l.Commits)-1].ID)
+	opt.Page = 0
+	return &opt
+}
+
+// Reasons for a CommitVerification result.
+const (
+	CommitVerificationReasonValid           = "valid"
+	CommitVerificationReasonUnsigned        = "unsigned"
+	CommitVerificationReasonUnknownKey      = "unknown_key"
+	CommitVerificationReasonBadSignature    = "bad_signature"
+	CommitVerificationReasonExpiredKey      = "expired_key"
+	CommitVerificationReasonUnverifiedEmail = "unverified_email"
+)
+
+// Unverified returns the commits in l whose signatures are not
+// verified (including those that l.Verifications has no result for).
+// It is only meaningful if l was listed with
+// RepoListCommitsOptions.VerifySignatures.
+func (l *CommitList) Unverified() []*vcs.Commit {
+	var unverified []*vcs.Commit
+	for _, c := range l.Commits {
+		if v := l.Verifications[string(c.ID)]; v == nil || !v.Verified {
+			unverified = append(unverified, c)
+		}
+	}
+	return unverified
+}
+
+// ContributorsByCommits sorts contributors by descending commit count
+// (breaking ties by login or email).
+type ContributorsByCommits []*Contributor
+
+func (v ContributorsByCommits) Len() int      { return len(v) }
+func (v ContributorsByCommits) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v ContributorsByCommits) Less(i, j int) bool {
+	if v[i].Commits != v[j].Commits {
+		return v[i].Commits > v[j].Commits
+	}
+	return v[i].ShortName() < v[j].ShortName()
+}
+
+// PrimaryLanguage returns the name of the language with the most
+// bytes in the inventory, or the empty string if there are no
+// languages.
+func (inv *Inventory) PrimaryLanguage() string {
+	var primary *InventoryLanguage
+	for _, lang := range inv.Languages {
+		if primary == nil || lang.TotalBytes > primary.TotalBytes {
+			primary = lang
+		}
+	}
+	if primary == nil {
+		return ""
+	}
+	return primary.Name
+}
+
+// LanguageFraction returns the fraction (from 0 to 1) of the
+// inventory's bytes that are in the named language.
+func (inv *Inventory) LanguageFraction(name string) float64 {
+	var total, n int64
+	for _, lang := range inv.Languages {
+		total += lang.TotalBytes
+		if lang.Name == name {
+			n += lang.TotalBytes
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total)
+}
+
+// Normalize returns p with the permissions implied by its other
+// permissions set: admin implies write, and write implies read.
+func (p RepoPermissions) Normalize() RepoPermissions {
+	if p.Admin {
+		p.Write = true
+	}
+	if p.Write {
+		p.Read = true
+	}
+	return p
+}
+
+// Includes reports whether p grants every permission that q grants
+// (taking implied permissions into account).
+func (p RepoPermissions) Includes(q RepoPermissions) bool {
+	p, q = p.Normalize(), q.Normalize()
+	return (p.Read || !q.Read) && (p.Write || !q.Write) && (p.Admin || !q.Admin)
 }
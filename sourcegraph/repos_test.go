@@ -1,6 +1,14 @@
 package sourcegraph
 
-import "testing"
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"sourcegraph.com/sourcegraph/go-vcs/vcs"
+	"sourcegraph.com/sqs/pbtypes"
+)
 
 const commitID = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
 
@@ -25,3 +33,223 @@ func TestRepo_GitHubHTMLURL(t *testing.T) {
 		}
 	}
 }
+
+func TestContributorsByCommits(t *testing.T) {
+	cs := []*Contributor{
+		{Person: Person{PersonSpec: PersonSpec{Login: "b"}}, Commits: 3},
+		{Person: Person{PersonSpec: PersonSpec{Email: "c@example.com"}}, Commits: 7},
+		{Person: Person{PersonSpec: PersonSpec{Login: "a"}}, Commits: 3},
+	}
+	sort.Sort(ContributorsByCommits(cs))
+
+	var got []string
+	for _, c := range cs {
+		got = append(got, c.ShortName())
+	}
+	if want := []string{"c", "a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestInventory(t *testing.T) {
+	inv := &Inventory{
+		Languages: []*InventoryLanguage{
+			{Name: "Go", TotalBytes: 300},
+			{Name: "Python", TotalBytes: 600},
+			{Name: "Shell", TotalBytes: 100},
+		},
+	}
+	if got, want := inv.PrimaryLanguage(), "Python"; got != want {
+		t.Errorf("got PrimaryLanguage %q, want %q", got, want)
+	}
+	if got, want := inv.LanguageFraction("Go"), 0.3; got != want {
+		t.Errorf("got LanguageFraction(Go) %v, want %v", got, want)
+	}
+	if got, want := inv.LanguageFraction("Java"), 0.0; got != want {
+		t.Errorf("got LanguageFraction(Java) %v, want %v", got, want)
+	}
+
+	empty := &Inventory{}
+	if got := empty.PrimaryLanguage(); got != "" {
+		t.Errorf("got PrimaryLanguage %q for empty inventory, want empty", got)
+	}
+	if got := empty.LanguageFraction("Go"); got != 0 {
+		t.Errorf("got LanguageFraction %v for empty inventory, want 0", got)
+	}
+}
+
+func TestRepoPermissions_Includes(t *testing.T) {
+	var (
+		none  = RepoPermissions{}
+		read  = RepoPermissions{Read: true}
+		write = RepoPermissions{Write: true}
+		admin = RepoPermissions{Admin: true}
+	)
+	tests := []struct {
+		p, q RepoPermissions
+		want bool
+	}{
+		{none, none, true},
+		{none, read, false},
+		{read, read, true},
+		{read, write, false},
+		{write, read, true},
+		{write, admin, false},
+		{admin, read, true},
+		{admin, write, true},
+		{admin, RepoPermissions{Read: true, Write: true, Admin: true}, true},
+	}
+	for _, test := range tests {
+		if got := test.p.Includes(test.q); got != test.want {
+			t.Errorf("%+v includes %+v: got %v, want %v", test.p, test.q, got, test.want)
+		}
+	}
+}
+
+func TestRepoListOptions_Matches(t *testing.T) {
+	t0 := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	ts := func(d time.Duration) *pbtypes.Timestamp {
+		v := pbtypes.NewTimestamp(t0.Add(d))
+		return &v
+	}
+	repo := &Repo{URI: "github.com/foo/bar", Name: "bar", Language: "Go", PushedAt: *ts(0)}
+
+	tests := []struct {
+		opt  *RepoListOptions
+		want bool
+	}{
+		{nil, true},
+		{&RepoListOptions{}, true},
+		{&RepoListOptions{Language: "go"}, true},
+		{&RepoListOptions{Language: "Java"}, false},
+		{&RepoListOptions{Host: "github.com"}, true},
+		{&RepoListOptions{Host: "github"}, false},
+		{&RepoListOptions{PushedAfter: ts(-time.Hour), PushedBefore: ts(time.Hour)}, true},
+		{&RepoListOptions{PushedAfter: ts(time.Hour)}, false},
+		{&RepoListOptions{PushedBefore: ts(-time.Hour)}, false},
+		{&RepoListOptions{URIs: []string{"a", "github.com/foo/bar"}}, true},
+		{&RepoListOptions{URIs: []string{"a"}}, false},
+		{&RepoListOptions{Name: "baz"}, false},
+	}
+	for _, test := range tests {
+		if got := test.opt.Matches(repo); got != test.want {
+			t.Errorf("%+v: got %v, want %v", test.opt, got, test.want)
+		}
+	}
+}
+
+func TestRepoListOptions_SortKeys(t *testing.T) {
+	opt := &RepoListOptions{Sort: "pushed,-name", Direction: "desc"}
+	keys, err := opt.SortKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := RepoSortKeys{{Field: RepoSortPushed, Desc: true}, {Field: RepoSortName}}
+	if !reflect.DeepEqual(keys, want) {
+		t.Errorf("got keys %+v, want %+v", keys, want)
+	}
+
+	t0 := pbtypes.NewTimestamp(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))
+	t1 := pbtypes.NewTimestamp(time.Date(2015, 1, 2, 0, 0, 0, 0, time.UTC))
+	a := &Repo{Name: "a", PushedAt: t0}
+	b := &Repo{Name: "b", PushedAt: t1}
+	c := &Repo{Name: "c", PushedAt: t1}
+	if !keys.Less(b, a) || keys.Less(a, b) {
+		t.Error("want more recently pushed repo first")
+	}
+	if !keys.Less(b, c) || keys.Less(c, b) {
+		t.Error("want repos pushed at the same time sorted by name ascending")
+	}
+
+	if _, err := (&RepoListOptions{Sort: "stars"}).SortKeys(); err == nil {
+		t.Error("got nil error for unknown sort field")
+	}
+}
+
+func TestSelectRepoFields(t *testing.T) {
+	repo := &Repo{URI: "r", Name: "n", Description: "d", DefaultBranch: "master", Language: "Go"}
+
+	sel, err := SelectRepoFields(repo, []string{"name", "default_branch"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&Repo{URI: "r", Name: "n", DefaultBranch: "master"}); !reflect.DeepEqual(sel, want) {
+		t.Errorf("got %+v, want %+v", sel, want)
+	}
+
+	all, err := SelectRepoFields(repo, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(all, repo) || all == repo {
+		t.Errorf("got %+v, want a copy of %+v", all, repo)
+	}
+
+	if _, err := SelectRepoFields(repo, []string{"nonexistent"}); err == nil {
+		t.Error("got nil error for unknown field")
+	}
+}
+
+func TestReposCreateOp_Validate(t *testing.T) {
+	tests := []struct {
+		op           ReposCreateOp
+		wantProvider string
+		wantErr      bool
+	}{
+		{ReposCreateOp{URI: "r"}, ProviderLocal, false},
+		{ReposCreateOp{URI: "r", Mirror: true, CloneURL: "https://gitlab.com/a/b.git"}, ProviderGitLab, false},
+		{ReposCreateOp{URI: "r", Mirror: true, CloneURL: "git@bitbucket.org:a/b.git"}, ProviderBitbucket, false},
+		{ReposCreateOp{URI: "r", Mirror: true, CloneURL: "https://git.example.com/a/b"}, "", false},
+		{ReposCreateOp{URI: "r", Provider: ProviderGitHub, ExternalID: "123", InstallationID: 4}, ProviderGitHub, false},
+		{ReposCreateOp{URI: "r", Mirror: true}, ProviderLocal, true},
+		{ReposCreateOp{URI: "r", Provider: ProviderGitHub}, ProviderGitHub, true},
+		{ReposCreateOp{URI: "r", Provider: "svn"}, "svn", true},
+		{ReposCreateOp{URI: "r", Provider: ProviderGitLab, ExternalID: "1", InstallationID: 4}, ProviderGitLab, true},
+	}
+	for _, test := range tests {
+		if provider := test.op.ProviderOrDefault(); provider != test.wantProvider {
+			t.Errorf("%+v: got provider %q, want %q", test.op, provider, test.wantProvider)
+		}
+		err := test.op.Validate()
+		if (err != nil) != test.wantErr {
+			t.Errorf("%+v: got error %v, want error? %v", test.op, err, test.wantErr)
+		}
+		if _, ok := err.(*InvalidOptionsError); err != nil && !ok {
+			t.Errorf("%+v: got error type %T, want *InvalidOptionsError", test.op, err)
+		}
+	}
+}
+
+func TestCommitList_NextPageOptions(t *testing.T) {
+	opt := RepoListCommitsOptions{Head: "master", ListOptions: ListOptions{PerPage: 2, Page: 3}}
+
+	l := &CommitList{Commits: []*vcs.Commit{{ID: "a"}, {ID: "b"}}, StreamResponse: StreamResponse{HasMore: true}}
+	next := l.NextPageOptions(opt)
+	want := &RepoListCommitsOptions{Head: "master", AfterCommit: "b", ListOptions: ListOptions{PerPage: 2}}
+	if !reflect.DeepEqual(next, want) {
+		t.Errorf("got %+v, want %+v", next, want)
+	}
+	if opt.AfterCommit != "" {
+		t.Error("NextPageOptions modified opt")
+	}
+
+	l.HasMore = false
+	if next := l.NextPageOptions(opt); next != nil {
+		t.Errorf("got %+v for last page, want nil", next)
+	}
+}
+
+func TestCommitList_Unverified(t *testing.T) {
+	l := &CommitList{
+		Commits: []*vcs.Commit{{ID: "a"}, {ID: "b"}, {ID: "c"}},
+		Verifications: map[string]*CommitVerification{
+			"a": {Verified: true, Reason: CommitVerificationReasonValid, SignerKeyID: "k"},
+			"b": {Reason: CommitVerificationReasonUnknownKey, SignerKeyID: "k2"},
+		},
+	}
+	got := l.Unverified()
+	want := []*vcs.Commit{{ID: "b"}, {ID: "c"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
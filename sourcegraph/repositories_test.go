@@ -3,6 +3,11 @@ package sourcegraph
 import (
 	"reflect"
 	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"sourcegraph.com/sourcegraph/go-vcs/vcs"
 )
 
 func TestRepoSpec(t *testing.T) {
@@ -43,6 +48,39 @@ func TestRepoSpec(t *testing.T) {
 	}
 }
 
+func TestParseRepoSpec_cloneURL(t *testing.T) {
+	tests := map[string]string{
+		"https://github.com/foo/bar.git": "github.com/foo/bar",
+		"https://github.com/foo/bar/":    "github.com/foo/bar",
+		"git://example.com/foo/bar":      "example.com/foo/bar",
+		"ssh://git@example.com/foo/bar":  "example.com/foo/bar",
+		"git@github.com:foo/bar.git":     "github.com/foo/bar",
+		"localhost:3080/foo":             "localhost:3080/foo",
+	}
+	for input, want := range tests {
+		spec, err := ParseRepoSpec(input)
+		if err != nil {
+			t.Errorf("%q: %s", input, err)
+			continue
+		}
+		if spec.URI != want {
+			t.Errorf("%q: got URI %q, want %q", input, spec.URI, want)
+		}
+		if err := spec.Validate(); err != nil {
+			t.Errorf("%q: Validate: %s", input, err)
+		}
+	}
+
+	for _, input := range []string{"", "https://github.com", "git@github.com:/.git"} {
+		if spec, err := ParseRepoSpec(input); err == nil {
+			t.Errorf("%q: got spec %+v, want error", input, spec)
+		}
+	}
+	if err := (RepoSpec{URI: "foo/.bar"}).Validate(); err == nil {
+		t.Error("got nil error from Validate for invalid URI")
+	}
+}
+
 func TestRepoRevSpec(t *testing.T) {
 	tests := []struct {
 		spec      RepoRevSpec
@@ -68,3 +106,131 @@ func TestRepoRevSpec(t *testing.T) {
 		}
 	}
 }
+
+func TestRepoRevSpec_Validate(t *testing.T) {
+	tests := []struct {
+		spec    RepoRevSpec
+		wantErr bool
+	}{
+		{RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}}, false},
+		{RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, Rev: "my/branch~1"}, false},
+		{RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, Rev: "v", CommitID: commitID}, false},
+		{RepoRevSpec{RepoSpec: RepoSpec{URI: ".r"}}, true},
+		{RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, Rev: "v===" + commitID}, true},
+		{RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, Rev: "v", CommitID: "abc"}, true},
+	}
+	for _, test := range tests {
+		if err := test.spec.Validate(); (err != nil) != test.wantErr {
+			t.Errorf("%+v: got error %v, want error? %v", test.spec, err, test.wantErr)
+		}
+	}
+
+	for _, vars := range []map[string]string{
+		{"Repo": "r", "ResolvedRev": "v===abc"},
+		{"Repo": "r", "Rev": "v", "CommitID": "abc"},
+	} {
+		if spec, err := UnmarshalRepoRevSpec(vars); err == nil {
+			t.Errorf("%v: got spec %+v, want error", vars, spec)
+		}
+	}
+}
+
+type resolveReposClient struct {
+	ReposClient
+	calls int
+}
+
+func (c *resolveReposClient) GetCommit(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*vcs.Commit, error) {
+	c.calls++
+	if in.Rev != "v" {
+		return nil, grpc.Errorf(codes.NotFound, "rev %q not found", in.Rev)
+	}
+	return &vcs.Commit{ID: commitID}, nil
+}
+
+func TestRepoRevSpec_Resolve(t *testing.T) {
+	ctx := context.Background()
+	c := &resolveReposClient{}
+
+	spec, err := RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, Rev: "v"}.Resolve(ctx, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, Rev: "v", CommitID: commitID}); spec != want {
+		t.Errorf("got %+v, want %+v", spec, want)
+	}
+	if !spec.Resolved() {
+		t.Error("got Resolved() == false")
+	}
+
+	// Already resolved.
+	if _, err := spec.Resolve(ctx, c); err != nil {
+		t.Fatal(err)
+	}
+	if c.calls != 1 {
+		t.Errorf("got %d GetCommit calls, want 1", c.calls)
+	}
+
+	if _, err := (RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, Rev: "x"}).Resolve(ctx, c); grpc.Code(err) != codes.NotFound {
+		t.Errorf("got error %v, want NotFound", err)
+	}
+}
+
+func TestCanonicalRepoURI(t *testing.T) {
+	SetProviderHost("GitLab.example.com", ProviderGitLab)
+	defer SetProviderHost("gitlab.example.com", "")
+
+	tests := []struct {
+		input, wantURI, wantProvider string
+	}{
+		{"github.com/foo/bar", "github.com/foo/bar", ProviderGitHub},
+		{"GitHub.com/Foo/Bar.git", "github.com/Foo/Bar", ProviderGitHub},
+		{"gitlab.com/group/subgroup/proj", "gitlab.com/group/subgroup/proj", ProviderGitLab},
+		{"https://gitlab.com/group/proj.git", "gitlab.com/group/proj", ProviderGitLab},
+		{"bitbucket.org/Foo/Bar", "bitbucket.org/foo/bar", ProviderBitbucket},
+		{"git@bitbucket.org:Foo/Bar.git", "bitbucket.org/foo/bar", ProviderBitbucket},
+		{"GITLAB.example.com/a/b.git", "gitlab.example.com/a/b", ProviderGitLab},
+		{"example.com/a/b.git", "example.com/a/b.git", ""},
+	}
+	for _, test := range tests {
+		spec, err := ParseRepoSpec(test.input)
+		if err != nil {
+			t.Errorf("%q: %s", test.input, err)
+			continue
+		}
+
+		// ParseRepoSpec must not canonicalize URIs, so that specs
+		// round-trip.
+		spec2, err := ParseRepoSpec(spec.SpecString())
+		if err != nil {
+			t.Errorf("%q: %s", spec.URI, err)
+			continue
+		}
+		if spec2 != spec {
+			t.Errorf("%q: got %+v after round-trip, want %+v", test.input, spec2, spec)
+		}
+
+		uri := CanonicalRepoURI(spec.URI)
+		if uri != test.wantURI {
+			t.Errorf("%q: got canonical URI %q, want %q", test.input, uri, test.wantURI)
+		}
+		if provider := RepoProvider(uri); provider != test.wantProvider {
+			t.Errorf("%q: got provider %q, want %q", test.input, provider, test.wantProvider)
+		}
+	}
+
+	if got := RepoProvider("gitlab.example.com/a/b"); got != ProviderGitLab {
+		t.Errorf("got provider %q for registered host, want %q", got, ProviderGitLab)
+	}
+	SetProviderHost("gitlab.example.com", "")
+	if got := RepoProvider("gitlab.example.com/a/b"); got != "" {
+		t.Errorf("got provider %q for unregistered host, want empty", got)
+	}
+
+	if got, want := (&Repo{URI: "gitlab.com/a/b"}).ProviderHTMLURL(), "https://gitlab.com/a/b"; got != want {
+		t.Errorf("got ProviderHTMLURL %q, want %q", got, want)
+	}
+	if got := (&Repo{URI: "example.com/a/b"}).ProviderHTMLURL(); got != "" {
+		t.Errorf("got ProviderHTMLURL %q for unknown host, want empty", got)
+	}
+}
@@ -1,7 +1,61 @@
 package sourcegraph
 
+import "fmt"
+
 func (s *TreeEntrySpec) RouteVars() map[string]string {
 	m := s.RepoRev.RouteVars()
 	m["Path"] = s.Path
 	return m
 }
+
+// UnmarshalTreeEntrySpec marshals a map containing route variables
+// generated by (*TreeEntrySpec).RouteVars() and returns the
+// equivalent TreeEntrySpec struct.
+func UnmarshalTreeEntrySpec(routeVars map[string]string) (TreeEntrySpec, error) {
+	rr, err := UnmarshalRepoRevSpec(routeVars)
+	if err != nil {
+		return TreeEntrySpec{}, err
+	}
+	return TreeEntrySpec{RepoRev: rr, Path: routeVars["Path"]}, nil
+}
+
+// Validate returns an *InvalidOptionsError if o contains an unknown
+// def kind.
+func (o *RepoTreeListFileDefsOptions) Validate() error {
+	for _, k := range o.Kinds {
+		if !DefKind(k).Valid() {
+			return &InvalidOptionsError{Reason: fmt.Sprintf("unknown def kind %q", k)}
+		}
+	}
+	return nil
+}
+
+// Enclosing returns the defs in l whose byte ranges contain the byte
+// offset, from outermost to innermost (e.g., for rendering a
+// breadcrumb). It assumes that l.Defs are sorted by DefsByPosition.
+func (l *FileDefList) Enclosing(offset uint32) []*Def {
+	var defs []*Def
+	for _, d := range l.Defs {
+		if d.DefStart > offset {
+			break
+		}
+		if offset < d.DefEnd {
+			defs = append(defs, d)
+		}
+	}
+	return defs
+}
+
+// DefsByPosition sorts defs by their position in a file: by start
+// byte, and then by end byte descending (so that enclosing defs come
+// before the defs they enclose).
+type DefsByPosition []*Def
+
+func (v DefsByPosition) Len() int      { return len(v) }
+func (v DefsByPosition) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v DefsByPosition) Less(i, j int) bool {
+	if v[i].DefStart != v[j].DefStart {
+		return v[i].DefStart < v[j].DefStart
+	}
+	return v[i].DefEnd > v[j].DefEnd
+}
@@ -0,0 +1,46 @@
+package sourcegraph
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"sourcegraph.com/sourcegraph/srclib/graph"
+)
+
+func TestFileDefList_Enclosing(t *testing.T) {
+	def := func(name string, start, end uint32) *Def {
+		return &Def{Def: graph.Def{Name: name, DefStart: start, DefEnd: end}}
+	}
+	typ, method, local, fn := def("T", 0, 100), def("M", 20, 80), def("x", 30, 40), def("F", 110, 150)
+	l := &FileDefList{Defs: []*Def{fn, local, method, typ}}
+	sort.Sort(DefsByPosition(l.Defs))
+	if want := []*Def{typ, method, local, fn}; !reflect.DeepEqual(l.Defs, want) {
+		t.Fatalf("got sorted defs %v, want %v", l.Defs, want)
+	}
+
+	tests := []struct {
+		offset uint32
+		want   []*Def
+	}{
+		{0, []*Def{typ}},
+		{35, []*Def{typ, method, local}},
+		{80, []*Def{typ}},
+		{105, nil},
+		{120, []*Def{fn}},
+	}
+	for _, test := range tests {
+		if got := l.Enclosing(test.offset); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("offset %d: got %v, want %v", test.offset, got, test.want)
+		}
+	}
+}
+
+func TestRepoTreeListFileDefsOptions_Validate(t *testing.T) {
+	if err := (&RepoTreeListFileDefsOptions{Kinds: []string{"func", "type"}}).Validate(); err != nil {
+		t.Error(err)
+	}
+	if err := (&RepoTreeListFileDefsOptions{Kinds: []string{"function"}}).Validate(); err == nil {
+		t.Error("got nil error for unknown kind")
+	}
+}
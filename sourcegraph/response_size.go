@@ -0,0 +1,74 @@
+package sourcegraph
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// ErrResponseTooLarge is returned by calls whose response message
+// exceeds the client's response size limit (see
+// Client.MaxResponseBytes and WithMaxResponseBytes). The limit is
+// enforced before the response is decoded, so a pathologically large
+// response is rejected without decoding it.
+type ErrResponseTooLarge struct {
+	Method        string // the API method (e.g., "Defs.List")
+	ContentLength int    // the size in bytes of the response message on the wire
+	Max           int    // the limit that was exceeded
+}
+
+func (e *ErrResponseTooLarge) Error() string {
+	return fmt.Sprintf("%s response is too large (%d bytes, limit %d bytes)", e.Method, e.ContentLength, e.Max)
+}
+
+// WithMaxResponseBytes returns a copy of parent in which calls use
+// max as the response size limit, instead of the client's
+// MaxResponseBytes. If max is zero, responses to these calls are not
+// limited.
+func WithMaxResponseBytes(parent context.Context, max int) context.Context {
+	return context.WithValue(parent, maxResponseBytesKey, max)
+}
+
+// customCodecCallOption is grpc.CallCustomCodec; it is a var so that
+// tests can observe the codec that responseSizeInterceptor uses.
+var customCodecCallOption = grpc.CallCustomCodec
+
+// responseSizeInterceptor enforces the response size limit of each
+// call (see Client.MaxResponseBytes) by decoding its response with a
+// codec that checks the response's size first.
+func (c *Client) responseSizeInterceptor(next Invoker) Invoker {
+	return func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		max := c.MaxResponseBytes
+		if v, ok := ctx.Value(maxResponseBytesKey).(int); ok {
+			max = v
+		}
+		if max <= 0 {
+			return next(ctx, method, in)
+		}
+		codec := &limitCodec{max: max}
+		result, err := next(WithCallOption(ctx, customCodecCallOption(codec)), method, in)
+		if codec.err != nil {
+			codec.err.Method = method
+			return nil, codec.err
+		}
+		return result, err
+	}
+}
+
+// limitCodec is a codec for a single call that refuses to decode a
+// response larger than max bytes, recording an *ErrResponseTooLarge
+// (because gRPC does not return codec errors as is).
+type limitCodec struct {
+	gogoCodec
+	max int
+	err *ErrResponseTooLarge
+}
+
+func (c *limitCodec) Unmarshal(data []byte, v interface{}) error {
+	if len(data) > c.max {
+		c.err = &ErrResponseTooLarge{ContentLength: len(data), Max: c.max}
+		return c.err
+	}
+	return c.gogoCodec.Unmarshal(data, v)
+}
@@ -0,0 +1,80 @@
+package sourcegraph
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// codecCallOption is a grpc.CallOption that records the codec of a
+// custom codec call option, so that fake clients can use it.
+type codecCallOption struct {
+	grpc.CallOption
+	codec grpc.Codec
+}
+
+// codecReposClient is a ReposClient whose List decodes its response
+// using the codec of its caller's custom codec call option, if any.
+type codecReposClient struct {
+	ReposClient
+	data []byte // the encoded response
+}
+
+func (c *codecReposClient) List(ctx context.Context, in *RepoListOptions, opts ...grpc.CallOption) (*RepoList, error) {
+	var codec grpc.Codec = GRPCCodec
+	for _, opt := range opts {
+		if opt, ok := opt.(codecCallOption); ok {
+			codec = opt.codec
+		}
+	}
+	var list RepoList
+	if err := codec.Unmarshal(c.data, &list); err != nil {
+		return nil, grpc.Errorf(codes.Internal, "grpc: failed to unmarshal the received message %v", err)
+	}
+	return &list, nil
+}
+
+func TestClient_MaxResponseBytes(t *testing.T) {
+	customCodecCallOption = func(codec grpc.Codec) grpc.CallOption { return codecCallOption{codec: codec} }
+	defer func() { customCodecCallOption = grpc.CallCustomCodec }()
+
+	data, err := proto.Marshal(&RepoList{Repos: []*Repo{{URI: "github.com/a/b"}, {URI: "github.com/c/d"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient(nil)
+	c.Repos.(*CachedReposClient).ReposClient.(*InterceptedReposClient).ReposClient = &codecReposClient{data: data}
+
+	tests := []struct {
+		label   string
+		max     int
+		ctx     context.Context
+		wantErr bool
+	}{
+		{"no limit", 0, context.Background(), false},
+		{"under limit", len(data), context.Background(), false},
+		{"over limit", len(data) - 1, context.Background(), true},
+		{"per-call override raises limit", len(data) - 1, WithMaxResponseBytes(context.Background(), len(data)), false},
+		{"per-call override lowers limit", 0, WithMaxResponseBytes(context.Background(), 1), true},
+		{"per-call override removes limit", 1, WithMaxResponseBytes(context.Background(), 0), false},
+	}
+	for _, test := range tests {
+		c.MaxResponseBytes = test.max
+		list, err := c.Repos.List(test.ctx, &RepoListOptions{})
+		if !test.wantErr {
+			if err != nil || len(list.Repos) != 2 {
+				t.Errorf("%s: got (%v, %v), want response", test.label, list, err)
+			}
+			continue
+		}
+		if list != nil {
+			t.Errorf("%s: got response, want it discarded", test.label)
+		}
+		if e, ok := err.(*ErrResponseTooLarge); !ok || e.Method != "Repos.List" || e.ContentLength != len(data) {
+			t.Errorf("%s: got error %#v, want *ErrResponseTooLarge", test.label, err)
+		}
+	}
+}
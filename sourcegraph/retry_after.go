@@ -0,0 +1,108 @@
+package sourcegraph
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// RetryAfterMetadataKey is the gRPC trailer metadata key that the
+// server uses to tell clients how long to wait before retrying a
+// call that failed because the server was overloaded or rate-limited.
+// Its value is either a number of seconds or an HTTP date, as in the
+// HTTP Retry-After header.
+const RetryAfterMetadataKey = "retry-after"
+
+// RetryAfterError is an error returned by a call that the server asked
+// the client to retry after a delay.
+type RetryAfterError struct {
+	Err        error         // the error returned by the call
+	RetryAfter time.Duration // how long to wait before retrying
+}
+
+func (e *RetryAfterError) Error() string {
+	return fmt.Sprintf("%s (retry after %s)", e.Err, e.RetryAfter)
+}
+
+// Unwrap returns the underlying error.
+func (e *RetryAfterError) Unwrap() error { return e.Err }
+
+// RetryAfter returns the delay after which the server asked for the
+// failed call that returned err to be retried, if any. It looks for a
+// *RetryAfterError in err's chain of wrapped errors (e.g., inside a
+// *CallError).
+func RetryAfter(err error) (time.Duration, bool) {
+	for err != nil {
+		if e, ok := err.(*RetryAfterError); ok {
+			return e.RetryAfter, true
+		}
+		w, ok := err.(interface{ Unwrap() error })
+		if !ok {
+			break
+		}
+		err = w.Unwrap()
+	}
+	return 0, false
+}
+
+// parseRetryAfter parses a Retry-After value, which is either a
+// number of seconds or an HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// trailerCallOption is grpc.Trailer; it is a var so that tests can
+// observe the trailer that RetryAfterInterceptor captures.
+var trailerCallOption = grpc.Trailer
+
+// RetryAfterInterceptor returns an Interceptor that handles calls
+// that fail with codes.ResourceExhausted or codes.Unavailable and
+// that carry Retry-After trailer metadata (see RetryAfterMetadataKey).
+// It waits and retries such calls up to maxRetries times, as long as
+// the call's context's deadline permits. Otherwise (or if maxRetries
+// is 0), it returns a *RetryAfterError so that the caller may
+// implement its own backoff.
+func RetryAfterInterceptor(maxRetries int) Interceptor {
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+			for retries := 0; ; retries++ {
+				var trailer metadata.MD
+				result, err := next(WithCallOption(ctx, trailerCallOption(&trailer)), method, in)
+				if c := ErrorCode(err); c != codes.ResourceExhausted && c != codes.Unavailable {
+					return result, err
+				}
+				v := trailer[RetryAfterMetadataKey]
+				if len(v) == 0 {
+					return result, err
+				}
+				d, ok := parseRetryAfter(v[0], time.Now())
+				if !ok {
+					return result, err
+				}
+				if dl, ok := ctx.Deadline(); retries >= maxRetries || (ok && time.Now().Add(d).After(dl)) {
+					return nil, &RetryAfterError{Err: err, RetryAfter: d}
+				}
+				select {
+				case <-time.After(d):
+				case <-ctx.Done():
+					return nil, &RetryAfterError{Err: err, RetryAfter: d}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package sourcegraph
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2015, 10, 21, 7, 28, 0, 0, time.UTC)
+	tests := []struct {
+		v      string
+		want   time.Duration
+		wantOK bool
+	}{
+		{"3", 3 * time.Second, true},
+		{"Wed, 21 Oct 2015 07:28:10 GMT", 10 * time.Second, true},
+		{"Wed, 21 Oct 2015 07:27:00 GMT", 0, true},
+		{"-1", 0, false},
+		{"soon", 0, false},
+	}
+	for _, test := range tests {
+		d, ok := parseRetryAfter(test.v, now)
+		if d != test.want || ok != test.wantOK {
+			t.Errorf("%q: got (%v, %v), want (%v, %v)", test.v, d, ok, test.want, test.wantOK)
+		}
+	}
+}
+
+func TestRetryAfterInterceptor(t *testing.T) {
+	var trailer *metadata.MD
+	trailerCallOption = func(md *metadata.MD) grpc.CallOption {
+		trailer = md
+		return nil
+	}
+	defer func() { trailerCallOption = grpc.Trailer }()
+
+	calls := 0
+	invoker := func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		calls++
+		if calls == 1 {
+			*trailer = metadata.Pairs(RetryAfterMetadataKey, "0")
+			return nil, grpc.Errorf(codes.ResourceExhausted, "rate limited")
+		}
+		return "ok", nil
+	}
+
+	// Retries after the delay.
+	result, err := RetryAfterInterceptor(1)(invoker)(context.Background(), "Repos.Get", nil)
+	if err != nil || result != "ok" || calls != 2 {
+		t.Errorf("got (%v, %v) after %d calls, want (ok, nil) after 2 calls", result, err, calls)
+	}
+
+	// Returns the delay when retries are exhausted.
+	calls = 0
+	_, err = RetryAfterInterceptor(0)(invoker)(context.Background(), "Repos.Get", nil)
+	if d, ok := RetryAfter(err); !ok || d != 0 {
+		t.Errorf("got RetryAfter (%v, %v) for error %v, want (0, true)", d, ok, err)
+	}
+	if calls != 1 {
+		t.Errorf("got %d calls, want 1", calls)
+	}
+
+	if _, ok := RetryAfter(errors.New("x")); ok {
+		t.Error("got RetryAfter ok for plain error")
+	}
+
+	// Finds the delay in errors wrapped more than once.
+	wrapped := &CallError{Method: "Repos.Get", Err: &CallError{Method: "Repos.Get", Err: &RetryAfterError{Err: errors.New("x"), RetryAfter: time.Second}}}
+	if d, ok := RetryAfter(wrapped); !ok || d != time.Second {
+		t.Errorf("got RetryAfter (%v, %v) for wrapped error, want (1s, true)", d, ok)
+	}
+}
+
+// metadataCallOption is a grpc.CallOption that records where a
+// header or trailer call option stores its metadata, so that fake
+// clients can set it.
+type metadataCallOption struct {
+	grpc.CallOption
+	md *metadata.MD
+}
+
+// retryAfterReposClient is a ReposClient whose Get fails with a
+// Retry-After trailer (if its caller asked for trailers) on the first
+// call and succeeds afterwards.
+type retryAfterReposClient struct {
+	ReposClient
+	calls int
+}
+
+func (c *retryAfterReposClient) Get(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*Repo, error) {
+	c.calls++
+	if c.calls == 1 {
+		for _, opt := range opts {
+			if opt, ok := opt.(metadataCallOption); ok {
+				*opt.md = metadata.Pairs(RetryAfterMetadataKey, "0")
+			}
+		}
+		return nil, grpc.Errorf(codes.ResourceExhausted, "rate limited")
+	}
+	return &Repo{URI: in.URI}, nil
+}
+
+func TestRetryAfterInterceptor_client(t *testing.T) {
+	trailerCallOption = func(md *metadata.MD) grpc.CallOption { return metadataCallOption{md: md} }
+	defer func() { trailerCallOption = grpc.Trailer }()
+
+	c := NewClient(nil)
+	repos := &retryAfterReposClient{}
+	c.Repos.(*CachedReposClient).ReposClient.(*InterceptedReposClient).ReposClient = repos
+	c.UseInterceptor(RetryAfterInterceptor(1))
+
+	repo, err := c.Repos.Get(context.Background(), &RepoSpec{URI: "r"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repo.URI != "r" || repos.calls != 2 {
+		t.Errorf("got repo %q after %d calls, want %q after 2 calls", repo.URI, repos.calls, "r")
+	}
+}
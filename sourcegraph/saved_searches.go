@@ -0,0 +1,52 @@
+package sourcegraph
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Notification frequencies for SavedSearchNotifications.Frequency.
+const (
+	SavedSearchFrequencyNever     = "never"
+	SavedSearchFrequencyImmediate = "immediate"
+	SavedSearchFrequencyDaily     = "daily"
+	SavedSearchFrequencyWeekly    = "weekly"
+)
+
+// Enabled reports whether n specifies that the owner is notified of
+// new results (by email or webhook).
+func (n SavedSearchNotifications) Enabled() bool {
+	return n.Frequency != "" && n.Frequency != SavedSearchFrequencyNever && (n.Email || n.WebhookURL != "")
+}
+
+// Validate returns an *InvalidOptionsError if n.Frequency is unknown
+// or n.WebhookURL is not an absolute http or https URL.
+func (n SavedSearchNotifications) Validate() error {
+	switch n.Frequency {
+	case "", SavedSearchFrequencyNever, SavedSearchFrequencyImmediate, SavedSearchFrequencyDaily, SavedSearchFrequencyWeekly:
+	default:
+		return &InvalidOptionsError{Reason: fmt.Sprintf("unknown saved search notification frequency %q", n.Frequency)}
+	}
+	if n.WebhookURL != "" {
+		u, err := url.Parse(n.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return &InvalidOptionsError{Reason: fmt.Sprintf("invalid saved search webhook URL %q (must be an absolute http or https URL)", n.WebhookURL)}
+		}
+	}
+	return nil
+}
+
+// Validate returns an *InvalidOptionsError if s's Name or Query is
+// empty or its notification settings are invalid.
+func (s *SavedSearch) Validate() error {
+	if s.Name == "" {
+		return &InvalidOptionsError{Reason: "saved search name is empty"}
+	}
+	if s.Query == "" {
+		return &InvalidOptionsError{Reason: "saved search query is empty"}
+	}
+	return s.Notifications.Validate()
+}
+
+// Spec returns the SavedSearchSpec that identifies s.
+func (s *SavedSearch) Spec() SavedSearchSpec { return SavedSearchSpec{ID: s.ID} }
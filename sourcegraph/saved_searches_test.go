@@ -0,0 +1,47 @@
+package sourcegraph
+
+import "testing"
+
+func TestSavedSearch_Validate(t *testing.T) {
+	tests := []struct {
+		search  SavedSearch
+		wantErr bool
+	}{
+		{SavedSearch{Name: "n", Query: "q"}, false},
+		{SavedSearch{Name: "n", Query: "q", Notifications: SavedSearchNotifications{Frequency: SavedSearchFrequencyDaily, Email: true}}, false},
+		{SavedSearch{Name: "n", Query: "q", Notifications: SavedSearchNotifications{Frequency: SavedSearchFrequencyImmediate, WebhookURL: "https://example.com/hook"}}, false},
+		{SavedSearch{Query: "q"}, true},
+		{SavedSearch{Name: "n"}, true},
+		{SavedSearch{Name: "n", Query: "q", Notifications: SavedSearchNotifications{Frequency: "hourly"}}, true},
+		{SavedSearch{Name: "n", Query: "q", Notifications: SavedSearchNotifications{WebhookURL: "example.com/hook"}}, true},
+		{SavedSearch{Name: "n", Query: "q", Notifications: SavedSearchNotifications{WebhookURL: "ftp://example.com/hook"}}, true},
+	}
+	for _, test := range tests {
+		err := test.search.Validate()
+		if (err != nil) != test.wantErr {
+			t.Errorf("%+v: got error %v, want error %v", test.search, err, test.wantErr)
+		}
+		if _, ok := err.(*InvalidOptionsError); err != nil && !ok {
+			t.Errorf("%+v: got error type %T, want *InvalidOptionsError", test.search, err)
+		}
+	}
+}
+
+func TestSavedSearchNotifications_Enabled(t *testing.T) {
+	tests := []struct {
+		n    SavedSearchNotifications
+		want bool
+	}{
+		{SavedSearchNotifications{}, false},
+		{SavedSearchNotifications{Email: true}, false},
+		{SavedSearchNotifications{Frequency: SavedSearchFrequencyNever, Email: true}, false},
+		{SavedSearchNotifications{Frequency: SavedSearchFrequencyWeekly}, false},
+		{SavedSearchNotifications{Frequency: SavedSearchFrequencyWeekly, Email: true}, true},
+		{SavedSearchNotifications{Frequency: SavedSearchFrequencyDaily, WebhookURL: "https://example.com"}, true},
+	}
+	for _, test := range tests {
+		if got := test.n.Enabled(); got != test.want {
+			t.Errorf("%+v: got %v, want %v", test.n, got, test.want)
+		}
+	}
+}
@@ -1,6 +1,75 @@
 package sourcegraph
 
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
 // Empty is whether there are no search results for any result type.
 func (r *SearchResults) Empty() bool {
 	return len(r.Defs) == 0 && len(r.People) == 0 && len(r.Repos) == 0 && len(r.Tree) == 0
 }
+
+// Pattern returns the compiled regexp that o.Query denotes, taking
+// o.Regexp and o.CaseSensitive into account. It returns an
+// *InvalidOptionsError if o.Query is empty or is an invalid regexp.
+func (o *CodeSearchOptions) Pattern() (*regexp.Regexp, error) {
+	if o.Query == "" {
+		return nil, &InvalidOptionsError{Reason: "empty code search query"}
+	}
+	expr := o.Query
+	if !o.Regexp {
+		expr = regexp.QuoteMeta(expr)
+	}
+	if !o.CaseSensitive {
+		expr = "(?i)" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, &InvalidOptionsError{Reason: fmt.Sprintf("invalid code search regexp: %s", err)}
+	}
+	return re, nil
+}
+
+// Validate returns an *InvalidOptionsError if o.Query is empty or is
+// an invalid regexp.
+func (o *CodeSearchOptions) Validate() error {
+	_, err := o.Pattern()
+	return err
+}
+
+// IncludesFile reports whether the file at path name satisfies the
+// Paths filter in o.
+func (o *CodeSearchOptions) IncludesFile(name string) bool {
+	if len(o.Paths) == 0 {
+		return true
+	}
+	for _, p := range o.Paths {
+		p = strings.TrimSuffix(p, "/")
+		if name == p || strings.HasPrefix(name, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// SearchLines returns the code search results for the lines of a
+// file's contents that match re (which is usually obtained from
+// (*CodeSearchOptions).Pattern).
+func SearchLines(re *regexp.Regexp, file string, contents []byte) []*CodeSearchResult {
+	var results []*CodeSearchResult
+	for i, line := range strings.Split(string(contents), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		locs := re.FindAllStringIndex(line, -1)
+		if len(locs) == 0 {
+			continue
+		}
+		r := &CodeSearchResult{File: file, Line: int32(i + 1), Preview: line}
+		for _, loc := range locs {
+			r.Matches = append(r.Matches, MatchRange{Start: int32(loc[0]), End: int32(loc[1])})
+		}
+		results = append(results, r)
+	}
+	return results
+}
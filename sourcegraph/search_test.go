@@ -0,0 +1,72 @@
+package sourcegraph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCodeSearchOptions_Pattern(t *testing.T) {
+	tests := []struct {
+		opt     CodeSearchOptions
+		line    string
+		want    bool
+		wantErr bool
+	}{
+		{CodeSearchOptions{Query: "a.b"}, "A.B", true, false},
+		{CodeSearchOptions{Query: "a.b"}, "axb", false, false},
+		{CodeSearchOptions{Query: "a.b", CaseSensitive: true}, "A.B", false, false},
+		{CodeSearchOptions{Query: "a.b", Regexp: true}, "axb", true, false},
+		{CodeSearchOptions{Query: "(", Regexp: true}, "", false, true},
+		{CodeSearchOptions{}, "", false, true},
+	}
+	for _, test := range tests {
+		re, err := test.opt.Pattern()
+		if (err != nil) != test.wantErr {
+			t.Errorf("%+v: got error %v, want error %v", test.opt, err, test.wantErr)
+			continue
+		}
+		if err != nil {
+			if _, ok := err.(*InvalidOptionsError); !ok {
+				t.Errorf("%+v: got error type %T, want *InvalidOptionsError", test.opt, err)
+			}
+			continue
+		}
+		if got := re.MatchString(test.line); got != test.want {
+			t.Errorf("%+v: %q: got match %v, want %v", test.opt, test.line, got, test.want)
+		}
+	}
+}
+
+func TestCodeSearchOptions_IncludesFile(t *testing.T) {
+	opt := CodeSearchOptions{Paths: []string{"a/b", "c.go"}}
+	for name, want := range map[string]bool{
+		"a/b":     true,
+		"a/b/c":   true,
+		"a/bc":    false,
+		"c.go":    true,
+		"d/c.go":  false,
+		"a/x.txt": false,
+	} {
+		if got := opt.IncludesFile(name); got != want {
+			t.Errorf("%q: got %v, want %v", name, got, want)
+		}
+	}
+	if !(&CodeSearchOptions{}).IncludesFile("x") {
+		t.Error("got false with no Paths, want true")
+	}
+}
+
+func TestSearchLines(t *testing.T) {
+	re, err := (&CodeSearchOptions{Query: "foo"}).Pattern()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := SearchLines(re, "f.go", []byte("foo()\r\nbar()\nFoo(foo)\n"))
+	want := []*CodeSearchResult{
+		{File: "f.go", Line: 1, Preview: "foo()", Matches: []MatchRange{{0, 3}}},
+		{File: "f.go", Line: 3, Preview: "Foo(foo)", Matches: []MatchRange{{0, 3}, {4, 7}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
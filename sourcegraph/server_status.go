@@ -0,0 +1,62 @@
+package sourcegraph
+
+import (
+	"strings"
+
+	"golang.org/x/net/context"
+	"sourcegraph.com/sqs/pbtypes"
+)
+
+// APIVersion is the version of the Sourcegraph API that this client
+// implements, in "major.minor" form. A server whose API major version
+// differs from this client's is incompatible with it.
+const APIVersion = "1.0"
+
+// Subsystems whose health is reported in ServerStatus.Subsystems.
+const (
+	SubsystemBuilds   = "builds"
+	SubsystemVCSStore = "vcsstore"
+	SubsystemDB       = "db"
+)
+
+// ServerStatus returns the server's version and the health of its
+// subsystems (using Meta.Status). Deploy scripts can use it to check
+// that an instance is healthy and that its API version is compatible
+// with this client.
+func (c *Client) ServerStatus(ctx context.Context) (*ServerStatus, error) {
+	status, err := c.Meta.Status(ctx, &pbtypes.Void{})
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// Healthy reports whether all of the server's subsystems are healthy.
+func (s *ServerStatus) Healthy() bool { return len(s.Unhealthy()) == 0 }
+
+// Unhealthy returns the names of the server's unhealthy subsystems.
+func (s *ServerStatus) Unhealthy() []string {
+	var names []string
+	for _, sub := range s.Subsystems {
+		if !sub.Healthy {
+			names = append(names, sub.Name)
+		}
+	}
+	return names
+}
+
+// Compatible reports whether the server's API version is compatible
+// with this client's (APIVersion). A server that does not report its
+// API version is assumed to be compatible.
+func (s *ServerStatus) Compatible() bool {
+	return s.APIVersion == "" || apiMajorVersion(s.APIVersion) == apiMajorVersion(APIVersion)
+}
+
+// apiMajorVersion returns the major version component of an API
+// version (e.g., "1" for "1.2").
+func apiMajorVersion(v string) string {
+	if i := strings.Index(v, "."); i != -1 {
+		return v[:i]
+	}
+	return v
+}
@@ -0,0 +1,75 @@
+package sourcegraph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"sourcegraph.com/sqs/pbtypes"
+)
+
+type statusMetaClient struct {
+	MetaClient
+	status *ServerStatus
+	err    error
+}
+
+func (c *statusMetaClient) Status(ctx context.Context, _ *pbtypes.Void, opts ...grpc.CallOption) (*ServerStatus, error) {
+	return c.status, c.err
+}
+
+func TestClient_ServerStatus(t *testing.T) {
+	want := &ServerStatus{Version: "v", APIVersion: APIVersion}
+	c := &Client{Meta: &statusMetaClient{status: want}}
+	status, err := c.ServerStatus(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != want {
+		t.Errorf("got %+v, want %+v", status, want)
+	}
+
+	c.Meta = &statusMetaClient{err: errors.New("x")}
+	if _, err := c.ServerStatus(context.Background()); err == nil {
+		t.Error("got nil error")
+	}
+}
+
+func TestServerStatus_Healthy(t *testing.T) {
+	s := &ServerStatus{Subsystems: []SubsystemHealth{
+		{Name: SubsystemBuilds, Healthy: true},
+		{Name: SubsystemVCSStore, Healthy: false, Message: "disk full"},
+		{Name: SubsystemDB, Healthy: true},
+	}}
+	if s.Healthy() {
+		t.Error("got Healthy == true, want false")
+	}
+	if got, want := s.Unhealthy(), []string{SubsystemVCSStore}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got unhealthy %v, want %v", got, want)
+	}
+
+	s.Subsystems[1].Healthy = true
+	if !s.Healthy() {
+		t.Error("got Healthy == false, want true")
+	}
+}
+
+func TestServerStatus_Compatible(t *testing.T) {
+	tests := map[string]bool{
+		"":      true,
+		"1":     true,
+		"1.0":   true,
+		"1.7":   true,
+		"2.0":   false,
+		"0.9":   false,
+		"10.0":  false,
+		"11.1a": false,
+	}
+	for v, want := range tests {
+		if got := (&ServerStatus{APIVersion: v}).Compatible(); got != want {
+			t.Errorf("%q: got %v, want %v", v, got, want)
+		}
+	}
+}
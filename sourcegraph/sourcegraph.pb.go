@@ -10,6 +10,7 @@ It is generated from these files:
 
 It has these top-level messages:
 	Badge
+	BadgeOptions
 	CombinedStatus
 	Counter
 	ListOptions
@@ -22,19 +23,47 @@ It has these top-level messages:
 	ChangesetEvent
 	InlineComment
 	Readme
+	ReadmeOptions
+	ReposGetReadmeOp
+	Inventory
+	InventoryLanguage
+	ReposGetStatsHistoryOp
+	RepoStatsHistoryOptions
+	RepoStatsHistory
+	RepoStatsPeriod
 	GitHubRepo
 	RepoConfig
 	Repo
+	ExternalRepo
 	BadgeList
 	CounterList
 	RepoBadgesCountHitsOp
 	RepoBadgesCountHitsResult
+	RepoBadgesCreateOp
+	RepoBadgesDeleteOp
 	RepoListOptions
 	RepoPermissions
+	Collaborator
+	CollaboratorList
+	ReposListCollaboratorsOp
+	ReposAddCollaboratorOp
+	ReposRemoveCollaboratorOp
+	ReposGetPermissionsOp
+	DeployKey
+	DeployKeyList
+	ReposListKeysOp
+	ReposAddKeyOp
+	ReposDeleteKeyOp
+	RepoSubscription
+	ReposWatchOp
+	ReposListWatchedOp
 	RepoRevSpec
 	RepoSpec
 	RepoStatus
 	RepoStatusesCreateOp
+	RepoStatusesGetRollupOp
+	RepoStatusesListOp
+	RepoStatusList
 	RepoList
 	StorageError
 	StorageName
@@ -47,6 +76,15 @@ It has these top-level messages:
 	StorageReadDir
 	ReposCreateOp
 	ReposUpdateOp
+	ReposGetCommitOp
+	RepoGetCommitOptions
+	CommitDetail
+	CommitVerification
+	ReposGetCommitPatchOp
+	RepoGetCommitPatchOptions
+	CommitPatch
+	ReposGetArchiveOp
+	RepoArchive
 	ReposListCommitsOp
 	RepoListCommitsOptions
 	CommitList
@@ -57,6 +95,18 @@ It has these top-level messages:
 	ReposListCommittersOp
 	RepoListCommittersOptions
 	CommitterList
+	ReposListContributorsOp
+	RepoListContributorsOptions
+	Contributor
+	ContributorList
+	ReposSearchCodeOp
+	CodeSearchOptions
+	CodeSearchResult
+	CodeSearchResultList
+	ReposGetCodeOwnersOp
+	CodeOwners
+	CodeOwnersRule
+	CodeOwner
 	ChangesetCreateOp
 	ChangesetCreateReviewOp
 	ChangesetListReviewsOp
@@ -68,9 +118,19 @@ It has these top-level messages:
 	DiscussionListOp
 	DiscussionCommentCreateOp
 	DiscussionRatingUpdateOp
+	IssueSpec
+	Issue
+	IssueComment
+	IssuesListOp
+	IssueListOptions
+	IssueList
+	IssuesCreateOp
+	IssuesCreateCommentOp
 	RepoListTagsOptions
 	TagList
 	MirrorReposRefreshVCSOp
+	MirrorReposEnableOp
+	MirrorStatus
 	VCSCredentials
 	MirroredRepoSSHKeysCreateOp
 	SSHPrivateKey
@@ -86,6 +146,8 @@ It has these top-level messages:
 	BuildUpdate
 	BuildsGetRepoBuildInfoOptions
 	BuildsGetRepoBuildInfoOp
+	BuildsGetMultipleRepoBuildInfoOp
+	BuildsListByRepoOp
 	BuildList
 	BuildsCreateOp
 	BuildsUpdateOp
@@ -100,16 +162,29 @@ It has these top-level messages:
 	BuildsGetLogOp
 	BuildsGetTaskLogOp
 	BuildsDequeueNextOp
+	BuildsCancelOp
+	BuildCancelOptions
+	BuildsRestartOp
+	BuildRestartOptions
 	EmailAddr
 	LogEntries
+	LogEntry
 	Org
 	OrgListMembersOptions
 	OrgSpec
 	OrgsListMembersOp
 	UserList
+	Team
+	TeamSpec
+	OrgsListTeamsOp
+	OrgListTeamsOptions
+	TeamList
+	OrgsListTeamMembersOp
+	OrgsAddTeamRepoOp
 	Person
 	PersonSpec
 	RepoBuildInfo
+	RepoBuildInfoList
 	TaskSpec
 	TaskUpdate
 	User
@@ -121,7 +196,27 @@ It has these top-level messages:
 	PasswordResetToken
 	NewPassword
 	NewAccount
+	PersonalAccessToken
+	PersonalAccessTokenList
+	AuthCreateTokenOp
+	AuthListTokensOp
+	AuthRevokeTokenOp
 	SSHPublicKey
+	UserKey
+	UserKeyList
+	UsersListKeysOp
+	UserKeysListOptions
+	UsersAddKeyOp
+	UsersDeleteKeyOp
+	UsersListAuthoredDefsOp
+	UserListAuthoredDefsOptions
+	AuthoredDef
+	AuthoredDefList
+	UsersListContributionsOp
+	UserListContributionsOptions
+	UserContribution
+	UserContributionList
+	ExternalAccountSpec
 	AuthorizationCodeRequest
 	AuthorizationCode
 	LoginCredentials
@@ -132,6 +227,9 @@ It has these top-level messages:
 	AuthorshipInfo
 	Completions
 	Def
+	DefDocumentation
+	DefDocParam
+	Hover
 	DefAuthor
 	DefAuthorship
 	DefClient
@@ -144,13 +242,39 @@ It has these top-level messages:
 	DefListRefsOptions
 	DefSpec
 	DefsGetOp
+	DefsGetMultipleOp
+	DefsGetByPositionOp
 	DefList
 	DefsListRefsOp
 	RefList
+	DefsListCallersOp
+	DefListCallersOptions
+	DefsListCalleesOp
+	DefListCalleesOptions
 	DefsListExamplesOp
 	ExampleList
 	DefsListAuthorsOp
 	DefsListClientsOp
+	DefsListDependentsOp
+	DefListDependentsOptions
+	DefDependent
+	DefDependentList
+	DefsListTopOp
+	DefListTopOptions
+	DefsListHistoryOp
+	DefSearchOptions
+	MatchRange
+	DefSearchResult
+	DefSearchResultList
+	DefsDiffRefsOp
+	RefDiff
+	DefListHistoryOptions
+	DefHistoryEntry
+	DefHistory
+	DefsGetLineageOp
+	DefGetLineageOptions
+	DefLineage
+	DefLineageEntry
 	Delta
 	DeltaAffectedPerson
 	DeltaDefs
@@ -162,21 +286,44 @@ It has these top-level messages:
 	DeltaListAffectedClientsOptions
 	DeltaListDefsOptions
 	DeltaListFilesOptions
+	DeltaListIncomingOptions
 	DeltaListUnitsOptions
+	DeltaMergeBase
+	DeltaImpact
 	DeltaSpec
+	DeltasGetPatchOp
+	DeltaGetPatchOptions
+	DeltaPatch
 	DeltasListUnitsOp
 	UnitDeltaList
 	DeltasListDefsOp
 	DeltasListFilesOp
+	DeltasListDependenciesOp
+	DeltaListDependenciesOptions
+	DeltaDependencies
+	DependencyChange
+	DependencyVersion
 	DeltasListAffectedAuthorsOp
 	DeltaAffectedPersonList
+	DeltasListSuggestedReviewersOp
+	DeltaListReviewersOptions
+	SuggestedReviewer
+	SuggestedReviewerList
 	DeltasListAffectedClientsOp
+	DeltasListIncomingOp
+	DeltaList
+	DeltasSetLabelsOp
+	DeltasSetMilestoneOp
+	DeltasReviewerOp
 	Example
 	FormatResult
 	MarkdownData
 	MarkdownOpt
 	MarkdownRequestBody
 	MarkdownRenderOp
+	GraphQLRequest
+	GraphQLResponse
+	GraphQLError
 	Ref
 	RepoTreeGetOptions
 	RepoTreeSearchOptions
@@ -185,6 +332,9 @@ It has these top-level messages:
 	RepoTreeSearchOp
 	RepoTreeListOp
 	RepoTreeListResult
+	RepoTreeListFileDefsOp
+	RepoTreeListFileDefsOptions
+	FileDefList
 	VCSSearchResultList
 	TokenSearchOptions
 	TextSearchOptions
@@ -202,6 +352,12 @@ It has these top-level messages:
 	RepoSourceUnitList
 	DefAuthorList
 	DefClientList
+	RepoDependenciesListOp
+	RepoDependency
+	RepoDependencyList
+	AnnotationsListOptions
+	Annotation
+	AnnotationList
 	Checklist
 	FileToken
 	Plan
@@ -214,7 +370,17 @@ It has these top-level messages:
 	UserToken
 	TokenError
 	PBToken
+	SiteConfig
+	AdminReindexAllReposOp
+	AdminReindexResult
+	JobQueueStats
+	JobQueueStat
+	AdminStatsGetUsageOp
+	UsageStats
+	DailyCount
+	RouteCount
 	ServerStatus
+	SubsystemHealth
 	ServerConfig
 	ServerPubKey
 	RegisteredClient
@@ -225,6 +391,12 @@ It has these top-level messages:
 	UserPermissions
 	UserPermissionsList
 	UserPermissionsOptions
+	SavedSearch
+	SavedSearchNotifications
+	SavedSearchSpec
+	SavedSearchListOptions
+	SavedSearchList
+	SavedSearchesUpdateNotificationsOp
 	MetricsSnapshot
 	UserEvent
 	UserEventList
@@ -259,6 +431,125 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+// BadgeStyle is the visual style of a badge or counter image.
+type BadgeStyle int32
+
+const (
+	BadgeStyle_Flat       BadgeStyle = 0
+	BadgeStyle_FlatSquare BadgeStyle = 1
+	BadgeStyle_Plastic    BadgeStyle = 2
+)
+
+var BadgeStyle_name = map[int32]string{
+	0: "Flat",
+	1: "FlatSquare",
+	2: "Plastic",
+}
+var BadgeStyle_value = map[string]int32{
+	"Flat":       0,
+	"FlatSquare": 1,
+	"Plastic":    2,
+}
+
+func (x BadgeStyle) String() string {
+	return proto.EnumName(BadgeStyle_name, int32(x))
+}
+
+// BadgeFormat is the image format of a badge or counter.
+type BadgeFormat int32
+
+const (
+	BadgeFormat_SVG BadgeFormat = 0
+	BadgeFormat_PNG BadgeFormat = 1
+)
+
+var BadgeFormat_name = map[int32]string{
+	0: "SVG",
+	1: "PNG",
+}
+var BadgeFormat_value = map[string]int32{
+	"SVG": 0,
+	"PNG": 1,
+}
+
+func (x BadgeFormat) String() string {
+	return proto.EnumName(BadgeFormat_name, int32(x))
+}
+
+// ReadmeRepresentation is the representation in which a readme's
+// contents are returned.
+type ReadmeRepresentation int32
+
+const (
+	// HTML is the readme rendered to sanitized HTML (in Readme.HTML).
+	ReadmeRepresentation_HTML ReadmeRepresentation = 0
+	// Raw is the readme file's raw contents (in Readme.Contents).
+	ReadmeRepresentation_Raw ReadmeRepresentation = 1
+	// Text is the readme's plain text, with markup removed (in
+	// Readme.Text).
+	ReadmeRepresentation_Text ReadmeRepresentation = 2
+)
+
+var ReadmeRepresentation_name = map[int32]string{
+	0: "HTML",
+	1: "Raw",
+	2: "Text",
+}
+var ReadmeRepresentation_value = map[string]int32{
+	"HTML": 0,
+	"Raw":  1,
+	"Text": 2,
+}
+
+func (x ReadmeRepresentation) String() string {
+	return proto.EnumName(ReadmeRepresentation_name, int32(x))
+}
+
+// RepoStatsInterval is the length of each period in a
+// RepoStatsHistory.
+type RepoStatsInterval int32
+
+const (
+	RepoStatsInterval_Week  RepoStatsInterval = 0
+	RepoStatsInterval_Month RepoStatsInterval = 1
+)
+
+var RepoStatsInterval_name = map[int32]string{
+	0: "Week",
+	1: "Month",
+}
+var RepoStatsInterval_value = map[string]int32{
+	"Week":  0,
+	"Month": 1,
+}
+
+func (x RepoStatsInterval) String() string {
+	return proto.EnumName(RepoStatsInterval_name, int32(x))
+}
+
+// ArchiveFormat is the file format of a repository archive.
+type ArchiveFormat int32
+
+const (
+	// TarGz is a gzip-compressed tar archive.
+	ArchiveFormat_TarGz ArchiveFormat = 0
+	// Zip is a zip archive.
+	ArchiveFormat_Zip ArchiveFormat = 1
+)
+
+var ArchiveFormat_name = map[int32]string{
+	0: "TarGz",
+	1: "Zip",
+}
+var ArchiveFormat_value = map[string]int32{
+	"TarGz": 0,
+	"Zip":   1,
+}
+
+func (x ArchiveFormat) String() string {
+	return proto.EnumName(ArchiveFormat_name, int32(x))
+}
+
 type DiscussionListOrder int32
 
 const (
@@ -281,6 +572,87 @@ func (x DiscussionListOrder) String() string {
 	return proto.EnumName(DiscussionListOrder_name, int32(x))
 }
 
+// UserKeyType is the type of a UserKey.
+type UserKeyType int32
+
+const (
+	// SSH keys authenticate git operations over SSH.
+	UserKeyType_SSH UserKeyType = 0
+	// GPG keys verify the signatures of commits and tags.
+	UserKeyType_GPG UserKeyType = 1
+)
+
+var UserKeyType_name = map[int32]string{
+	0: "SSH",
+	1: "GPG",
+}
+var UserKeyType_value = map[string]int32{
+	"SSH": 0,
+	"GPG": 1,
+}
+
+func (x UserKeyType) String() string {
+	return proto.EnumName(UserKeyType_name, int32(x))
+}
+
+// DefSearchMode is how DefSearchOptions.Query is matched against
+// def names.
+type DefSearchMode int32
+
+const (
+	// Fuzzy matches names that contain the query's characters in
+	// order, though not necessarily adjacent (e.g., "nwcl" matches
+	// "NewClient").
+	DefSearchMode_Fuzzy DefSearchMode = 0
+	// Prefix matches names that begin with the query.
+	DefSearchMode_Prefix DefSearchMode = 1
+	// Regexp matches names that match the query as a regular
+	// expression (in RE2 syntax).
+	DefSearchMode_Regexp DefSearchMode = 2
+)
+
+var DefSearchMode_name = map[int32]string{
+	0: "Fuzzy",
+	1: "Prefix",
+	2: "Regexp",
+}
+var DefSearchMode_value = map[string]int32{
+	"Fuzzy":  0,
+	"Prefix": 1,
+	"Regexp": 2,
+}
+
+func (x DefSearchMode) String() string {
+	return proto.EnumName(DefSearchMode_name, int32(x))
+}
+
+// DefChangeType is the kind of change that a commit made to a def.
+type DefChangeType int32
+
+const (
+	// Modified means the def existed before and after the commit.
+	DefChangeType_Modified DefChangeType = 0
+	// Added means the def was added by the commit.
+	DefChangeType_Added DefChangeType = 1
+	// Deleted means the def was deleted by the commit.
+	DefChangeType_Deleted DefChangeType = 2
+)
+
+var DefChangeType_name = map[int32]string{
+	0: "Modified",
+	1: "Added",
+	2: "Deleted",
+}
+var DefChangeType_value = map[string]int32{
+	"Modified": 0,
+	"Added":    1,
+	"Deleted":  2,
+}
+
+func (x DefChangeType) String() string {
+	return proto.EnumName(DefChangeType_name, int32(x))
+}
+
 // RegisteredClientType is the set of kinds of clients.
 type RegisteredClientType int32
 
@@ -376,12 +748,30 @@ type Badge struct {
 	ImageURL          string `protobuf:"bytes,3,opt,name=image_url,proto3" json:"image_url,omitempty"`
 	UncountedImageURL string `protobuf:"bytes,4,opt,name=uncounted_image_url,proto3" json:"uncounted_image_url,omitempty"`
 	Markdown          string `protobuf:"bytes,5,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	// Custom is whether the badge was created with CreateBadge (as
+	// opposed to being one of the built-in badges).
+	Custom bool `protobuf:"varint,6,opt,name=custom,proto3" json:"custom,omitempty"`
+	// Options are the badge's display options.
+	Options BadgeOptions `protobuf:"bytes,7,opt,name=options" json:"options"`
 }
 
 func (m *Badge) Reset()         { *m = Badge{} }
 func (m *Badge) String() string { return proto.CompactTextString(m) }
 func (*Badge) ProtoMessage()    {}
 
+// BadgeOptions specifies how a badge or counter is displayed.
+type BadgeOptions struct {
+	Style  BadgeStyle  `protobuf:"varint,1,opt,name=style,proto3,enum=sourcegraph.BadgeStyle" json:"style,omitempty"`
+	Format BadgeFormat `protobuf:"varint,2,opt,name=format,proto3,enum=sourcegraph.BadgeFormat" json:"format,omitempty"`
+	// Branch is the branch whose data the badge or counter shows. If
+	// empty, the repository's default branch is used.
+	Branch string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (m *BadgeOptions) Reset()         { *m = BadgeOptions{} }
+func (m *BadgeOptions) String() string { return proto.CompactTextString(m) }
+func (*BadgeOptions) ProtoMessage()    {}
+
 // CombinedStatus is the combined status (i.e., incorporating statuses from all
 // contexts) of the repository at a specific rev.
 type CombinedStatus struct {
@@ -394,6 +784,10 @@ type CombinedStatus struct {
 	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
 	// Statuses are the statuses for each context.
 	Statuses []*RepoStatus `protobuf:"bytes,3,rep,name=statuses" json:"statuses,omitempty"`
+	// MissingContexts are the required contexts (see
+	// RepoStatusesGetRollupOp) that have no status. It is only set by
+	// GetRollup.
+	MissingContexts []string `protobuf:"bytes,5,rep,name=missing_contexts" json:"missing_contexts,omitempty"`
 }
 
 func (m *CombinedStatus) Reset()         { *m = CombinedStatus{} }
@@ -406,6 +800,11 @@ type Counter struct {
 	ImageURL          string `protobuf:"bytes,3,opt,name=image_url,proto3" json:"image_url,omitempty"`
 	UncountedImageURL string `protobuf:"bytes,4,opt,name=uncounted_image_url,proto3" json:"uncounted_image_url,omitempty"`
 	Markdown          string `protobuf:"bytes,5,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	// Custom is whether the counter was created with CreateCounter
+	// (as opposed to being one of the built-in counters).
+	Custom bool `protobuf:"varint,6,opt,name=custom,proto3" json:"custom,omitempty"`
+	// Options are the counter's display options.
+	Options BadgeOptions `protobuf:"bytes,7,opt,name=options" json:"options"`
 }
 
 func (m *Counter) Reset()         { *m = Counter{} }
@@ -413,9 +812,19 @@ func (m *Counter) String() string { return proto.CompactTextString(m) }
 func (*Counter) ProtoMessage()    {}
 
 // ListOptions specifies general pagination options for fetching a list of results.
+//
+// Lists may be paginated by page number (Page and PerPage) or by
+// cursor (Cursor and PerPage). To paginate by cursor, leave Cursor
+// empty on the first request and then set it to the NextCursor of the
+// previous response. Cursor pagination is faster for large lists and
+// doesn't skip or repeat items when the list changes between
+// requests. Methods that don't support cursors ignore Cursor.
 type ListOptions struct {
 	PerPage int32 `protobuf:"varint,1,opt,name=per_page,proto3" json:"per_page,omitempty" url:",omitempty"`
 	Page    int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty" url:",omitempty"`
+	// Cursor is the opaque NextCursor value from the previous
+	// response. If set, Page is ignored.
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty" url:",omitempty"`
 }
 
 func (m *ListOptions) Reset()         { *m = ListOptions{} }
@@ -426,6 +835,10 @@ func (*ListOptions) ProtoMessage()    {}
 type ListResponse struct {
 	// Total is the total number of results in the list.
 	Total int32 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty" url:",omitempty"`
+	// NextCursor is the opaque cursor to pass in ListOptions.Cursor
+	// to fetch the next page of results. It is empty if there are no
+	// more results or if the method doesn't support cursors.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,proto3" json:"next_cursor,omitempty" url:",omitempty"`
 }
 
 func (m *ListResponse) Reset()         { *m = ListResponse{} }
@@ -437,6 +850,10 @@ func (*ListResponse) ProtoMessage()    {}
 type StreamResponse struct {
 	// HasMore is true if there are more results available after the returned page.
 	HasMore bool `protobuf:"varint,1,opt,name=has_more,proto3" json:"has_more,omitempty" url:",omitempty"`
+	// NextCursor is the opaque cursor to pass in ListOptions.Cursor
+	// to fetch the next page of results. It is empty if there are no
+	// more results or if the method doesn't support cursors.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,proto3" json:"next_cursor,omitempty" url:",omitempty"`
 }
 
 func (m *StreamResponse) Reset()         { *m = StreamResponse{} }
@@ -598,14 +1015,126 @@ func (*InlineComment) ProtoMessage()    {}
 type Readme struct {
 	// Path is the relative path of this readme file from the repository root.
 	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	// HTML is the formatted HTML of this readme.
+	// HTML is the formatted HTML of this readme. It is only set if
+	// the requested representation is HTML (the default).
 	HTML string `protobuf:"bytes,2,opt,name=html,proto3" json:"html,omitempty"`
+	// Format is the markup format of the readme file, detected from
+	// its name (e.g., "markdown", "rst", or "text"; see the
+	// ReadmeFormat* consts).
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// Contents is the raw contents of the readme file. It is only set
+	// if the requested representation is Raw.
+	Contents []byte `protobuf:"bytes,4,opt,name=contents,proto3" json:"contents,omitempty"`
+	// Text is the plain text of this readme, with markup removed. It
+	// is only set if the requested representation is Text.
+	Text string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
 }
 
 func (m *Readme) Reset()         { *m = Readme{} }
 func (m *Readme) String() string { return proto.CompactTextString(m) }
 func (*Readme) ProtoMessage()    {}
 
+// ReadmeOptions specifies options for Repos.GetReadme.
+type ReadmeOptions struct {
+	// Representation is the representation in which the readme's
+	// contents are returned.
+	Representation ReadmeRepresentation `protobuf:"varint,1,opt,name=representation,proto3,enum=sourcegraph.ReadmeRepresentation" json:"representation,omitempty" url:",omitempty"`
+}
+
+func (m *ReadmeOptions) Reset()         { *m = ReadmeOptions{} }
+func (m *ReadmeOptions) String() string { return proto.CompactTextString(m) }
+func (*ReadmeOptions) ProtoMessage()    {}
+
+type ReposGetReadmeOp struct {
+	Rev RepoRevSpec    `protobuf:"bytes,1,opt,name=rev" json:"rev"`
+	Opt *ReadmeOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *ReposGetReadmeOp) Reset()         { *m = ReposGetReadmeOp{} }
+func (m *ReposGetReadmeOp) String() string { return proto.CompactTextString(m) }
+func (*ReposGetReadmeOp) ProtoMessage()    {}
+
+// Inventory summarizes the languages and build systems used in a
+// repository's tree.
+type Inventory struct {
+	// Languages are the programming languages used in the tree,
+	// sorted by descending TotalBytes.
+	Languages []*InventoryLanguage `protobuf:"bytes,1,rep,name=languages" json:"languages,omitempty"`
+	// BuildSystems are the names of the build systems detected in the
+	// tree (e.g., "Maven" or "npm").
+	BuildSystems []string `protobuf:"bytes,2,rep,name=build_systems" json:"build_systems,omitempty"`
+}
+
+func (m *Inventory) Reset()         { *m = Inventory{} }
+func (m *Inventory) String() string { return proto.CompactTextString(m) }
+func (*Inventory) ProtoMessage()    {}
+
+// InventoryLanguage describes how much of a repository's tree is
+// written in a single programming language.
+type InventoryLanguage struct {
+	// Name is the name of the language (e.g., "Go").
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// TotalBytes is the total size in bytes of files in the language.
+	TotalBytes int64 `protobuf:"varint,2,opt,name=total_bytes,proto3" json:"total_bytes,omitempty"`
+	// Files is the number of files in the language.
+	Files int32 `protobuf:"varint,3,opt,name=files,proto3" json:"files,omitempty"`
+}
+
+func (m *InventoryLanguage) Reset()         { *m = InventoryLanguage{} }
+func (m *InventoryLanguage) String() string { return proto.CompactTextString(m) }
+func (*InventoryLanguage) ProtoMessage()    {}
+
+type ReposGetStatsHistoryOp struct {
+	Repo RepoRevSpec              `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	Opt  *RepoStatsHistoryOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *ReposGetStatsHistoryOp) Reset()         { *m = ReposGetStatsHistoryOp{} }
+func (m *ReposGetStatsHistoryOp) String() string { return proto.CompactTextString(m) }
+func (*ReposGetStatsHistoryOp) ProtoMessage()    {}
+
+// RepoStatsHistoryOptions specifies options for
+// ReposService.GetStatsHistory.
+type RepoStatsHistoryOptions struct {
+	// Interval is the length of each period (default: Week).
+	Interval RepoStatsInterval `protobuf:"varint,1,opt,name=interval,proto3,enum=sourcegraph.RepoStatsInterval" json:"interval,omitempty" url:",omitempty"`
+	// Periods is the number of most recent periods to return. If
+	// zero, a server-defined default is used.
+	Periods int32 `protobuf:"varint,2,opt,name=periods,proto3" json:"periods,omitempty" url:",omitempty"`
+}
+
+func (m *RepoStatsHistoryOptions) Reset()         { *m = RepoStatsHistoryOptions{} }
+func (m *RepoStatsHistoryOptions) String() string { return proto.CompactTextString(m) }
+func (*RepoStatsHistoryOptions) ProtoMessage()    {}
+
+// RepoStatsHistory is a time series of a repository's stats.
+type RepoStatsHistory struct {
+	Interval RepoStatsInterval `protobuf:"varint,1,opt,name=interval,proto3,enum=sourcegraph.RepoStatsInterval" json:"interval,omitempty"`
+	// Periods are the periods in the series, oldest first.
+	Periods []*RepoStatsPeriod `protobuf:"bytes,2,rep,name=periods" json:"periods,omitempty"`
+}
+
+func (m *RepoStatsHistory) Reset()         { *m = RepoStatsHistory{} }
+func (m *RepoStatsHistory) String() string { return proto.CompactTextString(m) }
+func (*RepoStatsHistory) ProtoMessage()    {}
+
+// RepoStatsPeriod holds a repository's stats for a single period.
+type RepoStatsPeriod struct {
+	// Start is the beginning of the period (inclusive).
+	Start pbtypes.Timestamp `protobuf:"bytes,1,opt,name=start" json:"start"`
+	// End is the end of the period (exclusive).
+	End pbtypes.Timestamp `protobuf:"bytes,2,opt,name=end" json:"end"`
+	// Stats maps stat names (e.g., "commits", "authors", "defs", and
+	// "refs") to their values for the period. Commit and author stats
+	// count activity during the period; def and ref stats are
+	// snapshots as of the end of the period.
+	Stats map[string]int64 `protobuf:"bytes,3,rep,name=stats" json:"stats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *RepoStatsPeriod) Reset()         { *m = RepoStatsPeriod{} }
+func (m *RepoStatsPeriod) String() string { return proto.CompactTextString(m) }
+func (*RepoStatsPeriod) ProtoMessage()    {}
+
 // GitHubRepo holds additional metadata about GitHub repos.
 type GitHubRepo struct {
 	Stars int32 `protobuf:"varint,1,opt,name=stars,proto3" json:"stars,omitempty"`
@@ -700,12 +1229,33 @@ type Repo struct {
 	Permissions *RepoPermissions `protobuf:"bytes,18,opt,name=permissions" json:"permissions,omitempty"`
 	GitHub      *GitHubRepo      `protobuf:"bytes,19,opt,name=github" json:"github,omitempty"`
 	Config      *RepoConfig      `protobuf:"bytes,20,opt,name=config" json:"config,omitempty"`
+	// External describes the repository on the code host that it
+	// originates from. It is null for hosted repositories.
+	External *ExternalRepo `protobuf:"bytes,22,opt,name=external" json:"external,omitempty"`
 }
 
 func (m *Repo) Reset()         { *m = Repo{} }
 func (m *Repo) String() string { return proto.CompactTextString(m) }
 func (*Repo) ProtoMessage()    {}
 
+// ExternalRepo describes a repository on the code host (provider) that
+// it originates from.
+type ExternalRepo struct {
+	// Provider is the code host ("github", "gitlab", or "bitbucket").
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// ID is the repository's ID on the provider.
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// InstallationID is the ID of the GitHub App installation that
+	// grants Sourcegraph access to the repository, if any.
+	InstallationID int64 `protobuf:"varint,3,opt,name=installation_id,proto3" json:"installation_id,omitempty"`
+	// HTMLURL is the URL to the repository's page on the provider.
+	HTMLURL string `protobuf:"bytes,4,opt,name=html_url,proto3" json:"html_url,omitempty"`
+}
+
+func (m *ExternalRepo) Reset()         { *m = ExternalRepo{} }
+func (m *ExternalRepo) String() string { return proto.CompactTextString(m) }
+func (*ExternalRepo) ProtoMessage()    {}
+
 type BadgeList struct {
 	Badges []*Badge `protobuf:"bytes,1,rep,name=badges" json:"badges,omitempty"`
 }
@@ -739,19 +1289,66 @@ func (m *RepoBadgesCountHitsResult) Reset()         { *m = RepoBadgesCountHitsRe
 func (m *RepoBadgesCountHitsResult) String() string { return proto.CompactTextString(m) }
 func (*RepoBadgesCountHitsResult) ProtoMessage()    {}
 
+type RepoBadgesCreateOp struct {
+	Repo RepoSpec `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	// Name is the name of the badge or counter. It must be unique
+	// among the repository's badges (or counters).
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Metric is the metric that the badge or counter displays (e.g.,
+	// "build-status" or "docs" for badges, and "hits", "refs", or
+	// "dependents" for counters).
+	Metric  string       `protobuf:"bytes,4,opt,name=metric,proto3" json:"metric,omitempty"`
+	Options BadgeOptions `protobuf:"bytes,5,opt,name=options" json:"options"`
+}
+
+func (m *RepoBadgesCreateOp) Reset()         { *m = RepoBadgesCreateOp{} }
+func (m *RepoBadgesCreateOp) String() string { return proto.CompactTextString(m) }
+func (*RepoBadgesCreateOp) ProtoMessage()    {}
+
+type RepoBadgesDeleteOp struct {
+	Repo RepoSpec `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	Name string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *RepoBadgesDeleteOp) Reset()         { *m = RepoBadgesDeleteOp{} }
+func (m *RepoBadgesDeleteOp) String() string { return proto.CompactTextString(m) }
+func (*RepoBadgesDeleteOp) ProtoMessage()    {}
+
 type RepoListOptions struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" url:",omitempty"`
 	// Specifies a search query for repositories. If specified, then the Sort and
 	// Direction options are ignored
-	Query       string   `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty" url:",omitempty"`
-	URIs        []string `protobuf:"bytes,3,rep,name=uri_s" json:"uri_s,omitempty" url:",comma,omitempty"`
-	BuiltOnly   bool     `protobuf:"varint,4,opt,name=built_only,proto3" json:"built_only,omitempty" url:",omitempty"`
-	Sort        string   `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty" url:",omitempty"`
-	Direction   string   `protobuf:"bytes,6,opt,name=direction,proto3" json:"direction,omitempty" url:",omitempty"`
-	NoFork      bool     `protobuf:"varint,7,opt,name=no_fork,proto3" json:"no_fork,omitempty" url:",omitempty"`
-	Type        string   `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty" url:",omitempty"`
-	State       string   `protobuf:"bytes,9,opt,name=state,proto3" json:"state,omitempty" url:",omitempty"`
-	Owner       string   `protobuf:"bytes,10,opt,name=owner,proto3" json:"owner,omitempty" url:",omitempty"`
+	Query     string   `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty" url:",omitempty"`
+	URIs      []string `protobuf:"bytes,3,rep,name=uri_s" json:"uri_s,omitempty" url:",comma,omitempty"`
+	BuiltOnly bool     `protobuf:"varint,4,opt,name=built_only,proto3" json:"built_only,omitempty" url:",omitempty"`
+	// Sort is a comma-separated list of fields to sort by, in order
+	// of precedence (e.g., "pushed,name"). The fields are "uri",
+	// "name", "created", "updated", and "pushed". A field prefixed
+	// with "-" is sorted in the opposite of Direction.
+	Sort string `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty" url:",omitempty"`
+	// Direction is the sort direction ("asc" or "desc").
+	Direction string `protobuf:"bytes,6,opt,name=direction,proto3" json:"direction,omitempty" url:",omitempty"`
+	NoFork    bool   `protobuf:"varint,7,opt,name=no_fork,proto3" json:"no_fork,omitempty" url:",omitempty"`
+	Type      string `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty" url:",omitempty"`
+	State     string `protobuf:"bytes,9,opt,name=state,proto3" json:"state,omitempty" url:",omitempty"`
+	Owner     string `protobuf:"bytes,10,opt,name=owner,proto3" json:"owner,omitempty" url:",omitempty"`
+	// Language, if set, limits the list to repositories whose primary
+	// language is Language (compared case-insensitively).
+	Language string `protobuf:"bytes,12,opt,name=language,proto3" json:"language,omitempty" url:",omitempty"`
+	// Host, if set, limits the list to repositories whose URIs begin
+	// with this host (e.g., "github.com").
+	Host string `protobuf:"bytes,13,opt,name=host,proto3" json:"host,omitempty" url:",omitempty"`
+	// PushedAfter and PushedBefore, if set, limit the list to
+	// repositories that were last pushed to in this time range.
+	PushedAfter  *pbtypes.Timestamp `protobuf:"bytes,14,opt,name=pushed_after" json:"pushed_after,omitempty" url:",omitempty"`
+	PushedBefore *pbtypes.Timestamp `protobuf:"bytes,15,opt,name=pushed_before" json:"pushed_before,omitempty" url:",omitempty"`
+	// Fields, if set, is the list of Repo fields (by their JSON
+	// names, e.g., "uri", "name", and "default_branch") to return.
+	// The other fields of the returned repositories are left empty,
+	// which reduces the size of the response. The URI is always
+	// returned.
+	Fields      []string `protobuf:"bytes,16,rep,name=fields" json:"fields,omitempty" url:",comma,omitempty"`
 	ListOptions `protobuf:"bytes,11,opt,name=list_options,embedded=list_options" json:"list_options"`
 }
 
@@ -771,6 +1368,160 @@ func (m *RepoPermissions) Reset()         { *m = RepoPermissions{} }
 func (m *RepoPermissions) String() string { return proto.CompactTextString(m) }
 func (*RepoPermissions) ProtoMessage()    {}
 
+// Collaborator is a user who has been granted permissions on a
+// repository.
+type Collaborator struct {
+	User        UserSpec        `protobuf:"bytes,1,opt,name=user" json:"user"`
+	Permissions RepoPermissions `protobuf:"bytes,2,opt,name=permissions" json:"permissions"`
+}
+
+func (m *Collaborator) Reset()         { *m = Collaborator{} }
+func (m *Collaborator) String() string { return proto.CompactTextString(m) }
+func (*Collaborator) ProtoMessage()    {}
+
+type CollaboratorList struct {
+	Collaborators  []*Collaborator `protobuf:"bytes,1,rep,name=collaborators" json:"collaborators,omitempty"`
+	StreamResponse `protobuf:"bytes,2,opt,name=stream_response,embedded=stream_response" json:"stream_response"`
+}
+
+func (m *CollaboratorList) Reset()         { *m = CollaboratorList{} }
+func (m *CollaboratorList) String() string { return proto.CompactTextString(m) }
+func (*CollaboratorList) ProtoMessage()    {}
+
+type ReposListCollaboratorsOp struct {
+	Repo RepoSpec     `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	Opt  *ListOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *ReposListCollaboratorsOp) Reset()         { *m = ReposListCollaboratorsOp{} }
+func (m *ReposListCollaboratorsOp) String() string { return proto.CompactTextString(m) }
+func (*ReposListCollaboratorsOp) ProtoMessage()    {}
+
+type ReposAddCollaboratorOp struct {
+	Repo        RepoSpec        `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	User        UserSpec        `protobuf:"bytes,2,opt,name=user" json:"user"`
+	Permissions RepoPermissions `protobuf:"bytes,3,opt,name=permissions" json:"permissions"`
+}
+
+func (m *ReposAddCollaboratorOp) Reset()         { *m = ReposAddCollaboratorOp{} }
+func (m *ReposAddCollaboratorOp) String() string { return proto.CompactTextString(m) }
+func (*ReposAddCollaboratorOp) ProtoMessage()    {}
+
+type ReposRemoveCollaboratorOp struct {
+	Repo RepoSpec `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	User UserSpec `protobuf:"bytes,2,opt,name=user" json:"user"`
+}
+
+func (m *ReposRemoveCollaboratorOp) Reset()         { *m = ReposRemoveCollaboratorOp{} }
+func (m *ReposRemoveCollaboratorOp) String() string { return proto.CompactTextString(m) }
+func (*ReposRemoveCollaboratorOp) ProtoMessage()    {}
+
+type ReposGetPermissionsOp struct {
+	Repo RepoSpec `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	User UserSpec `protobuf:"bytes,2,opt,name=user" json:"user"`
+}
+
+func (m *ReposGetPermissionsOp) Reset()         { *m = ReposGetPermissionsOp{} }
+func (m *ReposGetPermissionsOp) String() string { return proto.CompactTextString(m) }
+func (*ReposGetPermissionsOp) ProtoMessage()    {}
+
+// DeployKey is an SSH public key that grants access to a single
+// repository (rather than to a user's account).
+type DeployKey struct {
+	ID int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Title is a human-readable name for the key.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Key is the SSH public key.
+	Key SSHPublicKey `protobuf:"bytes,3,opt,name=key" json:"key"`
+	// ReadOnly is whether the key grants only read access (and not
+	// push access) to the repository.
+	ReadOnly  bool              `protobuf:"varint,4,opt,name=read_only,proto3" json:"read_only,omitempty"`
+	CreatedAt pbtypes.Timestamp `protobuf:"bytes,5,opt,name=created_at" json:"created_at"`
+}
+
+func (m *DeployKey) Reset()         { *m = DeployKey{} }
+func (m *DeployKey) String() string { return proto.CompactTextString(m) }
+func (*DeployKey) ProtoMessage()    {}
+
+type DeployKeyList struct {
+	Keys []*DeployKey `protobuf:"bytes,1,rep,name=keys" json:"keys,omitempty"`
+}
+
+func (m *DeployKeyList) Reset()         { *m = DeployKeyList{} }
+func (m *DeployKeyList) String() string { return proto.CompactTextString(m) }
+func (*DeployKeyList) ProtoMessage()    {}
+
+type ReposListKeysOp struct {
+	Repo RepoSpec `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+}
+
+func (m *ReposListKeysOp) Reset()         { *m = ReposListKeysOp{} }
+func (m *ReposListKeysOp) String() string { return proto.CompactTextString(m) }
+func (*ReposListKeysOp) ProtoMessage()    {}
+
+type ReposAddKeyOp struct {
+	Repo     RepoSpec     `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	Title    string       `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Key      SSHPublicKey `protobuf:"bytes,3,opt,name=key" json:"key"`
+	ReadOnly bool         `protobuf:"varint,4,opt,name=read_only,proto3" json:"read_only,omitempty"`
+}
+
+func (m *ReposAddKeyOp) Reset()         { *m = ReposAddKeyOp{} }
+func (m *ReposAddKeyOp) String() string { return proto.CompactTextString(m) }
+func (*ReposAddKeyOp) ProtoMessage()    {}
+
+type ReposDeleteKeyOp struct {
+	Repo RepoSpec `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	ID   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *ReposDeleteKeyOp) Reset()         { *m = ReposDeleteKeyOp{} }
+func (m *ReposDeleteKeyOp) String() string { return proto.CompactTextString(m) }
+func (*ReposDeleteKeyOp) ProtoMessage()    {}
+
+// RepoSubscription describes a user's subscription to (i.e., watch of)
+// a repository and their notification preferences for it.
+type RepoSubscription struct {
+	Repo RepoSpec `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	// Watching is whether the user is watching the repository.
+	Watching bool `protobuf:"varint,2,opt,name=watching,proto3" json:"watching,omitempty"`
+	// NotifyBuilds is whether the user is notified when builds of the
+	// repository complete.
+	NotifyBuilds bool `protobuf:"varint,3,opt,name=notify_builds,proto3" json:"notify_builds,omitempty"`
+	// NotifyDeltas is whether the user is notified when deltas
+	// (e.g., pull requests) are opened against the repository.
+	NotifyDeltas bool `protobuf:"varint,4,opt,name=notify_deltas,proto3" json:"notify_deltas,omitempty"`
+	// NotifyDiscussions is whether the user is notified about new
+	// discussions in the repository.
+	NotifyDiscussions bool `protobuf:"varint,5,opt,name=notify_discussions,proto3" json:"notify_discussions,omitempty"`
+	// CreatedAt is when the user began watching the repository.
+	CreatedAt *pbtypes.Timestamp `protobuf:"bytes,6,opt,name=created_at" json:"created_at,omitempty"`
+}
+
+func (m *RepoSubscription) Reset()         { *m = RepoSubscription{} }
+func (m *RepoSubscription) String() string { return proto.CompactTextString(m) }
+func (*RepoSubscription) ProtoMessage()    {}
+
+type ReposWatchOp struct {
+	Repo              RepoSpec `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	NotifyBuilds      bool     `protobuf:"varint,2,opt,name=notify_builds,proto3" json:"notify_builds,omitempty"`
+	NotifyDeltas      bool     `protobuf:"varint,3,opt,name=notify_deltas,proto3" json:"notify_deltas,omitempty"`
+	NotifyDiscussions bool     `protobuf:"varint,4,opt,name=notify_discussions,proto3" json:"notify_discussions,omitempty"`
+}
+
+func (m *ReposWatchOp) Reset()         { *m = ReposWatchOp{} }
+func (m *ReposWatchOp) String() string { return proto.CompactTextString(m) }
+func (*ReposWatchOp) ProtoMessage()    {}
+
+type ReposListWatchedOp struct {
+	User UserSpec     `protobuf:"bytes,1,opt,name=user" json:"user"`
+	Opt  *ListOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *ReposListWatchedOp) Reset()         { *m = ReposListWatchedOp{} }
+func (m *ReposListWatchedOp) String() string { return proto.CompactTextString(m) }
+func (*ReposListWatchedOp) ProtoMessage()    {}
+
 // RepoRevSpec specifies a repository at a specific commit (or revision specifier,
 // such as a branch, which is resolved on the server side to a specific commit).
 //
@@ -849,6 +1600,35 @@ func (m *RepoStatusesCreateOp) Reset()         { *m = RepoStatusesCreateOp{} }
 func (m *RepoStatusesCreateOp) String() string { return proto.CompactTextString(m) }
 func (*RepoStatusesCreateOp) ProtoMessage()    {}
 
+type RepoStatusesGetRollupOp struct {
+	Repo RepoRevSpec `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	// RequiredContexts are the contexts whose statuses determine the
+	// combined state. If empty, all contexts are used.
+	RequiredContexts []string `protobuf:"bytes,2,rep,name=required_contexts" json:"required_contexts,omitempty" url:",omitempty,comma"`
+}
+
+func (m *RepoStatusesGetRollupOp) Reset()         { *m = RepoStatusesGetRollupOp{} }
+func (m *RepoStatusesGetRollupOp) String() string { return proto.CompactTextString(m) }
+func (*RepoStatusesGetRollupOp) ProtoMessage()    {}
+
+type RepoStatusesListOp struct {
+	Repo RepoRevSpec  `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	Opt  *ListOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *RepoStatusesListOp) Reset()         { *m = RepoStatusesListOp{} }
+func (m *RepoStatusesListOp) String() string { return proto.CompactTextString(m) }
+func (*RepoStatusesListOp) ProtoMessage()    {}
+
+type RepoStatusList struct {
+	Statuses       []*RepoStatus `protobuf:"bytes,1,rep,name=statuses" json:"statuses,omitempty"`
+	StreamResponse `protobuf:"bytes,2,opt,name=stream_response,embedded=stream_response" json:"stream_response"`
+}
+
+func (m *RepoStatusList) Reset()         { *m = RepoStatusList{} }
+func (m *RepoStatusList) String() string { return proto.CompactTextString(m) }
+func (*RepoStatusList) ProtoMessage()    {}
+
 type RepoList struct {
 	Repos []*Repo `protobuf:"bytes,1,rep,name=repos" json:"repos,omitempty"`
 }
@@ -1024,6 +1804,18 @@ type ReposCreateOp struct {
 	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
 	// Language is the primary programming language of the repository.
 	Language string `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"`
+	// Provider is the code host that the repository originates from
+	// ("github", "gitlab", "bitbucket", or "local"). If empty, it is
+	// "local" for hosted repositories and determined from CloneURL
+	// for mirrors.
+	Provider string `protobuf:"bytes,8,opt,name=provider,proto3" json:"provider,omitempty"`
+	// ExternalID is the repository's ID on its provider (e.g., the
+	// GitHub repository ID). It is required for providers other than
+	// "local".
+	ExternalID string `protobuf:"bytes,9,opt,name=external_id,proto3" json:"external_id,omitempty"`
+	// InstallationID is the ID of the GitHub App installation that
+	// grants Sourcegraph access to the repository, if any.
+	InstallationID int64 `protobuf:"varint,10,opt,name=installation_id,proto3" json:"installation_id,omitempty"`
 }
 
 func (m *ReposCreateOp) Reset()         { *m = ReposCreateOp{} }
@@ -1046,6 +1838,152 @@ func (m *ReposUpdateOp) Reset()         { *m = ReposUpdateOp{} }
 func (m *ReposUpdateOp) String() string { return proto.CompactTextString(m) }
 func (*ReposUpdateOp) ProtoMessage()    {}
 
+type ReposGetCommitOp struct {
+	Rev RepoRevSpec           `protobuf:"bytes,1,opt,name=rev" json:"rev"`
+	Opt *RepoGetCommitOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *ReposGetCommitOp) Reset()         { *m = ReposGetCommitOp{} }
+func (m *ReposGetCommitOp) String() string { return proto.CompactTextString(m) }
+func (*ReposGetCommitOp) ProtoMessage()    {}
+
+type RepoGetCommitOptions struct {
+	// IncludeDiff is whether to include the commit's file diffs (and
+	// diff stats) in the CommitDetail.
+	IncludeDiff bool `protobuf:"varint,1,opt,name=include_diff,proto3" json:"include_diff,omitempty" url:",omitempty"`
+	// IncludeFiles is whether to include the paths of the files
+	// changed by the commit in the CommitDetail.
+	IncludeFiles bool `protobuf:"varint,2,opt,name=include_files,proto3" json:"include_files,omitempty" url:",omitempty"`
+	// VerifySignature is whether to verify the commit's GPG signature
+	// (against the GPG keys of the repository's users) and include the
+	// result in the CommitDetail.
+	VerifySignature bool `protobuf:"varint,3,opt,name=verify_signature,proto3" json:"verify_signature,omitempty" url:",omitempty"`
+}
+
+func (m *RepoGetCommitOptions) Reset()         { *m = RepoGetCommitOptions{} }
+func (m *RepoGetCommitOptions) String() string { return proto.CompactTextString(m) }
+func (*RepoGetCommitOptions) ProtoMessage()    {}
+
+// CommitDetail is a commit along with (optionally) the files it
+// changed and its diff.
+type CommitDetail struct {
+	vcs.Commit `protobuf:"bytes,1,opt,name=commit,embedded=commit" json:"commit"`
+	// Files are the paths of the files changed by the commit. It is
+	// only set if RepoGetCommitOptions.IncludeFiles is true.
+	Files []string `protobuf:"bytes,2,rep,name=files" json:"files,omitempty"`
+	// FileDiffs are the commit's file diffs. It is only set if
+	// RepoGetCommitOptions.IncludeDiff is true.
+	FileDiffs []*FileDiff `protobuf:"bytes,3,rep,name=file_diffs" json:"file_diffs,omitempty"`
+	// Stats are the commit's diff stats. It is only set if
+	// RepoGetCommitOptions.IncludeDiff is true.
+	Stats diff.Stat `protobuf:"bytes,4,opt,name=stats" json:"stats"`
+	// Verification is the result of verifying the commit's signature.
+	// It is only set if RepoGetCommitOptions.VerifySignature is true.
+	Verification *CommitVerification `protobuf:"bytes,5,opt,name=verification" json:"verification,omitempty"`
+}
+
+func (m *CommitDetail) Reset()         { *m = CommitDetail{} }
+func (m *CommitDetail) String() string { return proto.CompactTextString(m) }
+func (*CommitDetail) ProtoMessage()    {}
+
+// CommitVerification is the result of verifying a commit's GPG
+// signature.
+type CommitVerification struct {
+	// Verified is whether the commit is signed with a valid signature
+	// by a known key whose owner's email matches the committer's.
+	Verified bool `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
+	// Reason is why the commit is (or is not) verified. It is one of
+	// the CommitVerificationReason* constants.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// SignerKeyID is the ID of the GPG key that signed the commit, if
+	// it is signed. It is set even if the key is unknown.
+	SignerKeyID string `protobuf:"bytes,3,opt,name=signer_key_id,proto3" json:"signer_key_id,omitempty"`
+}
+
+func (m *CommitVerification) Reset()         { *m = CommitVerification{} }
+func (m *CommitVerification) String() string { return proto.CompactTextString(m) }
+func (*CommitVerification) ProtoMessage()    {}
+
+type ReposGetCommitPatchOp struct {
+	Rev RepoRevSpec                `protobuf:"bytes,1,opt,name=rev" json:"rev"`
+	Opt *RepoGetCommitPatchOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *ReposGetCommitPatchOp) Reset()         { *m = ReposGetCommitPatchOp{} }
+func (m *ReposGetCommitPatchOp) String() string { return proto.CompactTextString(m) }
+func (*ReposGetCommitPatchOp) ProtoMessage()    {}
+
+type RepoGetCommitPatchOptions struct {
+	// Offset is the offset in bytes into the patch at which to begin
+	// reading. You must retain the offset state yourself.
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty" url:",omitempty"`
+	// MaxBytes is the maximum number of bytes of patch data to
+	// return. The server enforces its own cap, so fewer bytes may be
+	// returned. If zero, the server's default is used.
+	MaxBytes int64 `protobuf:"varint,2,opt,name=max_bytes,proto3" json:"max_bytes,omitempty" url:",omitempty"`
+	// OmitBinary causes the diffs of binary files to be omitted from
+	// the patch. The names of omitted files are listed in
+	// CommitPatch.OmittedFiles.
+	OmitBinary bool `protobuf:"varint,3,opt,name=omit_binary,proto3" json:"omit_binary,omitempty" url:",omitempty"`
+}
+
+func (m *RepoGetCommitPatchOptions) Reset()         { *m = RepoGetCommitPatchOptions{} }
+func (m *RepoGetCommitPatchOptions) String() string { return proto.CompactTextString(m) }
+func (*RepoGetCommitPatchOptions) ProtoMessage()    {}
+
+// CommitPatch is a chunk of a commit's patch.
+type CommitPatch struct {
+	// Data is the patch data starting at Offset. There is no
+	// guarantee that the requested number of bytes will be returned,
+	// so if EOF is false you should read again from Offset+len(Data).
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Offset is the offset in bytes of Data within the patch.
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// TotalSize is the total size in bytes of the patch.
+	TotalSize int64 `protobuf:"varint,3,opt,name=total_size,proto3" json:"total_size,omitempty"`
+	// EOF is whether Data extends to the end of the patch.
+	EOF bool `protobuf:"varint,4,opt,name=eof,proto3" json:"eof,omitempty"`
+	// OmittedFiles is the list of files whose diffs were omitted
+	// from the patch (e.g., because they are binary).
+	OmittedFiles []string `protobuf:"bytes,5,rep,name=omitted_files" json:"omitted_files,omitempty"`
+}
+
+func (m *CommitPatch) Reset()         { *m = CommitPatch{} }
+func (m *CommitPatch) String() string { return proto.CompactTextString(m) }
+func (*CommitPatch) ProtoMessage()    {}
+
+type ReposGetArchiveOp struct {
+	Rev    RepoRevSpec   `protobuf:"bytes,1,opt,name=rev" json:"rev"`
+	Format ArchiveFormat `protobuf:"varint,2,opt,name=format,proto3,enum=sourcegraph.ArchiveFormat" json:"format,omitempty"`
+	// Offset is the offset in bytes into the archive at which to begin
+	// reading. You must retain the offset state yourself.
+	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// MaxBytes is the maximum number of bytes of archive data to
+	// return. The server enforces its own cap, so fewer bytes may be
+	// returned. If zero, the server's default is used.
+	MaxBytes int64 `protobuf:"varint,4,opt,name=max_bytes,proto3" json:"max_bytes,omitempty"`
+}
+
+func (m *ReposGetArchiveOp) Reset()         { *m = ReposGetArchiveOp{} }
+func (m *ReposGetArchiveOp) String() string { return proto.CompactTextString(m) }
+func (*ReposGetArchiveOp) ProtoMessage()    {}
+
+// RepoArchive is a chunk of a repository archive.
+type RepoArchive struct {
+	// Data is the archive data starting at Offset. There is no
+	// guarantee that the requested number of bytes will be returned,
+	// so if EOF is false you should read again from Offset+len(Data).
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Offset is the offset in bytes of Data within the archive.
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// EOF is whether Data extends to the end of the archive.
+	EOF bool `protobuf:"varint,3,opt,name=eof,proto3" json:"eof,omitempty"`
+}
+
+func (m *RepoArchive) Reset()         { *m = RepoArchive{} }
+func (m *RepoArchive) String() string { return proto.CompactTextString(m) }
+func (*RepoArchive) ProtoMessage()    {}
+
 type ReposListCommitsOp struct {
 	Repo RepoSpec                `protobuf:"bytes,1,opt,name=repo" json:"repo"`
 	Opt  *RepoListCommitsOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
@@ -1056,11 +1994,37 @@ func (m *ReposListCommitsOp) String() string { return proto.CompactTextString(m)
 func (*ReposListCommitsOp) ProtoMessage()    {}
 
 type RepoListCommitsOptions struct {
-	Head         string `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty" url:",omitempty"`
-	Base         string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty" url:",omitempty"`
-	ListOptions  `protobuf:"bytes,3,opt,name=list_options,embedded=list_options" json:"list_options"`
+	Head        string `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty" url:",omitempty"`
+	Base        string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty" url:",omitempty"`
+	ListOptions `protobuf:"bytes,3,opt,name=list_options,embedded=list_options" json:"list_options"`
+	// Path, if set, limits the list to commits that changed the file or
+	// directory at this path.
 	Path         string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty" url:",omitempty"`
 	RefreshCache bool   `protobuf:"varint,5,opt,name=refresh_cache,proto3" json:"refresh_cache,omitempty" url:",omitempty"`
+	// Author, if set, limits the list to commits whose author's name or
+	// email contains this string (case-insensitively).
+	Author string `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty" url:",omitempty"`
+	// Committer, if set, limits the list to commits whose committer's
+	// name or email contains this string (case-insensitively).
+	Committer string `protobuf:"bytes,7,opt,name=committer,proto3" json:"committer,omitempty" url:",omitempty"`
+	// Since, if set, limits the list to commits committed at or after
+	// this time.
+	Since *pbtypes.Timestamp `protobuf:"bytes,8,opt,name=since" json:"since,omitempty" url:",omitempty"`
+	// Until, if set, limits the list to commits committed before this
+	// time.
+	Until *pbtypes.Timestamp `protobuf:"bytes,9,opt,name=until" json:"until,omitempty" url:",omitempty"`
+	// AfterCommit, if set, is the ID of a commit in Head's history.
+	// The list starts with the commit that follows it in history (and
+	// Page is ignored). To page through history, set it to the ID of
+	// the last commit in the previous page (see
+	// CommitList.NextPageOptions). This is faster than paging by
+	// page number, because the server need not skip over the commits
+	// on earlier pages.
+	AfterCommit string `protobuf:"bytes,10,opt,name=after_commit,proto3" json:"after_commit,omitempty" url:",omitempty"`
+	// VerifySignatures is whether to verify the listed commits' GPG
+	// signatures and include the results in
+	// CommitList.Verifications.
+	VerifySignatures bool `protobuf:"varint,11,opt,name=verify_signatures,proto3" json:"verify_signatures,omitempty" url:",omitempty"`
 }
 
 func (m *RepoListCommitsOptions) Reset()         { *m = RepoListCommitsOptions{} }
@@ -1070,6 +2034,10 @@ func (*RepoListCommitsOptions) ProtoMessage()    {}
 type CommitList struct {
 	Commits        []*vcs.Commit `protobuf:"bytes,1,rep,name=commits" json:"commits,omitempty"`
 	StreamResponse `protobuf:"bytes,2,opt,name=stream_response,embedded=stream_response" json:"stream_response"`
+	// Verifications maps the IDs of the listed commits to the results
+	// of verifying their signatures. It is only set if
+	// RepoListCommitsOptions.VerifySignatures is true.
+	Verifications map[string]*CommitVerification `protobuf:"bytes,3,rep,name=verifications" json:"verifications,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *CommitList) Reset()         { *m = CommitList{} }
@@ -1086,10 +2054,17 @@ func (m *ReposListBranchesOp) String() string { return proto.CompactTextString(m
 func (*ReposListBranchesOp) ProtoMessage()    {}
 
 type RepoListBranchesOptions struct {
-	IncludeCommit     bool   `protobuf:"varint,4,opt,name=include_commit,proto3" json:"include_commit,omitempty"`
-	BehindAheadBranch string `protobuf:"bytes,5,opt,name=behind_ahead_branch,proto3" json:"behind_ahead_branch,omitempty"`
-	ContainsCommit    string `protobuf:"bytes,6,opt,name=contains_commit,proto3" json:"contains_commit,omitempty"`
-	ListOptions       `protobuf:"bytes,3,opt,name=list_options,embedded=list_options" json:"list_options"`
+	// IncludeCommit is whether to include each branch's tip commit
+	// (in Branch.Commit).
+	IncludeCommit bool `protobuf:"varint,4,opt,name=include_commit,proto3" json:"include_commit,omitempty" url:",omitempty"`
+	// BehindAheadBranch, if set, is the name of the branch (usually
+	// the repository's default branch) that each branch's ahead/behind
+	// commit counts (in Branch.Counts) are computed relative to.
+	BehindAheadBranch string `protobuf:"bytes,5,opt,name=behind_ahead_branch,proto3" json:"behind_ahead_branch,omitempty" url:",omitempty"`
+	// ContainsCommit, if set, limits the list to branches that
+	// contain this commit.
+	ContainsCommit string `protobuf:"bytes,6,opt,name=contains_commit,proto3" json:"contains_commit,omitempty" url:",omitempty"`
+	ListOptions    `protobuf:"bytes,3,opt,name=list_options,embedded=list_options" json:"list_options"`
 }
 
 func (m *RepoListBranchesOptions) Reset()         { *m = RepoListBranchesOptions{} }
@@ -1141,6 +2116,167 @@ func (m *CommitterList) Reset()         { *m = CommitterList{} }
 func (m *CommitterList) String() string { return proto.CompactTextString(m) }
 func (*CommitterList) ProtoMessage()    {}
 
+type ReposListContributorsOp struct {
+	Repo RepoSpec                     `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	Opt  *RepoListContributorsOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *ReposListContributorsOp) Reset()         { *m = ReposListContributorsOp{} }
+func (m *ReposListContributorsOp) String() string { return proto.CompactTextString(m) }
+func (*ReposListContributorsOp) ProtoMessage()    {}
+
+type RepoListContributorsOptions struct {
+	Rev         string `protobuf:"bytes,1,opt,name=rev,proto3" json:"rev,omitempty" url:",omitempty"`
+	ListOptions `protobuf:"bytes,2,opt,name=list_options,embedded=list_options" json:"list_options"`
+}
+
+func (m *RepoListContributorsOptions) Reset()         { *m = RepoListContributorsOptions{} }
+func (m *RepoListContributorsOptions) String() string { return proto.CompactTextString(m) }
+func (*RepoListContributorsOptions) ProtoMessage()    {}
+
+// Contributor is a person who has contributed to a repository.
+type Contributor struct {
+	Person `protobuf:"bytes,1,opt,name=person,embedded=person" json:"person"`
+	// Commits is the number of commits the person has authored.
+	Commits int32 `protobuf:"varint,2,opt,name=commits,proto3" json:"commits,omitempty"`
+}
+
+func (m *Contributor) Reset()         { *m = Contributor{} }
+func (m *Contributor) String() string { return proto.CompactTextString(m) }
+func (*Contributor) ProtoMessage()    {}
+
+type ContributorList struct {
+	// Contributors are sorted by descending commit count.
+	Contributors   []*Contributor `protobuf:"bytes,1,rep,name=contributors" json:"contributors,omitempty"`
+	StreamResponse `protobuf:"bytes,2,opt,name=stream_response,embedded=stream_response" json:"stream_response"`
+}
+
+func (m *ContributorList) Reset()         { *m = ContributorList{} }
+func (m *ContributorList) String() string { return proto.CompactTextString(m) }
+func (*ContributorList) ProtoMessage()    {}
+
+type ReposSearchCodeOp struct {
+	Rev RepoRevSpec        `protobuf:"bytes,1,opt,name=rev" json:"rev"`
+	Opt *CodeSearchOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *ReposSearchCodeOp) Reset()         { *m = ReposSearchCodeOp{} }
+func (m *ReposSearchCodeOp) String() string { return proto.CompactTextString(m) }
+func (*ReposSearchCodeOp) ProtoMessage()    {}
+
+// CodeSearchOptions specifies options for Repos.SearchCode.
+type CodeSearchOptions struct {
+	// Query is the string (or, if Regexp is true, the RE2 regexp) to
+	// search for. Matches do not span lines.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty" url:"q" schema:"q"`
+	// Regexp is whether Query is a regexp (instead of a literal
+	// string).
+	Regexp bool `protobuf:"varint,2,opt,name=regexp,proto3" json:"regexp,omitempty" url:",omitempty"`
+	// CaseSensitive is whether to match Query case-sensitively.
+	CaseSensitive bool `protobuf:"varint,3,opt,name=case_sensitive,proto3" json:"case_sensitive,omitempty" url:",omitempty"`
+	// Paths, if set, limits the search to these files and to files
+	// beneath these directories.
+	Paths       []string `protobuf:"bytes,4,rep,name=paths" json:"paths,omitempty" url:",omitempty,comma"`
+	ListOptions `protobuf:"bytes,5,opt,name=list_options,embedded=list_options" json:"list_options"`
+}
+
+func (m *CodeSearchOptions) Reset()         { *m = CodeSearchOptions{} }
+func (m *CodeSearchOptions) String() string { return proto.CompactTextString(m) }
+func (*CodeSearchOptions) ProtoMessage()    {}
+
+// CodeSearchResult is a line of a file that matched a code search.
+type CodeSearchResult struct {
+	// File is the path of the file.
+	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Line is the line number (1-indexed) of the matching line.
+	Line int32 `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	// Preview is the text of the matching line (without the trailing
+	// newline).
+	Preview string `protobuf:"bytes,3,opt,name=preview,proto3" json:"preview,omitempty"`
+	// Matches are the ranges of Preview that matched the query. They
+	// are ordered and do not overlap.
+	Matches []MatchRange `protobuf:"bytes,4,rep,name=matches" json:"matches"`
+}
+
+func (m *CodeSearchResult) Reset()         { *m = CodeSearchResult{} }
+func (m *CodeSearchResult) String() string { return proto.CompactTextString(m) }
+func (*CodeSearchResult) ProtoMessage()    {}
+
+type CodeSearchResultList struct {
+	Results      []*CodeSearchResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+	ListResponse `protobuf:"bytes,2,opt,name=list_response,embedded=list_response" json:"list_response"`
+}
+
+func (m *CodeSearchResultList) Reset()         { *m = CodeSearchResultList{} }
+func (m *CodeSearchResultList) String() string { return proto.CompactTextString(m) }
+func (*CodeSearchResultList) ProtoMessage()    {}
+
+type ReposGetCodeOwnersOp struct {
+	Rev RepoRevSpec `protobuf:"bytes,1,opt,name=rev" json:"rev"`
+	// Path is the path (relative to the repository root) of the file
+	// or directory whose owners are returned.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (m *ReposGetCodeOwnersOp) Reset()         { *m = ReposGetCodeOwnersOp{} }
+func (m *ReposGetCodeOwnersOp) String() string { return proto.CompactTextString(m) }
+func (*ReposGetCodeOwnersOp) ProtoMessage()    {}
+
+// CodeOwners are the owners of a path in a repository, according to
+// the repository's code owners file.
+type CodeOwners struct {
+	// Path is the path whose owners these are.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// File is the path of the code owners file that was evaluated.
+	// It is empty if the repository has no code owners file.
+	File string `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	// Rule is the rule in File that matched Path (the last matching
+	// rule). It is null if no rule matched, in which case Owners is
+	// empty.
+	Rule *CodeOwnersRule `protobuf:"bytes,3,opt,name=rule" json:"rule,omitempty"`
+	// Owners are the owners of Path, in the order that Rule lists
+	// them.
+	Owners []*CodeOwner `protobuf:"bytes,4,rep,name=owners" json:"owners,omitempty"`
+}
+
+func (m *CodeOwners) Reset()         { *m = CodeOwners{} }
+func (m *CodeOwners) String() string { return proto.CompactTextString(m) }
+func (*CodeOwners) ProtoMessage()    {}
+
+// A CodeOwnersRule is a rule in a code owners file, which assigns
+// owners to the paths that match a pattern.
+type CodeOwnersRule struct {
+	// Pattern is the rule's path pattern (e.g., "*.go" or "/docs/").
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// Owners are the rule's owners as written in the file (e.g.,
+	// "@alice", "@org/team", or "bob@example.com").
+	Owners []string `protobuf:"bytes,2,rep,name=owners" json:"owners,omitempty"`
+	// Line is the rule's 1-indexed line number in the file.
+	Line int32 `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+}
+
+func (m *CodeOwnersRule) Reset()         { *m = CodeOwnersRule{} }
+func (m *CodeOwnersRule) String() string { return proto.CompactTextString(m) }
+func (*CodeOwnersRule) ProtoMessage()    {}
+
+// A CodeOwner is an owner listed in a code owners file, resolved (if
+// possible) to a user or team.
+type CodeOwner struct {
+	// Owner is the owner as written in the file.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// User is the user that the owner resolved to, if any.
+	User *UserSpec `protobuf:"bytes,2,opt,name=user" json:"user,omitempty"`
+	// Team is the team that the owner resolved to, if any.
+	Team *TeamSpec `protobuf:"bytes,3,opt,name=team" json:"team,omitempty"`
+	// Email is the owner's email address, if the owner was listed by
+	// email.
+	Email string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (m *CodeOwner) Reset()         { *m = CodeOwner{} }
+func (m *CodeOwner) String() string { return proto.CompactTextString(m) }
+func (*CodeOwner) ProtoMessage()    {}
+
 type ChangesetCreateOp struct {
 	Repo      RepoSpec   `protobuf:"bytes,1,opt,name=repo" json:"repo"`
 	Changeset *Changeset `protobuf:"bytes,2,opt,name=changeset" json:"changeset,omitempty"`
@@ -1273,6 +2409,106 @@ func (m *DiscussionRatingUpdateOp) Reset()         { *m = DiscussionRatingUpdate
 func (m *DiscussionRatingUpdateOp) String() string { return proto.CompactTextString(m) }
 func (*DiscussionRatingUpdateOp) ProtoMessage()    {}
 
+// IssueSpec specifies an issue.
+type IssueSpec struct {
+	Repo RepoSpec `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	// ID is the issue's number, relative to the repository.
+	ID int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *IssueSpec) Reset()         { *m = IssueSpec{} }
+func (m *IssueSpec) String() string { return proto.CompactTextString(m) }
+func (*IssueSpec) ProtoMessage()    {}
+
+// Issue is an issue in a repository's issue tracker.
+type Issue struct {
+	// ID is the issue's number, relative to the repository.
+	ID    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body  string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// State is the issue's state: "open" or "closed".
+	State  string   `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Author UserSpec `protobuf:"bytes,5,opt,name=author" json:"author"`
+	Labels []string `protobuf:"bytes,6,rep,name=labels" json:"labels,omitempty"`
+	// HTMLURL is the URL to the issue's page on its issue tracker.
+	HTMLURL string `protobuf:"bytes,7,opt,name=html_url,proto3" json:"html_url,omitempty"`
+	// Tracker is the issue tracker that holds the issue (e.g.,
+	// "github" or "native").
+	Tracker   string             `protobuf:"bytes,8,opt,name=tracker,proto3" json:"tracker,omitempty"`
+	CreatedAt pbtypes.Timestamp  `protobuf:"bytes,9,opt,name=created_at" json:"created_at"`
+	UpdatedAt pbtypes.Timestamp  `protobuf:"bytes,10,opt,name=updated_at" json:"updated_at"`
+	ClosedAt  *pbtypes.Timestamp `protobuf:"bytes,11,opt,name=closed_at" json:"closed_at,omitempty"`
+}
+
+func (m *Issue) Reset()         { *m = Issue{} }
+func (m *Issue) String() string { return proto.CompactTextString(m) }
+func (*Issue) ProtoMessage()    {}
+
+// IssueComment is a comment on an issue.
+type IssueComment struct {
+	ID        int64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Body      string            `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Author    UserSpec          `protobuf:"bytes,3,opt,name=author" json:"author"`
+	CreatedAt pbtypes.Timestamp `protobuf:"bytes,4,opt,name=created_at" json:"created_at"`
+}
+
+func (m *IssueComment) Reset()         { *m = IssueComment{} }
+func (m *IssueComment) String() string { return proto.CompactTextString(m) }
+func (*IssueComment) ProtoMessage()    {}
+
+type IssuesListOp struct {
+	Repo RepoSpec          `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	Opt  *IssueListOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *IssuesListOp) Reset()         { *m = IssuesListOp{} }
+func (m *IssuesListOp) String() string { return proto.CompactTextString(m) }
+func (*IssuesListOp) ProtoMessage()    {}
+
+// IssueListOptions specifies options for IssuesService.List.
+type IssueListOptions struct {
+	// State filters the list to issues in the given state ("open" or
+	// "closed"). If empty, issues in all states are listed.
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty" url:",omitempty"`
+	// Labels filters the list to issues that have all of the given
+	// labels.
+	Labels      []string `protobuf:"bytes,2,rep,name=labels" json:"labels,omitempty" url:",omitempty,comma"`
+	ListOptions `protobuf:"bytes,3,opt,name=list_options,embedded=list_options" json:"list_options"`
+}
+
+func (m *IssueListOptions) Reset()         { *m = IssueListOptions{} }
+func (m *IssueListOptions) String() string { return proto.CompactTextString(m) }
+func (*IssueListOptions) ProtoMessage()    {}
+
+type IssueList struct {
+	Issues         []*Issue `protobuf:"bytes,1,rep,name=issues" json:"issues,omitempty"`
+	StreamResponse `protobuf:"bytes,2,opt,name=stream_response,embedded=stream_response" json:"stream_response"`
+}
+
+func (m *IssueList) Reset()         { *m = IssueList{} }
+func (m *IssueList) String() string { return proto.CompactTextString(m) }
+func (*IssueList) ProtoMessage()    {}
+
+type IssuesCreateOp struct {
+	Repo   RepoSpec `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	Title  string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body   string   `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Labels []string `protobuf:"bytes,4,rep,name=labels" json:"labels,omitempty"`
+}
+
+func (m *IssuesCreateOp) Reset()         { *m = IssuesCreateOp{} }
+func (m *IssuesCreateOp) String() string { return proto.CompactTextString(m) }
+func (*IssuesCreateOp) ProtoMessage()    {}
+
+type IssuesCreateCommentOp struct {
+	Issue IssueSpec `protobuf:"bytes,1,opt,name=issue" json:"issue"`
+	Body  string    `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (m *IssuesCreateCommentOp) Reset()         { *m = IssuesCreateCommentOp{} }
+func (m *IssuesCreateCommentOp) String() string { return proto.CompactTextString(m) }
+func (*IssuesCreateCommentOp) ProtoMessage()    {}
+
 type RepoListTagsOptions struct {
 	ListOptions `protobuf:"bytes,3,opt,name=list_options,embedded=list_options" json:"list_options"`
 }
@@ -1299,6 +2535,41 @@ func (m *MirrorReposRefreshVCSOp) Reset()         { *m = MirrorReposRefreshVCSOp
 func (m *MirrorReposRefreshVCSOp) String() string { return proto.CompactTextString(m) }
 func (*MirrorReposRefreshVCSOp) ProtoMessage()    {}
 
+type MirrorReposEnableOp struct {
+	Repo RepoSpec `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	// CloneURL is the clone URL of the upstream repository to
+	// mirror. If empty, the repository's existing clone URL is used.
+	CloneURL    string          `protobuf:"bytes,2,opt,name=clone_url,proto3" json:"clone_url,omitempty"`
+	Credentials *VCSCredentials `protobuf:"bytes,3,opt,name=credentials" json:"credentials,omitempty"`
+}
+
+func (m *MirrorReposEnableOp) Reset()         { *m = MirrorReposEnableOp{} }
+func (m *MirrorReposEnableOp) String() string { return proto.CompactTextString(m) }
+func (*MirrorReposEnableOp) ProtoMessage()    {}
+
+// MirrorStatus describes the health of a mirrored repository's
+// synchronization with its upstream.
+type MirrorStatus struct {
+	// Enabled is whether the repository is currently mirrored.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// CloneURL is the clone URL of the upstream repository being
+	// mirrored.
+	CloneURL string `protobuf:"bytes,2,opt,name=clone_url,proto3" json:"clone_url,omitempty"`
+	// LastSyncedAt is when the mirror was last successfully synced
+	// with its upstream. It is null if it has never been synced.
+	LastSyncedAt *pbtypes.Timestamp `protobuf:"bytes,3,opt,name=last_synced_at" json:"last_synced_at,omitempty"`
+	// LastAttemptedAt is when the last sync (successful or not) was
+	// attempted.
+	LastAttemptedAt *pbtypes.Timestamp `protobuf:"bytes,4,opt,name=last_attempted_at" json:"last_attempted_at,omitempty"`
+	// LastError is the error message from the last sync attempt, or
+	// empty if it succeeded.
+	LastError string `protobuf:"bytes,5,opt,name=last_error,proto3" json:"last_error,omitempty"`
+}
+
+func (m *MirrorStatus) Reset()         { *m = MirrorStatus{} }
+func (m *MirrorStatus) String() string { return proto.CompactTextString(m) }
+func (*MirrorStatus) ProtoMessage()    {}
+
 // VCSCredentials for authentication during communication with VCS remotes.
 type VCSCredentials struct {
 	// Pass is the password provided to the VCS.
@@ -1375,6 +2646,9 @@ type Build struct {
 	Host        string `protobuf:"bytes,11,opt,name=host,proto3" json:"host,omitempty"`
 	Purged      bool   `protobuf:"varint,12,opt,name=purged,proto3" json:"purged,omitempty"`
 	BuildConfig `protobuf:"bytes,13,opt,name=build_config,embedded=build_config" json:"build_config"`
+	// Branch is the name of the branch whose head commit this build was
+	// created for, if any.
+	Branch string `protobuf:"bytes,14,opt,name=branch,proto3" json:"branch,omitempty"`
 }
 
 func (m *Build) Reset()         { *m = Build{} }
@@ -1400,6 +2674,13 @@ type BuildConfig struct {
 	// Priority of the build in the queue (higher numbers mean the build is dequeued
 	// sooner).
 	Priority int32 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Tags are arbitrary labels on the build (e.g., "ci" or
+	// "nightly"), used to distinguish builds created by different
+	// systems.
+	Tags []string `protobuf:"bytes,5,rep,name=tags" json:"tags,omitempty"`
+	// RequestedBy identifies the user or system that requested the
+	// build (e.g., a user's login or "webhook").
+	RequestedBy string `protobuf:"bytes,6,opt,name=requested_by,proto3" json:"requested_by,omitempty"`
 }
 
 func (m *BuildConfig) Reset()         { *m = BuildConfig{} }
@@ -1427,6 +2708,17 @@ type BuildGetLogOptions struct {
 	// To "tail -f" or watch a log for updates, set each subsequent request's MinID to
 	// the MaxID of the previous request.
 	MinID string `protobuf:"bytes,1,opt,name=min_id,proto3" json:"min_id,omitempty"`
+	// Offset is the number of log entries (after MinID, if set) to
+	// skip.
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty" url:",omitempty"`
+	// Limit is the maximum number of log entries to return. If zero,
+	// all entries are returned.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty" url:",omitempty"`
+	// Structured is whether to return the log entries parsed into
+	// LogEntries.Structured (instead of as lines in
+	// LogEntries.Entries). It is supported by Builds.GetTaskLog, whose
+	// task logs are stored as JSON lines (see ParseLogEntry).
+	Structured bool `protobuf:"varint,4,opt,name=structured,proto3" json:"structured,omitempty" url:",omitempty"`
 }
 
 func (m *BuildGetLogOptions) Reset()         { *m = BuildGetLogOptions{} }
@@ -1434,15 +2726,29 @@ func (m *BuildGetLogOptions) String() string { return proto.CompactTextString(m)
 func (*BuildGetLogOptions) ProtoMessage()    {}
 
 type BuildListOptions struct {
-	Queued      bool   `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty" url:",omitempty"`
-	Active      bool   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty" url:",omitempty"`
-	Ended       bool   `protobuf:"varint,3,opt,name=ended,proto3" json:"ended,omitempty" url:",omitempty"`
-	Succeeded   bool   `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty" url:",omitempty"`
-	Failed      bool   `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty" url:",omitempty"`
-	Purged      bool   `protobuf:"varint,6,opt,name=purged,proto3" json:"purged,omitempty" url:",omitempty"`
-	Repo        string `protobuf:"bytes,7,opt,name=repo,proto3" json:"repo,omitempty" url:",omitempty"`
-	CommitID    string `protobuf:"bytes,8,opt,name=commit_id,proto3" json:"commit_id,omitempty" url:",omitempty"`
-	Sort        string `protobuf:"bytes,9,opt,name=sort,proto3" json:"sort,omitempty" url:",omitempty"`
+	Queued    bool   `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty" url:",omitempty"`
+	Active    bool   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty" url:",omitempty"`
+	Ended     bool   `protobuf:"varint,3,opt,name=ended,proto3" json:"ended,omitempty" url:",omitempty"`
+	Succeeded bool   `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty" url:",omitempty"`
+	Failed    bool   `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty" url:",omitempty"`
+	Purged    bool   `protobuf:"varint,6,opt,name=purged,proto3" json:"purged,omitempty" url:",omitempty"`
+	Repo      string `protobuf:"bytes,7,opt,name=repo,proto3" json:"repo,omitempty" url:",omitempty"`
+	CommitID  string `protobuf:"bytes,8,opt,name=commit_id,proto3" json:"commit_id,omitempty" url:",omitempty"`
+	// Branch filters the list to builds created for the named branch.
+	Branch string `protobuf:"bytes,12,opt,name=branch,proto3" json:"branch,omitempty" url:",omitempty"`
+	// MinPriority filters the list to builds whose priority is at
+	// least MinPriority.
+	MinPriority int32 `protobuf:"varint,13,opt,name=min_priority,proto3" json:"min_priority,omitempty" url:",omitempty"`
+	// Tags filters the list to builds that have all of the given
+	// tags.
+	Tags []string `protobuf:"bytes,14,rep,name=tags" json:"tags,omitempty" url:",omitempty,comma"`
+	// RequestedBy filters the list to builds requested by the given
+	// user or system.
+	RequestedBy string `protobuf:"bytes,15,opt,name=requested_by,proto3" json:"requested_by,omitempty" url:",omitempty"`
+	// Sort is the field to order builds by: "created_at" (the default)
+	// or "started_at".
+	Sort string `protobuf:"bytes,9,opt,name=sort,proto3" json:"sort,omitempty" url:",omitempty"`
+	// Direction is the sort direction: "asc" or "desc".
 	Direction   string `protobuf:"bytes,10,opt,name=direction,proto3" json:"direction,omitempty" url:",omitempty"`
 	ListOptions `protobuf:"bytes,11,opt,name=list_options,embedded=list_options" json:"list_options"`
 }
@@ -1582,6 +2888,24 @@ func (m *BuildsGetRepoBuildInfoOp) Reset()         { *m = BuildsGetRepoBuildInfo
 func (m *BuildsGetRepoBuildInfoOp) String() string { return proto.CompactTextString(m) }
 func (*BuildsGetRepoBuildInfoOp) ProtoMessage()    {}
 
+type BuildsGetMultipleRepoBuildInfoOp struct {
+	Repos []RepoRevSpec                  `protobuf:"bytes,1,rep,name=repos" json:"repos"`
+	Opt   *BuildsGetRepoBuildInfoOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *BuildsGetMultipleRepoBuildInfoOp) Reset()         { *m = BuildsGetMultipleRepoBuildInfoOp{} }
+func (m *BuildsGetMultipleRepoBuildInfoOp) String() string { return proto.CompactTextString(m) }
+func (*BuildsGetMultipleRepoBuildInfoOp) ProtoMessage()    {}
+
+type BuildsListByRepoOp struct {
+	Repo RepoSpec          `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	Opt  *BuildListOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *BuildsListByRepoOp) Reset()         { *m = BuildsListByRepoOp{} }
+func (m *BuildsListByRepoOp) String() string { return proto.CompactTextString(m) }
+func (*BuildsListByRepoOp) ProtoMessage()    {}
+
 type BuildList struct {
 	Builds         []*Build `protobuf:"bytes,1,rep,name=builds" json:"builds,omitempty"`
 	StreamResponse `protobuf:"bytes,2,opt,name=stream_response,embedded=stream_response" json:"stream_response"`
@@ -1701,6 +3025,47 @@ func (m *BuildsDequeueNextOp) Reset()         { *m = BuildsDequeueNextOp{} }
 func (m *BuildsDequeueNextOp) String() string { return proto.CompactTextString(m) }
 func (*BuildsDequeueNextOp) ProtoMessage()    {}
 
+type BuildsCancelOp struct {
+	Build BuildSpec           `protobuf:"bytes,1,opt,name=build" json:"build"`
+	Opt   *BuildCancelOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *BuildsCancelOp) Reset()         { *m = BuildsCancelOp{} }
+func (m *BuildsCancelOp) String() string { return proto.CompactTextString(m) }
+func (*BuildsCancelOp) ProtoMessage()    {}
+
+// BuildCancelOptions specifies options for BuildsService.Cancel.
+type BuildCancelOptions struct {
+	// Force kills the build's in-progress tasks. If false, an
+	// in-progress build is marked as killed but its worker is allowed
+	// to finish the task it is running.
+	Force bool `protobuf:"varint,1,opt,name=force,proto3" json:"force,omitempty" url:",omitempty"`
+}
+
+func (m *BuildCancelOptions) Reset()         { *m = BuildCancelOptions{} }
+func (m *BuildCancelOptions) String() string { return proto.CompactTextString(m) }
+func (*BuildCancelOptions) ProtoMessage()    {}
+
+type BuildsRestartOp struct {
+	Build BuildSpec            `protobuf:"bytes,1,opt,name=build" json:"build"`
+	Opt   *BuildRestartOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *BuildsRestartOp) Reset()         { *m = BuildsRestartOp{} }
+func (m *BuildsRestartOp) String() string { return proto.CompactTextString(m) }
+func (*BuildsRestartOp) ProtoMessage()    {}
+
+// BuildRestartOptions specifies options for BuildsService.Restart.
+type BuildRestartOptions struct {
+	// Force restarts the build even if it has not ended, killing its
+	// in-progress tasks first.
+	Force bool `protobuf:"varint,1,opt,name=force,proto3" json:"force,omitempty" url:",omitempty"`
+}
+
+func (m *BuildRestartOptions) Reset()         { *m = BuildRestartOptions{} }
+func (m *BuildRestartOptions) String() string { return proto.CompactTextString(m) }
+func (*BuildRestartOptions) ProtoMessage()    {}
+
 // EmailAddr is an email address associated with a user.
 type EmailAddr struct {
 	// the email address (case-insensitively compared in the DB and API)
@@ -1722,12 +3087,29 @@ func (*EmailAddr) ProtoMessage()    {}
 type LogEntries struct {
 	MaxID   string   `protobuf:"bytes,1,opt,name=max_id,proto3" json:"max_id,omitempty"`
 	Entries []string `protobuf:"bytes,2,rep,name=entries" json:"entries,omitempty"`
+	// Structured are the log entries, if the Structured option was
+	// set in BuildGetLogOptions.
+	Structured []*LogEntry `protobuf:"bytes,3,rep,name=structured" json:"structured,omitempty"`
 }
 
 func (m *LogEntries) Reset()         { *m = LogEntries{} }
 func (m *LogEntries) String() string { return proto.CompactTextString(m) }
 func (*LogEntries) ProtoMessage()    {}
 
+// A LogEntry is a structured build log entry.
+type LogEntry struct {
+	// Time is when the entry was logged.
+	Time pbtypes.Timestamp `protobuf:"bytes,1,opt,name=time" json:"time"`
+	// Level is the entry's log level (see the LogLevel* consts).
+	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	// Message is the entry's message.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *LogEntry) Reset()         { *m = LogEntry{} }
+func (m *LogEntry) String() string { return proto.CompactTextString(m) }
+func (*LogEntry) ProtoMessage()    {}
+
 type Org struct {
 	User `protobuf:"bytes,1,opt,name=user,embedded=user" json:"user"`
 }
@@ -1772,6 +3154,85 @@ func (m *UserList) Reset()         { *m = UserList{} }
 func (m *UserList) String() string { return proto.CompactTextString(m) }
 func (*UserList) ProtoMessage()    {}
 
+// Team is a group of an organization's members that is granted
+// permissions on repositories.
+type Team struct {
+	// ID is the team's ID.
+	ID int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Org is the organization that the team belongs to.
+	Org OrgSpec `protobuf:"bytes,2,opt,name=org" json:"org"`
+	// Slug is the team's URL-safe name, unique within its
+	// organization (e.g., "core-devs").
+	Slug string `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Name is the team's display name (e.g., "Core Devs").
+	Name        string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// ExternalID is the ID of the team on the code host that it is
+	// mirrored from (e.g., a GitHub team ID), if any.
+	ExternalID string `protobuf:"bytes,6,opt,name=external_id,proto3" json:"external_id,omitempty"`
+}
+
+func (m *Team) Reset()         { *m = Team{} }
+func (m *Team) String() string { return proto.CompactTextString(m) }
+func (*Team) ProtoMessage()    {}
+
+// TeamSpec specifies a team.
+type TeamSpec struct {
+	Org  OrgSpec `protobuf:"bytes,1,opt,name=org" json:"org"`
+	Slug string  `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+}
+
+func (m *TeamSpec) Reset()         { *m = TeamSpec{} }
+func (m *TeamSpec) String() string { return proto.CompactTextString(m) }
+func (*TeamSpec) ProtoMessage()    {}
+
+type OrgsListTeamsOp struct {
+	Org OrgSpec              `protobuf:"bytes,1,opt,name=org" json:"org"`
+	Opt *OrgListTeamsOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *OrgsListTeamsOp) Reset()         { *m = OrgsListTeamsOp{} }
+func (m *OrgsListTeamsOp) String() string { return proto.CompactTextString(m) }
+func (*OrgsListTeamsOp) ProtoMessage()    {}
+
+type OrgListTeamsOptions struct {
+	ListOptions `protobuf:"bytes,1,opt,name=list_options,embedded=list_options" json:"list_options"`
+}
+
+func (m *OrgListTeamsOptions) Reset()         { *m = OrgListTeamsOptions{} }
+func (m *OrgListTeamsOptions) String() string { return proto.CompactTextString(m) }
+func (*OrgListTeamsOptions) ProtoMessage()    {}
+
+type TeamList struct {
+	Teams          []*Team `protobuf:"bytes,1,rep,name=teams" json:"teams,omitempty"`
+	StreamResponse `protobuf:"bytes,2,opt,name=stream_response,embedded=stream_response" json:"stream_response"`
+}
+
+func (m *TeamList) Reset()         { *m = TeamList{} }
+func (m *TeamList) String() string { return proto.CompactTextString(m) }
+func (*TeamList) ProtoMessage()    {}
+
+type OrgsListTeamMembersOp struct {
+	Team TeamSpec               `protobuf:"bytes,1,opt,name=team" json:"team"`
+	Opt  *OrgListMembersOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *OrgsListTeamMembersOp) Reset()         { *m = OrgsListTeamMembersOp{} }
+func (m *OrgsListTeamMembersOp) String() string { return proto.CompactTextString(m) }
+func (*OrgsListTeamMembersOp) ProtoMessage()    {}
+
+type OrgsAddTeamRepoOp struct {
+	Team TeamSpec `protobuf:"bytes,1,opt,name=team" json:"team"`
+	Repo RepoSpec `protobuf:"bytes,2,opt,name=repo" json:"repo"`
+	// Permissions are the permissions to grant the team's members on
+	// the repository.
+	Permissions RepoPermissions `protobuf:"bytes,3,opt,name=permissions" json:"permissions"`
+}
+
+func (m *OrgsAddTeamRepoOp) Reset()         { *m = OrgsAddTeamRepoOp{} }
+func (m *OrgsAddTeamRepoOp) String() string { return proto.CompactTextString(m) }
+func (*OrgsAddTeamRepoOp) ProtoMessage()    {}
+
 // A Person represents either a registered user or a committer to a repository
 // (typically when their commit email can't be resolved to a user).
 type Person struct {
@@ -1818,6 +3279,16 @@ func (m *RepoBuildInfo) Reset()         { *m = RepoBuildInfo{} }
 func (m *RepoBuildInfo) String() string { return proto.CompactTextString(m) }
 func (*RepoBuildInfo) ProtoMessage()    {}
 
+// RepoBuildInfoList is a list of RepoBuildInfos, returned by
+// Builds.GetMultipleRepoBuildInfo.
+type RepoBuildInfoList struct {
+	Infos []*RepoBuildInfo `protobuf:"bytes,1,rep,name=infos" json:"infos,omitempty"`
+}
+
+func (m *RepoBuildInfoList) Reset()         { *m = RepoBuildInfoList{} }
+func (m *RepoBuildInfoList) String() string { return proto.CompactTextString(m) }
+func (*RepoBuildInfoList) ProtoMessage()    {}
+
 type TaskSpec struct {
 	BuildSpec `protobuf:"bytes,1,opt,name=build_spec,embedded=build_spec" json:"build_spec"`
 	TaskID    int64 `protobuf:"varint,2,opt,name=task_id,proto3" json:"task_id,omitempty"`
@@ -1965,6 +3436,63 @@ func (m *NewAccount) Reset()         { *m = NewAccount{} }
 func (m *NewAccount) String() string { return proto.CompactTextString(m) }
 func (*NewAccount) ProtoMessage()    {}
 
+// PersonalAccessToken is a long-lived access token that a user
+// creates for use by automation (such as scripts and CI), in place of
+// the OAuth2 flow.
+type PersonalAccessToken struct {
+	ID int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Note is a human-readable description of what the token is used
+	// for.
+	Note string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	// Scope is the list of scopes that the token grants.
+	Scope []string `protobuf:"bytes,3,rep,name=scope" json:"scope,omitempty"`
+	// Token is the secret token value, usable as an OAuth2 access
+	// token. It is only set in the response to Auth.CreateToken.
+	Token     string            `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	CreatedAt pbtypes.Timestamp `protobuf:"bytes,5,opt,name=created_at" json:"created_at"`
+	// LastUsedAt is when the token was last used to authenticate a
+	// request, or null if it has never been used.
+	LastUsedAt *pbtypes.Timestamp `protobuf:"bytes,6,opt,name=last_used_at" json:"last_used_at,omitempty"`
+}
+
+func (m *PersonalAccessToken) Reset()         { *m = PersonalAccessToken{} }
+func (m *PersonalAccessToken) String() string { return proto.CompactTextString(m) }
+func (*PersonalAccessToken) ProtoMessage()    {}
+
+type PersonalAccessTokenList struct {
+	Tokens         []*PersonalAccessToken `protobuf:"bytes,1,rep,name=tokens" json:"tokens,omitempty"`
+	StreamResponse `protobuf:"bytes,2,opt,name=stream_response,embedded=stream_response" json:"stream_response"`
+}
+
+func (m *PersonalAccessTokenList) Reset()         { *m = PersonalAccessTokenList{} }
+func (m *PersonalAccessTokenList) String() string { return proto.CompactTextString(m) }
+func (*PersonalAccessTokenList) ProtoMessage()    {}
+
+type AuthCreateTokenOp struct {
+	Note  string   `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	Scope []string `protobuf:"bytes,2,rep,name=scope" json:"scope,omitempty"`
+}
+
+func (m *AuthCreateTokenOp) Reset()         { *m = AuthCreateTokenOp{} }
+func (m *AuthCreateTokenOp) String() string { return proto.CompactTextString(m) }
+func (*AuthCreateTokenOp) ProtoMessage()    {}
+
+type AuthListTokensOp struct {
+	ListOptions `protobuf:"bytes,1,opt,name=list_options,embedded=list_options" json:"list_options"`
+}
+
+func (m *AuthListTokensOp) Reset()         { *m = AuthListTokensOp{} }
+func (m *AuthListTokensOp) String() string { return proto.CompactTextString(m) }
+func (*AuthListTokensOp) ProtoMessage()    {}
+
+type AuthRevokeTokenOp struct {
+	ID int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *AuthRevokeTokenOp) Reset()         { *m = AuthRevokeTokenOp{} }
+func (m *AuthRevokeTokenOp) String() string { return proto.CompactTextString(m) }
+func (*AuthRevokeTokenOp) ProtoMessage()    {}
+
 // SSHPublicKey that users to authenticate with for SSH git access.
 type SSHPublicKey struct {
 	// Key is the serialized key data in SSH wire format, with the name prefix.
@@ -1975,6 +3503,181 @@ func (m *SSHPublicKey) Reset()         { *m = SSHPublicKey{} }
 func (m *SSHPublicKey) String() string { return proto.CompactTextString(m) }
 func (*SSHPublicKey) ProtoMessage()    {}
 
+// UserKey is an SSH or GPG public key that belongs to a user.
+type UserKey struct {
+	ID   int64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type UserKeyType `protobuf:"varint,2,opt,name=type,proto3,enum=sourcegraph.UserKeyType" json:"type,omitempty"`
+	// Title is a human-readable name for the key.
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// Key is the public key. For SSH keys, it is the serialized key
+	// data in SSH wire format, with the name prefix. For GPG keys, it
+	// is the ASCII-armored public key.
+	Key []byte `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// Fingerprint is the key's fingerprint, as computed by the
+	// server.
+	Fingerprint string            `protobuf:"bytes,5,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	CreatedAt   pbtypes.Timestamp `protobuf:"bytes,6,opt,name=created_at" json:"created_at"`
+}
+
+func (m *UserKey) Reset()         { *m = UserKey{} }
+func (m *UserKey) String() string { return proto.CompactTextString(m) }
+func (*UserKey) ProtoMessage()    {}
+
+type UserKeyList struct {
+	Keys []*UserKey `protobuf:"bytes,1,rep,name=keys" json:"keys,omitempty"`
+}
+
+func (m *UserKeyList) Reset()         { *m = UserKeyList{} }
+func (m *UserKeyList) String() string { return proto.CompactTextString(m) }
+func (*UserKeyList) ProtoMessage()    {}
+
+type UsersListKeysOp struct {
+	User UserSpec             `protobuf:"bytes,1,opt,name=user" json:"user"`
+	Opt  *UserKeysListOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *UsersListKeysOp) Reset()         { *m = UsersListKeysOp{} }
+func (m *UsersListKeysOp) String() string { return proto.CompactTextString(m) }
+func (*UsersListKeysOp) ProtoMessage()    {}
+
+// UserKeysListOptions specifies options for UsersService.ListKeys.
+type UserKeysListOptions struct {
+	// Type, if set, restricts the list to keys of the given type.
+	Type UserKeyType `protobuf:"varint,1,opt,name=type,proto3,enum=sourcegraph.UserKeyType" json:"type,omitempty" url:",omitempty"`
+	// AllTypes lists keys of all types, ignoring Type.
+	AllTypes bool `protobuf:"varint,2,opt,name=all_types,proto3" json:"all_types,omitempty" url:",omitempty"`
+}
+
+func (m *UserKeysListOptions) Reset()         { *m = UserKeysListOptions{} }
+func (m *UserKeysListOptions) String() string { return proto.CompactTextString(m) }
+func (*UserKeysListOptions) ProtoMessage()    {}
+
+type UsersAddKeyOp struct {
+	User  UserSpec    `protobuf:"bytes,1,opt,name=user" json:"user"`
+	Type  UserKeyType `protobuf:"varint,2,opt,name=type,proto3,enum=sourcegraph.UserKeyType" json:"type,omitempty"`
+	Title string      `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Key   []byte      `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *UsersAddKeyOp) Reset()         { *m = UsersAddKeyOp{} }
+func (m *UsersAddKeyOp) String() string { return proto.CompactTextString(m) }
+func (*UsersAddKeyOp) ProtoMessage()    {}
+
+type UsersDeleteKeyOp struct {
+	User UserSpec `protobuf:"bytes,1,opt,name=user" json:"user"`
+	ID   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *UsersDeleteKeyOp) Reset()         { *m = UsersDeleteKeyOp{} }
+func (m *UsersDeleteKeyOp) String() string { return proto.CompactTextString(m) }
+func (*UsersDeleteKeyOp) ProtoMessage()    {}
+
+type UsersListAuthoredDefsOp struct {
+	User UserSpec                     `protobuf:"bytes,1,opt,name=user" json:"user"`
+	Opt  *UserListAuthoredDefsOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *UsersListAuthoredDefsOp) Reset()         { *m = UsersListAuthoredDefsOp{} }
+func (m *UsersListAuthoredDefsOp) String() string { return proto.CompactTextString(m) }
+func (*UsersListAuthoredDefsOp) ProtoMessage()    {}
+
+// UserListAuthoredDefsOptions specifies options for
+// Users.ListAuthoredDefs.
+type UserListAuthoredDefsOptions struct {
+	// Repo, if set, limits the list to defs in this repository.
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty" url:",omitempty"`
+	// Exported, if true, limits the list to exported defs.
+	Exported    bool `protobuf:"varint,2,opt,name=exported,proto3" json:"exported,omitempty" url:",omitempty"`
+	ListOptions `protobuf:"bytes,3,opt,name=list_options,embedded=list_options" json:"list_options"`
+}
+
+func (m *UserListAuthoredDefsOptions) Reset()         { *m = UserListAuthoredDefsOptions{} }
+func (m *UserListAuthoredDefsOptions) String() string { return proto.CompactTextString(m) }
+func (*UserListAuthoredDefsOptions) ProtoMessage()    {}
+
+// AuthoredDef is a def and a user's authorship of it.
+type AuthoredDef struct {
+	Def           *Def `protobuf:"bytes,1,opt,name=def" json:"def,omitempty"`
+	DefAuthorship `protobuf:"bytes,2,opt,name=def_authorship,embedded=def_authorship" json:"def_authorship"`
+}
+
+func (m *AuthoredDef) Reset()         { *m = AuthoredDef{} }
+func (m *AuthoredDef) String() string { return proto.CompactTextString(m) }
+func (*AuthoredDef) ProtoMessage()    {}
+
+type AuthoredDefList struct {
+	Defs           []*AuthoredDef `protobuf:"bytes,1,rep,name=defs" json:"defs,omitempty"`
+	StreamResponse `protobuf:"bytes,2,opt,name=stream_response,embedded=stream_response" json:"stream_response"`
+}
+
+func (m *AuthoredDefList) Reset()         { *m = AuthoredDefList{} }
+func (m *AuthoredDefList) String() string { return proto.CompactTextString(m) }
+func (*AuthoredDefList) ProtoMessage()    {}
+
+type UsersListContributionsOp struct {
+	User UserSpec                      `protobuf:"bytes,1,opt,name=user" json:"user"`
+	Opt  *UserListContributionsOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *UsersListContributionsOp) Reset()         { *m = UsersListContributionsOp{} }
+func (m *UsersListContributionsOp) String() string { return proto.CompactTextString(m) }
+func (*UsersListContributionsOp) ProtoMessage()    {}
+
+// UserListContributionsOptions specifies options for
+// Users.ListContributions.
+type UserListContributionsOptions struct {
+	ListOptions `protobuf:"bytes,1,opt,name=list_options,embedded=list_options" json:"list_options"`
+}
+
+func (m *UserListContributionsOptions) Reset()         { *m = UserListContributionsOptions{} }
+func (m *UserListContributionsOptions) String() string { return proto.CompactTextString(m) }
+func (*UserListContributionsOptions) ProtoMessage()    {}
+
+// UserContribution summarizes a user's contributions to a repository.
+type UserContribution struct {
+	Repo RepoSpec `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	// Commits is the number of commits the user has authored in the
+	// repository (on its default branch).
+	Commits int32 `protobuf:"varint,2,opt,name=commits,proto3" json:"commits,omitempty"`
+	// AuthoredDefs is the number of defs in the repository that the
+	// user authored.
+	AuthoredDefs int32 `protobuf:"varint,3,opt,name=authored_defs,proto3" json:"authored_defs,omitempty"`
+	// LastCommitDate is the date of the user's most recent commit to
+	// the repository.
+	LastCommitDate pbtypes.Timestamp `protobuf:"bytes,4,opt,name=last_commit_date" json:"last_commit_date"`
+}
+
+func (m *UserContribution) Reset()         { *m = UserContribution{} }
+func (m *UserContribution) String() string { return proto.CompactTextString(m) }
+func (*UserContribution) ProtoMessage()    {}
+
+type UserContributionList struct {
+	Contributions  []*UserContribution `protobuf:"bytes,1,rep,name=contributions" json:"contributions,omitempty"`
+	StreamResponse `protobuf:"bytes,2,opt,name=stream_response,embedded=stream_response" json:"stream_response"`
+}
+
+func (m *UserContributionList) Reset()         { *m = UserContributionList{} }
+func (m *UserContributionList) String() string { return proto.CompactTextString(m) }
+func (*UserContributionList) ProtoMessage()    {}
+
+// ExternalAccountSpec identifies a user's account on an external code
+// host.
+type ExternalAccountSpec struct {
+	// Provider is the code host (one of the Provider* constants, such
+	// as "github").
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// ID is the account's ID on the code host (e.g., a GitHub user's
+	// numeric ID).
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Login is the account's login on the code host (e.g., a GitHub
+	// username). It is used if ID is empty.
+	Login string `protobuf:"bytes,3,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (m *ExternalAccountSpec) Reset()         { *m = ExternalAccountSpec{} }
+func (m *ExternalAccountSpec) String() string { return proto.CompactTextString(m) }
+func (*ExternalAccountSpec) ProtoMessage()    {}
+
 // AuthorizationCodeRequest: see
 // https://tools.ietf.org/html/rfc6749#section-4.1.1.
 type AuthorizationCodeRequest struct {
@@ -2237,12 +3940,81 @@ type Def struct {
 	graph.Def  `protobuf:"bytes,1,opt,name=def,embedded=def" json:""`
 	DocHTML    *pbtypes2.HTML          `protobuf:"bytes,2,opt,name=doc_html" json:"doc_html,omitempty"`
 	FmtStrings *graph.DefFormatStrings `protobuf:"bytes,3,opt,name=fmt_strings" json:"fmt_strings,omitempty"`
+	// Score is a measure of the def's importance (e.g., based on how
+	// many external refs it has). It is only set by methods that rank
+	// defs, such as DefsService.ListTop.
+	Score float64 `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	// RefCount is the total number of refs to the def (including refs
+	// from other repositories). It is only set if the RefCounts option
+	// is set in DefGetOptions or DefListOptions.
+	RefCount int32 `protobuf:"varint,5,opt,name=ref_count,proto3" json:"ref_count,omitempty"`
+	// XRepoRefCount is the number of refs to the def from other
+	// repositories. It is only set if the RefCounts option is set in
+	// DefGetOptions or DefListOptions.
+	XRepoRefCount int32 `protobuf:"varint,6,opt,name=xrepo_ref_count,proto3" json:"xrepo_ref_count,omitempty"`
+	// Doc is the def's documentation, parsed into sections. It is
+	// only set if the StructuredDoc option is set in DefGetOptions.
+	Doc *DefDocumentation `protobuf:"bytes,7,opt,name=doc" json:"doc,omitempty"`
 }
 
 func (m *Def) Reset()         { *m = Def{} }
 func (m *Def) String() string { return proto.CompactTextString(m) }
 func (*Def) ProtoMessage()    {}
 
+// DefDocumentation is a def's documentation, parsed into sections
+// according to the conventions of the def's language (e.g., Javadoc
+// tags or Python docstring sections).
+type DefDocumentation struct {
+	// Summary is the first sentence of the documentation, as plain
+	// text.
+	Summary string `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	// Params documents the def's parameters, in declaration order.
+	Params []*DefDocParam `protobuf:"bytes,2,rep,name=params" json:"params,omitempty"`
+	// Returns documents the def's return value(s), as HTML.
+	Returns *pbtypes2.HTML `protobuf:"bytes,3,opt,name=returns" json:"returns,omitempty"`
+	// Examples are the code examples in the documentation.
+	Examples []string `protobuf:"bytes,4,rep,name=examples" json:"examples,omitempty"`
+	// Format is the MIME type of Raw (e.g., "text/plain" or
+	// "text/x-rst").
+	Format string `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`
+	// Raw is the documentation's unparsed source text.
+	Raw string `protobuf:"bytes,6,opt,name=raw,proto3" json:"raw,omitempty"`
+	// HTML is the whole documentation rendered to HTML.
+	HTML *pbtypes2.HTML `protobuf:"bytes,7,opt,name=html" json:"html,omitempty"`
+}
+
+func (m *DefDocumentation) Reset()         { *m = DefDocumentation{} }
+func (m *DefDocumentation) String() string { return proto.CompactTextString(m) }
+func (*DefDocumentation) ProtoMessage()    {}
+
+// DefDocParam documents a parameter of a def.
+type DefDocParam struct {
+	// Name is the parameter's name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Type is the parameter's type, if documented or known.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// DocHTML is the parameter's documentation, as HTML.
+	DocHTML *pbtypes2.HTML `protobuf:"bytes,3,opt,name=doc_html" json:"doc_html,omitempty"`
+}
+
+func (m *DefDocParam) Reset()         { *m = DefDocParam{} }
+func (m *DefDocParam) String() string { return proto.CompactTextString(m) }
+func (*DefDocParam) ProtoMessage()    {}
+
+// Hover is a summary of a def for display in an editor tooltip.
+type Hover struct {
+	// Def specifies the def.
+	Def DefSpec `protobuf:"bytes,1,opt,name=def" json:"def"`
+	// Title is the def's signature (e.g., "func Foo(x int) error").
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// DocHTML is the def's rendered documentation, if any.
+	DocHTML *pbtypes2.HTML `protobuf:"bytes,3,opt,name=doc_html" json:"doc_html,omitempty"`
+}
+
+func (m *Hover) Reset()         { *m = Hover{} }
+func (m *Hover) String() string { return proto.CompactTextString(m) }
+func (*Hover) ProtoMessage()    {}
+
 type DefAuthor struct {
 	UID           int32  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	Email         string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
@@ -2287,6 +4059,10 @@ type DefDelta struct {
 	Base *Def `protobuf:"bytes,1,opt,name=base" json:"base,omitempty"`
 	// the def in the head commit (if nil, this def was deleted in the head)
 	Head *Def `protobuf:"bytes,2,opt,name=head" json:"head,omitempty"`
+	// RefCount is the number of refs (in all repositories) to the def
+	// in the base commit, or to the def in the head commit if it was
+	// added. It indicates how many usages the change may affect.
+	RefCount int32 `protobuf:"varint,3,opt,name=ref_count,proto3" json:"ref_count,omitempty"`
 }
 
 func (m *DefDelta) Reset()         { *m = DefDelta{} }
@@ -2296,6 +4072,13 @@ func (*DefDelta) ProtoMessage()    {}
 // DefGetOptions specifies options for DefsService.Get.
 type DefGetOptions struct {
 	Doc bool `protobuf:"varint,1,opt,name=doc,proto3" json:"doc,omitempty" url:",omitempty"`
+	// RefCounts is whether the def's RefCount and XRepoRefCount
+	// should be populated.
+	RefCounts bool `protobuf:"varint,2,opt,name=ref_counts,proto3" json:"ref_counts,omitempty" url:",omitempty"`
+	// StructuredDoc is whether the def's documentation should be
+	// returned parsed into sections (in Def.Doc), in addition to the
+	// single HTML blob that Doc returns (in Def.DocHTML).
+	StructuredDoc bool `protobuf:"varint,3,opt,name=structured_doc,proto3" json:"structured_doc,omitempty" url:",omitempty"`
 }
 
 func (m *DefGetOptions) Reset()         { *m = DefGetOptions{} }
@@ -2332,6 +4115,22 @@ type DefListExamplesOptions struct {
 	// manipulating the contents.
 	TokenizedSource bool `protobuf:"varint,3,opt,name=tokenized_source,proto3" json:"tokenized_source,omitempty" url:",omitempty"`
 	ListOptions     `protobuf:"bytes,4,opt,name=list_options,embedded=list_options" json:"list_options"`
+	// DeprioritizeTests ranks examples in test files after all other
+	// examples.
+	DeprioritizeTests bool `protobuf:"varint,5,opt,name=deprioritize_tests,proto3" json:"deprioritize_tests,omitempty" url:",omitempty"`
+	// PreferPopularRepos ranks examples in more popular repositories
+	// (by number of stars and dependents) first.
+	PreferPopularRepos bool `protobuf:"varint,6,opt,name=prefer_popular_repos,proto3" json:"prefer_popular_repos,omitempty" url:",omitempty"`
+	// Dedupe omits examples whose source code is nearly identical to
+	// a higher-ranked example's (ignoring whitespace and identifier
+	// names). The number of omitted duplicates of each example is
+	// returned in Example.Duplicates.
+	Dedupe bool `protobuf:"varint,7,opt,name=dedupe,proto3" json:"dedupe,omitempty" url:",omitempty"`
+	// ContextLines is the number of lines of surrounding code to
+	// include before and after each example's ref. If zero,
+	// DefaultExampleContextLines is used. It may not exceed
+	// MaxExampleContextLines.
+	ContextLines int32 `protobuf:"varint,8,opt,name=context_lines,proto3" json:"context_lines,omitempty" url:",omitempty"`
 }
 
 func (m *DefListExamplesOptions) Reset()         { *m = DefListExamplesOptions{} }
@@ -2366,10 +4165,13 @@ type DefListOptions struct {
 	File string `protobuf:"bytes,10,opt,name=file,proto3" json:"file,omitempty" url:",omitempty"`
 	// FilePathPrefix, if specified, will restrict the results to only defs defined in
 	// files whose path is underneath the specified prefix.
-	FilePathPrefix string   `protobuf:"bytes,11,opt,name=file_path_prefix,proto3" json:"file_path_prefix,omitempty" url:",omitempty"`
-	Kinds          []string `protobuf:"bytes,12,rep,name=kinds" json:"kinds,omitempty" url:",omitempty,comma"`
-	Exported       bool     `protobuf:"varint,13,opt,name=exported,proto3" json:"exported,omitempty" url:",omitempty"`
-	Nonlocal       bool     `protobuf:"varint,14,opt,name=nonlocal,proto3" json:"nonlocal,omitempty" url:",omitempty"`
+	FilePathPrefix string `protobuf:"bytes,11,opt,name=file_path_prefix,proto3" json:"file_path_prefix,omitempty" url:",omitempty"`
+	// Kinds, if set, limits the results to defs of these kinds (which
+	// are DefKind values). Use DefListFilter to set Kinds and the
+	// other filters below with client-side validation.
+	Kinds    []string `protobuf:"bytes,12,rep,name=kinds" json:"kinds,omitempty" url:",omitempty,comma"`
+	Exported bool     `protobuf:"varint,13,opt,name=exported,proto3" json:"exported,omitempty" url:",omitempty"`
+	Nonlocal bool     `protobuf:"varint,14,opt,name=nonlocal,proto3" json:"nonlocal,omitempty" url:",omitempty"`
 	// IncludeTest is whether the results should include definitions in test files.
 	IncludeTest bool `protobuf:"varint,15,opt,name=include_test,proto3" json:"include_test,omitempty" url:",omitempty"`
 	// Enhancements
@@ -2380,6 +4182,9 @@ type DefListOptions struct {
 	Direction string `protobuf:"bytes,19,opt,name=direction,proto3" json:"direction,omitempty" url:",omitempty"`
 	// Paging
 	ListOptions `protobuf:"bytes,20,opt,name=list_options,embedded=list_options" json:"list_options"`
+	// RefCounts is whether the defs' RefCount and XRepoRefCount
+	// should be populated.
+	RefCounts bool `protobuf:"varint,21,opt,name=ref_counts,proto3" json:"ref_counts,omitempty" url:",omitempty"`
 }
 
 func (m *DefListOptions) Reset()         { *m = DefListOptions{} }
@@ -2390,6 +4195,12 @@ type DefListRefsOptions struct {
 	Authorship  bool   `protobuf:"varint,1,opt,name=authorship,proto3" json:"authorship,omitempty" url:",omitempty"`
 	Repo        string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty" url:",omitempty"`
 	ListOptions `protobuf:"bytes,3,opt,name=list_options,embedded=list_options" json:"list_options"`
+	// Repos, if set, limits the list to refs in these repositories
+	// (in addition to the Repo filter, if any).
+	Repos []string `protobuf:"bytes,4,rep,name=repos" json:"repos,omitempty" url:",omitempty,comma"`
+	// Files, if set, limits the list to refs in these files or in
+	// files beneath these directories (e.g., "pkg/foo").
+	Files []string `protobuf:"bytes,5,rep,name=files" json:"files,omitempty" url:",omitempty,comma"`
 }
 
 func (m *DefListRefsOptions) Reset()         { *m = DefListRefsOptions{} }
@@ -2397,8 +4208,18 @@ func (m *DefListRefsOptions) String() string { return proto.CompactTextString(m)
 func (*DefListRefsOptions) ProtoMessage()    {}
 
 // DefSpec specifies a def.
+//
+// To pin a def (and the refs, examples, etc., that are listed for it)
+// to a specific revision of its repository, set CommitID (and,
+// optionally, Rev). Otherwise the def is looked up at the latest
+// built commit on the repository's default branch.
 type DefSpec struct {
-	Repo     string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Rev is the VCS revision (e.g., branch) that the def is looked
+	// up at. It is resolved to a commit ID if CommitID is not set.
+	Rev string `protobuf:"bytes,6,opt,name=rev,proto3" json:"rev,omitempty"`
+	// CommitID is the absolute commit ID that the def is looked up
+	// at. If set, it takes precedence over Rev.
 	CommitID string `protobuf:"bytes,2,opt,name=commit_id,proto3" json:"commit_id,omitempty"`
 	UnitType string `protobuf:"bytes,3,opt,name=unit_type,proto3" json:"unit_type,omitempty"`
 	Unit     string `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
@@ -2418,6 +4239,30 @@ func (m *DefsGetOp) Reset()         { *m = DefsGetOp{} }
 func (m *DefsGetOp) String() string { return proto.CompactTextString(m) }
 func (*DefsGetOp) ProtoMessage()    {}
 
+type DefsGetMultipleOp struct {
+	Defs []DefSpec      `protobuf:"bytes,1,rep,name=defs" json:"defs"`
+	Opt  *DefGetOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *DefsGetMultipleOp) Reset()         { *m = DefsGetMultipleOp{} }
+func (m *DefsGetMultipleOp) String() string { return proto.CompactTextString(m) }
+func (*DefsGetMultipleOp) ProtoMessage()    {}
+
+type DefsGetByPositionOp struct {
+	// Entry is the file that contains the position.
+	Entry TreeEntrySpec `protobuf:"bytes,1,opt,name=entry" json:"entry"`
+	// Line is the zero-based line number of the position.
+	Line int32 `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	// Character is the zero-based byte offset of the position within
+	// its line.
+	Character int32          `protobuf:"varint,3,opt,name=character,proto3" json:"character,omitempty"`
+	Opt       *DefGetOptions `protobuf:"bytes,4,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *DefsGetByPositionOp) Reset()         { *m = DefsGetByPositionOp{} }
+func (m *DefsGetByPositionOp) String() string { return proto.CompactTextString(m) }
+func (*DefsGetByPositionOp) ProtoMessage()    {}
+
 type DefList struct {
 	Defs         []*Def `protobuf:"bytes,1,rep,name=defs" json:"defs,omitempty"`
 	ListResponse `protobuf:"bytes,2,opt,name=list_response,embedded=list_response" json:"list_response"`
@@ -2445,6 +4290,48 @@ func (m *RefList) Reset()         { *m = RefList{} }
 func (m *RefList) String() string { return proto.CompactTextString(m) }
 func (*RefList) ProtoMessage()    {}
 
+type DefsListCallersOp struct {
+	Def DefSpec                `protobuf:"bytes,1,opt,name=def" json:"def"`
+	Opt *DefListCallersOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *DefsListCallersOp) Reset()         { *m = DefsListCallersOp{} }
+func (m *DefsListCallersOp) String() string { return proto.CompactTextString(m) }
+func (*DefsListCallersOp) ProtoMessage()    {}
+
+// DefListCallersOptions specifies options for DefsService.ListCallers.
+type DefListCallersOptions struct {
+	// Repos, if set, limits the list to callers defined in these
+	// repositories.
+	Repos       []string `protobuf:"bytes,1,rep,name=repos" json:"repos,omitempty" url:",omitempty,comma"`
+	ListOptions `protobuf:"bytes,2,opt,name=list_options,embedded=list_options" json:"list_options"`
+}
+
+func (m *DefListCallersOptions) Reset()         { *m = DefListCallersOptions{} }
+func (m *DefListCallersOptions) String() string { return proto.CompactTextString(m) }
+func (*DefListCallersOptions) ProtoMessage()    {}
+
+type DefsListCalleesOp struct {
+	Def DefSpec                `protobuf:"bytes,1,opt,name=def" json:"def"`
+	Opt *DefListCalleesOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *DefsListCalleesOp) Reset()         { *m = DefsListCalleesOp{} }
+func (m *DefsListCalleesOp) String() string { return proto.CompactTextString(m) }
+func (*DefsListCalleesOp) ProtoMessage()    {}
+
+// DefListCalleesOptions specifies options for DefsService.ListCallees.
+type DefListCalleesOptions struct {
+	// Repos, if set, limits the list to callees defined in these
+	// repositories.
+	Repos       []string `protobuf:"bytes,1,rep,name=repos" json:"repos,omitempty" url:",omitempty,comma"`
+	ListOptions `protobuf:"bytes,2,opt,name=list_options,embedded=list_options" json:"list_options"`
+}
+
+func (m *DefListCalleesOptions) Reset()         { *m = DefListCalleesOptions{} }
+func (m *DefListCalleesOptions) String() string { return proto.CompactTextString(m) }
+func (*DefListCalleesOptions) ProtoMessage()    {}
+
 type DefsListExamplesOp struct {
 	Def DefSpec `protobuf:"bytes,1,opt,name=def" json:"def"`
 	// If set, source code in the examples will be linked to this branch, rather
@@ -2484,6 +4371,250 @@ func (m *DefsListClientsOp) Reset()         { *m = DefsListClientsOp{} }
 func (m *DefsListClientsOp) String() string { return proto.CompactTextString(m) }
 func (*DefsListClientsOp) ProtoMessage()    {}
 
+type DefsListDependentsOp struct {
+	Def DefSpec                   `protobuf:"bytes,1,opt,name=def" json:"def"`
+	Opt *DefListDependentsOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *DefsListDependentsOp) Reset()         { *m = DefsListDependentsOp{} }
+func (m *DefsListDependentsOp) String() string { return proto.CompactTextString(m) }
+func (*DefsListDependentsOp) ProtoMessage()    {}
+
+// DefListDependentsOptions specifies options for DefsService.ListDependents.
+type DefListDependentsOptions struct {
+	ListOptions `protobuf:"bytes,1,opt,name=list_options,embedded=list_options" json:"list_options"`
+}
+
+func (m *DefListDependentsOptions) Reset()         { *m = DefListDependentsOptions{} }
+func (m *DefListDependentsOptions) String() string { return proto.CompactTextString(m) }
+func (*DefListDependentsOptions) ProtoMessage()    {}
+
+// DefDependent is a repository that refers to a def.
+type DefDependent struct {
+	// Repo is the URI of the repository.
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// RefCount is the number of refs to the def in the repository.
+	RefCount int32 `protobuf:"varint,2,opt,name=ref_count,proto3" json:"ref_count,omitempty"`
+}
+
+func (m *DefDependent) Reset()         { *m = DefDependent{} }
+func (m *DefDependent) String() string { return proto.CompactTextString(m) }
+func (*DefDependent) ProtoMessage()    {}
+
+type DefDependentList struct {
+	// Dependents are sorted by descending RefCount.
+	Dependents     []*DefDependent `protobuf:"bytes,1,rep,name=dependents" json:"dependents,omitempty"`
+	StreamResponse `protobuf:"bytes,2,opt,name=stream_response,embedded=stream_response" json:"stream_response"`
+}
+
+func (m *DefDependentList) Reset()         { *m = DefDependentList{} }
+func (m *DefDependentList) String() string { return proto.CompactTextString(m) }
+func (*DefDependentList) ProtoMessage()    {}
+
+type DefsListTopOp struct {
+	Rev RepoRevSpec        `protobuf:"bytes,1,opt,name=rev" json:"rev"`
+	Opt *DefListTopOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *DefsListTopOp) Reset()         { *m = DefsListTopOp{} }
+func (m *DefsListTopOp) String() string { return proto.CompactTextString(m) }
+func (*DefsListTopOp) ProtoMessage()    {}
+
+// DefListTopOptions specifies options for DefsService.ListTop.
+type DefListTopOptions struct {
+	// Sort is the ranking to use: "refs" (the default) ranks defs by
+	// the number of refs to them from other repositories, and "score"
+	// ranks them by the server's combined importance score.
+	Sort string `protobuf:"bytes,1,opt,name=sort,proto3" json:"sort,omitempty" url:",omitempty"`
+	// Exported is whether to list only exported defs.
+	Exported    bool `protobuf:"varint,2,opt,name=exported,proto3" json:"exported,omitempty" url:",omitempty"`
+	ListOptions `protobuf:"bytes,3,opt,name=list_options,embedded=list_options" json:"list_options"`
+}
+
+func (m *DefListTopOptions) Reset()         { *m = DefListTopOptions{} }
+func (m *DefListTopOptions) String() string { return proto.CompactTextString(m) }
+func (*DefListTopOptions) ProtoMessage()    {}
+
+type DefsListHistoryOp struct {
+	Def DefSpec                `protobuf:"bytes,1,opt,name=def" json:"def"`
+	Opt *DefListHistoryOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *DefsListHistoryOp) Reset()         { *m = DefsListHistoryOp{} }
+func (m *DefsListHistoryOp) String() string { return proto.CompactTextString(m) }
+func (*DefsListHistoryOp) ProtoMessage()    {}
+
+// DefSearchOptions specifies options for Defs.Search.
+type DefSearchOptions struct {
+	// Query is matched against def names (case-insensitively),
+	// according to Mode.
+	Query string        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty" url:"q" schema:"q"`
+	Mode  DefSearchMode `protobuf:"varint,2,opt,name=mode,proto3,enum=sourcegraph.DefSearchMode" json:"mode,omitempty" url:",omitempty"`
+	// Languages, if set, limits the results to defs in files in these
+	// languages (e.g., "Go").
+	Languages []string `protobuf:"bytes,3,rep,name=languages" json:"languages,omitempty" url:",omitempty,comma"`
+	// Repos, if set, limits the results to defs in these
+	// repositories.
+	Repos []string `protobuf:"bytes,4,rep,name=repos" json:"repos,omitempty" url:",omitempty,comma"`
+	// Kinds, if set, limits the results to defs of these kinds (which
+	// are DefKind values).
+	Kinds       []string `protobuf:"bytes,5,rep,name=kinds" json:"kinds,omitempty" url:",omitempty,comma"`
+	ListOptions `protobuf:"bytes,6,opt,name=list_options,embedded=list_options" json:"list_options"`
+}
+
+func (m *DefSearchOptions) Reset()         { *m = DefSearchOptions{} }
+func (m *DefSearchOptions) String() string { return proto.CompactTextString(m) }
+func (*DefSearchOptions) ProtoMessage()    {}
+
+// MatchRange is the range of a match in a string, in byte offsets
+// (Start is inclusive and End is exclusive).
+type MatchRange struct {
+	Start int32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   int32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *MatchRange) Reset()         { *m = MatchRange{} }
+func (m *MatchRange) String() string { return proto.CompactTextString(m) }
+func (*MatchRange) ProtoMessage()    {}
+
+// DefSearchResult is a def that matched a Defs.Search query.
+type DefSearchResult struct {
+	Def *Def `protobuf:"bytes,1,opt,name=def" json:"def,omitempty"`
+	// Score is the result's relevance score. Results are ordered by
+	// descending score.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// NameMatches are the ranges of the def's name that matched the
+	// query (for highlighting). They are ordered and do not overlap.
+	NameMatches []MatchRange `protobuf:"bytes,3,rep,name=name_matches" json:"name_matches"`
+}
+
+func (m *DefSearchResult) Reset()         { *m = DefSearchResult{} }
+func (m *DefSearchResult) String() string { return proto.CompactTextString(m) }
+func (*DefSearchResult) ProtoMessage()    {}
+
+type DefSearchResultList struct {
+	Results      []*DefSearchResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+	ListResponse `protobuf:"bytes,2,opt,name=list_response,embedded=list_response" json:"list_response"`
+}
+
+func (m *DefSearchResultList) Reset()         { *m = DefSearchResultList{} }
+func (m *DefSearchResultList) String() string { return proto.CompactTextString(m) }
+func (*DefSearchResultList) ProtoMessage()    {}
+
+// DefsDiffRefsOp specifies the def and revisions for Defs.DiffRefs.
+// The CommitID of Def is ignored.
+type DefsDiffRefsOp struct {
+	Def DefSpec `protobuf:"bytes,1,opt,name=def" json:"def"`
+	// Base and Head are the revisions (of Def's repository) to
+	// compare the refs to Def at.
+	Base string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Head string `protobuf:"bytes,3,opt,name=head,proto3" json:"head,omitempty"`
+	// Opt filters the compared refs (by repository and file). Its
+	// Authorship and list options are ignored.
+	Opt *DefListRefsOptions `protobuf:"bytes,4,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *DefsDiffRefsOp) Reset()         { *m = DefsDiffRefsOp{} }
+func (m *DefsDiffRefsOp) String() string { return proto.CompactTextString(m) }
+func (*DefsDiffRefsOp) ProtoMessage()    {}
+
+// RefDiff is the difference between the refs to a def at two
+// revisions.
+type RefDiff struct {
+	// Added are the refs that exist at the head revision but not at
+	// the base revision.
+	Added []*Ref `protobuf:"bytes,1,rep,name=added" json:"added,omitempty"`
+	// Removed are the refs that exist at the base revision but not at
+	// the head revision.
+	Removed []*Ref `protobuf:"bytes,2,rep,name=removed" json:"removed,omitempty"`
+}
+
+func (m *RefDiff) Reset()         { *m = RefDiff{} }
+func (m *RefDiff) String() string { return proto.CompactTextString(m) }
+func (*RefDiff) ProtoMessage()    {}
+
+// DefListHistoryOptions specifies options for DefsService.ListHistory.
+type DefListHistoryOptions struct {
+	ListOptions `protobuf:"bytes,1,opt,name=list_options,embedded=list_options" json:"list_options"`
+}
+
+func (m *DefListHistoryOptions) Reset()         { *m = DefListHistoryOptions{} }
+func (m *DefListHistoryOptions) String() string { return proto.CompactTextString(m) }
+func (*DefListHistoryOptions) ProtoMessage()    {}
+
+// DefHistoryEntry describes a commit that changed a def.
+type DefHistoryEntry struct {
+	Commit vcs.Commit    `protobuf:"bytes,1,opt,name=commit" json:"commit"`
+	Change DefChangeType `protobuf:"varint,2,opt,name=change,proto3,enum=sourcegraph.DefChangeType" json:"change,omitempty"`
+	// BeforeSignature is the def's signature before the commit. It is
+	// empty if the def was added by the commit.
+	BeforeSignature string `protobuf:"bytes,3,opt,name=before_signature,proto3" json:"before_signature,omitempty"`
+	// AfterSignature is the def's signature after the commit. It is
+	// empty if the def was deleted by the commit.
+	AfterSignature string `protobuf:"bytes,4,opt,name=after_signature,proto3" json:"after_signature,omitempty"`
+}
+
+func (m *DefHistoryEntry) Reset()         { *m = DefHistoryEntry{} }
+func (m *DefHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*DefHistoryEntry) ProtoMessage()    {}
+
+type DefHistory struct {
+	Entries        []*DefHistoryEntry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+	StreamResponse `protobuf:"bytes,2,opt,name=stream_response,embedded=stream_response" json:"stream_response"`
+}
+
+func (m *DefHistory) Reset()         { *m = DefHistory{} }
+func (m *DefHistory) String() string { return proto.CompactTextString(m) }
+func (*DefHistory) ProtoMessage()    {}
+
+type DefsGetLineageOp struct {
+	Def DefSpec               `protobuf:"bytes,1,opt,name=def" json:"def"`
+	Opt *DefGetLineageOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *DefsGetLineageOp) Reset()         { *m = DefsGetLineageOp{} }
+func (m *DefsGetLineageOp) String() string { return proto.CompactTextString(m) }
+func (*DefsGetLineageOp) ProtoMessage()    {}
+
+type DefGetLineageOptions struct {
+	ListOptions `protobuf:"bytes,1,opt,name=list_options,embedded=list_options" json:"list_options"`
+}
+
+func (m *DefGetLineageOptions) Reset()         { *m = DefGetLineageOptions{} }
+func (m *DefGetLineageOptions) String() string { return proto.CompactTextString(m) }
+func (*DefGetLineageOptions) ProtoMessage()    {}
+
+// DefLineage describes the identities (repo, unit, and path) that a def
+// has had over the history of its repository, as it was renamed or
+// moved.
+type DefLineage struct {
+	// Entries are the def's identities, newest first. Consecutive
+	// entries are separated by the commit at which the def was
+	// renamed or moved.
+	Entries        []DefLineageEntry `protobuf:"bytes,1,rep,name=entries" json:"entries"`
+	StreamResponse `protobuf:"bytes,2,opt,name=stream_response,embedded=stream_response" json:"stream_response"`
+}
+
+func (m *DefLineage) Reset()         { *m = DefLineage{} }
+func (m *DefLineage) String() string { return proto.CompactTextString(m) }
+func (*DefLineage) ProtoMessage()    {}
+
+// DefLineageEntry is a single identity of a def, along with the range
+// of commits over which the def had that identity.
+type DefLineageEntry struct {
+	// Def specifies the def's identity. Its CommitID is the commit
+	// at which the def first had this identity.
+	Def DefSpec `protobuf:"bytes,1,opt,name=def" json:"def"`
+	// EndCommitID is the first commit at which the def no longer had
+	// this identity (because it was renamed or moved). It is empty for
+	// the def's current identity.
+	EndCommitID string `protobuf:"bytes,2,opt,name=end_commit_id,proto3" json:"end_commit_id,omitempty"`
+}
+
+func (m *DefLineageEntry) Reset()         { *m = DefLineageEntry{} }
+func (m *DefLineageEntry) String() string { return proto.CompactTextString(m) }
+func (*DefLineageEntry) ProtoMessage()    {}
+
 // Delta represents the difference between two commits (possibly in 2 separate
 // repositories).
 type Delta struct {
@@ -2495,6 +4626,15 @@ type Delta struct {
 	HeadRepo   *Repo       `protobuf:"bytes,6,opt,name=head_repo" json:"head_repo,omitempty"`
 	BaseBuild  *Build      `protobuf:"bytes,7,opt,name=base_build" json:"base_build,omitempty"`
 	HeadBuild  *Build      `protobuf:"bytes,8,opt,name=head_build" json:"head_build,omitempty"`
+	// Labels are free-form labels (e.g., "bug" or "needs-review") attached
+	// to the delta to help triage it.
+	Labels []string `protobuf:"bytes,9,rep,name=labels" json:"labels,omitempty"`
+	// Milestone is the name of the milestone that the delta is targeted
+	// at, if any.
+	Milestone string `protobuf:"bytes,10,opt,name=milestone,proto3" json:"milestone,omitempty"`
+	// Reviewers are the users who have been assigned to review the
+	// delta.
+	Reviewers []UserSpec `protobuf:"bytes,11,rep,name=reviewers" json:"reviewers"`
 }
 
 func (m *Delta) Reset()         { *m = Delta{} }
@@ -2544,6 +4684,9 @@ type FileDiff struct {
 	PostImage string `protobuf:"bytes,4,opt,name=post_image,proto3" json:",omitempty"`
 	// Stat contains statistics about additions and deletions to this diff.
 	Stats diff.Stat `protobuf:"bytes,5,opt,name=stats" json:"stats"`
+	// HunksTruncated is whether some of this file's hunks were omitted
+	// (because of DeltaListFilesOptions.MaxHunksPerFile).
+	HunksTruncated bool `protobuf:"varint,6,opt,name=hunks_truncated,proto3" json:"hunks_truncated,omitempty"`
 }
 
 func (m *FileDiff) Reset()         { *m = FileDiff{} }
@@ -2581,6 +4724,10 @@ type DeltaFiles struct {
 	// have not been tokenized and linked. This occurs when the 'MaxSize'
 	// limit in DeltaListFilesOptions has been met.
 	OverThreshold bool `protobuf:"varint,4,opt,name=over_threshold,proto3" json:"over_threshold,omitempty"`
+	// TotalFiles is the total number of files that match the
+	// DeltaListFilesOptions filters, regardless of pagination and
+	// MaxFiles.
+	TotalFiles int32 `protobuf:"varint,5,opt,name=total_files,proto3" json:"total_files,omitempty"`
 }
 
 func (m *DeltaFiles) Reset()         { *m = DeltaFiles{} }
@@ -2620,8 +4767,21 @@ func (*DeltaListAffectedClientsOptions) ProtoMessage()    {}
 
 // DeltaListDefsOptions specifies options for ListDefs.
 type DeltaListDefsOptions struct {
+	// DeltaFilter limits the list to defs in a source unit. If only
+	// its UnitType is set, the list is limited to defs in source
+	// units of that type.
 	DeltaFilter `protobuf:"bytes,1,opt,name=delta_filter,embedded=delta_filter" json:"delta_filter"`
 	ListOptions `protobuf:"bytes,2,opt,name=list_options,embedded=list_options" json:"list_options"`
+	// Exported, if true, limits the list to defs that are exported in
+	// the base or head commit.
+	Exported bool `protobuf:"varint,3,opt,name=exported,proto3" json:"exported,omitempty" url:",omitempty"`
+	// Kinds, if set, limits the list to defs of these kinds (which
+	// are DefKind values) in the base or head commit.
+	Kinds []string `protobuf:"bytes,4,rep,name=kinds" json:"kinds,omitempty" url:",omitempty,comma"`
+	// Sort is the order of the list: "" (added, then changed, then
+	// deleted defs, each sorted by def key) or "refs" (descending
+	// RefCount).
+	Sort string `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty" url:",omitempty"`
 }
 
 func (m *DeltaListDefsOptions) Reset()         { *m = DeltaListDefsOptions{} }
@@ -2648,12 +4808,53 @@ type DeltaListFilesOptions struct {
 	// size of the raw diff when tokenized and linked.
 	MaxSize     int32 `protobuf:"varint,4,opt,name=max_size,proto3" json:"max_size,omitempty" url:",omitempty"`
 	DeltaFilter `protobuf:"bytes,5,opt,name=delta_filter,embedded=delta_filter" json:"delta_filter"`
+	// PathPrefixes, if set, limits the list to files whose path
+	// begins with one of these prefixes (e.g., "pkg/foo/").
+	PathPrefixes []string `protobuf:"bytes,6,rep,name=path_prefixes" json:"path_prefixes,omitempty" url:",omitempty,comma"`
+	// Globs, if set, limits the list to files whose path matches one
+	// of these glob patterns (using path.Match syntax, e.g., "*.go"
+	// or "cmd/*/main.go").
+	Globs []string `protobuf:"bytes,7,rep,name=globs" json:"globs,omitempty" url:",omitempty,comma"`
+	// MaxFiles, if set, is the maximum number of files to return
+	// (across all pages).
+	MaxFiles int32 `protobuf:"varint,8,opt,name=max_files,proto3" json:"max_files,omitempty" url:",omitempty"`
+	// MaxHunksPerFile, if set, is the maximum number of hunks to
+	// return for each file. Files whose hunks were truncated have
+	// HunksTruncated set.
+	MaxHunksPerFile int32 `protobuf:"varint,9,opt,name=max_hunks_per_file,proto3" json:"max_hunks_per_file,omitempty" url:",omitempty"`
+	// StatOnly is whether to omit the files' hunks entirely and
+	// return only each file's names and diffstat.
+	StatOnly    bool `protobuf:"varint,10,opt,name=stat_only,proto3" json:"stat_only,omitempty" url:",omitempty"`
+	ListOptions `protobuf:"bytes,11,opt,name=list_options,embedded=list_options" json:"list_options"`
 }
 
 func (m *DeltaListFilesOptions) Reset()         { *m = DeltaListFilesOptions{} }
 func (m *DeltaListFilesOptions) String() string { return proto.CompactTextString(m) }
 func (*DeltaListFilesOptions) ProtoMessage()    {}
 
+// DeltaListIncomingOptions specifies options for ListIncoming.
+type DeltaListIncomingOptions struct {
+	// Labels filters the list to deltas that have all of the given labels.
+	Labels []string `protobuf:"bytes,1,rep,name=labels" json:"labels,omitempty" url:",omitempty,comma"`
+	// Milestone filters the list to deltas that are targeted at the given
+	// milestone.
+	Milestone   string `protobuf:"bytes,2,opt,name=milestone,proto3" json:"milestone,omitempty" url:",omitempty"`
+	ListOptions `protobuf:"bytes,3,opt,name=list_options,embedded=list_options" json:"list_options"`
+	// BaseRev filters the list to deltas whose base revision (e.g.,
+	// branch) is BaseRev.
+	BaseRev string `protobuf:"bytes,4,opt,name=base_rev,proto3" json:"base_rev,omitempty" url:",omitempty"`
+	// StaleDays, if positive, filters the list to deltas whose head
+	// commit is at least StaleDays days old.
+	StaleDays int32 `protobuf:"varint,5,opt,name=stale_days,proto3" json:"stale_days,omitempty" url:",omitempty"`
+	// HeadBuild filters the list by the state of the deltas' head
+	// builds (see the DeltaHeadBuild* consts).
+	HeadBuild string `protobuf:"bytes,6,opt,name=head_build,proto3" json:"head_build,omitempty" url:",omitempty"`
+}
+
+func (m *DeltaListIncomingOptions) Reset()         { *m = DeltaListIncomingOptions{} }
+func (m *DeltaListIncomingOptions) String() string { return proto.CompactTextString(m) }
+func (*DeltaListIncomingOptions) ProtoMessage()    {}
+
 // DeltaListUnitsOptions specifies options for ListUnits.
 type DeltaListUnitsOptions struct {
 }
@@ -2663,6 +4864,44 @@ func (m *DeltaListUnitsOptions) String() string { return proto.CompactTextString
 func (*DeltaListUnitsOptions) ProtoMessage()    {}
 
 // A DeltaSpec specifies a delta.
+// DeltaMergeBase describes the merge base of a delta's base and head.
+type DeltaMergeBase struct {
+	// CommitID is the merge base commit's ID.
+	CommitID string `protobuf:"bytes,1,opt,name=commit_id,proto3" json:"commit_id,omitempty"`
+	// FastForward is whether the head is a descendant of the base
+	// (i.e., the merge base is the base commit), in which case the
+	// delta can be merged without a merge commit.
+	FastForward bool `protobuf:"varint,2,opt,name=fast_forward,proto3" json:"fast_forward,omitempty"`
+	// Ahead is the number of commits in head that are not in base.
+	Ahead int32 `protobuf:"varint,3,opt,name=ahead,proto3" json:"ahead,omitempty"`
+	// Behind is the number of commits in base that are not in head.
+	Behind int32 `protobuf:"varint,4,opt,name=behind,proto3" json:"behind,omitempty"`
+}
+
+func (m *DeltaMergeBase) Reset()         { *m = DeltaMergeBase{} }
+func (m *DeltaMergeBase) String() string { return proto.CompactTextString(m) }
+func (*DeltaMergeBase) ProtoMessage()    {}
+
+// DeltaImpact summarizes the impact of a delta.
+type DeltaImpact struct {
+	// AffectedAuthors is the number of people whose code is added,
+	// changed, or deleted by the delta.
+	AffectedAuthors int32 `protobuf:"varint,1,opt,name=affected_authors,proto3" json:"affected_authors,omitempty"`
+	// AffectedClients is the number of people who use defs that the
+	// delta changes.
+	AffectedClients int32 `protobuf:"varint,2,opt,name=affected_clients,proto3" json:"affected_clients,omitempty"`
+	// DependentRepos is the number of other repositories that use
+	// defs that the delta changes.
+	DependentRepos int32 `protobuf:"varint,3,opt,name=dependent_repos,proto3" json:"dependent_repos,omitempty"`
+	// ChangedExportedDefs is the number of exported defs that the
+	// delta adds, changes, or deletes.
+	ChangedExportedDefs int32 `protobuf:"varint,4,opt,name=changed_exported_defs,proto3" json:"changed_exported_defs,omitempty"`
+}
+
+func (m *DeltaImpact) Reset()         { *m = DeltaImpact{} }
+func (m *DeltaImpact) String() string { return proto.CompactTextString(m) }
+func (*DeltaImpact) ProtoMessage()    {}
+
 type DeltaSpec struct {
 	Base RepoRevSpec `protobuf:"bytes,1,opt,name=base" json:"base"`
 	Head RepoRevSpec `protobuf:"bytes,2,opt,name=head" json:"head"`
@@ -2672,6 +4911,51 @@ func (m *DeltaSpec) Reset()         { *m = DeltaSpec{} }
 func (m *DeltaSpec) String() string { return proto.CompactTextString(m) }
 func (*DeltaSpec) ProtoMessage()    {}
 
+type DeltasGetPatchOp struct {
+	Ds  DeltaSpec             `protobuf:"bytes,1,opt,name=ds" json:"ds"`
+	Opt *DeltaGetPatchOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *DeltasGetPatchOp) Reset()         { *m = DeltasGetPatchOp{} }
+func (m *DeltasGetPatchOp) String() string { return proto.CompactTextString(m) }
+func (*DeltasGetPatchOp) ProtoMessage()    {}
+
+// DeltaGetPatchOptions specifies options for DeltasService.GetPatch.
+type DeltaGetPatchOptions struct {
+	// FormatPatch is whether to return the patch as a series of
+	// emails in git format-patch format (one per commit, suitable for
+	// git am) instead of a single unified diff (suitable for git
+	// apply).
+	FormatPatch bool `protobuf:"varint,1,opt,name=format_patch,proto3" json:"format_patch,omitempty" url:",omitempty"`
+	// Offset is the offset in bytes into the patch at which to begin
+	// reading. You must retain the offset state yourself.
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty" url:",omitempty"`
+	// MaxBytes is the maximum number of bytes of patch data to
+	// return. The server enforces its own cap, so fewer bytes may be
+	// returned. If zero, the server's default is used.
+	MaxBytes int64 `protobuf:"varint,3,opt,name=max_bytes,proto3" json:"max_bytes,omitempty" url:",omitempty"`
+}
+
+func (m *DeltaGetPatchOptions) Reset()         { *m = DeltaGetPatchOptions{} }
+func (m *DeltaGetPatchOptions) String() string { return proto.CompactTextString(m) }
+func (*DeltaGetPatchOptions) ProtoMessage()    {}
+
+// DeltaPatch is a chunk of a delta's patch.
+type DeltaPatch struct {
+	// Data is the patch data starting at Offset. There is no
+	// guarantee that the requested number of bytes will be returned,
+	// so if EOF is false you should read again from Offset+len(Data).
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Offset is the offset in bytes of Data within the patch.
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// EOF is whether Data extends to the end of the patch.
+	EOF bool `protobuf:"varint,3,opt,name=eof,proto3" json:"eof,omitempty"`
+}
+
+func (m *DeltaPatch) Reset()         { *m = DeltaPatch{} }
+func (m *DeltaPatch) String() string { return proto.CompactTextString(m) }
+func (*DeltaPatch) ProtoMessage()    {}
+
 type DeltasListUnitsOp struct {
 	Ds  DeltaSpec              `protobuf:"bytes,1,opt,name=ds" json:"ds"`
 	Opt *DeltaListUnitsOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
@@ -2707,6 +4991,79 @@ func (m *DeltasListFilesOp) Reset()         { *m = DeltasListFilesOp{} }
 func (m *DeltasListFilesOp) String() string { return proto.CompactTextString(m) }
 func (*DeltasListFilesOp) ProtoMessage()    {}
 
+type DeltasListDependenciesOp struct {
+	Ds  DeltaSpec                     `protobuf:"bytes,1,opt,name=ds" json:"ds"`
+	Opt *DeltaListDependenciesOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *DeltasListDependenciesOp) Reset()         { *m = DeltasListDependenciesOp{} }
+func (m *DeltasListDependenciesOp) String() string { return proto.CompactTextString(m) }
+func (*DeltasListDependenciesOp) ProtoMessage()    {}
+
+// DeltaListDependenciesOptions specifies options for
+// Deltas.ListDependencies.
+type DeltaListDependenciesOptions struct {
+	// Transitive is whether to include changes to transitive
+	// dependencies (the dependencies of dependencies), not just to
+	// the repositories' direct dependencies.
+	Transitive bool `protobuf:"varint,1,opt,name=transitive,proto3" json:"transitive,omitempty" url:",omitempty"`
+}
+
+func (m *DeltaListDependenciesOptions) Reset()         { *m = DeltaListDependenciesOptions{} }
+func (m *DeltaListDependenciesOptions) String() string { return proto.CompactTextString(m) }
+func (*DeltaListDependenciesOptions) ProtoMessage()    {}
+
+// DeltaDependencies lists the changes a delta makes to its
+// repository's dependencies.
+type DeltaDependencies struct {
+	// Added are the dependencies of the head commit that the base
+	// commit lacks.
+	Added []*DependencyChange `protobuf:"bytes,1,rep,name=added" json:"added,omitempty"`
+	// Removed are the dependencies of the base commit that the head
+	// commit lacks.
+	Removed []*DependencyChange `protobuf:"bytes,2,rep,name=removed" json:"removed,omitempty"`
+	// Updated are the dependencies of both commits whose versions
+	// differ.
+	Updated []*DependencyChange `protobuf:"bytes,3,rep,name=updated" json:"updated,omitempty"`
+}
+
+func (m *DeltaDependencies) Reset()         { *m = DeltaDependencies{} }
+func (m *DeltaDependencies) String() string { return proto.CompactTextString(m) }
+func (*DeltaDependencies) ProtoMessage()    {}
+
+// DependencyChange is a change to a dependency on a repository.
+type DependencyChange struct {
+	// Repo is the repository depended on.
+	Repo RepoSpec `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	// Base is the dependency in the base commit (nil if it was
+	// added).
+	Base *DependencyVersion `protobuf:"bytes,2,opt,name=base" json:"base,omitempty"`
+	// Head is the dependency in the head commit (nil if it was
+	// removed).
+	Head *DependencyVersion `protobuf:"bytes,3,opt,name=head" json:"head,omitempty"`
+	// Direct is whether the repository is a direct dependency (in the
+	// head commit, or in the base commit if it was removed).
+	Direct bool `protobuf:"varint,4,opt,name=direct,proto3" json:"direct,omitempty"`
+}
+
+func (m *DependencyChange) Reset()         { *m = DependencyChange{} }
+func (m *DependencyChange) String() string { return proto.CompactTextString(m) }
+func (*DependencyChange) ProtoMessage()    {}
+
+// DependencyVersion is the version of a dependency.
+type DependencyVersion struct {
+	// Range is the version or version range that the dependency is
+	// declared with (e.g., "^1.2.0"), if any.
+	Range string `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	// Resolved is the revision of the repository that the version
+	// resolved to. Its CommitID is empty if it could not be resolved.
+	Resolved RepoRevSpec `protobuf:"bytes,2,opt,name=resolved" json:"resolved"`
+}
+
+func (m *DependencyVersion) Reset()         { *m = DependencyVersion{} }
+func (m *DependencyVersion) String() string { return proto.CompactTextString(m) }
+func (*DependencyVersion) ProtoMessage()    {}
+
 type DeltasListAffectedAuthorsOp struct {
 	Ds  DeltaSpec                        `protobuf:"bytes,1,opt,name=ds" json:"ds"`
 	Opt *DeltaListAffectedAuthorsOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
@@ -2724,6 +5081,60 @@ func (m *DeltaAffectedPersonList) Reset()         { *m = DeltaAffectedPersonList
 func (m *DeltaAffectedPersonList) String() string { return proto.CompactTextString(m) }
 func (*DeltaAffectedPersonList) ProtoMessage()    {}
 
+type DeltasListSuggestedReviewersOp struct {
+	Ds  DeltaSpec                  `protobuf:"bytes,1,opt,name=ds" json:"ds"`
+	Opt *DeltaListReviewersOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *DeltasListSuggestedReviewersOp) Reset()         { *m = DeltasListSuggestedReviewersOp{} }
+func (m *DeltasListSuggestedReviewersOp) String() string { return proto.CompactTextString(m) }
+func (*DeltasListSuggestedReviewersOp) ProtoMessage()    {}
+
+// DeltaListReviewersOptions specifies options for
+// ListSuggestedReviewers, which tune how reviewers are weighted.
+type DeltaListReviewersOptions struct {
+	// RecencyHalfLifeDays is the half-life, in days, of the weight of
+	// a person's past changes to the code (so that a change made
+	// RecencyHalfLifeDays ago counts half as much as one made today).
+	// If zero, DefaultReviewerRecencyHalfLifeDays is used.
+	RecencyHalfLifeDays int32 `protobuf:"varint,1,opt,name=recency_half_life_days,proto3" json:"recency_half_life_days,omitempty" url:",omitempty"`
+	// MinOwnershipPercent excludes people who own less than this
+	// percentage (0-100) of the code that the delta changes.
+	MinOwnershipPercent float64 `protobuf:"fixed64,2,opt,name=min_ownership_percent,proto3" json:"min_ownership_percent,omitempty" url:",omitempty"`
+	// ExcludeDeltaAuthors excludes the authors of the delta's commits.
+	ExcludeDeltaAuthors bool `protobuf:"varint,3,opt,name=exclude_delta_authors,proto3" json:"exclude_delta_authors,omitempty" url:",omitempty"`
+	// MaxSuggestions is the maximum number of reviewers to return. If
+	// zero, DefaultMaxSuggestedReviewers is used.
+	MaxSuggestions int32 `protobuf:"varint,4,opt,name=max_suggestions,proto3" json:"max_suggestions,omitempty" url:",omitempty"`
+}
+
+func (m *DeltaListReviewersOptions) Reset()         { *m = DeltaListReviewersOptions{} }
+func (m *DeltaListReviewersOptions) String() string { return proto.CompactTextString(m) }
+func (*DeltaListReviewersOptions) ProtoMessage()    {}
+
+// A SuggestedReviewer is a person suggested to review a delta.
+type SuggestedReviewer struct {
+	Person `protobuf:"bytes,1,opt,name=person,embedded=person" json:"person"`
+	// Score is the reviewer's recency-weighted ownership of the
+	// changed code. Reviewers are sorted by descending Score.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// OwnershipPercent is the percentage (0-100) of the changed code
+	// that the reviewer owns (not weighted by recency).
+	OwnershipPercent float64 `protobuf:"fixed64,3,opt,name=ownership_percent,proto3" json:"ownership_percent,omitempty"`
+}
+
+func (m *SuggestedReviewer) Reset()         { *m = SuggestedReviewer{} }
+func (m *SuggestedReviewer) String() string { return proto.CompactTextString(m) }
+func (*SuggestedReviewer) ProtoMessage()    {}
+
+type SuggestedReviewerList struct {
+	Reviewers []*SuggestedReviewer `protobuf:"bytes,1,rep,name=reviewers" json:"reviewers,omitempty"`
+}
+
+func (m *SuggestedReviewerList) Reset()         { *m = SuggestedReviewerList{} }
+func (m *SuggestedReviewerList) String() string { return proto.CompactTextString(m) }
+func (*SuggestedReviewerList) ProtoMessage()    {}
+
 type DeltasListAffectedClientsOp struct {
 	Ds  DeltaSpec                        `protobuf:"bytes,1,opt,name=ds" json:"ds"`
 	Opt *DeltaListAffectedClientsOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
@@ -2733,6 +5144,56 @@ func (m *DeltasListAffectedClientsOp) Reset()         { *m = DeltasListAffectedC
 func (m *DeltasListAffectedClientsOp) String() string { return proto.CompactTextString(m) }
 func (*DeltasListAffectedClientsOp) ProtoMessage()    {}
 
+type DeltasListIncomingOp struct {
+	// Repo is the base repository of the deltas to list.
+	Repo RepoSpec                  `protobuf:"bytes,1,opt,name=repo" json:"repo"`
+	Opt  *DeltaListIncomingOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *DeltasListIncomingOp) Reset()         { *m = DeltasListIncomingOp{} }
+func (m *DeltasListIncomingOp) String() string { return proto.CompactTextString(m) }
+func (*DeltasListIncomingOp) ProtoMessage()    {}
+
+type DeltaList struct {
+	Deltas         []*Delta `protobuf:"bytes,1,rep,name=deltas" json:"deltas,omitempty"`
+	StreamResponse `protobuf:"bytes,2,opt,name=stream_response,embedded=stream_response" json:"stream_response"`
+}
+
+func (m *DeltaList) Reset()         { *m = DeltaList{} }
+func (m *DeltaList) String() string { return proto.CompactTextString(m) }
+func (*DeltaList) ProtoMessage()    {}
+
+type DeltasSetLabelsOp struct {
+	Ds DeltaSpec `protobuf:"bytes,1,opt,name=ds" json:"ds"`
+	// Labels is the new set of labels for the delta. It replaces any
+	// labels previously set on the delta.
+	Labels []string `protobuf:"bytes,2,rep,name=labels" json:"labels,omitempty"`
+}
+
+func (m *DeltasSetLabelsOp) Reset()         { *m = DeltasSetLabelsOp{} }
+func (m *DeltasSetLabelsOp) String() string { return proto.CompactTextString(m) }
+func (*DeltasSetLabelsOp) ProtoMessage()    {}
+
+type DeltasSetMilestoneOp struct {
+	Ds DeltaSpec `protobuf:"bytes,1,opt,name=ds" json:"ds"`
+	// Milestone is the new milestone for the delta. If empty, the
+	// delta's milestone is cleared.
+	Milestone string `protobuf:"bytes,2,opt,name=milestone,proto3" json:"milestone,omitempty"`
+}
+
+func (m *DeltasSetMilestoneOp) Reset()         { *m = DeltasSetMilestoneOp{} }
+func (m *DeltasSetMilestoneOp) String() string { return proto.CompactTextString(m) }
+func (*DeltasSetMilestoneOp) ProtoMessage()    {}
+
+type DeltasReviewerOp struct {
+	Ds       DeltaSpec `protobuf:"bytes,1,opt,name=ds" json:"ds"`
+	Reviewer UserSpec  `protobuf:"bytes,2,opt,name=reviewer" json:"reviewer"`
+}
+
+func (m *DeltasReviewerOp) Reset()         { *m = DeltasReviewerOp{} }
+func (m *DeltasReviewerOp) String() string { return proto.CompactTextString(m) }
+func (*DeltasReviewerOp) ProtoMessage()    {}
+
 // Example is a usage example of a def.
 type Example struct {
 	graph1.Ref `protobuf:"bytes,1,opt,name=ref,embedded=ref" json:""`
@@ -2751,6 +5212,10 @@ type Example struct {
 	// If the example has been requested by revision name (ie. branch, tag), this
 	// value will be set.
 	Rev string `protobuf:"bytes,7,opt,name=rev,proto3" json:",omitempty"`
+	// Duplicates is the number of nearly identical examples that were
+	// omitted in favor of this one. It is only set if
+	// DefListExamplesOptions.Dedupe is true.
+	Duplicates int32 `protobuf:"varint,8,opt,name=duplicates,proto3" json:",omitempty"`
 }
 
 func (m *Example) Reset()         { *m = Example{} }
@@ -2775,8 +5240,13 @@ func (m *FormatResult) Reset()         { *m = FormatResult{} }
 func (m *FormatResult) String() string { return proto.CompactTextString(m) }
 func (*FormatResult) ProtoMessage()    {}
 
+// MarkdownData is the result of rendering Markdown.
 type MarkdownData struct {
-	Rendered  []byte     `protobuf:"bytes,1,opt,name=rendered,proto3" json:"rendered,omitempty"`
+	// Rendered is the rendered HTML. It is sanitized, so it is safe
+	// to include in a web page.
+	Rendered []byte `protobuf:"bytes,1,opt,name=rendered,proto3" json:"rendered,omitempty"`
+	// Checklist counts the task-list checkboxes in the Markdown. It
+	// is only set if MarkdownOpt.EnableCheckboxes is true.
 	Checklist *Checklist `protobuf:"bytes,2,opt,name=checklist" json:"checklist,omitempty"`
 }
 
@@ -2784,8 +5254,14 @@ func (m *MarkdownData) Reset()         { *m = MarkdownData{} }
 func (m *MarkdownData) String() string { return proto.CompactTextString(m) }
 func (*MarkdownData) ProtoMessage()    {}
 
+// MarkdownOpt specifies options for rendering Markdown.
 type MarkdownOpt struct {
+	// EnableCheckboxes is whether to render task-list items ("- [ ]"
+	// and "- [x]") as checkboxes.
 	EnableCheckboxes bool `protobuf:"varint,1,opt,name=enable_checkboxes,proto3" json:"enable_checkboxes,omitempty"`
+	// HighlightCode is whether to syntax-highlight fenced code blocks
+	// whose language is specified (e.g., "```go").
+	HighlightCode bool `protobuf:"varint,2,opt,name=highlight_code,proto3" json:"highlight_code,omitempty"`
 }
 
 func (m *MarkdownOpt) Reset()         { *m = MarkdownOpt{} }
@@ -2810,6 +5286,46 @@ func (m *MarkdownRenderOp) Reset()         { *m = MarkdownRenderOp{} }
 func (m *MarkdownRenderOp) String() string { return proto.CompactTextString(m) }
 func (*MarkdownRenderOp) ProtoMessage()    {}
 
+// GraphQLRequest is a GraphQL query and its variables.
+type GraphQLRequest struct {
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Variables is the JSON-encoded object of the query's variables.
+	Variables []byte `protobuf:"bytes,2,opt,name=variables,proto3" json:"variables,omitempty"`
+	// OperationName is the name of the operation in Query to
+	// execute, if Query contains multiple operations.
+	OperationName string `protobuf:"bytes,3,opt,name=operation_name,proto3" json:"operation_name,omitempty"`
+}
+
+func (m *GraphQLRequest) Reset()         { *m = GraphQLRequest{} }
+func (m *GraphQLRequest) String() string { return proto.CompactTextString(m) }
+func (*GraphQLRequest) ProtoMessage()    {}
+
+// GraphQLResponse is the result of executing a GraphQL query.
+type GraphQLResponse struct {
+	// Data is the JSON-encoded result data.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Errors are the errors that occurred while executing the query.
+	// If any are present, Data may be partial or empty.
+	Errors []*GraphQLError `protobuf:"bytes,2,rep,name=errors" json:"errors,omitempty"`
+}
+
+func (m *GraphQLResponse) Reset()         { *m = GraphQLResponse{} }
+func (m *GraphQLResponse) String() string { return proto.CompactTextString(m) }
+func (*GraphQLResponse) ProtoMessage()    {}
+
+// GraphQLError is an error that occurred while executing a GraphQL
+// query.
+type GraphQLError struct {
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Path is the path to the response field that caused the error
+	// (e.g., ["repository", "commits", "2"]).
+	Path []string `protobuf:"bytes,2,rep,name=path" json:"path,omitempty"`
+}
+
+func (m *GraphQLError) Reset()         { *m = GraphQLError{} }
+func (m *GraphQLError) String() string { return proto.CompactTextString(m) }
+func (*GraphQLError) ProtoMessage()    {}
+
 type Ref struct {
 	graph1.Ref `protobuf:"bytes,1,opt,name=ref,embedded=ref" json:""`
 	Authorship *AuthorshipInfo `protobuf:"bytes,2,opt,name=authorship" json:"authorship,omitempty"`
@@ -2896,6 +5412,43 @@ func (m *RepoTreeListResult) Reset()         { *m = RepoTreeListResult{} }
 func (m *RepoTreeListResult) String() string { return proto.CompactTextString(m) }
 func (*RepoTreeListResult) ProtoMessage()    {}
 
+type RepoTreeListFileDefsOp struct {
+	Entry TreeEntrySpec                `protobuf:"bytes,1,opt,name=entry" json:"entry"`
+	Opt   *RepoTreeListFileDefsOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
+}
+
+func (m *RepoTreeListFileDefsOp) Reset()         { *m = RepoTreeListFileDefsOp{} }
+func (m *RepoTreeListFileDefsOp) String() string { return proto.CompactTextString(m) }
+func (*RepoTreeListFileDefsOp) ProtoMessage()    {}
+
+// RepoTreeListFileDefsOptions specifies options for
+// RepoTree.ListFileDefs.
+type RepoTreeListFileDefsOptions struct {
+	// Kinds, if set, limits the list to defs of these kinds (e.g.,
+	// "func"; see the DefKind consts).
+	Kinds []string `protobuf:"bytes,1,rep,name=kinds" json:"kinds,omitempty" url:",omitempty,comma"`
+	// Exported limits the list to exported defs.
+	Exported bool `protobuf:"varint,2,opt,name=exported,proto3" json:"exported,omitempty" url:",omitempty"`
+	// Nonlocal limits the list to nonlocal defs.
+	Nonlocal bool `protobuf:"varint,3,opt,name=nonlocal,proto3" json:"nonlocal,omitempty" url:",omitempty"`
+}
+
+func (m *RepoTreeListFileDefsOptions) Reset()         { *m = RepoTreeListFileDefsOptions{} }
+func (m *RepoTreeListFileDefsOptions) String() string { return proto.CompactTextString(m) }
+func (*RepoTreeListFileDefsOptions) ProtoMessage()    {}
+
+// A FileDefList is a list of the defs defined in a single file.
+type FileDefList struct {
+	// Defs are the defs defined in the file, sorted by position (see
+	// DefsByPosition). Each def's DefStart and DefEnd are the byte
+	// range of its definition in the file.
+	Defs []*Def `protobuf:"bytes,1,rep,name=defs" json:"defs,omitempty"`
+}
+
+func (m *FileDefList) Reset()         { *m = FileDefList{} }
+func (m *FileDefList) String() string { return proto.CompactTextString(m) }
+func (*FileDefList) ProtoMessage()    {}
+
 type VCSSearchResultList struct {
 	SearchResults []*vcs.SearchResult `protobuf:"bytes,1,rep,name=search_results" json:"search_results,omitempty"`
 	ListResponse  `protobuf:"bytes,2,opt,name=list_response,embedded=list_response" json:"list_response"`
@@ -3129,15 +5682,91 @@ func (m *DefClientList) Reset()         { *m = DefClientList{} }
 func (m *DefClientList) String() string { return proto.CompactTextString(m) }
 func (*DefClientList) ProtoMessage()    {}
 
-type Checklist struct {
-	// number of tasks to be done (unchecked)
-	Todo int32 `protobuf:"varint,1,opt,name=todo,proto3" json:"todo,omitempty"`
-	// number of tasks that are done (checked)
-	Done int32 `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+type RepoDependenciesListOp struct {
+	Rev RepoRevSpec  `protobuf:"bytes,1,opt,name=rev" json:"rev"`
+	Opt *ListOptions `protobuf:"bytes,2,opt,name=opt" json:"opt,omitempty"`
 }
 
-func (m *Checklist) Reset()         { *m = Checklist{} }
-func (m *Checklist) String() string { return proto.CompactTextString(m) }
+func (m *RepoDependenciesListOp) Reset()         { *m = RepoDependenciesListOp{} }
+func (m *RepoDependenciesListOp) String() string { return proto.CompactTextString(m) }
+func (*RepoDependenciesListOp) ProtoMessage()    {}
+
+// RepoDependency is an edge in the repository dependency graph.
+type RepoDependency struct {
+	// From is the repository (at a commit) that has the dependency.
+	From RepoRevSpec `protobuf:"bytes,1,opt,name=from" json:"from"`
+	// To is the repository that From depends on. Its CommitID is set
+	// if the dependency's version was resolved to a commit.
+	To RepoRevSpec `protobuf:"bytes,2,opt,name=to" json:"to"`
+	// Units are the names of the source units in From that declare
+	// the dependency.
+	Units []string `protobuf:"bytes,3,rep,name=units" json:"units,omitempty"`
+	// Version is the version or version range that the dependency is
+	// declared with (e.g., "^1.2.0"), if any.
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *RepoDependency) Reset()         { *m = RepoDependency{} }
+func (m *RepoDependency) String() string { return proto.CompactTextString(m) }
+func (*RepoDependency) ProtoMessage()    {}
+
+type RepoDependencyList struct {
+	Dependencies   []*RepoDependency `protobuf:"bytes,1,rep,name=dependencies" json:"dependencies,omitempty"`
+	StreamResponse `protobuf:"bytes,2,opt,name=stream_response,embedded=stream_response" json:"stream_response"`
+}
+
+func (m *RepoDependencyList) Reset()         { *m = RepoDependencyList{} }
+func (m *RepoDependencyList) String() string { return proto.CompactTextString(m) }
+func (*RepoDependencyList) ProtoMessage()    {}
+
+type AnnotationsListOptions struct {
+	Entry TreeEntrySpec `protobuf:"bytes,1,opt,name=entry" json:"entry"`
+	// StartByte and EndByte, if set, limit the list to annotations
+	// that overlap this byte range of the file. If EndByte is 0, the
+	// range extends to the end of the file.
+	StartByte int32 `protobuf:"varint,2,opt,name=start_byte,proto3" json:"start_byte,omitempty"`
+	EndByte   int32 `protobuf:"varint,3,opt,name=end_byte,proto3" json:"end_byte,omitempty"`
+}
+
+func (m *AnnotationsListOptions) Reset()         { *m = AnnotationsListOptions{} }
+func (m *AnnotationsListOptions) String() string { return proto.CompactTextString(m) }
+func (*AnnotationsListOptions) ProtoMessage()    {}
+
+// Annotation links a byte range of a file to a def.
+type Annotation struct {
+	// StartByte and EndByte are the byte range of the annotated
+	// text in the file.
+	StartByte int32 `protobuf:"varint,1,opt,name=start_byte,proto3" json:"start_byte,omitempty"`
+	EndByte   int32 `protobuf:"varint,2,opt,name=end_byte,proto3" json:"end_byte,omitempty"`
+	// URL is the URL of the def that the annotated text refers to.
+	URL string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// Def is whether the annotated text is the def's own definition
+	// (as opposed to a ref to the def).
+	Def bool `protobuf:"varint,4,opt,name=def,proto3" json:"def,omitempty"`
+}
+
+func (m *Annotation) Reset()         { *m = Annotation{} }
+func (m *Annotation) String() string { return proto.CompactTextString(m) }
+func (*Annotation) ProtoMessage()    {}
+
+type AnnotationList struct {
+	// Annotations are sorted by StartByte (and then by EndByte).
+	Annotations []*Annotation `protobuf:"bytes,1,rep,name=annotations" json:"annotations,omitempty"`
+}
+
+func (m *AnnotationList) Reset()         { *m = AnnotationList{} }
+func (m *AnnotationList) String() string { return proto.CompactTextString(m) }
+func (*AnnotationList) ProtoMessage()    {}
+
+type Checklist struct {
+	// number of tasks to be done (unchecked)
+	Todo int32 `protobuf:"varint,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	// number of tasks that are done (checked)
+	Done int32 `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+}
+
+func (m *Checklist) Reset()         { *m = Checklist{} }
+func (m *Checklist) String() string { return proto.CompactTextString(m) }
 func (*Checklist) ProtoMessage()    {}
 
 type FileToken struct {
@@ -3503,17 +6132,168 @@ func _PBToken_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer
 	}
 }
 
+// SiteConfig is the site-wide configuration of a server.
+type SiteConfig struct {
+	// ID identifies this version of the configuration. It changes
+	// each time the configuration is updated.
+	ID int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Contents is the configuration, as a JSON object.
+	Contents string `protobuf:"bytes,2,opt,name=contents,proto3" json:"contents,omitempty"`
+	// UpdatedAt is when the configuration was last updated.
+	UpdatedAt *pbtypes.Timestamp `protobuf:"bytes,3,opt,name=updated_at" json:"updated_at,omitempty"`
+}
+
+func (m *SiteConfig) Reset()         { *m = SiteConfig{} }
+func (m *SiteConfig) String() string { return proto.CompactTextString(m) }
+func (*SiteConfig) ProtoMessage()    {}
+
+type AdminReindexAllReposOp struct {
+	// Force reindexes repositories even if their latest commits have
+	// already been successfully built.
+	Force bool `protobuf:"varint,1,opt,name=force,proto3" json:"force,omitempty"`
+	// Priority is the priority of the enqueued builds.
+	Priority int32 `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (m *AdminReindexAllReposOp) Reset()         { *m = AdminReindexAllReposOp{} }
+func (m *AdminReindexAllReposOp) String() string { return proto.CompactTextString(m) }
+func (*AdminReindexAllReposOp) ProtoMessage()    {}
+
+type AdminReindexResult struct {
+	// Queued is the number of builds that were enqueued.
+	Queued int32 `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`
+}
+
+func (m *AdminReindexResult) Reset()         { *m = AdminReindexResult{} }
+func (m *AdminReindexResult) String() string { return proto.CompactTextString(m) }
+func (*AdminReindexResult) ProtoMessage()    {}
+
+// JobQueueStats describes the server's background job queues.
+type JobQueueStats struct {
+	Queues []JobQueueStat `protobuf:"bytes,1,rep,name=queues" json:"queues"`
+}
+
+func (m *JobQueueStats) Reset()         { *m = JobQueueStats{} }
+func (m *JobQueueStats) String() string { return proto.CompactTextString(m) }
+func (*JobQueueStats) ProtoMessage()    {}
+
+// JobQueueStat describes a single background job queue.
+type JobQueueStat struct {
+	// Name is the name of the queue (e.g., "builds").
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Queued is the number of jobs waiting to be started.
+	Queued int32 `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`
+	// Active is the number of jobs in progress.
+	Active int32 `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	// Failed is the number of jobs that failed in the last 24 hours.
+	Failed int32 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	// OldestQueuedAt is when the oldest waiting job was enqueued, if
+	// there are waiting jobs.
+	OldestQueuedAt *pbtypes.Timestamp `protobuf:"bytes,5,opt,name=oldest_queued_at" json:"oldest_queued_at,omitempty"`
+}
+
+func (m *JobQueueStat) Reset()         { *m = JobQueueStat{} }
+func (m *JobQueueStat) String() string { return proto.CompactTextString(m) }
+func (*JobQueueStat) ProtoMessage()    {}
+
+type AdminStatsGetUsageOp struct {
+	// Since is the start of the time window (inclusive). If not set,
+	// the window starts 30 days before Until.
+	Since *pbtypes.Timestamp `protobuf:"bytes,1,opt,name=since" json:"since,omitempty"`
+	// Until is the end of the time window (exclusive). If not set,
+	// the window ends at the current time.
+	Until *pbtypes.Timestamp `protobuf:"bytes,2,opt,name=until" json:"until,omitempty"`
+}
+
+func (m *AdminStatsGetUsageOp) Reset()         { *m = AdminStatsGetUsageOp{} }
+func (m *AdminStatsGetUsageOp) String() string { return proto.CompactTextString(m) }
+func (*AdminStatsGetUsageOp) ProtoMessage()    {}
+
+// UsageStats holds aggregate usage statistics for a server.
+type UsageStats struct {
+	// Since and Until are the bounds of the time window that the
+	// windowed statistics (BuildsPerDay and APICalls) cover.
+	Since pbtypes.Timestamp `protobuf:"bytes,1,opt,name=since" json:"since"`
+	Until pbtypes.Timestamp `protobuf:"bytes,2,opt,name=until" json:"until"`
+	// Repos is the total number of repositories on the server.
+	Repos int32 `protobuf:"varint,3,opt,name=repos,proto3" json:"repos,omitempty"`
+	// Users is the total number of registered users.
+	Users int32 `protobuf:"varint,4,opt,name=users,proto3" json:"users,omitempty"`
+	// DefsIndexed is the total number of defs in the server's index.
+	DefsIndexed int64 `protobuf:"varint,5,opt,name=defs_indexed,proto3" json:"defs_indexed,omitempty"`
+	// BuildsPerDay is the number of builds created on each day in the
+	// time window, in chronological order.
+	BuildsPerDay []DailyCount `protobuf:"bytes,6,rep,name=builds_per_day" json:"builds_per_day"`
+	// APICalls is the number of API calls made to each route in the
+	// time window, sorted by descending count.
+	APICalls []RouteCount `protobuf:"bytes,7,rep,name=api_calls" json:"api_calls"`
+}
+
+func (m *UsageStats) Reset()         { *m = UsageStats{} }
+func (m *UsageStats) String() string { return proto.CompactTextString(m) }
+func (*UsageStats) ProtoMessage()    {}
+
+// DailyCount is a count of events that occurred on a single day.
+type DailyCount struct {
+	// Day is the start of the day (in UTC).
+	Day   pbtypes.Timestamp `protobuf:"bytes,1,opt,name=day" json:"day"`
+	Count int64             `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *DailyCount) Reset()         { *m = DailyCount{} }
+func (m *DailyCount) String() string { return proto.CompactTextString(m) }
+func (*DailyCount) ProtoMessage()    {}
+
+// RouteCount is a count of API calls made to a route.
+type RouteCount struct {
+	// Route is the name of the API route (e.g., "Repos.Get").
+	Route string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *RouteCount) Reset()         { *m = RouteCount{} }
+func (m *RouteCount) String() string { return proto.CompactTextString(m) }
+func (*RouteCount) ProtoMessage()    {}
+
 // ServerStatus describes the server's status.
 type ServerStatus struct {
 	// Info contains arbitrary human-readable status information about
 	// the server.
 	Info string `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	// Version is the version of Sourcegraph that the server is
+	// running.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// APIVersion is the version of the API that the server implements
+	// (in "major.minor" form; see the APIVersion constant).
+	APIVersion string `protobuf:"bytes,3,opt,name=api_version,proto3" json:"api_version,omitempty"`
+	// SrclibVersion is the version of srclib that the server uses to
+	// build repositories.
+	SrclibVersion string `protobuf:"bytes,4,opt,name=srclib_version,proto3" json:"srclib_version,omitempty"`
+	// Subsystems describes the health of the server's subsystems
+	// (e.g., "builds", "vcsstore", and "db").
+	Subsystems []SubsystemHealth `protobuf:"bytes,5,rep,name=subsystems" json:"subsystems"`
 }
 
 func (m *ServerStatus) Reset()         { *m = ServerStatus{} }
 func (m *ServerStatus) String() string { return proto.CompactTextString(m) }
 func (*ServerStatus) ProtoMessage()    {}
 
+// SubsystemHealth describes the health of one of a server's
+// subsystems.
+type SubsystemHealth struct {
+	// Name is the name of the subsystem (one of the Subsystem*
+	// constants, or another server-defined name).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Healthy is whether the subsystem is working normally.
+	Healthy bool `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// Message describes the problem, if the subsystem is unhealthy.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *SubsystemHealth) Reset()         { *m = SubsystemHealth{} }
+func (m *SubsystemHealth) String() string { return proto.CompactTextString(m) }
+func (*SubsystemHealth) ProtoMessage()    {}
+
 // ServerConfig describes the server's configuration.
 //
 // DEV NOTE: There is some overlap with Go CLI flag structs. In the
@@ -3682,6 +6462,89 @@ func (m *UserPermissionsOptions) Reset()         { *m = UserPermissionsOptions{}
 func (m *UserPermissionsOptions) String() string { return proto.CompactTextString(m) }
 func (*UserPermissionsOptions) ProtoMessage()    {}
 
+// SavedSearch is a search query that a user has saved, with
+// settings for notifying the user when the query has new results.
+type SavedSearch struct {
+	// ID is the saved search's ID. It is assigned by the server.
+	ID int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Owner is the user who saved the search. It is set by the
+	// server to the current user.
+	Owner UserSpec `protobuf:"bytes,2,opt,name=owner" json:"owner"`
+	// Name is a human-readable name for the saved search (e.g.,
+	// "refs to MyAPI added").
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Query is the search query, in the syntax accepted by
+	// Search.Search.
+	Query string `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	// Notifications specifies how and when the owner is notified of
+	// new results.
+	Notifications SavedSearchNotifications `protobuf:"bytes,5,opt,name=notifications" json:"notifications"`
+	// CreatedAt is when the search was saved.
+	CreatedAt pbtypes.Timestamp `protobuf:"bytes,6,opt,name=created_at" json:"created_at"`
+	// LastNotifiedAt is when the owner was last notified of new
+	// results, if ever.
+	LastNotifiedAt *pbtypes.Timestamp `protobuf:"bytes,7,opt,name=last_notified_at" json:"last_notified_at,omitempty"`
+}
+
+func (m *SavedSearch) Reset()         { *m = SavedSearch{} }
+func (m *SavedSearch) String() string { return proto.CompactTextString(m) }
+func (*SavedSearch) ProtoMessage()    {}
+
+// SavedSearchNotifications specifies how and when a saved search's
+// owner is notified of new results.
+type SavedSearchNotifications struct {
+	// Frequency is how often to notify the owner of new results. It
+	// is one of the SavedSearchFrequency* constants. If empty (or
+	// SavedSearchFrequencyNever), the owner is not notified.
+	Frequency string `protobuf:"bytes,1,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	// Email is whether to send notifications to the owner's primary
+	// email address.
+	Email bool `protobuf:"varint,2,opt,name=email,proto3" json:"email,omitempty"`
+	// WebhookURL, if set, is an http or https URL that notifications
+	// are POSTed to (as JSON).
+	WebhookURL string `protobuf:"bytes,3,opt,name=webhook_url,proto3" json:"webhook_url,omitempty"`
+}
+
+func (m *SavedSearchNotifications) Reset()         { *m = SavedSearchNotifications{} }
+func (m *SavedSearchNotifications) String() string { return proto.CompactTextString(m) }
+func (*SavedSearchNotifications) ProtoMessage()    {}
+
+// A SavedSearchSpec uniquely identifies a SavedSearch.
+type SavedSearchSpec struct {
+	ID int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *SavedSearchSpec) Reset()         { *m = SavedSearchSpec{} }
+func (m *SavedSearchSpec) String() string { return proto.CompactTextString(m) }
+func (*SavedSearchSpec) ProtoMessage()    {}
+
+// SavedSearchListOptions configures a call to SavedSearches.List.
+type SavedSearchListOptions struct {
+	ListOptions `protobuf:"bytes,1,opt,name=list_options,embedded=list_options" json:"list_options"`
+}
+
+func (m *SavedSearchListOptions) Reset()         { *m = SavedSearchListOptions{} }
+func (m *SavedSearchListOptions) String() string { return proto.CompactTextString(m) }
+func (*SavedSearchListOptions) ProtoMessage()    {}
+
+type SavedSearchList struct {
+	SavedSearches  []*SavedSearch `protobuf:"bytes,1,rep,name=saved_searches" json:"saved_searches,omitempty"`
+	StreamResponse `protobuf:"bytes,2,opt,name=stream_response,embedded=stream_response" json:"stream_response"`
+}
+
+func (m *SavedSearchList) Reset()         { *m = SavedSearchList{} }
+func (m *SavedSearchList) String() string { return proto.CompactTextString(m) }
+func (*SavedSearchList) ProtoMessage()    {}
+
+type SavedSearchesUpdateNotificationsOp struct {
+	Search        SavedSearchSpec          `protobuf:"bytes,1,opt,name=search" json:"search"`
+	Notifications SavedSearchNotifications `protobuf:"bytes,2,opt,name=notifications" json:"notifications"`
+}
+
+func (m *SavedSearchesUpdateNotificationsOp) Reset()         { *m = SavedSearchesUpdateNotificationsOp{} }
+func (m *SavedSearchesUpdateNotificationsOp) String() string { return proto.CompactTextString(m) }
+func (*SavedSearchesUpdateNotificationsOp) ProtoMessage()    {}
+
 // MetricsSnapshots encodes
 type MetricsSnapshot struct {
 	// Type is the encoding of TelemetryData
@@ -3759,7 +6622,15 @@ func (m *NotifyGenericEvent) String() string { return proto.CompactTextString(m)
 func (*NotifyGenericEvent) ProtoMessage()    {}
 
 func init() {
+	proto.RegisterEnum("sourcegraph.BadgeStyle", BadgeStyle_name, BadgeStyle_value)
+	proto.RegisterEnum("sourcegraph.BadgeFormat", BadgeFormat_name, BadgeFormat_value)
+	proto.RegisterEnum("sourcegraph.ReadmeRepresentation", ReadmeRepresentation_name, ReadmeRepresentation_value)
+	proto.RegisterEnum("sourcegraph.RepoStatsInterval", RepoStatsInterval_name, RepoStatsInterval_value)
+	proto.RegisterEnum("sourcegraph.ArchiveFormat", ArchiveFormat_name, ArchiveFormat_value)
 	proto.RegisterEnum("sourcegraph.DiscussionListOrder", DiscussionListOrder_name, DiscussionListOrder_value)
+	proto.RegisterEnum("sourcegraph.UserKeyType", UserKeyType_name, UserKeyType_value)
+	proto.RegisterEnum("sourcegraph.DefSearchMode", DefSearchMode_name, DefSearchMode_value)
+	proto.RegisterEnum("sourcegraph.DefChangeType", DefChangeType_name, DefChangeType_value)
 	proto.RegisterEnum("sourcegraph.RegisteredClientType", RegisteredClientType_name, RegisteredClientType_value)
 	proto.RegisterEnum("sourcegraph.TelemetryType", TelemetryType_name, TelemetryType_value)
 	proto.RegisterEnum("sourcegraph.StorageError_Code", StorageError_Code_name, StorageError_Code_value)
@@ -3782,6 +6653,18 @@ type RepoBadgesClient interface {
 	// CountHits returns the hit count (optionally in a recent time
 	// period).
 	CountHits(ctx context.Context, in *RepoBadgesCountHitsOp, opts ...grpc.CallOption) (*RepoBadgesCountHitsResult, error)
+	// CreateBadge creates a custom badge for repo. Only repository
+	// admins may call it.
+	CreateBadge(ctx context.Context, in *RepoBadgesCreateOp, opts ...grpc.CallOption) (*Badge, error)
+	// DeleteBadge deletes a custom badge. Built-in badges can't be
+	// deleted.
+	DeleteBadge(ctx context.Context, in *RepoBadgesDeleteOp, opts ...grpc.CallOption) (*pbtypes1.Void, error)
+	// CreateCounter creates a custom counter for repo. Only
+	// repository admins may call it.
+	CreateCounter(ctx context.Context, in *RepoBadgesCreateOp, opts ...grpc.CallOption) (*Counter, error)
+	// DeleteCounter deletes a custom counter. Built-in counters can't
+	// be deleted.
+	DeleteCounter(ctx context.Context, in *RepoBadgesDeleteOp, opts ...grpc.CallOption) (*pbtypes1.Void, error)
 }
 
 type repoBadgesClient struct {
@@ -3828,6 +6711,42 @@ func (c *repoBadgesClient) CountHits(ctx context.Context, in *RepoBadgesCountHit
 	return out, nil
 }
 
+func (c *repoBadgesClient) CreateBadge(ctx context.Context, in *RepoBadgesCreateOp, opts ...grpc.CallOption) (*Badge, error) {
+	out := new(Badge)
+	err := grpc.Invoke(ctx, "/sourcegraph.RepoBadges/CreateBadge", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoBadgesClient) DeleteBadge(ctx context.Context, in *RepoBadgesDeleteOp, opts ...grpc.CallOption) (*pbtypes1.Void, error) {
+	out := new(pbtypes1.Void)
+	err := grpc.Invoke(ctx, "/sourcegraph.RepoBadges/DeleteBadge", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoBadgesClient) CreateCounter(ctx context.Context, in *RepoBadgesCreateOp, opts ...grpc.CallOption) (*Counter, error) {
+	out := new(Counter)
+	err := grpc.Invoke(ctx, "/sourcegraph.RepoBadges/CreateCounter", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoBadgesClient) DeleteCounter(ctx context.Context, in *RepoBadgesDeleteOp, opts ...grpc.CallOption) (*pbtypes1.Void, error) {
+	out := new(pbtypes1.Void)
+	err := grpc.Invoke(ctx, "/sourcegraph.RepoBadges/DeleteCounter", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for RepoBadges service
 
 type RepoBadgesServer interface {
@@ -3841,6 +6760,18 @@ type RepoBadgesServer interface {
 	// CountHits returns the hit count (optionally in a recent time
 	// period).
 	CountHits(context.Context, *RepoBadgesCountHitsOp) (*RepoBadgesCountHitsResult, error)
+	// CreateBadge creates a custom badge for repo. Only repository
+	// admins may call it.
+	CreateBadge(context.Context, *RepoBadgesCreateOp) (*Badge, error)
+	// DeleteBadge deletes a custom badge. Built-in badges can't be
+	// deleted.
+	DeleteBadge(context.Context, *RepoBadgesDeleteOp) (*pbtypes1.Void, error)
+	// CreateCounter creates a custom counter for repo. Only
+	// repository admins may call it.
+	CreateCounter(context.Context, *RepoBadgesCreateOp) (*Counter, error)
+	// DeleteCounter deletes a custom counter. Built-in counters can't
+	// be deleted.
+	DeleteCounter(context.Context, *RepoBadgesDeleteOp) (*pbtypes1.Void, error)
 }
 
 func RegisterRepoBadgesServer(s *grpc.Server, srv RepoBadgesServer) {
@@ -3895,6 +6826,54 @@ func _RepoBadges_CountHits_Handler(srv interface{}, ctx context.Context, dec fun
 	return out, nil
 }
 
+func _RepoBadges_CreateBadge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RepoBadgesCreateOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RepoBadgesServer).CreateBadge(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RepoBadges_DeleteBadge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RepoBadgesDeleteOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RepoBadgesServer).DeleteBadge(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RepoBadges_CreateCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RepoBadgesCreateOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RepoBadgesServer).CreateCounter(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RepoBadges_DeleteCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RepoBadgesDeleteOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RepoBadgesServer).DeleteCounter(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 var _RepoBadges_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sourcegraph.RepoBadges",
 	HandlerType: (*RepoBadgesServer)(nil),
@@ -3915,6 +6894,22 @@ var _RepoBadges_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CountHits",
 			Handler:    _RepoBadges_CountHits_Handler,
 		},
+		{
+			MethodName: "CreateBadge",
+			Handler:    _RepoBadges_CreateBadge_Handler,
+		},
+		{
+			MethodName: "DeleteBadge",
+			Handler:    _RepoBadges_DeleteBadge_Handler,
+		},
+		{
+			MethodName: "CreateCounter",
+			Handler:    _RepoBadges_CreateCounter_Handler,
+		},
+		{
+			MethodName: "DeleteCounter",
+			Handler:    _RepoBadges_DeleteCounter_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{},
 }
@@ -3926,6 +6921,13 @@ type RepoStatusesClient interface {
 	GetCombined(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*CombinedStatus, error)
 	// Create creates a repository status for the given commit.
 	Create(ctx context.Context, in *RepoStatusesCreateOp, opts ...grpc.CallOption) (*RepoStatus, error)
+	// GetRollup is like GetCombined, but the combined state only
+	// accounts for the statuses of the given required contexts. A
+	// required context with no status makes the state "pending".
+	GetRollup(ctx context.Context, in *RepoStatusesGetRollupOp, opts ...grpc.CallOption) (*CombinedStatus, error)
+	// List lists all of the statuses for the given commit, including
+	// earlier statuses for the same context, newest first.
+	List(ctx context.Context, in *RepoStatusesListOp, opts ...grpc.CallOption) (*RepoStatusList, error)
 }
 
 type repoStatusesClient struct {
@@ -3954,6 +6956,24 @@ func (c *repoStatusesClient) Create(ctx context.Context, in *RepoStatusesCreateO
 	return out, nil
 }
 
+func (c *repoStatusesClient) GetRollup(ctx context.Context, in *RepoStatusesGetRollupOp, opts ...grpc.CallOption) (*CombinedStatus, error) {
+	out := new(CombinedStatus)
+	err := grpc.Invoke(ctx, "/sourcegraph.RepoStatuses/GetRollup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoStatusesClient) List(ctx context.Context, in *RepoStatusesListOp, opts ...grpc.CallOption) (*RepoStatusList, error) {
+	out := new(RepoStatusList)
+	err := grpc.Invoke(ctx, "/sourcegraph.RepoStatuses/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for RepoStatuses service
 
 type RepoStatusesServer interface {
@@ -3961,6 +6981,13 @@ type RepoStatusesServer interface {
 	GetCombined(context.Context, *RepoRevSpec) (*CombinedStatus, error)
 	// Create creates a repository status for the given commit.
 	Create(context.Context, *RepoStatusesCreateOp) (*RepoStatus, error)
+	// GetRollup is like GetCombined, but the combined state only
+	// accounts for the statuses of the given required contexts. A
+	// required context with no status makes the state "pending".
+	GetRollup(context.Context, *RepoStatusesGetRollupOp) (*CombinedStatus, error)
+	// List lists all of the statuses for the given commit, including
+	// earlier statuses for the same context, newest first.
+	List(context.Context, *RepoStatusesListOp) (*RepoStatusList, error)
 }
 
 func RegisterRepoStatusesServer(s *grpc.Server, srv RepoStatusesServer) {
@@ -3991,6 +7018,30 @@ func _RepoStatuses_Create_Handler(srv interface{}, ctx context.Context, dec func
 	return out, nil
 }
 
+func _RepoStatuses_GetRollup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RepoStatusesGetRollupOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RepoStatusesServer).GetRollup(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RepoStatuses_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RepoStatusesListOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RepoStatusesServer).List(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 var _RepoStatuses_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sourcegraph.RepoStatuses",
 	HandlerType: (*RepoStatusesServer)(nil),
@@ -4003,6 +7054,14 @@ var _RepoStatuses_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Create",
 			Handler:    _RepoStatuses_Create_Handler,
 		},
+		{
+			MethodName: "GetRollup",
+			Handler:    _RepoStatuses_GetRollup_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _RepoStatuses_List_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{},
 }
@@ -4020,8 +7079,17 @@ type ReposClient interface {
 	Update(ctx context.Context, in *ReposUpdateOp, opts ...grpc.CallOption) (*Repo, error)
 	// Delete removes a repository.
 	Delete(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes1.Void, error)
-	// GetReadme fetches the formatted README file for a repository.
-	GetReadme(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*Readme, error)
+	// GetReadme fetches the README file for a repository, in the
+	// requested representation (formatted HTML by default).
+	GetReadme(ctx context.Context, in *ReposGetReadmeOp, opts ...grpc.CallOption) (*Readme, error)
+	// GetInventory returns a summary of the languages and build
+	// systems used in a repository at a commit.
+	GetInventory(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*Inventory, error)
+	// GetStatsHistory returns a time series of a repository's stats
+	// (such as its number of commits, authors, defs, and refs) as of
+	// the given revision, so that clients can chart repository
+	// activity over time.
+	GetStatsHistory(ctx context.Context, in *ReposGetStatsHistoryOp, opts ...grpc.CallOption) (*RepoStatsHistory, error)
 	// Enable enables the specified repository.
 	Enable(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes1.Void, error)
 	// Disable disables the specified repository.
@@ -4030,9 +7098,58 @@ type ReposClient interface {
 	// update the config, use Enable or Disable (direct updating is
 	// not currently supported).
 	GetConfig(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*RepoConfig, error)
+	// ListCollaborators lists the users who have been granted
+	// permissions on a repository.
+	ListCollaborators(ctx context.Context, in *ReposListCollaboratorsOp, opts ...grpc.CallOption) (*CollaboratorList, error)
+	// AddCollaborator grants a user permissions on a repository. If
+	// the user is already a collaborator, their permissions are
+	// replaced. Only repository admins may call it.
+	AddCollaborator(ctx context.Context, in *ReposAddCollaboratorOp, opts ...grpc.CallOption) (*pbtypes1.Void, error)
+	// RemoveCollaborator revokes all of a user's permissions on a
+	// repository. Only repository admins may call it.
+	RemoveCollaborator(ctx context.Context, in *ReposRemoveCollaboratorOp, opts ...grpc.CallOption) (*pbtypes1.Void, error)
+	// GetPermissions returns the permissions that a user has on a
+	// repository, taking into account the repository's visibility
+	// and the user's site-wide permissions.
+	GetPermissions(ctx context.Context, in *ReposGetPermissionsOp, opts ...grpc.CallOption) (*RepoPermissions, error)
+	// ListKeys lists a repository's SSH deploy keys.
+	ListKeys(ctx context.Context, in *ReposListKeysOp, opts ...grpc.CallOption) (*DeployKeyList, error)
+	// AddKey adds an SSH deploy key to a repository. To rotate a key,
+	// add the new key and then delete the old one. Only repository
+	// admins may call it.
+	AddKey(ctx context.Context, in *ReposAddKeyOp, opts ...grpc.CallOption) (*DeployKey, error)
+	// DeleteKey revokes and deletes an SSH deploy key from a
+	// repository. Only repository admins may call it.
+	DeleteKey(ctx context.Context, in *ReposDeleteKeyOp, opts ...grpc.CallOption) (*pbtypes1.Void, error)
+	// Watch subscribes the current user to notifications about a
+	// repository. If the user is already watching the repository,
+	// their notification preferences are updated.
+	Watch(ctx context.Context, in *ReposWatchOp, opts ...grpc.CallOption) (*RepoSubscription, error)
+	// Unwatch unsubscribes the current user from notifications about
+	// a repository.
+	Unwatch(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes1.Void, error)
+	// GetSubscription returns the current user's subscription to a
+	// repository. If the user is not watching the repository, the
+	// returned subscription's Watching field is false.
+	GetSubscription(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*RepoSubscription, error)
+	// ListWatched lists the repositories that a user is watching.
+	ListWatched(ctx context.Context, in *ReposListWatchedOp, opts ...grpc.CallOption) (*RepoList, error)
 	// TODO(sqs!nodb-ctx): move these to a "VCS" service (not Repos)
 	// TODO(slimsag): add google.api.http annotations to these once moved
 	GetCommit(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*vcs.Commit, error)
+	// GetCommitDetail is like GetCommit, but it can also return the
+	// commit's changed files and file diffs (against its first parent)
+	// in a single call. See RepoGetCommitOptions.
+	GetCommitDetail(ctx context.Context, in *ReposGetCommitOp, opts ...grpc.CallOption) (*CommitDetail, error)
+	// GetCommitPatch returns a chunk of the patch (unified diff) for a
+	// single commit against its first parent. Large patches are
+	// returned in chunks no larger than the server's size cap; use
+	// CommitPatchReader to read the whole patch as a stream.
+	GetCommitPatch(ctx context.Context, in *ReposGetCommitPatchOp, opts ...grpc.CallOption) (*CommitPatch, error)
+	// GetArchive returns a chunk of an archive (e.g., a tar.gz or zip
+	// file) of the repository's tree at a commit. Use
+	// RepoArchiveReader to read the whole archive as a stream.
+	GetArchive(ctx context.Context, in *ReposGetArchiveOp, opts ...grpc.CallOption) (*RepoArchive, error)
 	// ListCommits returns the list of commits that span between the revisions
 	// specified in the given DeltaSpec. By default, it will return 1 page of
 	// commits with a maximum of DefaultPerPage entries. To retrieve all commits
@@ -4043,6 +7160,20 @@ type ReposClient interface {
 	// ListCommitters returns the list of authors who have contributed
 	// to the main branch of the repo.
 	ListCommitters(ctx context.Context, in *ReposListCommittersOp, opts ...grpc.CallOption) (*CommitterList, error)
+	// ListContributors returns the list of people who have contributed
+	// to the main branch of the repo, with their commit counts. Unlike
+	// ListCommitters, it resolves each contributor to a Person (and to
+	// a registered user, if possible).
+	ListContributors(ctx context.Context, in *ReposListContributorsOp, opts ...grpc.CallOption) (*ContributorList, error)
+	// SearchCode searches the contents of the files in a repository
+	// at a commit for a literal string or regexp (like grep),
+	// returning each matching line and the ranges of the matches in
+	// it.
+	SearchCode(ctx context.Context, in *ReposSearchCodeOp, opts ...grpc.CallOption) (*CodeSearchResultList, error)
+	// GetCodeOwners evaluates the repository's code owners file
+	// (e.g., CODEOWNERS; see CodeOwnersFilePaths) at a commit and
+	// returns the owners of a path.
+	GetCodeOwners(ctx context.Context, in *ReposGetCodeOwnersOp, opts ...grpc.CallOption) (*CodeOwners, error)
 }
 
 type reposClient struct {
@@ -4098,7 +7229,7 @@ func (c *reposClient) Delete(ctx context.Context, in *RepoSpec, opts ...grpc.Cal
 	return out, nil
 }
 
-func (c *reposClient) GetReadme(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*Readme, error) {
+func (c *reposClient) GetReadme(ctx context.Context, in *ReposGetReadmeOp, opts ...grpc.CallOption) (*Readme, error) {
 	out := new(Readme)
 	err := grpc.Invoke(ctx, "/sourcegraph.Repos/GetReadme", in, out, c.cc, opts...)
 	if err != nil {
@@ -4107,6 +7238,24 @@ func (c *reposClient) GetReadme(ctx context.Context, in *RepoRevSpec, opts ...gr
 	return out, nil
 }
 
+func (c *reposClient) GetInventory(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*Inventory, error) {
+	out := new(Inventory)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/GetInventory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reposClient) GetStatsHistory(ctx context.Context, in *ReposGetStatsHistoryOp, opts ...grpc.CallOption) (*RepoStatsHistory, error) {
+	out := new(RepoStatsHistory)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/GetStatsHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reposClient) Enable(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes1.Void, error) {
 	out := new(pbtypes1.Void)
 	err := grpc.Invoke(ctx, "/sourcegraph.Repos/Enable", in, out, c.cc, opts...)
@@ -4134,118 +7283,343 @@ func (c *reposClient) GetConfig(ctx context.Context, in *RepoSpec, opts ...grpc.
 	return out, nil
 }
 
-func (c *reposClient) GetCommit(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*vcs.Commit, error) {
-	out := new(vcs.Commit)
-	err := grpc.Invoke(ctx, "/sourcegraph.Repos/GetCommit", in, out, c.cc, opts...)
+func (c *reposClient) ListCollaborators(ctx context.Context, in *ReposListCollaboratorsOp, opts ...grpc.CallOption) (*CollaboratorList, error) {
+	out := new(CollaboratorList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/ListCollaborators", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *reposClient) ListCommits(ctx context.Context, in *ReposListCommitsOp, opts ...grpc.CallOption) (*CommitList, error) {
-	out := new(CommitList)
-	err := grpc.Invoke(ctx, "/sourcegraph.Repos/ListCommits", in, out, c.cc, opts...)
+func (c *reposClient) AddCollaborator(ctx context.Context, in *ReposAddCollaboratorOp, opts ...grpc.CallOption) (*pbtypes1.Void, error) {
+	out := new(pbtypes1.Void)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/AddCollaborator", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *reposClient) ListBranches(ctx context.Context, in *ReposListBranchesOp, opts ...grpc.CallOption) (*BranchList, error) {
-	out := new(BranchList)
-	err := grpc.Invoke(ctx, "/sourcegraph.Repos/ListBranches", in, out, c.cc, opts...)
+func (c *reposClient) RemoveCollaborator(ctx context.Context, in *ReposRemoveCollaboratorOp, opts ...grpc.CallOption) (*pbtypes1.Void, error) {
+	out := new(pbtypes1.Void)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/RemoveCollaborator", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *reposClient) ListTags(ctx context.Context, in *ReposListTagsOp, opts ...grpc.CallOption) (*TagList, error) {
-	out := new(TagList)
-	err := grpc.Invoke(ctx, "/sourcegraph.Repos/ListTags", in, out, c.cc, opts...)
+func (c *reposClient) GetPermissions(ctx context.Context, in *ReposGetPermissionsOp, opts ...grpc.CallOption) (*RepoPermissions, error) {
+	out := new(RepoPermissions)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/GetPermissions", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *reposClient) ListCommitters(ctx context.Context, in *ReposListCommittersOp, opts ...grpc.CallOption) (*CommitterList, error) {
-	out := new(CommitterList)
-	err := grpc.Invoke(ctx, "/sourcegraph.Repos/ListCommitters", in, out, c.cc, opts...)
+func (c *reposClient) ListKeys(ctx context.Context, in *ReposListKeysOp, opts ...grpc.CallOption) (*DeployKeyList, error) {
+	out := new(DeployKeyList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/ListKeys", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Server API for Repos service
-
-type ReposServer interface {
-	// Get fetches a repository.
-	Get(context.Context, *RepoSpec) (*Repo, error)
-	// List repositories.
-	List(context.Context, *RepoListOptions) (*RepoList, error)
-	// Create creates a new repository.
-	Create(context.Context, *ReposCreateOp) (*Repo, error)
-	// Update updates a repository.
-	Update(context.Context, *ReposUpdateOp) (*Repo, error)
-	// Delete removes a repository.
-	Delete(context.Context, *RepoSpec) (*pbtypes1.Void, error)
-	// GetReadme fetches the formatted README file for a repository.
-	GetReadme(context.Context, *RepoRevSpec) (*Readme, error)
-	// Enable enables the specified repository.
-	Enable(context.Context, *RepoSpec) (*pbtypes1.Void, error)
-	// Disable disables the specified repository.
-	Disable(context.Context, *RepoSpec) (*pbtypes1.Void, error)
-	// GetConfig retrieves the configuration for a repository. To
-	// update the config, use Enable or Disable (direct updating is
-	// not currently supported).
-	GetConfig(context.Context, *RepoSpec) (*RepoConfig, error)
-	// TODO(sqs!nodb-ctx): move these to a "VCS" service (not Repos)
-	// TODO(slimsag): add google.api.http annotations to these once moved
-	GetCommit(context.Context, *RepoRevSpec) (*vcs.Commit, error)
-	// ListCommits returns the list of commits that span between the revisions
-	// specified in the given DeltaSpec. By default, it will return 1 page of
-	// commits with a maximum of DefaultPerPage entries. To retrieve all commits
-	// the PerPage value can be set to -1.
-	ListCommits(context.Context, *ReposListCommitsOp) (*CommitList, error)
-	ListBranches(context.Context, *ReposListBranchesOp) (*BranchList, error)
-	ListTags(context.Context, *ReposListTagsOp) (*TagList, error)
-	// ListCommitters returns the list of authors who have contributed
-	// to the main branch of the repo.
-	ListCommitters(context.Context, *ReposListCommittersOp) (*CommitterList, error)
-}
-
-func RegisterReposServer(s *grpc.Server, srv ReposServer) {
-	s.RegisterService(&_Repos_serviceDesc, srv)
-}
-
-func _Repos_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
-	in := new(RepoSpec)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	out, err := srv.(ReposServer).Get(ctx, in)
+func (c *reposClient) AddKey(ctx context.Context, in *ReposAddKeyOp, opts ...grpc.CallOption) (*DeployKey, error) {
+	out := new(DeployKey)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/AddKey", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func _Repos_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
-	in := new(RepoListOptions)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	out, err := srv.(ReposServer).List(ctx, in)
+func (c *reposClient) DeleteKey(ctx context.Context, in *ReposDeleteKeyOp, opts ...grpc.CallOption) (*pbtypes1.Void, error) {
+	out := new(pbtypes1.Void)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/DeleteKey", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func _Repos_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+func (c *reposClient) Watch(ctx context.Context, in *ReposWatchOp, opts ...grpc.CallOption) (*RepoSubscription, error) {
+	out := new(RepoSubscription)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/Watch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reposClient) Unwatch(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes1.Void, error) {
+	out := new(pbtypes1.Void)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/Unwatch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reposClient) GetSubscription(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*RepoSubscription, error) {
+	out := new(RepoSubscription)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/GetSubscription", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reposClient) ListWatched(ctx context.Context, in *ReposListWatchedOp, opts ...grpc.CallOption) (*RepoList, error) {
+	out := new(RepoList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/ListWatched", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reposClient) GetCommit(ctx context.Context, in *RepoRevSpec, opts ...grpc.CallOption) (*vcs.Commit, error) {
+	out := new(vcs.Commit)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/GetCommit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reposClient) GetCommitDetail(ctx context.Context, in *ReposGetCommitOp, opts ...grpc.CallOption) (*CommitDetail, error) {
+	out := new(CommitDetail)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/GetCommitDetail", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reposClient) GetCommitPatch(ctx context.Context, in *ReposGetCommitPatchOp, opts ...grpc.CallOption) (*CommitPatch, error) {
+	out := new(CommitPatch)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/GetCommitPatch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reposClient) GetArchive(ctx context.Context, in *ReposGetArchiveOp, opts ...grpc.CallOption) (*RepoArchive, error) {
+	out := new(RepoArchive)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/GetArchive", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reposClient) ListCommits(ctx context.Context, in *ReposListCommitsOp, opts ...grpc.CallOption) (*CommitList, error) {
+	out := new(CommitList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/ListCommits", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reposClient) ListBranches(ctx context.Context, in *ReposListBranchesOp, opts ...grpc.CallOption) (*BranchList, error) {
+	out := new(BranchList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/ListBranches", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reposClient) ListTags(ctx context.Context, in *ReposListTagsOp, opts ...grpc.CallOption) (*TagList, error) {
+	out := new(TagList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/ListTags", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reposClient) ListCommitters(ctx context.Context, in *ReposListCommittersOp, opts ...grpc.CallOption) (*CommitterList, error) {
+	out := new(CommitterList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/ListCommitters", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reposClient) ListContributors(ctx context.Context, in *ReposListContributorsOp, opts ...grpc.CallOption) (*ContributorList, error) {
+	out := new(ContributorList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/ListContributors", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reposClient) SearchCode(ctx context.Context, in *ReposSearchCodeOp, opts ...grpc.CallOption) (*CodeSearchResultList, error) {
+	out := new(CodeSearchResultList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/SearchCode", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reposClient) GetCodeOwners(ctx context.Context, in *ReposGetCodeOwnersOp, opts ...grpc.CallOption) (*CodeOwners, error) {
+	out := new(CodeOwners)
+	err := grpc.Invoke(ctx, "/sourcegraph.Repos/GetCodeOwners", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Repos service
+
+type ReposServer interface {
+	// Get fetches a repository.
+	Get(context.Context, *RepoSpec) (*Repo, error)
+	// List repositories.
+	List(context.Context, *RepoListOptions) (*RepoList, error)
+	// Create creates a new repository.
+	Create(context.Context, *ReposCreateOp) (*Repo, error)
+	// Update updates a repository.
+	Update(context.Context, *ReposUpdateOp) (*Repo, error)
+	// Delete removes a repository.
+	Delete(context.Context, *RepoSpec) (*pbtypes1.Void, error)
+	// GetReadme fetches the README file for a repository, in the
+	// requested representation (formatted HTML by default).
+	GetReadme(context.Context, *ReposGetReadmeOp) (*Readme, error)
+	// GetInventory returns a summary of the languages and build
+	// systems used in a repository at a commit.
+	GetInventory(context.Context, *RepoRevSpec) (*Inventory, error)
+	// GetStatsHistory returns a time series of a repository's stats
+	// (such as its number of commits, authors, defs, and refs) as of
+	// the given revision, so that clients can chart repository
+	// activity over time.
+	GetStatsHistory(context.Context, *ReposGetStatsHistoryOp) (*RepoStatsHistory, error)
+	// Enable enables the specified repository.
+	Enable(context.Context, *RepoSpec) (*pbtypes1.Void, error)
+	// Disable disables the specified repository.
+	Disable(context.Context, *RepoSpec) (*pbtypes1.Void, error)
+	// GetConfig retrieves the configuration for a repository. To
+	// update the config, use Enable or Disable (direct updating is
+	// not currently supported).
+	GetConfig(context.Context, *RepoSpec) (*RepoConfig, error)
+	// ListCollaborators lists the users who have been granted
+	// permissions on a repository.
+	ListCollaborators(context.Context, *ReposListCollaboratorsOp) (*CollaboratorList, error)
+	// AddCollaborator grants a user permissions on a repository. If
+	// the user is already a collaborator, their permissions are
+	// replaced. Only repository admins may call it.
+	AddCollaborator(context.Context, *ReposAddCollaboratorOp) (*pbtypes1.Void, error)
+	// RemoveCollaborator revokes all of a user's permissions on a
+	// repository. Only repository admins may call it.
+	RemoveCollaborator(context.Context, *ReposRemoveCollaboratorOp) (*pbtypes1.Void, error)
+	// GetPermissions returns the permissions that a user has on a
+	// repository, taking into account the repository's visibility
+	// and the user's site-wide permissions.
+	GetPermissions(context.Context, *ReposGetPermissionsOp) (*RepoPermissions, error)
+	// ListKeys lists a repository's SSH deploy keys.
+	ListKeys(context.Context, *ReposListKeysOp) (*DeployKeyList, error)
+	// AddKey adds an SSH deploy key to a repository. To rotate a key,
+	// add the new key and then delete the old one. Only repository
+	// admins may call it.
+	AddKey(context.Context, *ReposAddKeyOp) (*DeployKey, error)
+	// DeleteKey revokes and deletes an SSH deploy key from a
+	// repository. Only repository admins may call it.
+	DeleteKey(context.Context, *ReposDeleteKeyOp) (*pbtypes1.Void, error)
+	// Watch subscribes the current user to notifications about a
+	// repository. If the user is already watching the repository,
+	// their notification preferences are updated.
+	Watch(context.Context, *ReposWatchOp) (*RepoSubscription, error)
+	// Unwatch unsubscribes the current user from notifications about
+	// a repository.
+	Unwatch(context.Context, *RepoSpec) (*pbtypes1.Void, error)
+	// GetSubscription returns the current user's subscription to a
+	// repository. If the user is not watching the repository, the
+	// returned subscription's Watching field is false.
+	GetSubscription(context.Context, *RepoSpec) (*RepoSubscription, error)
+	// ListWatched lists the repositories that a user is watching.
+	ListWatched(context.Context, *ReposListWatchedOp) (*RepoList, error)
+	// TODO(sqs!nodb-ctx): move these to a "VCS" service (not Repos)
+	// TODO(slimsag): add google.api.http annotations to these once moved
+	GetCommit(context.Context, *RepoRevSpec) (*vcs.Commit, error)
+	// GetCommitDetail is like GetCommit, but it can also return the
+	// commit's changed files and file diffs (against its first parent)
+	// in a single call. See RepoGetCommitOptions.
+	GetCommitDetail(context.Context, *ReposGetCommitOp) (*CommitDetail, error)
+	// GetCommitPatch returns a chunk of the patch (unified diff) for a
+	// single commit against its first parent. Large patches are
+	// returned in chunks no larger than the server's size cap; use
+	// CommitPatchReader to read the whole patch as a stream.
+	GetCommitPatch(context.Context, *ReposGetCommitPatchOp) (*CommitPatch, error)
+	// GetArchive returns a chunk of an archive (e.g., a tar.gz or zip
+	// file) of the repository's tree at a commit. Use
+	// RepoArchiveReader to read the whole archive as a stream.
+	GetArchive(context.Context, *ReposGetArchiveOp) (*RepoArchive, error)
+	// ListCommits returns the list of commits that span between the revisions
+	// specified in the given DeltaSpec. By default, it will return 1 page of
+	// commits with a maximum of DefaultPerPage entries. To retrieve all commits
+	// the PerPage value can be set to -1.
+	ListCommits(context.Context, *ReposListCommitsOp) (*CommitList, error)
+	ListBranches(context.Context, *ReposListBranchesOp) (*BranchList, error)
+	ListTags(context.Context, *ReposListTagsOp) (*TagList, error)
+	// ListCommitters returns the list of authors who have contributed
+	// to the main branch of the repo.
+	ListCommitters(context.Context, *ReposListCommittersOp) (*CommitterList, error)
+	// ListContributors returns the list of people who have contributed
+	// to the main branch of the repo, with their commit counts. Unlike
+	// ListCommitters, it resolves each contributor to a Person (and to
+	// a registered user, if possible).
+	ListContributors(context.Context, *ReposListContributorsOp) (*ContributorList, error)
+	// SearchCode searches the contents of the files in a repository
+	// at a commit for a literal string or regexp (like grep),
+	// returning each matching line and the ranges of the matches in
+	// it.
+	SearchCode(context.Context, *ReposSearchCodeOp) (*CodeSearchResultList, error)
+	// GetCodeOwners evaluates the repository's code owners file
+	// (e.g., CODEOWNERS; see CodeOwnersFilePaths) at a commit and
+	// returns the owners of a path.
+	GetCodeOwners(context.Context, *ReposGetCodeOwnersOp) (*CodeOwners, error)
+}
+
+func RegisterReposServer(s *grpc.Server, srv ReposServer) {
+	s.RegisterService(&_Repos_serviceDesc, srv)
+}
+
+func _Repos_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RepoSpec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).Get(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Repos_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RepoListOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).List(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Repos_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
 	in := new(ReposCreateOp)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -4282,7 +7656,7 @@ func _Repos_Delete_Handler(srv interface{}, ctx context.Context, dec func(interf
 }
 
 func _Repos_GetReadme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
-	in := new(RepoRevSpec)
+	in := new(ReposGetReadmeOp)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -4293,6 +7667,30 @@ func _Repos_GetReadme_Handler(srv interface{}, ctx context.Context, dec func(int
 	return out, nil
 }
 
+func _Repos_GetInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RepoRevSpec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).GetInventory(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Repos_GetStatsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ReposGetStatsHistoryOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).GetStatsHistory(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func _Repos_Enable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
 	in := new(RepoSpec)
 	if err := dec(in); err != nil {
@@ -4329,6 +7727,138 @@ func _Repos_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(int
 	return out, nil
 }
 
+func _Repos_ListCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ReposListCollaboratorsOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).ListCollaborators(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Repos_AddCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ReposAddCollaboratorOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).AddCollaborator(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Repos_RemoveCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ReposRemoveCollaboratorOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).RemoveCollaborator(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Repos_GetPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ReposGetPermissionsOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).GetPermissions(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Repos_ListKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ReposListKeysOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).ListKeys(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Repos_AddKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ReposAddKeyOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).AddKey(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Repos_DeleteKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ReposDeleteKeyOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).DeleteKey(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Repos_Watch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ReposWatchOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).Watch(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Repos_Unwatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RepoSpec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).Unwatch(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Repos_GetSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RepoSpec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).GetSubscription(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Repos_ListWatched_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ReposListWatchedOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).ListWatched(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func _Repos_GetCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
 	in := new(RepoRevSpec)
 	if err := dec(in); err != nil {
@@ -4341,6 +7871,42 @@ func _Repos_GetCommit_Handler(srv interface{}, ctx context.Context, dec func(int
 	return out, nil
 }
 
+func _Repos_GetCommitDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ReposGetCommitOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).GetCommitDetail(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Repos_GetCommitPatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ReposGetCommitPatchOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).GetCommitPatch(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Repos_GetArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ReposGetArchiveOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).GetArchive(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func _Repos_ListCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
 	in := new(ReposListCommitsOp)
 	if err := dec(in); err != nil {
@@ -4389,6 +7955,42 @@ func _Repos_ListCommitters_Handler(srv interface{}, ctx context.Context, dec fun
 	return out, nil
 }
 
+func _Repos_ListContributors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ReposListContributorsOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).ListContributors(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Repos_SearchCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ReposSearchCodeOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).SearchCode(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Repos_GetCodeOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ReposGetCodeOwnersOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(ReposServer).GetCodeOwners(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 var _Repos_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sourcegraph.Repos",
 	HandlerType: (*ReposServer)(nil),
@@ -4402,36 +8004,100 @@ var _Repos_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Repos_List_Handler,
 		},
 		{
-			MethodName: "Create",
-			Handler:    _Repos_Create_Handler,
+			MethodName: "Create",
+			Handler:    _Repos_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Repos_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Repos_Delete_Handler,
+		},
+		{
+			MethodName: "GetReadme",
+			Handler:    _Repos_GetReadme_Handler,
+		},
+		{
+			MethodName: "GetInventory",
+			Handler:    _Repos_GetInventory_Handler,
+		},
+		{
+			MethodName: "GetStatsHistory",
+			Handler:    _Repos_GetStatsHistory_Handler,
+		},
+		{
+			MethodName: "Enable",
+			Handler:    _Repos_Enable_Handler,
+		},
+		{
+			MethodName: "Disable",
+			Handler:    _Repos_Disable_Handler,
+		},
+		{
+			MethodName: "GetConfig",
+			Handler:    _Repos_GetConfig_Handler,
+		},
+		{
+			MethodName: "ListCollaborators",
+			Handler:    _Repos_ListCollaborators_Handler,
+		},
+		{
+			MethodName: "AddCollaborator",
+			Handler:    _Repos_AddCollaborator_Handler,
+		},
+		{
+			MethodName: "RemoveCollaborator",
+			Handler:    _Repos_RemoveCollaborator_Handler,
+		},
+		{
+			MethodName: "GetPermissions",
+			Handler:    _Repos_GetPermissions_Handler,
+		},
+		{
+			MethodName: "ListKeys",
+			Handler:    _Repos_ListKeys_Handler,
+		},
+		{
+			MethodName: "AddKey",
+			Handler:    _Repos_AddKey_Handler,
+		},
+		{
+			MethodName: "DeleteKey",
+			Handler:    _Repos_DeleteKey_Handler,
+		},
+		{
+			MethodName: "Watch",
+			Handler:    _Repos_Watch_Handler,
 		},
 		{
-			MethodName: "Update",
-			Handler:    _Repos_Update_Handler,
+			MethodName: "Unwatch",
+			Handler:    _Repos_Unwatch_Handler,
 		},
 		{
-			MethodName: "Delete",
-			Handler:    _Repos_Delete_Handler,
+			MethodName: "GetSubscription",
+			Handler:    _Repos_GetSubscription_Handler,
 		},
 		{
-			MethodName: "GetReadme",
-			Handler:    _Repos_GetReadme_Handler,
+			MethodName: "ListWatched",
+			Handler:    _Repos_ListWatched_Handler,
 		},
 		{
-			MethodName: "Enable",
-			Handler:    _Repos_Enable_Handler,
+			MethodName: "GetCommit",
+			Handler:    _Repos_GetCommit_Handler,
 		},
 		{
-			MethodName: "Disable",
-			Handler:    _Repos_Disable_Handler,
+			MethodName: "GetCommitDetail",
+			Handler:    _Repos_GetCommitDetail_Handler,
 		},
 		{
-			MethodName: "GetConfig",
-			Handler:    _Repos_GetConfig_Handler,
+			MethodName: "GetCommitPatch",
+			Handler:    _Repos_GetCommitPatch_Handler,
 		},
 		{
-			MethodName: "GetCommit",
-			Handler:    _Repos_GetCommit_Handler,
+			MethodName: "GetArchive",
+			Handler:    _Repos_GetArchive_Handler,
 		},
 		{
 			MethodName: "ListCommits",
@@ -4449,6 +8115,18 @@ var _Repos_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListCommitters",
 			Handler:    _Repos_ListCommitters_Handler,
 		},
+		{
+			MethodName: "ListContributors",
+			Handler:    _Repos_ListContributors_Handler,
+		},
+		{
+			MethodName: "SearchCode",
+			Handler:    _Repos_SearchCode_Handler,
+		},
+		{
+			MethodName: "GetCodeOwners",
+			Handler:    _Repos_GetCodeOwners_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{},
 }
@@ -5178,11 +8856,169 @@ var _Discussions_serviceDesc = grpc.ServiceDesc{
 	Streams: []grpc.StreamDesc{},
 }
 
+// Client API for Issues service
+
+type IssuesClient interface {
+	// Get fetches an issue.
+	Get(ctx context.Context, in *IssueSpec, opts ...grpc.CallOption) (*Issue, error)
+	// List lists a repository's issues, most recently created first.
+	List(ctx context.Context, in *IssuesListOp, opts ...grpc.CallOption) (*IssueList, error)
+	// Create creates an issue and returns it, populating its fields,
+	// such as ID and CreatedAt.
+	Create(ctx context.Context, in *IssuesCreateOp, opts ...grpc.CallOption) (*Issue, error)
+	// CreateComment adds a comment to an issue and returns it,
+	// populating its fields, such as ID and CreatedAt.
+	CreateComment(ctx context.Context, in *IssuesCreateCommentOp, opts ...grpc.CallOption) (*IssueComment, error)
+}
+
+type issuesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewIssuesClient(cc *grpc.ClientConn) IssuesClient {
+	return &issuesClient{cc}
+}
+
+func (c *issuesClient) Get(ctx context.Context, in *IssueSpec, opts ...grpc.CallOption) (*Issue, error) {
+	out := new(Issue)
+	err := grpc.Invoke(ctx, "/sourcegraph.Issues/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issuesClient) List(ctx context.Context, in *IssuesListOp, opts ...grpc.CallOption) (*IssueList, error) {
+	out := new(IssueList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Issues/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issuesClient) Create(ctx context.Context, in *IssuesCreateOp, opts ...grpc.CallOption) (*Issue, error) {
+	out := new(Issue)
+	err := grpc.Invoke(ctx, "/sourcegraph.Issues/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issuesClient) CreateComment(ctx context.Context, in *IssuesCreateCommentOp, opts ...grpc.CallOption) (*IssueComment, error) {
+	out := new(IssueComment)
+	err := grpc.Invoke(ctx, "/sourcegraph.Issues/CreateComment", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Issues service
+
+type IssuesServer interface {
+	// Get fetches an issue.
+	Get(context.Context, *IssueSpec) (*Issue, error)
+	// List lists a repository's issues, most recently created first.
+	List(context.Context, *IssuesListOp) (*IssueList, error)
+	// Create creates an issue and returns it, populating its fields,
+	// such as ID and CreatedAt.
+	Create(context.Context, *IssuesCreateOp) (*Issue, error)
+	// CreateComment adds a comment to an issue and returns it,
+	// populating its fields, such as ID and CreatedAt.
+	CreateComment(context.Context, *IssuesCreateCommentOp) (*IssueComment, error)
+}
+
+func RegisterIssuesServer(s *grpc.Server, srv IssuesServer) {
+	s.RegisterService(&_Issues_serviceDesc, srv)
+}
+
+func _Issues_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(IssueSpec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(IssuesServer).Get(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Issues_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(IssuesListOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(IssuesServer).List(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Issues_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(IssuesCreateOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(IssuesServer).Create(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Issues_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(IssuesCreateCommentOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(IssuesServer).CreateComment(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+var _Issues_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sourcegraph.Issues",
+	HandlerType: (*IssuesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Issues_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Issues_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Issues_Create_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _Issues_CreateComment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
 // Client API for MirrorRepos service
 
 type MirrorReposClient interface {
 	// Refresh fetches the newest VCS data from the repo's origin.
 	RefreshVCS(ctx context.Context, in *MirrorReposRefreshVCSOp, opts ...grpc.CallOption) (*pbtypes1.Void, error)
+	// Enable makes the repository a mirror of the upstream repository
+	// at the given clone URL, which is then periodically synced.
+	Enable(ctx context.Context, in *MirrorReposEnableOp, opts ...grpc.CallOption) (*pbtypes1.Void, error)
+	// Disable stops mirroring the repository. Its existing VCS data
+	// is kept.
+	Disable(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes1.Void, error)
+	// GetStatus returns the sync status of the mirrored repository.
+	GetStatus(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*MirrorStatus, error)
 }
 
 type mirrorReposClient struct {
@@ -5202,11 +9038,46 @@ func (c *mirrorReposClient) RefreshVCS(ctx context.Context, in *MirrorReposRefre
 	return out, nil
 }
 
+func (c *mirrorReposClient) Enable(ctx context.Context, in *MirrorReposEnableOp, opts ...grpc.CallOption) (*pbtypes1.Void, error) {
+	out := new(pbtypes1.Void)
+	err := grpc.Invoke(ctx, "/sourcegraph.MirrorRepos/Enable", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mirrorReposClient) Disable(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*pbtypes1.Void, error) {
+	out := new(pbtypes1.Void)
+	err := grpc.Invoke(ctx, "/sourcegraph.MirrorRepos/Disable", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mirrorReposClient) GetStatus(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*MirrorStatus, error) {
+	out := new(MirrorStatus)
+	err := grpc.Invoke(ctx, "/sourcegraph.MirrorRepos/GetStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for MirrorRepos service
 
 type MirrorReposServer interface {
 	// Refresh fetches the newest VCS data from the repo's origin.
 	RefreshVCS(context.Context, *MirrorReposRefreshVCSOp) (*pbtypes1.Void, error)
+	// Enable makes the repository a mirror of the upstream repository
+	// at the given clone URL, which is then periodically synced.
+	Enable(context.Context, *MirrorReposEnableOp) (*pbtypes1.Void, error)
+	// Disable stops mirroring the repository. Its existing VCS data
+	// is kept.
+	Disable(context.Context, *RepoSpec) (*pbtypes1.Void, error)
+	// GetStatus returns the sync status of the mirrored repository.
+	GetStatus(context.Context, *RepoSpec) (*MirrorStatus, error)
 }
 
 func RegisterMirrorReposServer(s *grpc.Server, srv MirrorReposServer) {
@@ -5225,6 +9096,42 @@ func _MirrorRepos_RefreshVCS_Handler(srv interface{}, ctx context.Context, dec f
 	return out, nil
 }
 
+func _MirrorRepos_Enable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(MirrorReposEnableOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(MirrorReposServer).Enable(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _MirrorRepos_Disable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RepoSpec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(MirrorReposServer).Disable(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _MirrorRepos_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RepoSpec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(MirrorReposServer).GetStatus(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 var _MirrorRepos_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sourcegraph.MirrorRepos",
 	HandlerType: (*MirrorReposServer)(nil),
@@ -5233,6 +9140,18 @@ var _MirrorRepos_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshVCS",
 			Handler:    _MirrorRepos_RefreshVCS_Handler,
 		},
+		{
+			MethodName: "Enable",
+			Handler:    _MirrorRepos_Enable_Handler,
+		},
+		{
+			MethodName: "Disable",
+			Handler:    _MirrorRepos_Disable_Handler,
+		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _MirrorRepos_GetStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{},
 }
@@ -5358,8 +9277,17 @@ type BuildsClient interface {
 	// up-to-date with the revspec or a few commits behind the revspec. The opt param
 	// controls what is returned in this case.
 	GetRepoBuildInfo(ctx context.Context, in *BuildsGetRepoBuildInfoOp, opts ...grpc.CallOption) (*RepoBuildInfo, error)
+	// GetMultipleRepoBuildInfo is like GetRepoBuildInfo, but it gets
+	// the build info for multiple repo revspecs in a single call. At
+	// most MaxGetMultipleRepoBuildInfo revspecs may be requested at
+	// once. The returned infos are in the same order as the requested
+	// revspecs; a revspec with no build has an empty RepoBuildInfo.
+	GetMultipleRepoBuildInfo(ctx context.Context, in *BuildsGetMultipleRepoBuildInfoOp, opts ...grpc.CallOption) (*RepoBuildInfoList, error)
 	// List builds.
 	List(ctx context.Context, in *BuildListOptions, opts ...grpc.CallOption) (*BuildList, error)
+	// ListByRepo lists a repository's builds. The opt.Repo field is
+	// ignored in favor of the repository specified in the op.
+	ListByRepo(ctx context.Context, in *BuildsListByRepoOp, opts ...grpc.CallOption) (*BuildList, error)
 	// Create a new build. The build will run asynchronously (Create does not wait for
 	// it to return. To monitor the build's status, use Get.)
 	Create(ctx context.Context, in *BuildsCreateOp, opts ...grpc.CallOption) (*Build, error)
@@ -5381,6 +9309,18 @@ type BuildsClient interface {
 	// having started (atomically). If there are no builds in the
 	// queue, a NotFound error is returned.
 	DequeueNext(ctx context.Context, in *BuildsDequeueNextOp, opts ...grpc.CallOption) (*Build, error)
+	// Heartbeat records that the worker running a build is still
+	// alive, by setting the build's HeartbeatAt to the current
+	// time. Workers should call Heartbeat periodically while a build
+	// is running; builds without a recent heartbeat are killed.
+	Heartbeat(ctx context.Context, in *BuildSpec, opts ...grpc.CallOption) (*pbtypes1.Void, error)
+	// Cancel stops a queued or in-progress build. The build is
+	// dequeued (if it hasn't started) and marked as killed and failed.
+	Cancel(ctx context.Context, in *BuildsCancelOp, opts ...grpc.CallOption) (*Build, error)
+	// Restart re-enqueues a build as a new attempt with the same
+	// commit ID and BuildConfig, and returns the new build. Unless
+	// opt.Force is set, the build must have ended.
+	Restart(ctx context.Context, in *BuildsRestartOp, opts ...grpc.CallOption) (*Build, error)
 }
 
 type buildsClient struct {
@@ -5409,6 +9349,15 @@ func (c *buildsClient) GetRepoBuildInfo(ctx context.Context, in *BuildsGetRepoBu
 	return out, nil
 }
 
+func (c *buildsClient) GetMultipleRepoBuildInfo(ctx context.Context, in *BuildsGetMultipleRepoBuildInfoOp, opts ...grpc.CallOption) (*RepoBuildInfoList, error) {
+	out := new(RepoBuildInfoList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Builds/GetMultipleRepoBuildInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *buildsClient) List(ctx context.Context, in *BuildListOptions, opts ...grpc.CallOption) (*BuildList, error) {
 	out := new(BuildList)
 	err := grpc.Invoke(ctx, "/sourcegraph.Builds/List", in, out, c.cc, opts...)
@@ -5418,6 +9367,15 @@ func (c *buildsClient) List(ctx context.Context, in *BuildListOptions, opts ...g
 	return out, nil
 }
 
+func (c *buildsClient) ListByRepo(ctx context.Context, in *BuildsListByRepoOp, opts ...grpc.CallOption) (*BuildList, error) {
+	out := new(BuildList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Builds/ListByRepo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *buildsClient) Create(ctx context.Context, in *BuildsCreateOp, opts ...grpc.CallOption) (*Build, error) {
 	out := new(Build)
 	err := grpc.Invoke(ctx, "/sourcegraph.Builds/Create", in, out, c.cc, opts...)
@@ -5490,6 +9448,33 @@ func (c *buildsClient) DequeueNext(ctx context.Context, in *BuildsDequeueNextOp,
 	return out, nil
 }
 
+func (c *buildsClient) Heartbeat(ctx context.Context, in *BuildSpec, opts ...grpc.CallOption) (*pbtypes1.Void, error) {
+	out := new(pbtypes1.Void)
+	err := grpc.Invoke(ctx, "/sourcegraph.Builds/Heartbeat", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *buildsClient) Cancel(ctx context.Context, in *BuildsCancelOp, opts ...grpc.CallOption) (*Build, error) {
+	out := new(Build)
+	err := grpc.Invoke(ctx, "/sourcegraph.Builds/Cancel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *buildsClient) Restart(ctx context.Context, in *BuildsRestartOp, opts ...grpc.CallOption) (*Build, error) {
+	out := new(Build)
+	err := grpc.Invoke(ctx, "/sourcegraph.Builds/Restart", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Builds service
 
 type BuildsServer interface {
@@ -5500,8 +9485,17 @@ type BuildsServer interface {
 	// up-to-date with the revspec or a few commits behind the revspec. The opt param
 	// controls what is returned in this case.
 	GetRepoBuildInfo(context.Context, *BuildsGetRepoBuildInfoOp) (*RepoBuildInfo, error)
+	// GetMultipleRepoBuildInfo is like GetRepoBuildInfo, but it gets
+	// the build info for multiple repo revspecs in a single call. At
+	// most MaxGetMultipleRepoBuildInfo revspecs may be requested at
+	// once. The returned infos are in the same order as the requested
+	// revspecs; a revspec with no build has an empty RepoBuildInfo.
+	GetMultipleRepoBuildInfo(context.Context, *BuildsGetMultipleRepoBuildInfoOp) (*RepoBuildInfoList, error)
 	// List builds.
 	List(context.Context, *BuildListOptions) (*BuildList, error)
+	// ListByRepo lists a repository's builds. The opt.Repo field is
+	// ignored in favor of the repository specified in the op.
+	ListByRepo(context.Context, *BuildsListByRepoOp) (*BuildList, error)
 	// Create a new build. The build will run asynchronously (Create does not wait for
 	// it to return. To monitor the build's status, use Get.)
 	Create(context.Context, *BuildsCreateOp) (*Build, error)
@@ -5523,6 +9517,18 @@ type BuildsServer interface {
 	// having started (atomically). If there are no builds in the
 	// queue, a NotFound error is returned.
 	DequeueNext(context.Context, *BuildsDequeueNextOp) (*Build, error)
+	// Heartbeat records that the worker running a build is still
+	// alive, by setting the build's HeartbeatAt to the current
+	// time. Workers should call Heartbeat periodically while a build
+	// is running; builds without a recent heartbeat are killed.
+	Heartbeat(context.Context, *BuildSpec) (*pbtypes1.Void, error)
+	// Cancel stops a queued or in-progress build. The build is
+	// dequeued (if it hasn't started) and marked as killed and failed.
+	Cancel(context.Context, *BuildsCancelOp) (*Build, error)
+	// Restart re-enqueues a build as a new attempt with the same
+	// commit ID and BuildConfig, and returns the new build. Unless
+	// opt.Force is set, the build must have ended.
+	Restart(context.Context, *BuildsRestartOp) (*Build, error)
 }
 
 func RegisterBuildsServer(s *grpc.Server, srv BuildsServer) {
@@ -5553,6 +9559,18 @@ func _Builds_GetRepoBuildInfo_Handler(srv interface{}, ctx context.Context, dec
 	return out, nil
 }
 
+func _Builds_GetMultipleRepoBuildInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(BuildsGetMultipleRepoBuildInfoOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(BuildsServer).GetMultipleRepoBuildInfo(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func _Builds_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
 	in := new(BuildListOptions)
 	if err := dec(in); err != nil {
@@ -5565,6 +9583,18 @@ func _Builds_List_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return out, nil
 }
 
+func _Builds_ListByRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(BuildsListByRepoOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(BuildsServer).ListByRepo(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func _Builds_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
 	in := new(BuildsCreateOp)
 	if err := dec(in); err != nil {
@@ -5654,7 +9684,43 @@ func _Builds_DequeueNext_Handler(srv interface{}, ctx context.Context, dec func(
 	if err := dec(in); err != nil {
 		return nil, err
 	}
-	out, err := srv.(BuildsServer).DequeueNext(ctx, in)
+	out, err := srv.(BuildsServer).DequeueNext(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Builds_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(BuildSpec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(BuildsServer).Heartbeat(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Builds_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(BuildsCancelOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(BuildsServer).Cancel(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Builds_Restart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(BuildsRestartOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(BuildsServer).Restart(ctx, in)
 	if err != nil {
 		return nil, err
 	}
@@ -5673,10 +9739,18 @@ var _Builds_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetRepoBuildInfo",
 			Handler:    _Builds_GetRepoBuildInfo_Handler,
 		},
+		{
+			MethodName: "GetMultipleRepoBuildInfo",
+			Handler:    _Builds_GetMultipleRepoBuildInfo_Handler,
+		},
 		{
 			MethodName: "List",
 			Handler:    _Builds_List_Handler,
 		},
+		{
+			MethodName: "ListByRepo",
+			Handler:    _Builds_ListByRepo_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _Builds_Create_Handler,
@@ -5709,6 +9783,18 @@ var _Builds_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DequeueNext",
 			Handler:    _Builds_DequeueNext_Handler,
 		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _Builds_Heartbeat_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _Builds_Cancel_Handler,
+		},
+		{
+			MethodName: "Restart",
+			Handler:    _Builds_Restart_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{},
 }
@@ -5722,6 +9808,16 @@ type OrgsClient interface {
 	List(ctx context.Context, in *OrgsListOp, opts ...grpc.CallOption) (*OrgList, error)
 	// ListMembers lists members of an organization.
 	ListMembers(ctx context.Context, in *OrgsListMembersOp, opts ...grpc.CallOption) (*UserList, error)
+	// ListTeams lists the teams in an organization.
+	ListTeams(ctx context.Context, in *OrgsListTeamsOp, opts ...grpc.CallOption) (*TeamList, error)
+	// GetTeam fetches a team.
+	GetTeam(ctx context.Context, in *TeamSpec, opts ...grpc.CallOption) (*Team, error)
+	// ListTeamMembers lists the members of a team.
+	ListTeamMembers(ctx context.Context, in *OrgsListTeamMembersOp, opts ...grpc.CallOption) (*UserList, error)
+	// AddTeamRepo grants a team's members permissions on a repository
+	// (replacing the team's existing permissions on it, if any). Only
+	// organization admins may call it.
+	AddTeamRepo(ctx context.Context, in *OrgsAddTeamRepoOp, opts ...grpc.CallOption) (*pbtypes1.Void, error)
 }
 
 type orgsClient struct {
@@ -5759,6 +9855,42 @@ func (c *orgsClient) ListMembers(ctx context.Context, in *OrgsListMembersOp, opt
 	return out, nil
 }
 
+func (c *orgsClient) ListTeams(ctx context.Context, in *OrgsListTeamsOp, opts ...grpc.CallOption) (*TeamList, error) {
+	out := new(TeamList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Orgs/ListTeams", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgsClient) GetTeam(ctx context.Context, in *TeamSpec, opts ...grpc.CallOption) (*Team, error) {
+	out := new(Team)
+	err := grpc.Invoke(ctx, "/sourcegraph.Orgs/GetTeam", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgsClient) ListTeamMembers(ctx context.Context, in *OrgsListTeamMembersOp, opts ...grpc.CallOption) (*UserList, error) {
+	out := new(UserList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Orgs/ListTeamMembers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgsClient) AddTeamRepo(ctx context.Context, in *OrgsAddTeamRepoOp, opts ...grpc.CallOption) (*pbtypes1.Void, error) {
+	out := new(pbtypes1.Void)
+	err := grpc.Invoke(ctx, "/sourcegraph.Orgs/AddTeamRepo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Orgs service
 
 type OrgsServer interface {
@@ -5768,6 +9900,16 @@ type OrgsServer interface {
 	List(context.Context, *OrgsListOp) (*OrgList, error)
 	// ListMembers lists members of an organization.
 	ListMembers(context.Context, *OrgsListMembersOp) (*UserList, error)
+	// ListTeams lists the teams in an organization.
+	ListTeams(context.Context, *OrgsListTeamsOp) (*TeamList, error)
+	// GetTeam fetches a team.
+	GetTeam(context.Context, *TeamSpec) (*Team, error)
+	// ListTeamMembers lists the members of a team.
+	ListTeamMembers(context.Context, *OrgsListTeamMembersOp) (*UserList, error)
+	// AddTeamRepo grants a team's members permissions on a repository
+	// (replacing the team's existing permissions on it, if any). Only
+	// organization admins may call it.
+	AddTeamRepo(context.Context, *OrgsAddTeamRepoOp) (*pbtypes1.Void, error)
 }
 
 func RegisterOrgsServer(s *grpc.Server, srv OrgsServer) {
@@ -5810,6 +9952,54 @@ func _Orgs_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(in
 	return out, nil
 }
 
+func _Orgs_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(OrgsListTeamsOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(OrgsServer).ListTeams(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Orgs_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(TeamSpec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(OrgsServer).GetTeam(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Orgs_ListTeamMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(OrgsListTeamMembersOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(OrgsServer).ListTeamMembers(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Orgs_AddTeamRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(OrgsAddTeamRepoOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(OrgsServer).AddTeamRepo(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 var _Orgs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sourcegraph.Orgs",
 	HandlerType: (*OrgsServer)(nil),
@@ -5826,6 +10016,22 @@ var _Orgs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListMembers",
 			Handler:    _Orgs_ListMembers_Handler,
 		},
+		{
+			MethodName: "ListTeams",
+			Handler:    _Orgs_ListTeams_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _Orgs_GetTeam_Handler,
+		},
+		{
+			MethodName: "ListTeamMembers",
+			Handler:    _Orgs_ListTeamMembers_Handler,
+		},
+		{
+			MethodName: "AddTeamRepo",
+			Handler:    _Orgs_AddTeamRepo_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{},
 }
@@ -6048,10 +10254,31 @@ type UsersClient interface {
 	Get(ctx context.Context, in *UserSpec, opts ...grpc.CallOption) (*User, error)
 	// GetWithEmail fetches a user by their primary email.
 	GetWithEmail(ctx context.Context, in *EmailAddr, opts ...grpc.CallOption) (*User, error)
+	// GetByExternal fetches the user who has linked an account on an
+	// external code host (e.g., a GitHub account) to their Sourcegraph
+	// account.
+	GetByExternal(ctx context.Context, in *ExternalAccountSpec, opts ...grpc.CallOption) (*User, error)
+	// ListAuthoredDefs lists the defs that a user authored (i.e., the
+	// defs whose definitions consist mostly of code committed by one
+	// of the user's email addresses), most recently committed first.
+	ListAuthoredDefs(ctx context.Context, in *UsersListAuthoredDefsOp, opts ...grpc.CallOption) (*AuthoredDefList, error)
+	// ListContributions lists the repositories that a user has
+	// committed to, with counts of their commits and authored defs in
+	// each, sorted by descending commit count.
+	ListContributions(ctx context.Context, in *UsersListContributionsOp, opts ...grpc.CallOption) (*UserContributionList, error)
 	// ListEmails returns a list of a user's email addresses.
 	ListEmails(ctx context.Context, in *UserSpec, opts ...grpc.CallOption) (*EmailAddrList, error)
 	// List users.
 	List(ctx context.Context, in *UsersListOptions, opts ...grpc.CallOption) (*UserList, error)
+	// ListKeys lists a user's SSH and GPG public keys.
+	ListKeys(ctx context.Context, in *UsersListKeysOp, opts ...grpc.CallOption) (*UserKeyList, error)
+	// AddKey adds an SSH or GPG public key to a user's account and
+	// returns it with its ID and Fingerprint fields set. Only the user
+	// and site admins may call it.
+	AddKey(ctx context.Context, in *UsersAddKeyOp, opts ...grpc.CallOption) (*UserKey, error)
+	// DeleteKey deletes a public key from a user's account. Only the
+	// user and site admins may call it.
+	DeleteKey(ctx context.Context, in *UsersDeleteKeyOp, opts ...grpc.CallOption) (*pbtypes1.Void, error)
 }
 
 type usersClient struct {
@@ -6080,6 +10307,33 @@ func (c *usersClient) GetWithEmail(ctx context.Context, in *EmailAddr, opts ...g
 	return out, nil
 }
 
+func (c *usersClient) GetByExternal(ctx context.Context, in *ExternalAccountSpec, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := grpc.Invoke(ctx, "/sourcegraph.Users/GetByExternal", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ListAuthoredDefs(ctx context.Context, in *UsersListAuthoredDefsOp, opts ...grpc.CallOption) (*AuthoredDefList, error) {
+	out := new(AuthoredDefList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Users/ListAuthoredDefs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ListContributions(ctx context.Context, in *UsersListContributionsOp, opts ...grpc.CallOption) (*UserContributionList, error) {
+	out := new(UserContributionList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Users/ListContributions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usersClient) ListEmails(ctx context.Context, in *UserSpec, opts ...grpc.CallOption) (*EmailAddrList, error) {
 	out := new(EmailAddrList)
 	err := grpc.Invoke(ctx, "/sourcegraph.Users/ListEmails", in, out, c.cc, opts...)
@@ -6098,6 +10352,33 @@ func (c *usersClient) List(ctx context.Context, in *UsersListOptions, opts ...gr
 	return out, nil
 }
 
+func (c *usersClient) ListKeys(ctx context.Context, in *UsersListKeysOp, opts ...grpc.CallOption) (*UserKeyList, error) {
+	out := new(UserKeyList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Users/ListKeys", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) AddKey(ctx context.Context, in *UsersAddKeyOp, opts ...grpc.CallOption) (*UserKey, error) {
+	out := new(UserKey)
+	err := grpc.Invoke(ctx, "/sourcegraph.Users/AddKey", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) DeleteKey(ctx context.Context, in *UsersDeleteKeyOp, opts ...grpc.CallOption) (*pbtypes1.Void, error) {
+	out := new(pbtypes1.Void)
+	err := grpc.Invoke(ctx, "/sourcegraph.Users/DeleteKey", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Users service
 
 type UsersServer interface {
@@ -6105,10 +10386,31 @@ type UsersServer interface {
 	Get(context.Context, *UserSpec) (*User, error)
 	// GetWithEmail fetches a user by their primary email.
 	GetWithEmail(context.Context, *EmailAddr) (*User, error)
+	// GetByExternal fetches the user who has linked an account on an
+	// external code host (e.g., a GitHub account) to their Sourcegraph
+	// account.
+	GetByExternal(context.Context, *ExternalAccountSpec) (*User, error)
+	// ListAuthoredDefs lists the defs that a user authored (i.e., the
+	// defs whose definitions consist mostly of code committed by one
+	// of the user's email addresses), most recently committed first.
+	ListAuthoredDefs(context.Context, *UsersListAuthoredDefsOp) (*AuthoredDefList, error)
+	// ListContributions lists the repositories that a user has
+	// committed to, with counts of their commits and authored defs in
+	// each, sorted by descending commit count.
+	ListContributions(context.Context, *UsersListContributionsOp) (*UserContributionList, error)
 	// ListEmails returns a list of a user's email addresses.
 	ListEmails(context.Context, *UserSpec) (*EmailAddrList, error)
 	// List users.
 	List(context.Context, *UsersListOptions) (*UserList, error)
+	// ListKeys lists a user's SSH and GPG public keys.
+	ListKeys(context.Context, *UsersListKeysOp) (*UserKeyList, error)
+	// AddKey adds an SSH or GPG public key to a user's account and
+	// returns it with its ID and Fingerprint fields set. Only the user
+	// and site admins may call it.
+	AddKey(context.Context, *UsersAddKeyOp) (*UserKey, error)
+	// DeleteKey deletes a public key from a user's account. Only the
+	// user and site admins may call it.
+	DeleteKey(context.Context, *UsersDeleteKeyOp) (*pbtypes1.Void, error)
 }
 
 func RegisterUsersServer(s *grpc.Server, srv UsersServer) {
@@ -6139,6 +10441,42 @@ func _Users_GetWithEmail_Handler(srv interface{}, ctx context.Context, dec func(
 	return out, nil
 }
 
+func _Users_GetByExternal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ExternalAccountSpec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(UsersServer).GetByExternal(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Users_ListAuthoredDefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(UsersListAuthoredDefsOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(UsersServer).ListAuthoredDefs(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Users_ListContributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(UsersListContributionsOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(UsersServer).ListContributions(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func _Users_ListEmails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
 	in := new(UserSpec)
 	if err := dec(in); err != nil {
@@ -6163,6 +10501,42 @@ func _Users_List_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return out, nil
 }
 
+func _Users_ListKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(UsersListKeysOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(UsersServer).ListKeys(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Users_AddKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(UsersAddKeyOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(UsersServer).AddKey(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Users_DeleteKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(UsersDeleteKeyOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(UsersServer).DeleteKey(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 var _Users_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sourcegraph.Users",
 	HandlerType: (*UsersServer)(nil),
@@ -6175,6 +10549,18 @@ var _Users_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetWithEmail",
 			Handler:    _Users_GetWithEmail_Handler,
 		},
+		{
+			MethodName: "GetByExternal",
+			Handler:    _Users_GetByExternal_Handler,
+		},
+		{
+			MethodName: "ListAuthoredDefs",
+			Handler:    _Users_ListAuthoredDefs_Handler,
+		},
+		{
+			MethodName: "ListContributions",
+			Handler:    _Users_ListContributions_Handler,
+		},
 		{
 			MethodName: "ListEmails",
 			Handler:    _Users_ListEmails_Handler,
@@ -6183,6 +10569,18 @@ var _Users_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _Users_List_Handler,
 		},
+		{
+			MethodName: "ListKeys",
+			Handler:    _Users_ListKeys_Handler,
+		},
+		{
+			MethodName: "AddKey",
+			Handler:    _Users_AddKey_Handler,
+		},
+		{
+			MethodName: "DeleteKey",
+			Handler:    _Users_DeleteKey_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{},
 }
@@ -6332,6 +10730,16 @@ type AuthClient interface {
 	// GetPermissions returns the currently authenticated user's
 	// authorization levels on the client.
 	GetPermissions(ctx context.Context, in *pbtypes1.Void, opts ...grpc.CallOption) (*UserPermissions, error)
+	// CreateToken creates a personal access token for the currently
+	// authenticated user. The returned token's Token field holds the
+	// secret token value; it is never returned again.
+	CreateToken(ctx context.Context, in *AuthCreateTokenOp, opts ...grpc.CallOption) (*PersonalAccessToken, error)
+	// ListTokens lists the currently authenticated user's personal
+	// access tokens. The returned tokens' Token fields are not set.
+	ListTokens(ctx context.Context, in *AuthListTokensOp, opts ...grpc.CallOption) (*PersonalAccessTokenList, error)
+	// RevokeToken revokes and deletes one of the currently
+	// authenticated user's personal access tokens.
+	RevokeToken(ctx context.Context, in *AuthRevokeTokenOp, opts ...grpc.CallOption) (*pbtypes1.Void, error)
 }
 
 type authClient struct {
@@ -6378,6 +10786,33 @@ func (c *authClient) GetPermissions(ctx context.Context, in *pbtypes1.Void, opts
 	return out, nil
 }
 
+func (c *authClient) CreateToken(ctx context.Context, in *AuthCreateTokenOp, opts ...grpc.CallOption) (*PersonalAccessToken, error) {
+	out := new(PersonalAccessToken)
+	err := grpc.Invoke(ctx, "/sourcegraph.Auth/CreateToken", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) ListTokens(ctx context.Context, in *AuthListTokensOp, opts ...grpc.CallOption) (*PersonalAccessTokenList, error) {
+	out := new(PersonalAccessTokenList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Auth/ListTokens", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) RevokeToken(ctx context.Context, in *AuthRevokeTokenOp, opts ...grpc.CallOption) (*pbtypes1.Void, error) {
+	out := new(pbtypes1.Void)
+	err := grpc.Invoke(ctx, "/sourcegraph.Auth/RevokeToken", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Auth service
 
 type AuthServer interface {
@@ -6406,6 +10841,16 @@ type AuthServer interface {
 	// GetPermissions returns the currently authenticated user's
 	// authorization levels on the client.
 	GetPermissions(context.Context, *pbtypes1.Void) (*UserPermissions, error)
+	// CreateToken creates a personal access token for the currently
+	// authenticated user. The returned token's Token field holds the
+	// secret token value; it is never returned again.
+	CreateToken(context.Context, *AuthCreateTokenOp) (*PersonalAccessToken, error)
+	// ListTokens lists the currently authenticated user's personal
+	// access tokens. The returned tokens' Token fields are not set.
+	ListTokens(context.Context, *AuthListTokensOp) (*PersonalAccessTokenList, error)
+	// RevokeToken revokes and deletes one of the currently
+	// authenticated user's personal access tokens.
+	RevokeToken(context.Context, *AuthRevokeTokenOp) (*pbtypes1.Void, error)
 }
 
 func RegisterAuthServer(s *grpc.Server, srv AuthServer) {
@@ -6460,6 +10905,42 @@ func _Auth_GetPermissions_Handler(srv interface{}, ctx context.Context, dec func
 	return out, nil
 }
 
+func _Auth_CreateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(AuthCreateTokenOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(AuthServer).CreateToken(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Auth_ListTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(AuthListTokensOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(AuthServer).ListTokens(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Auth_RevokeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(AuthRevokeTokenOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(AuthServer).RevokeToken(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 var _Auth_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sourcegraph.Auth",
 	HandlerType: (*AuthServer)(nil),
@@ -6480,6 +10961,18 @@ var _Auth_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPermissions",
 			Handler:    _Auth_GetPermissions_Handler,
 		},
+		{
+			MethodName: "CreateToken",
+			Handler:    _Auth_CreateToken_Handler,
+		},
+		{
+			MethodName: "ListTokens",
+			Handler:    _Auth_ListTokens_Handler,
+		},
+		{
+			MethodName: "RevokeToken",
+			Handler:    _Auth_RevokeToken_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{},
 }
@@ -6489,6 +10982,20 @@ var _Auth_serviceDesc = grpc.ServiceDesc{
 type DefsClient interface {
 	// Get fetches a def.
 	Get(ctx context.Context, in *DefsGetOp, opts ...grpc.CallOption) (*Def, error)
+	// GetByPosition fetches the def that is defined or referred to at
+	// a position in a file (e.g., to implement go-to-definition in an
+	// editor).
+	GetByPosition(ctx context.Context, in *DefsGetByPositionOp, opts ...grpc.CallOption) (*Def, error)
+	// Hover returns a summary of the def at a position in a file,
+	// suitable for displaying in an editor tooltip. It is cheaper
+	// than GetByPosition with docs, because it returns only the def's
+	// signature and documentation.
+	Hover(ctx context.Context, in *DefsGetByPositionOp, opts ...grpc.CallOption) (*Hover, error)
+	// GetMultiple fetches multiple defs in a single call. At most
+	// MaxGetMultipleDefs defs may be requested at once. The returned
+	// defs are in the same order as the requested specs, but defs
+	// that do not exist are omitted.
+	GetMultiple(ctx context.Context, in *DefsGetMultipleOp, opts ...grpc.CallOption) (*DefList, error)
 	// List defs.
 	List(ctx context.Context, in *DefListOptions, opts ...grpc.CallOption) (*DefList, error)
 	// ListRefs lists references to def.
@@ -6499,19 +11006,76 @@ type DefsClient interface {
 	ListAuthors(ctx context.Context, in *DefsListAuthorsOp, opts ...grpc.CallOption) (*DefAuthorList, error)
 	// ListClients lists people who use def in their code.
 	ListClients(ctx context.Context, in *DefsListClientsOp, opts ...grpc.CallOption) (*DefClientList, error)
+	// ListDependents lists the repositories (other than def's own
+	// repository) that refer to def, with the number of refs in each.
+	ListDependents(ctx context.Context, in *DefsListDependentsOp, opts ...grpc.CallOption) (*DefDependentList, error)
+	// ListHistory lists the commits at which def was added, modified,
+	// or deleted, newest first.
+	ListHistory(ctx context.Context, in *DefsListHistoryOp, opts ...grpc.CallOption) (*DefHistory, error)
+	// ListTop lists the most important defs in a repository at a
+	// commit (e.g., its most used APIs), ranked by descending score.
+	ListTop(ctx context.Context, in *DefsListTopOp, opts ...grpc.CallOption) (*DefList, error)
+	// GetLineage follows the renames and moves of def across its
+	// repository's history, so that specs referring to the def's
+	// old identities can be resolved to its current one.
+	GetLineage(ctx context.Context, in *DefsGetLineageOp, opts ...grpc.CallOption) (*DefLineage, error)
+	// ListCallers lists the defs that call def (i.e., the defs whose
+	// bodies contain a ref to def).
+	ListCallers(ctx context.Context, in *DefsListCallersOp, opts ...grpc.CallOption) (*DefList, error)
+	// ListCallees lists the defs that def calls (i.e., the defs that
+	// are referred to in def's body).
+	ListCallees(ctx context.Context, in *DefsListCalleesOp, opts ...grpc.CallOption) (*DefList, error)
+	// DiffRefs compares the refs to def at two revisions of def's
+	// repository, returning the refs that were added and removed
+	// (e.g., to see which usages of a library's API a release
+	// breaks).
+	DiffRefs(ctx context.Context, in *DefsDiffRefsOp, opts ...grpc.CallOption) (*RefDiff, error)
+	// Search searches for defs across all repositories by name,
+	// ranking the results by how well their names match the query
+	// (and by the defs' popularity). Unlike List, it does not require
+	// exact structural filters, so it is suitable for interactive
+	// symbol jumpers.
+	Search(ctx context.Context, in *DefSearchOptions, opts ...grpc.CallOption) (*DefSearchResultList, error)
 }
 
 type defsClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewDefsClient(cc *grpc.ClientConn) DefsClient {
-	return &defsClient{cc}
+func NewDefsClient(cc *grpc.ClientConn) DefsClient {
+	return &defsClient{cc}
+}
+
+func (c *defsClient) Get(ctx context.Context, in *DefsGetOp, opts ...grpc.CallOption) (*Def, error) {
+	out := new(Def)
+	err := grpc.Invoke(ctx, "/sourcegraph.Defs/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *defsClient) GetByPosition(ctx context.Context, in *DefsGetByPositionOp, opts ...grpc.CallOption) (*Def, error) {
+	out := new(Def)
+	err := grpc.Invoke(ctx, "/sourcegraph.Defs/GetByPosition", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *defsClient) Hover(ctx context.Context, in *DefsGetByPositionOp, opts ...grpc.CallOption) (*Hover, error) {
+	out := new(Hover)
+	err := grpc.Invoke(ctx, "/sourcegraph.Defs/Hover", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *defsClient) Get(ctx context.Context, in *DefsGetOp, opts ...grpc.CallOption) (*Def, error) {
-	out := new(Def)
-	err := grpc.Invoke(ctx, "/sourcegraph.Defs/Get", in, out, c.cc, opts...)
+func (c *defsClient) GetMultiple(ctx context.Context, in *DefsGetMultipleOp, opts ...grpc.CallOption) (*DefList, error) {
+	out := new(DefList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Defs/GetMultiple", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6563,11 +11127,97 @@ func (c *defsClient) ListClients(ctx context.Context, in *DefsListClientsOp, opt
 	return out, nil
 }
 
+func (c *defsClient) ListDependents(ctx context.Context, in *DefsListDependentsOp, opts ...grpc.CallOption) (*DefDependentList, error) {
+	out := new(DefDependentList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Defs/ListDependents", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *defsClient) ListHistory(ctx context.Context, in *DefsListHistoryOp, opts ...grpc.CallOption) (*DefHistory, error) {
+	out := new(DefHistory)
+	err := grpc.Invoke(ctx, "/sourcegraph.Defs/ListHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *defsClient) ListTop(ctx context.Context, in *DefsListTopOp, opts ...grpc.CallOption) (*DefList, error) {
+	out := new(DefList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Defs/ListTop", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *defsClient) GetLineage(ctx context.Context, in *DefsGetLineageOp, opts ...grpc.CallOption) (*DefLineage, error) {
+	out := new(DefLineage)
+	err := grpc.Invoke(ctx, "/sourcegraph.Defs/GetLineage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *defsClient) ListCallers(ctx context.Context, in *DefsListCallersOp, opts ...grpc.CallOption) (*DefList, error) {
+	out := new(DefList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Defs/ListCallers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *defsClient) ListCallees(ctx context.Context, in *DefsListCalleesOp, opts ...grpc.CallOption) (*DefList, error) {
+	out := new(DefList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Defs/ListCallees", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *defsClient) DiffRefs(ctx context.Context, in *DefsDiffRefsOp, opts ...grpc.CallOption) (*RefDiff, error) {
+	out := new(RefDiff)
+	err := grpc.Invoke(ctx, "/sourcegraph.Defs/DiffRefs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *defsClient) Search(ctx context.Context, in *DefSearchOptions, opts ...grpc.CallOption) (*DefSearchResultList, error) {
+	out := new(DefSearchResultList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Defs/Search", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Defs service
 
 type DefsServer interface {
 	// Get fetches a def.
 	Get(context.Context, *DefsGetOp) (*Def, error)
+	// GetByPosition fetches the def that is defined or referred to at
+	// a position in a file (e.g., to implement go-to-definition in an
+	// editor).
+	GetByPosition(context.Context, *DefsGetByPositionOp) (*Def, error)
+	// Hover returns a summary of the def at a position in a file,
+	// suitable for displaying in an editor tooltip. It is cheaper
+	// than GetByPosition with docs, because it returns only the def's
+	// signature and documentation.
+	Hover(context.Context, *DefsGetByPositionOp) (*Hover, error)
+	// GetMultiple fetches multiple defs in a single call. At most
+	// MaxGetMultipleDefs defs may be requested at once. The returned
+	// defs are in the same order as the requested specs, but defs
+	// that do not exist are omitted.
+	GetMultiple(context.Context, *DefsGetMultipleOp) (*DefList, error)
 	// List defs.
 	List(context.Context, *DefListOptions) (*DefList, error)
 	// ListRefs lists references to def.
@@ -6578,6 +11228,36 @@ type DefsServer interface {
 	ListAuthors(context.Context, *DefsListAuthorsOp) (*DefAuthorList, error)
 	// ListClients lists people who use def in their code.
 	ListClients(context.Context, *DefsListClientsOp) (*DefClientList, error)
+	// ListDependents lists the repositories (other than def's own
+	// repository) that refer to def, with the number of refs in each.
+	ListDependents(context.Context, *DefsListDependentsOp) (*DefDependentList, error)
+	// ListHistory lists the commits at which def was added, modified,
+	// or deleted, newest first.
+	ListHistory(context.Context, *DefsListHistoryOp) (*DefHistory, error)
+	// ListTop lists the most important defs in a repository at a
+	// commit (e.g., its most used APIs), ranked by descending score.
+	ListTop(context.Context, *DefsListTopOp) (*DefList, error)
+	// GetLineage follows the renames and moves of def across its
+	// repository's history, so that specs referring to the def's
+	// old identities can be resolved to its current one.
+	GetLineage(context.Context, *DefsGetLineageOp) (*DefLineage, error)
+	// ListCallers lists the defs that call def (i.e., the defs whose
+	// bodies contain a ref to def).
+	ListCallers(context.Context, *DefsListCallersOp) (*DefList, error)
+	// ListCallees lists the defs that def calls (i.e., the defs that
+	// are referred to in def's body).
+	ListCallees(context.Context, *DefsListCalleesOp) (*DefList, error)
+	// DiffRefs compares the refs to def at two revisions of def's
+	// repository, returning the refs that were added and removed
+	// (e.g., to see which usages of a library's API a release
+	// breaks).
+	DiffRefs(context.Context, *DefsDiffRefsOp) (*RefDiff, error)
+	// Search searches for defs across all repositories by name,
+	// ranking the results by how well their names match the query
+	// (and by the defs' popularity). Unlike List, it does not require
+	// exact structural filters, so it is suitable for interactive
+	// symbol jumpers.
+	Search(context.Context, *DefSearchOptions) (*DefSearchResultList, error)
 }
 
 func RegisterDefsServer(s *grpc.Server, srv DefsServer) {
@@ -6596,6 +11276,42 @@ func _Defs_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return out, nil
 }
 
+func _Defs_GetByPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DefsGetByPositionOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DefsServer).GetByPosition(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Defs_Hover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DefsGetByPositionOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DefsServer).Hover(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Defs_GetMultiple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DefsGetMultipleOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DefsServer).GetMultiple(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func _Defs_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
 	in := new(DefListOptions)
 	if err := dec(in); err != nil {
@@ -6656,6 +11372,102 @@ func _Defs_ListClients_Handler(srv interface{}, ctx context.Context, dec func(in
 	return out, nil
 }
 
+func _Defs_ListDependents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DefsListDependentsOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DefsServer).ListDependents(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Defs_ListHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DefsListHistoryOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DefsServer).ListHistory(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Defs_ListTop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DefsListTopOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DefsServer).ListTop(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Defs_GetLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DefsGetLineageOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DefsServer).GetLineage(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Defs_ListCallers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DefsListCallersOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DefsServer).ListCallers(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Defs_ListCallees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DefsListCalleesOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DefsServer).ListCallees(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Defs_DiffRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DefsDiffRefsOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DefsServer).DiffRefs(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Defs_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DefSearchOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DefsServer).Search(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 var _Defs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sourcegraph.Defs",
 	HandlerType: (*DefsServer)(nil),
@@ -6664,6 +11476,18 @@ var _Defs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _Defs_Get_Handler,
 		},
+		{
+			MethodName: "GetByPosition",
+			Handler:    _Defs_GetByPosition_Handler,
+		},
+		{
+			MethodName: "Hover",
+			Handler:    _Defs_Hover_Handler,
+		},
+		{
+			MethodName: "GetMultiple",
+			Handler:    _Defs_GetMultiple_Handler,
+		},
 		{
 			MethodName: "List",
 			Handler:    _Defs_List_Handler,
@@ -6684,6 +11508,38 @@ var _Defs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListClients",
 			Handler:    _Defs_ListClients_Handler,
 		},
+		{
+			MethodName: "ListDependents",
+			Handler:    _Defs_ListDependents_Handler,
+		},
+		{
+			MethodName: "ListHistory",
+			Handler:    _Defs_ListHistory_Handler,
+		},
+		{
+			MethodName: "ListTop",
+			Handler:    _Defs_ListTop_Handler,
+		},
+		{
+			MethodName: "GetLineage",
+			Handler:    _Defs_GetLineage_Handler,
+		},
+		{
+			MethodName: "ListCallers",
+			Handler:    _Defs_ListCallers_Handler,
+		},
+		{
+			MethodName: "ListCallees",
+			Handler:    _Defs_ListCallees_Handler,
+		},
+		{
+			MethodName: "DiffRefs",
+			Handler:    _Defs_DiffRefs_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Defs_Search_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{},
 }
@@ -6693,17 +11549,53 @@ var _Defs_serviceDesc = grpc.ServiceDesc{
 type DeltasClient interface {
 	// Get fetches a summary of a delta.
 	Get(ctx context.Context, in *DeltaSpec, opts ...grpc.CallOption) (*Delta, error)
+	// GetMergeBase returns the merge base (best common ancestor) of a
+	// delta's base and head commits. If the base and head are in
+	// different repositories, the head repository must be a fork of
+	// (or otherwise share history with) the base repository.
+	GetMergeBase(ctx context.Context, in *DeltaSpec, opts ...grpc.CallOption) (*DeltaMergeBase, error)
 	// ListUnits lists units added/changed/deleted in a delta.
 	ListUnits(ctx context.Context, in *DeltasListUnitsOp, opts ...grpc.CallOption) (*UnitDeltaList, error)
 	// ListDefs lists definitions added/changed/deleted in a delta.
 	ListDefs(ctx context.Context, in *DeltasListDefsOp, opts ...grpc.CallOption) (*DeltaDefs, error)
 	// ListFiles fetches the file diff for a delta.
 	ListFiles(ctx context.Context, in *DeltasListFilesOp, opts ...grpc.CallOption) (*DeltaFiles, error)
+	// GetPatch returns a chunk of the delta's patch (unified diff, or
+	// a series of git format-patch emails). Large patches are returned
+	// in chunks no larger than the server's size cap; use
+	// DeltaPatchReader to read the whole patch as a stream.
+	GetPatch(ctx context.Context, in *DeltasGetPatchOp, opts ...grpc.CallOption) (*DeltaPatch, error)
 	// ListAffectedAuthors lists authors whose code is added/deleted/changed in a
 	// delta.
 	ListAffectedAuthors(ctx context.Context, in *DeltasListAffectedAuthorsOp, opts ...grpc.CallOption) (*DeltaAffectedPersonList, error)
 	// ListAffectedClients lists clients whose code is affected by a delta.
 	ListAffectedClients(ctx context.Context, in *DeltasListAffectedClientsOp, opts ...grpc.CallOption) (*DeltaAffectedPersonList, error)
+	// GetImpact returns summary counts of a delta's impact. It is much
+	// cheaper than calling the ListAffectedXxx methods and counting
+	// their results.
+	GetImpact(ctx context.Context, in *DeltaSpec, opts ...grpc.CallOption) (*DeltaImpact, error)
+	// ListDependencies lists the repository dependencies that a delta
+	// adds, removes, or updates (by comparing the dependencies of its
+	// base and head commits).
+	ListDependencies(ctx context.Context, in *DeltasListDependenciesOp, opts ...grpc.CallOption) (*DeltaDependencies, error)
+	// ListIncoming lists deltas whose base is the given repository
+	// (e.g., branches and forks that propose changes to it).
+	ListIncoming(ctx context.Context, in *DeltasListIncomingOp, opts ...grpc.CallOption) (*DeltaList, error)
+	// SetLabels replaces the labels on a delta and returns the updated
+	// delta.
+	SetLabels(ctx context.Context, in *DeltasSetLabelsOp, opts ...grpc.CallOption) (*Delta, error)
+	// SetMilestone sets (or clears) the milestone of a delta and returns
+	// the updated delta.
+	SetMilestone(ctx context.Context, in *DeltasSetMilestoneOp, opts ...grpc.CallOption) (*Delta, error)
+	// AssignReviewer adds a user to the delta's reviewers. It is a
+	// no-op if the user is already a reviewer.
+	AssignReviewer(ctx context.Context, in *DeltasReviewerOp, opts ...grpc.CallOption) (*Delta, error)
+	// UnassignReviewer removes a user from the delta's reviewers.
+	UnassignReviewer(ctx context.Context, in *DeltasReviewerOp, opts ...grpc.CallOption) (*Delta, error)
+	// ListSuggestedReviewers lists the people best suited to review a
+	// delta, ranked by their (recency-weighted) ownership of the code
+	// that the delta changes.
+	ListSuggestedReviewers(ctx context.Context, in *DeltasListSuggestedReviewersOp, opts ...grpc.CallOption) (*SuggestedReviewerList, error)
 }
 
 type deltasClient struct {
@@ -6723,6 +11615,15 @@ func (c *deltasClient) Get(ctx context.Context, in *DeltaSpec, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *deltasClient) GetMergeBase(ctx context.Context, in *DeltaSpec, opts ...grpc.CallOption) (*DeltaMergeBase, error) {
+	out := new(DeltaMergeBase)
+	err := grpc.Invoke(ctx, "/sourcegraph.Deltas/GetMergeBase", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *deltasClient) ListUnits(ctx context.Context, in *DeltasListUnitsOp, opts ...grpc.CallOption) (*UnitDeltaList, error) {
 	out := new(UnitDeltaList)
 	err := grpc.Invoke(ctx, "/sourcegraph.Deltas/ListUnits", in, out, c.cc, opts...)
@@ -6741,27 +11642,108 @@ func (c *deltasClient) ListDefs(ctx context.Context, in *DeltasListDefsOp, opts
 	return out, nil
 }
 
-func (c *deltasClient) ListFiles(ctx context.Context, in *DeltasListFilesOp, opts ...grpc.CallOption) (*DeltaFiles, error) {
-	out := new(DeltaFiles)
-	err := grpc.Invoke(ctx, "/sourcegraph.Deltas/ListFiles", in, out, c.cc, opts...)
+func (c *deltasClient) ListFiles(ctx context.Context, in *DeltasListFilesOp, opts ...grpc.CallOption) (*DeltaFiles, error) {
+	out := new(DeltaFiles)
+	err := grpc.Invoke(ctx, "/sourcegraph.Deltas/ListFiles", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deltasClient) GetPatch(ctx context.Context, in *DeltasGetPatchOp, opts ...grpc.CallOption) (*DeltaPatch, error) {
+	out := new(DeltaPatch)
+	err := grpc.Invoke(ctx, "/sourcegraph.Deltas/GetPatch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deltasClient) ListAffectedAuthors(ctx context.Context, in *DeltasListAffectedAuthorsOp, opts ...grpc.CallOption) (*DeltaAffectedPersonList, error) {
+	out := new(DeltaAffectedPersonList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Deltas/ListAffectedAuthors", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deltasClient) ListAffectedClients(ctx context.Context, in *DeltasListAffectedClientsOp, opts ...grpc.CallOption) (*DeltaAffectedPersonList, error) {
+	out := new(DeltaAffectedPersonList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Deltas/ListAffectedClients", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deltasClient) GetImpact(ctx context.Context, in *DeltaSpec, opts ...grpc.CallOption) (*DeltaImpact, error) {
+	out := new(DeltaImpact)
+	err := grpc.Invoke(ctx, "/sourcegraph.Deltas/GetImpact", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deltasClient) ListDependencies(ctx context.Context, in *DeltasListDependenciesOp, opts ...grpc.CallOption) (*DeltaDependencies, error) {
+	out := new(DeltaDependencies)
+	err := grpc.Invoke(ctx, "/sourcegraph.Deltas/ListDependencies", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deltasClient) ListIncoming(ctx context.Context, in *DeltasListIncomingOp, opts ...grpc.CallOption) (*DeltaList, error) {
+	out := new(DeltaList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Deltas/ListIncoming", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deltasClient) SetLabels(ctx context.Context, in *DeltasSetLabelsOp, opts ...grpc.CallOption) (*Delta, error) {
+	out := new(Delta)
+	err := grpc.Invoke(ctx, "/sourcegraph.Deltas/SetLabels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deltasClient) SetMilestone(ctx context.Context, in *DeltasSetMilestoneOp, opts ...grpc.CallOption) (*Delta, error) {
+	out := new(Delta)
+	err := grpc.Invoke(ctx, "/sourcegraph.Deltas/SetMilestone", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deltasClient) AssignReviewer(ctx context.Context, in *DeltasReviewerOp, opts ...grpc.CallOption) (*Delta, error) {
+	out := new(Delta)
+	err := grpc.Invoke(ctx, "/sourcegraph.Deltas/AssignReviewer", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *deltasClient) ListAffectedAuthors(ctx context.Context, in *DeltasListAffectedAuthorsOp, opts ...grpc.CallOption) (*DeltaAffectedPersonList, error) {
-	out := new(DeltaAffectedPersonList)
-	err := grpc.Invoke(ctx, "/sourcegraph.Deltas/ListAffectedAuthors", in, out, c.cc, opts...)
+func (c *deltasClient) UnassignReviewer(ctx context.Context, in *DeltasReviewerOp, opts ...grpc.CallOption) (*Delta, error) {
+	out := new(Delta)
+	err := grpc.Invoke(ctx, "/sourcegraph.Deltas/UnassignReviewer", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *deltasClient) ListAffectedClients(ctx context.Context, in *DeltasListAffectedClientsOp, opts ...grpc.CallOption) (*DeltaAffectedPersonList, error) {
-	out := new(DeltaAffectedPersonList)
-	err := grpc.Invoke(ctx, "/sourcegraph.Deltas/ListAffectedClients", in, out, c.cc, opts...)
+func (c *deltasClient) ListSuggestedReviewers(ctx context.Context, in *DeltasListSuggestedReviewersOp, opts ...grpc.CallOption) (*SuggestedReviewerList, error) {
+	out := new(SuggestedReviewerList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Deltas/ListSuggestedReviewers", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6773,17 +11755,53 @@ func (c *deltasClient) ListAffectedClients(ctx context.Context, in *DeltasListAf
 type DeltasServer interface {
 	// Get fetches a summary of a delta.
 	Get(context.Context, *DeltaSpec) (*Delta, error)
+	// GetMergeBase returns the merge base (best common ancestor) of a
+	// delta's base and head commits. If the base and head are in
+	// different repositories, the head repository must be a fork of
+	// (or otherwise share history with) the base repository.
+	GetMergeBase(context.Context, *DeltaSpec) (*DeltaMergeBase, error)
 	// ListUnits lists units added/changed/deleted in a delta.
 	ListUnits(context.Context, *DeltasListUnitsOp) (*UnitDeltaList, error)
 	// ListDefs lists definitions added/changed/deleted in a delta.
 	ListDefs(context.Context, *DeltasListDefsOp) (*DeltaDefs, error)
 	// ListFiles fetches the file diff for a delta.
 	ListFiles(context.Context, *DeltasListFilesOp) (*DeltaFiles, error)
+	// GetPatch returns a chunk of the delta's patch (unified diff, or
+	// a series of git format-patch emails). Large patches are returned
+	// in chunks no larger than the server's size cap; use
+	// DeltaPatchReader to read the whole patch as a stream.
+	GetPatch(context.Context, *DeltasGetPatchOp) (*DeltaPatch, error)
 	// ListAffectedAuthors lists authors whose code is added/deleted/changed in a
 	// delta.
 	ListAffectedAuthors(context.Context, *DeltasListAffectedAuthorsOp) (*DeltaAffectedPersonList, error)
 	// ListAffectedClients lists clients whose code is affected by a delta.
 	ListAffectedClients(context.Context, *DeltasListAffectedClientsOp) (*DeltaAffectedPersonList, error)
+	// GetImpact returns summary counts of a delta's impact. It is much
+	// cheaper than calling the ListAffectedXxx methods and counting
+	// their results.
+	GetImpact(context.Context, *DeltaSpec) (*DeltaImpact, error)
+	// ListDependencies lists the repository dependencies that a delta
+	// adds, removes, or updates (by comparing the dependencies of its
+	// base and head commits).
+	ListDependencies(context.Context, *DeltasListDependenciesOp) (*DeltaDependencies, error)
+	// ListIncoming lists deltas whose base is the given repository
+	// (e.g., branches and forks that propose changes to it).
+	ListIncoming(context.Context, *DeltasListIncomingOp) (*DeltaList, error)
+	// SetLabels replaces the labels on a delta and returns the updated
+	// delta.
+	SetLabels(context.Context, *DeltasSetLabelsOp) (*Delta, error)
+	// SetMilestone sets (or clears) the milestone of a delta and returns
+	// the updated delta.
+	SetMilestone(context.Context, *DeltasSetMilestoneOp) (*Delta, error)
+	// AssignReviewer adds a user to the delta's reviewers. It is a
+	// no-op if the user is already a reviewer.
+	AssignReviewer(context.Context, *DeltasReviewerOp) (*Delta, error)
+	// UnassignReviewer removes a user from the delta's reviewers.
+	UnassignReviewer(context.Context, *DeltasReviewerOp) (*Delta, error)
+	// ListSuggestedReviewers lists the people best suited to review a
+	// delta, ranked by their (recency-weighted) ownership of the code
+	// that the delta changes.
+	ListSuggestedReviewers(context.Context, *DeltasListSuggestedReviewersOp) (*SuggestedReviewerList, error)
 }
 
 func RegisterDeltasServer(s *grpc.Server, srv DeltasServer) {
@@ -6802,6 +11820,18 @@ func _Deltas_Get_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return out, nil
 }
 
+func _Deltas_GetMergeBase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DeltaSpec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DeltasServer).GetMergeBase(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func _Deltas_ListUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
 	in := new(DeltasListUnitsOp)
 	if err := dec(in); err != nil {
@@ -6838,6 +11868,18 @@ func _Deltas_ListFiles_Handler(srv interface{}, ctx context.Context, dec func(in
 	return out, nil
 }
 
+func _Deltas_GetPatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DeltasGetPatchOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DeltasServer).GetPatch(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func _Deltas_ListAffectedAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
 	in := new(DeltasListAffectedAuthorsOp)
 	if err := dec(in); err != nil {
@@ -6862,6 +11904,102 @@ func _Deltas_ListAffectedClients_Handler(srv interface{}, ctx context.Context, d
 	return out, nil
 }
 
+func _Deltas_GetImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DeltaSpec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DeltasServer).GetImpact(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Deltas_ListDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DeltasListDependenciesOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DeltasServer).ListDependencies(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Deltas_ListIncoming_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DeltasListIncomingOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DeltasServer).ListIncoming(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Deltas_SetLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DeltasSetLabelsOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DeltasServer).SetLabels(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Deltas_SetMilestone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DeltasSetMilestoneOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DeltasServer).SetMilestone(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Deltas_AssignReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DeltasReviewerOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DeltasServer).AssignReviewer(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Deltas_UnassignReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DeltasReviewerOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DeltasServer).UnassignReviewer(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Deltas_ListSuggestedReviewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DeltasListSuggestedReviewersOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(DeltasServer).ListSuggestedReviewers(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 var _Deltas_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sourcegraph.Deltas",
 	HandlerType: (*DeltasServer)(nil),
@@ -6870,6 +12008,10 @@ var _Deltas_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _Deltas_Get_Handler,
 		},
+		{
+			MethodName: "GetMergeBase",
+			Handler:    _Deltas_GetMergeBase_Handler,
+		},
 		{
 			MethodName: "ListUnits",
 			Handler:    _Deltas_ListUnits_Handler,
@@ -6882,6 +12024,10 @@ var _Deltas_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListFiles",
 			Handler:    _Deltas_ListFiles_Handler,
 		},
+		{
+			MethodName: "GetPatch",
+			Handler:    _Deltas_GetPatch_Handler,
+		},
 		{
 			MethodName: "ListAffectedAuthors",
 			Handler:    _Deltas_ListAffectedAuthors_Handler,
@@ -6890,6 +12036,38 @@ var _Deltas_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListAffectedClients",
 			Handler:    _Deltas_ListAffectedClients_Handler,
 		},
+		{
+			MethodName: "GetImpact",
+			Handler:    _Deltas_GetImpact_Handler,
+		},
+		{
+			MethodName: "ListDependencies",
+			Handler:    _Deltas_ListDependencies_Handler,
+		},
+		{
+			MethodName: "ListIncoming",
+			Handler:    _Deltas_ListIncoming_Handler,
+		},
+		{
+			MethodName: "SetLabels",
+			Handler:    _Deltas_SetLabels_Handler,
+		},
+		{
+			MethodName: "SetMilestone",
+			Handler:    _Deltas_SetMilestone_Handler,
+		},
+		{
+			MethodName: "AssignReviewer",
+			Handler:    _Deltas_AssignReviewer_Handler,
+		},
+		{
+			MethodName: "UnassignReviewer",
+			Handler:    _Deltas_UnassignReviewer_Handler,
+		},
+		{
+			MethodName: "ListSuggestedReviewers",
+			Handler:    _Deltas_ListSuggestedReviewers_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{},
 }
@@ -6897,6 +12075,7 @@ var _Deltas_serviceDesc = grpc.ServiceDesc{
 // Client API for Markdown service
 
 type MarkdownClient interface {
+	// Render renders Markdown to sanitized HTML.
 	Render(ctx context.Context, in *MarkdownRenderOp, opts ...grpc.CallOption) (*MarkdownData, error)
 }
 
@@ -6920,6 +12099,7 @@ func (c *markdownClient) Render(ctx context.Context, in *MarkdownRenderOp, opts
 // Server API for Markdown service
 
 type MarkdownServer interface {
+	// Render renders Markdown to sanitized HTML.
 	Render(context.Context, *MarkdownRenderOp) (*MarkdownData, error)
 }
 
@@ -6927,25 +12107,237 @@ func RegisterMarkdownServer(s *grpc.Server, srv MarkdownServer) {
 	s.RegisterService(&_Markdown_serviceDesc, srv)
 }
 
-func _Markdown_Render_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
-	in := new(MarkdownRenderOp)
+func _Markdown_Render_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(MarkdownRenderOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(MarkdownServer).Render(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+var _Markdown_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sourcegraph.Markdown",
+	HandlerType: (*MarkdownServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Render",
+			Handler:    _Markdown_Render_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+// Client API for GraphQL service
+
+type GraphQLClient interface {
+	// Query executes a GraphQL query.
+	Query(ctx context.Context, in *GraphQLRequest, opts ...grpc.CallOption) (*GraphQLResponse, error)
+}
+
+type graphQLClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGraphQLClient(cc *grpc.ClientConn) GraphQLClient {
+	return &graphQLClient{cc}
+}
+
+func (c *graphQLClient) Query(ctx context.Context, in *GraphQLRequest, opts ...grpc.CallOption) (*GraphQLResponse, error) {
+	out := new(GraphQLResponse)
+	err := grpc.Invoke(ctx, "/sourcegraph.GraphQL/Query", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for GraphQL service
+
+type GraphQLServer interface {
+	// Query executes a GraphQL query.
+	Query(context.Context, *GraphQLRequest) (*GraphQLResponse, error)
+}
+
+func RegisterGraphQLServer(s *grpc.Server, srv GraphQLServer) {
+	s.RegisterService(&_GraphQL_serviceDesc, srv)
+}
+
+func _GraphQL_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(GraphQLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(GraphQLServer).Query(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+var _GraphQL_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sourcegraph.GraphQL",
+	HandlerType: (*GraphQLServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Query",
+			Handler:    _GraphQL_Query_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+// Client API for RepoDependencies service
+
+type RepoDependenciesClient interface {
+	// ListDependencies lists the repositories that a repository
+	// depends on at a commit.
+	ListDependencies(ctx context.Context, in *RepoDependenciesListOp, opts ...grpc.CallOption) (*RepoDependencyList, error)
+	// ListDependents lists the repositories that depend on a
+	// repository. The dependents are found at their latest built
+	// commits, not at a specific commit of the repository.
+	ListDependents(ctx context.Context, in *RepoDependenciesListOp, opts ...grpc.CallOption) (*RepoDependencyList, error)
+}
+
+type repoDependenciesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRepoDependenciesClient(cc *grpc.ClientConn) RepoDependenciesClient {
+	return &repoDependenciesClient{cc}
+}
+
+func (c *repoDependenciesClient) ListDependencies(ctx context.Context, in *RepoDependenciesListOp, opts ...grpc.CallOption) (*RepoDependencyList, error) {
+	out := new(RepoDependencyList)
+	err := grpc.Invoke(ctx, "/sourcegraph.RepoDependencies/ListDependencies", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoDependenciesClient) ListDependents(ctx context.Context, in *RepoDependenciesListOp, opts ...grpc.CallOption) (*RepoDependencyList, error) {
+	out := new(RepoDependencyList)
+	err := grpc.Invoke(ctx, "/sourcegraph.RepoDependencies/ListDependents", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for RepoDependencies service
+
+type RepoDependenciesServer interface {
+	// ListDependencies lists the repositories that a repository
+	// depends on at a commit.
+	ListDependencies(context.Context, *RepoDependenciesListOp) (*RepoDependencyList, error)
+	// ListDependents lists the repositories that depend on a
+	// repository. The dependents are found at their latest built
+	// commits, not at a specific commit of the repository.
+	ListDependents(context.Context, *RepoDependenciesListOp) (*RepoDependencyList, error)
+}
+
+func RegisterRepoDependenciesServer(s *grpc.Server, srv RepoDependenciesServer) {
+	s.RegisterService(&_RepoDependencies_serviceDesc, srv)
+}
+
+func _RepoDependencies_ListDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RepoDependenciesListOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RepoDependenciesServer).ListDependencies(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _RepoDependencies_ListDependents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RepoDependenciesListOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RepoDependenciesServer).ListDependents(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+var _RepoDependencies_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sourcegraph.RepoDependencies",
+	HandlerType: (*RepoDependenciesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDependencies",
+			Handler:    _RepoDependencies_ListDependencies_Handler,
+		},
+		{
+			MethodName: "ListDependents",
+			Handler:    _RepoDependencies_ListDependents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+// Client API for Annotations service
+
+type AnnotationsClient interface {
+	// List lists the annotations for a file.
+	List(ctx context.Context, in *AnnotationsListOptions, opts ...grpc.CallOption) (*AnnotationList, error)
+}
+
+type annotationsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAnnotationsClient(cc *grpc.ClientConn) AnnotationsClient {
+	return &annotationsClient{cc}
+}
+
+func (c *annotationsClient) List(ctx context.Context, in *AnnotationsListOptions, opts ...grpc.CallOption) (*AnnotationList, error) {
+	out := new(AnnotationList)
+	err := grpc.Invoke(ctx, "/sourcegraph.Annotations/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Annotations service
+
+type AnnotationsServer interface {
+	// List lists the annotations for a file.
+	List(context.Context, *AnnotationsListOptions) (*AnnotationList, error)
+}
+
+func RegisterAnnotationsServer(s *grpc.Server, srv AnnotationsServer) {
+	s.RegisterService(&_Annotations_serviceDesc, srv)
+}
+
+func _Annotations_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(AnnotationsListOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
-	out, err := srv.(MarkdownServer).Render(ctx, in)
+	out, err := srv.(AnnotationsServer).List(ctx, in)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-var _Markdown_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sourcegraph.Markdown",
-	HandlerType: (*MarkdownServer)(nil),
+var _Annotations_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sourcegraph.Annotations",
+	HandlerType: (*AnnotationsServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Render",
-			Handler:    _Markdown_Render_Handler,
+			MethodName: "List",
+			Handler:    _Annotations_List_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{},
@@ -6959,6 +12351,9 @@ type RepoTreeClient interface {
 	// List returns a list of all the files in the repo tree at
 	// the given revision.
 	List(ctx context.Context, in *RepoTreeListOp, opts ...grpc.CallOption) (*RepoTreeListResult, error)
+	// ListFileDefs returns the defs defined in a single file, sorted
+	// by position, for rendering an outline of the file.
+	ListFileDefs(ctx context.Context, in *RepoTreeListFileDefsOp, opts ...grpc.CallOption) (*FileDefList, error)
 }
 
 type repoTreeClient struct {
@@ -6996,6 +12391,15 @@ func (c *repoTreeClient) List(ctx context.Context, in *RepoTreeListOp, opts ...g
 	return out, nil
 }
 
+func (c *repoTreeClient) ListFileDefs(ctx context.Context, in *RepoTreeListFileDefsOp, opts ...grpc.CallOption) (*FileDefList, error) {
+	out := new(FileDefList)
+	err := grpc.Invoke(ctx, "/sourcegraph.RepoTree/ListFileDefs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for RepoTree service
 
 type RepoTreeServer interface {
@@ -7004,6 +12408,9 @@ type RepoTreeServer interface {
 	// List returns a list of all the files in the repo tree at
 	// the given revision.
 	List(context.Context, *RepoTreeListOp) (*RepoTreeListResult, error)
+	// ListFileDefs returns the defs defined in a single file, sorted
+	// by position, for rendering an outline of the file.
+	ListFileDefs(context.Context, *RepoTreeListFileDefsOp) (*FileDefList, error)
 }
 
 func RegisterRepoTreeServer(s *grpc.Server, srv RepoTreeServer) {
@@ -7046,6 +12453,18 @@ func _RepoTree_List_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return out, nil
 }
 
+func _RepoTree_ListFileDefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RepoTreeListFileDefsOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(RepoTreeServer).ListFileDefs(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 var _RepoTree_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sourcegraph.RepoTree",
 	HandlerType: (*RepoTreeServer)(nil),
@@ -7062,6 +12481,10 @@ var _RepoTree_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _RepoTree_List_Handler,
 		},
+		{
+			MethodName: "ListFileDefs",
+			Handler:    _RepoTree_ListFileDefs_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{},
 }
@@ -7454,6 +12877,256 @@ var _Meta_serviceDesc = grpc.ServiceDesc{
 	Streams: []grpc.StreamDesc{},
 }
 
+// Client API for Admin service
+
+type AdminClient interface {
+	// GetSiteConfig returns the site configuration.
+	GetSiteConfig(ctx context.Context, in *pbtypes1.Void, opts ...grpc.CallOption) (*SiteConfig, error)
+	// UpdateSiteConfig replaces the site configuration and returns it
+	// with its new ID. The argument's ID must be the ID of the
+	// current configuration (to prevent lost updates); otherwise the
+	// call fails with codes.FailedPrecondition.
+	UpdateSiteConfig(ctx context.Context, in *SiteConfig, opts ...grpc.CallOption) (*SiteConfig, error)
+	// ReindexAllRepos enqueues builds to reindex all repositories (at
+	// their default branches).
+	ReindexAllRepos(ctx context.Context, in *AdminReindexAllReposOp, opts ...grpc.CallOption) (*AdminReindexResult, error)
+	// DeactivateUser deactivates a user's account, so that the user
+	// can no longer sign in or use the API. The account's data is
+	// preserved.
+	DeactivateUser(ctx context.Context, in *UserSpec, opts ...grpc.CallOption) (*pbtypes1.Void, error)
+	// GetJobQueueStats returns statistics about the server's
+	// background job queues (such as the build queue).
+	GetJobQueueStats(ctx context.Context, in *pbtypes1.Void, opts ...grpc.CallOption) (*JobQueueStats, error)
+}
+
+type adminClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminClient(cc *grpc.ClientConn) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) GetSiteConfig(ctx context.Context, in *pbtypes1.Void, opts ...grpc.CallOption) (*SiteConfig, error) {
+	out := new(SiteConfig)
+	err := grpc.Invoke(ctx, "/sourcegraph.Admin/GetSiteConfig", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) UpdateSiteConfig(ctx context.Context, in *SiteConfig, opts ...grpc.CallOption) (*SiteConfig, error) {
+	out := new(SiteConfig)
+	err := grpc.Invoke(ctx, "/sourcegraph.Admin/UpdateSiteConfig", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ReindexAllRepos(ctx context.Context, in *AdminReindexAllReposOp, opts ...grpc.CallOption) (*AdminReindexResult, error) {
+	out := new(AdminReindexResult)
+	err := grpc.Invoke(ctx, "/sourcegraph.Admin/ReindexAllRepos", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DeactivateUser(ctx context.Context, in *UserSpec, opts ...grpc.CallOption) (*pbtypes1.Void, error) {
+	out := new(pbtypes1.Void)
+	err := grpc.Invoke(ctx, "/sourcegraph.Admin/DeactivateUser", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetJobQueueStats(ctx context.Context, in *pbtypes1.Void, opts ...grpc.CallOption) (*JobQueueStats, error) {
+	out := new(JobQueueStats)
+	err := grpc.Invoke(ctx, "/sourcegraph.Admin/GetJobQueueStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Admin service
+
+type AdminServer interface {
+	// GetSiteConfig returns the site configuration.
+	GetSiteConfig(context.Context, *pbtypes1.Void) (*SiteConfig, error)
+	// UpdateSiteConfig replaces the site configuration and returns it
+	// with its new ID. The argument's ID must be the ID of the
+	// current configuration (to prevent lost updates); otherwise the
+	// call fails with codes.FailedPrecondition.
+	UpdateSiteConfig(context.Context, *SiteConfig) (*SiteConfig, error)
+	// ReindexAllRepos enqueues builds to reindex all repositories (at
+	// their default branches).
+	ReindexAllRepos(context.Context, *AdminReindexAllReposOp) (*AdminReindexResult, error)
+	// DeactivateUser deactivates a user's account, so that the user
+	// can no longer sign in or use the API. The account's data is
+	// preserved.
+	DeactivateUser(context.Context, *UserSpec) (*pbtypes1.Void, error)
+	// GetJobQueueStats returns statistics about the server's
+	// background job queues (such as the build queue).
+	GetJobQueueStats(context.Context, *pbtypes1.Void) (*JobQueueStats, error)
+}
+
+func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
+	s.RegisterService(&_Admin_serviceDesc, srv)
+}
+
+func _Admin_GetSiteConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(pbtypes1.Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(AdminServer).GetSiteConfig(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Admin_UpdateSiteConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(SiteConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(AdminServer).UpdateSiteConfig(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Admin_ReindexAllRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(AdminReindexAllReposOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(AdminServer).ReindexAllRepos(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Admin_DeactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(UserSpec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(AdminServer).DeactivateUser(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _Admin_GetJobQueueStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(pbtypes1.Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(AdminServer).GetJobQueueStats(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+var _Admin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sourcegraph.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSiteConfig",
+			Handler:    _Admin_GetSiteConfig_Handler,
+		},
+		{
+			MethodName: "UpdateSiteConfig",
+			Handler:    _Admin_UpdateSiteConfig_Handler,
+		},
+		{
+			MethodName: "ReindexAllRepos",
+			Handler:    _Admin_ReindexAllRepos_Handler,
+		},
+		{
+			MethodName: "DeactivateUser",
+			Handler:    _Admin_DeactivateUser_Handler,
+		},
+		{
+			MethodName: "GetJobQueueStats",
+			Handler:    _Admin_GetJobQueueStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+// Client API for AdminStats service
+
+type AdminStatsClient interface {
+	// GetUsage returns aggregate usage statistics for the server
+	// over a time window. Only admins may call it.
+	GetUsage(ctx context.Context, in *AdminStatsGetUsageOp, opts ...grpc.CallOption) (*UsageStats, error)
+}
+
+type adminStatsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminStatsClient(cc *grpc.ClientConn) AdminStatsClient {
+	return &adminStatsClient{cc}
+}
+
+func (c *adminStatsClient) GetUsage(ctx context.Context, in *AdminStatsGetUsageOp, opts ...grpc.CallOption) (*UsageStats, error) {
+	out := new(UsageStats)
+	err := grpc.Invoke(ctx, "/sourcegraph.AdminStats/GetUsage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for AdminStats service
+
+type AdminStatsServer interface {
+	// GetUsage returns aggregate usage statistics for the server
+	// over a time window. Only admins may call it.
+	GetUsage(context.Context, *AdminStatsGetUsageOp) (*UsageStats, error)
+}
+
+func RegisterAdminStatsServer(s *grpc.Server, srv AdminStatsServer) {
+	s.RegisterService(&_AdminStats_serviceDesc, srv)
+}
+
+func _AdminStats_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(AdminStatsGetUsageOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(AdminStatsServer).GetUsage(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+var _AdminStats_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sourcegraph.AdminStats",
+	HandlerType: (*AdminStatsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUsage",
+			Handler:    _AdminStats_GetUsage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
 // Client API for RegisteredClients service
 
 type RegisteredClientsClient interface {
@@ -7755,6 +13428,158 @@ var _RegisteredClients_serviceDesc = grpc.ServiceDesc{
 	Streams: []grpc.StreamDesc{},
 }
 
+// Client API for SavedSearches service
+
+type SavedSearchesClient interface {
+	// Create saves a search for the current user. The ID, Owner,
+	// CreatedAt, and LastNotifiedAt fields of the argument are
+	// ignored.
+	Create(ctx context.Context, in *SavedSearch, opts ...grpc.CallOption) (*SavedSearch, error)
+	// List lists the current user's saved searches, newest first.
+	List(ctx context.Context, in *SavedSearchListOptions, opts ...grpc.CallOption) (*SavedSearchList, error)
+	// Delete deletes a saved search.
+	Delete(ctx context.Context, in *SavedSearchSpec, opts ...grpc.CallOption) (*pbtypes1.Void, error)
+	// UpdateNotifications replaces a saved search's notification
+	// settings.
+	UpdateNotifications(ctx context.Context, in *SavedSearchesUpdateNotificationsOp, opts ...grpc.CallOption) (*SavedSearch, error)
+}
+
+type savedSearchesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSavedSearchesClient(cc *grpc.ClientConn) SavedSearchesClient {
+	return &savedSearchesClient{cc}
+}
+
+func (c *savedSearchesClient) Create(ctx context.Context, in *SavedSearch, opts ...grpc.CallOption) (*SavedSearch, error) {
+	out := new(SavedSearch)
+	err := grpc.Invoke(ctx, "/sourcegraph.SavedSearches/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchesClient) List(ctx context.Context, in *SavedSearchListOptions, opts ...grpc.CallOption) (*SavedSearchList, error) {
+	out := new(SavedSearchList)
+	err := grpc.Invoke(ctx, "/sourcegraph.SavedSearches/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchesClient) Delete(ctx context.Context, in *SavedSearchSpec, opts ...grpc.CallOption) (*pbtypes1.Void, error) {
+	out := new(pbtypes1.Void)
+	err := grpc.Invoke(ctx, "/sourcegraph.SavedSearches/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchesClient) UpdateNotifications(ctx context.Context, in *SavedSearchesUpdateNotificationsOp, opts ...grpc.CallOption) (*SavedSearch, error) {
+	out := new(SavedSearch)
+	err := grpc.Invoke(ctx, "/sourcegraph.SavedSearches/UpdateNotifications", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for SavedSearches service
+
+type SavedSearchesServer interface {
+	// Create saves a search for the current user. The ID, Owner,
+	// CreatedAt, and LastNotifiedAt fields of the argument are
+	// ignored.
+	Create(context.Context, *SavedSearch) (*SavedSearch, error)
+	// List lists the current user's saved searches, newest first.
+	List(context.Context, *SavedSearchListOptions) (*SavedSearchList, error)
+	// Delete deletes a saved search.
+	Delete(context.Context, *SavedSearchSpec) (*pbtypes1.Void, error)
+	// UpdateNotifications replaces a saved search's notification
+	// settings.
+	UpdateNotifications(context.Context, *SavedSearchesUpdateNotificationsOp) (*SavedSearch, error)
+}
+
+func RegisterSavedSearchesServer(s *grpc.Server, srv SavedSearchesServer) {
+	s.RegisterService(&_SavedSearches_serviceDesc, srv)
+}
+
+func _SavedSearches_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(SavedSearch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(SavedSearchesServer).Create(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _SavedSearches_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(SavedSearchListOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(SavedSearchesServer).List(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _SavedSearches_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(SavedSearchSpec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(SavedSearchesServer).Delete(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func _SavedSearches_UpdateNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(SavedSearchesUpdateNotificationsOp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	out, err := srv.(SavedSearchesServer).UpdateNotifications(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+var _SavedSearches_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sourcegraph.SavedSearches",
+	HandlerType: (*SavedSearchesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _SavedSearches_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _SavedSearches_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _SavedSearches_Delete_Handler,
+		},
+		{
+			MethodName: "UpdateNotifications",
+			Handler:    _SavedSearches_UpdateNotifications_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
 // Client API for GraphUplink service
 
 type GraphUplinkClient interface {
@@ -0,0 +1,82 @@
+package sourcegraph
+
+import (
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// Timeouts specifies how long API calls may take. Calls to different
+// methods often need very different deadlines (e.g., a List call that
+// returns many results vs. a Get call), so timeouts may be set per
+// service and per method. Zero values mean "no timeout."
+type Timeouts struct {
+	// Default is the timeout for methods that aren't listed in
+	// Services or Methods.
+	Default time.Duration
+
+	// Services maps a service name (e.g., "Repos") to the timeout
+	// for its methods. It overrides Default.
+	Services map[string]time.Duration
+
+	// Methods maps a method name (e.g., "Repos.List") to its
+	// timeout. It overrides Services and Default.
+	Methods map[string]time.Duration
+}
+
+// For returns the timeout for method, which is of the form
+// "Service.Method" (e.g., "Repos.List").
+func (t *Timeouts) For(method string) time.Duration {
+	if t == nil {
+		return 0
+	}
+	if d, ok := t.Methods[method]; ok {
+		return d
+	}
+	if i := strings.Index(method, "."); i != -1 {
+		if d, ok := t.Services[method[:i]]; ok {
+			return d
+		}
+	}
+	return t.Default
+}
+
+// WithTimeouts returns a copy of parent that uses t as the timeouts
+// for API calls made with it using clients created by NewClient (or
+// using CallContext).
+func WithTimeouts(parent context.Context, t *Timeouts) context.Context {
+	return context.WithValue(parent, timeoutsKey, t)
+}
+
+// TimeoutsFromContext returns the timeouts (if any) previously set in
+// the context by WithTimeouts.
+func TimeoutsFromContext(ctx context.Context) *Timeouts {
+	t, _ := ctx.Value(timeoutsKey).(*Timeouts)
+	return t
+}
+
+// CallContext returns a context for a single call to method (e.g.,
+// "Repos.List") whose deadline is set according to the context's
+// Timeouts (see WithTimeouts). An existing earlier deadline in ctx is
+// preserved, so a caller can always override the timeout for a
+// particular call by using a context with a shorter deadline. The
+// returned cancel func must be called when the call completes.
+//
+// Clients created by NewClient apply it to each call automatically.
+func CallContext(ctx context.Context, method string) (context.Context, context.CancelFunc) {
+	if d := TimeoutsFromContext(ctx).For(method); d > 0 {
+		return context.WithTimeout(ctx, d)
+	}
+	return context.WithCancel(ctx)
+}
+
+// timeoutInterceptor sets the deadline of each call according to the
+// context's Timeouts (see CallContext).
+func timeoutInterceptor(next Invoker) Invoker {
+	return func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		ctx, cancel := CallContext(ctx, method)
+		defer cancel()
+		return next(ctx, method, in)
+	}
+}
@@ -0,0 +1,97 @@
+package sourcegraph
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func TestTimeouts_For(t *testing.T) {
+	ts := &Timeouts{
+		Default:  time.Second,
+		Services: map[string]time.Duration{"Repos": 2 * time.Second},
+		Methods:  map[string]time.Duration{"Repos.List": time.Minute, "Repos.Get": 0},
+	}
+	tests := []struct {
+		ts     *Timeouts
+		method string
+		want   time.Duration
+	}{
+		{nil, "Repos.List", 0},
+		{ts, "Repos.List", time.Minute},
+		{ts, "Repos.Get", 0},
+		{ts, "Repos.GetConfig", 2 * time.Second},
+		{ts, "Builds.Get", time.Second},
+		{ts, "bogus", time.Second},
+	}
+	for _, test := range tests {
+		if got := test.ts.For(test.method); got != test.want {
+			t.Errorf("%s: got %v, want %v", test.method, got, test.want)
+		}
+	}
+}
+
+func TestCallContext(t *testing.T) {
+	ctx := WithTimeouts(context.Background(), &Timeouts{Default: time.Hour})
+
+	callCtx, cancel := CallContext(ctx, "Repos.Get")
+	defer cancel()
+	if d, ok := callCtx.Deadline(); !ok || d.After(time.Now().Add(time.Hour)) {
+		t.Errorf("got deadline %v (%v), want within an hour", d, ok)
+	}
+
+	// An earlier deadline in the parent is preserved.
+	shortCtx, cancel2 := context.WithTimeout(ctx, time.Millisecond)
+	defer cancel2()
+	want, _ := shortCtx.Deadline()
+	callCtx, cancel = CallContext(shortCtx, "Repos.Get")
+	defer cancel()
+	if d, _ := callCtx.Deadline(); !d.Equal(want) {
+		t.Errorf("got deadline %v, want %v", d, want)
+	}
+
+	callCtx, cancel = CallContext(context.Background(), "Repos.Get")
+	defer cancel()
+	if _, ok := callCtx.Deadline(); ok {
+		t.Error("got deadline without Timeouts, want none")
+	}
+}
+
+// deadlineReposClient is a ReposClient whose Get records its
+// context's deadline.
+type deadlineReposClient struct {
+	ReposClient
+	deadline time.Time
+	ok       bool
+}
+
+func (c *deadlineReposClient) Get(ctx context.Context, in *RepoSpec, opts ...grpc.CallOption) (*Repo, error) {
+	c.deadline, c.ok = ctx.Deadline()
+	return &Repo{URI: in.URI}, nil
+}
+
+func TestClient_timeouts(t *testing.T) {
+	c := NewClient(nil)
+	repos := &deadlineReposClient{}
+	c.Repos.(*CachedReposClient).ReposClient.(*InterceptedReposClient).ReposClient = repos
+
+	ctx := WithTimeouts(context.Background(), &Timeouts{
+		Default: time.Hour,
+		Methods: map[string]time.Duration{"Repos.Get": time.Minute},
+	})
+	if _, err := c.Repos.Get(ctx, &RepoSpec{URI: "r"}); err != nil {
+		t.Fatal(err)
+	}
+	if !repos.ok || repos.deadline.After(time.Now().Add(time.Minute)) {
+		t.Errorf("got deadline %v (%v), want within a minute", repos.deadline, repos.ok)
+	}
+
+	if _, err := c.Repos.Get(context.Background(), &RepoSpec{URI: "r"}); err != nil {
+		t.Fatal(err)
+	}
+	if repos.ok {
+		t.Errorf("got deadline %v without Timeouts, want none", repos.deadline)
+	}
+}
@@ -0,0 +1,51 @@
+package sourcegraph
+
+import "golang.org/x/net/context"
+
+// A Tracer creates spans for API calls, for distributed tracing
+// systems (such as OpenTracing or OpenCensus implementations).
+type Tracer interface {
+	// StartSpan starts a span for an operation, which is the name
+	// of the API method being called (e.g., "Repos.Get"). The
+	// returned context is used for the call.
+	StartSpan(ctx context.Context, operation string) (context.Context, Span)
+}
+
+// A Span represents a single API call in a trace.
+type Span interface {
+	// Inject returns the metadata (e.g., trace and span IDs) that
+	// propagate the span to the server. It is sent with the call's
+	// gRPC request metadata.
+	Inject() map[string]string
+
+	// Finish ends the span. Err is the error returned by the call,
+	// if any.
+	Finish(err error)
+}
+
+// TracingInterceptor returns an Interceptor that creates a span using
+// t for each API call and propagates it to the server. Add it to a
+// client with:
+//
+//	c.UseInterceptor(TracingInterceptor(t))
+func TracingInterceptor(t Tracer) Interceptor {
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+			ctx, span := t.StartSpan(ctx, method)
+			if md := span.Inject(); len(md) > 0 {
+				// Merge with (don't shadow) existing metadata.
+				merged := make(map[string]string, len(md))
+				for k, v := range clientMetadataFromContext(ctx) {
+					merged[k] = v
+				}
+				for k, v := range md {
+					merged[k] = v
+				}
+				ctx = WithClientMetadata(ctx, merged)
+			}
+			result, err := next(ctx, method, in)
+			span.Finish(err)
+			return result, err
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package sourcegraph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+type testTracer struct{ spans []*testSpan }
+
+func (t *testTracer) StartSpan(ctx context.Context, operation string) (context.Context, Span) {
+	s := &testSpan{operation: operation}
+	t.spans = append(t.spans, s)
+	return ctx, s
+}
+
+type testSpan struct {
+	operation string
+	finished  bool
+	err       error
+}
+
+func (s *testSpan) Inject() map[string]string { return map[string]string{"span-id": "1"} }
+
+func (s *testSpan) Finish(err error) { s.finished, s.err = true, err }
+
+func TestTracingInterceptor(t *testing.T) {
+	tracer := &testTracer{}
+	wantErr := errors.New("x")
+	var md map[string]string
+	invoke := TracingInterceptor(tracer)(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		var err error
+		md, err = (contextCredentials{}).GetRequestMetadata(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return nil, wantErr
+	})
+
+	ctx := WithClientMetadata(context.Background(), map[string]string{"k": "v"})
+	if _, err := invoke(ctx, "Repos.Get", nil); err != wantErr {
+		t.Errorf("got error %v, want %v", err, wantErr)
+	}
+	if want := map[string]string{"k": "v", "span-id": "1", APIVersionMetadataKey: APIVersion}; !reflect.DeepEqual(md, want) {
+		t.Errorf("got metadata %v, want %v", md, want)
+	}
+	if len(tracer.spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(tracer.spans))
+	}
+	s := tracer.spans[0]
+	if s.operation != "Repos.Get" || !s.finished || s.err != wantErr {
+		t.Errorf("got span %+v, want finished Repos.Get span with error", s)
+	}
+}
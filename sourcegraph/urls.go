@@ -0,0 +1,128 @@
+package sourcegraph
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"sourcegraph.com/sourcegraph/go-sourcegraph/router"
+	"sourcegraph.com/sourcegraph/go-sourcegraph/spec"
+)
+
+// URL returns the canonical URL to the repository's page on
+// Sourcegraph. It returns an error if r.URI is not a valid repository
+// URI.
+func (r *Repo) URL() (*url.URL, error) {
+	return routeURL(router.Repo, r.RepoSpec().RouteVars, r.URI)
+}
+
+// URL returns the canonical URL to the def's page on Sourcegraph. If
+// the def's CommitID is set, the URL is pinned to that commit. It
+// returns an error if the def's key is incomplete or invalid.
+func (d *Def) URL() (*url.URL, error) {
+	spec := d.DefSpec()
+	return routeURL(router.Def, spec.RouteVars, spec.Repo)
+}
+
+// URL returns the canonical URL to the build's page on Sourcegraph.
+// It returns an error if the build's repo or commit ID is invalid.
+func (b *Build) URL() (*url.URL, error) {
+	spec := b.Spec()
+	return routeURL(router.RepoBuild, spec.RouteVars, spec.Repo.URI)
+}
+
+// URL returns the canonical URL to the delta's page on Sourcegraph.
+// It returns an error if the delta's base or head is invalid.
+func (s DeltaSpec) URL() (*url.URL, error) {
+	return routeURL(router.Delta, s.RouteVars, s.Base.URI, s.Head.URI)
+}
+
+// URL returns the canonical URL to the user's profile page on
+// Sourcegraph. It returns an error if the user's login is invalid.
+func (u *User) URL() (*url.URL, error) {
+	spec := u.Spec()
+	return routeURL(router.User, spec.RouteVars)
+}
+
+// routeURL returns the URL to the named route with the route
+// variables returned by routeVars. It returns an error instead of
+// calling routeVars if any of repos is empty, because
+// RepoSpec.RouteVars panics for an empty repo.
+func routeURL(routeName string, routeVars func() map[string]string, repos ...string) (*url.URL, error) {
+	for _, repo := range repos {
+		if repo == "" {
+			return nil, &InvalidSpecError{Reason: "empty repo URI"}
+		}
+	}
+	return router.URLTo(routeName, routeVars(), nil)
+}
+
+// routeSpecString returns the string representation of spec, which
+// is the path (without the leading "/") of the URL to the named route
+// (see routeURL). If the URL can't be built because spec is
+// incomplete or invalid, it returns spec's protobuf text
+// representation instead, which the spec's Parse func rejects.
+func routeSpecString(routeName string, spec fmt.Stringer, routeVars func() map[string]string, repos ...string) string {
+	u, err := routeURL(routeName, routeVars, repos...)
+	if err != nil {
+		return spec.String()
+	}
+	return strings.TrimPrefix(u.Path, "/")
+}
+
+// parseRouteSpec parses a string generated by routeSpecString for the
+// named route and returns its route variables. The typ is the spec
+// type name, used in the InvalidError returned if s is invalid.
+func parseRouteSpec(routeName, typ, s string) (map[string]string, error) {
+	name, routeVars, ok := router.Match(nil, &http.Request{Method: "GET", URL: &url.URL{Path: "/" + s}})
+	if !ok || name != routeName {
+		return nil, spec.InvalidError{Type: typ, Input: s}
+	}
+	return routeVars, nil
+}
+
+// MatchRoute matches req against the Sourcegraph web page routes (see
+// package router) and returns the name of the matched route and the
+// spec that the request's route variables specify. The spec's type
+// depends on the route, as documented for UnmarshalRouteSpec.
+func MatchRoute(req *http.Request) (routeName string, spec interface{}, err error) {
+	routeName, routeVars, ok := router.Match(nil, req)
+	if !ok {
+		return "", nil, fmt.Errorf("no route matches %s", req.URL.Path)
+	}
+	spec, err = UnmarshalRouteSpec(routeName, routeVars)
+	if err != nil {
+		return "", nil, err
+	}
+	return routeName, spec, nil
+}
+
+// UnmarshalRouteSpec returns the spec specified by the route
+// variables of a route in package router. For servers that use their
+// own router (built with router.New), it is typically called with
+// mux.Vars(req). The spec's type depends on the route:
+//
+//	router.Repo      RepoRevSpec
+//	router.RepoTree  TreeEntrySpec
+//	router.RepoBuild BuildSpec
+//	router.Delta     DeltaSpec
+//	router.Def       DefSpec
+//	router.User      UserSpec
+func UnmarshalRouteSpec(routeName string, routeVars map[string]string) (interface{}, error) {
+	switch routeName {
+	case router.Repo:
+		return UnmarshalRepoRevSpec(routeVars)
+	case router.RepoTree:
+		return UnmarshalTreeEntrySpec(routeVars)
+	case router.RepoBuild:
+		return UnmarshalBuildSpec(routeVars)
+	case router.Delta:
+		return UnmarshalDeltaSpec(routeVars)
+	case router.Def:
+		return UnmarshalDefSpec(routeVars)
+	case router.User:
+		return UnmarshalUserSpec(routeVars)
+	}
+	return nil, fmt.Errorf("route %q has no spec", routeName)
+}
@@ -0,0 +1,129 @@
+package sourcegraph
+
+import (
+	"math/rand"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"sourcegraph.com/sourcegraph/go-sourcegraph/router"
+
+	"sourcegraph.com/sourcegraph/srclib/graph"
+)
+
+func TestURLs(t *testing.T) {
+	const commitID = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	tests := []struct {
+		urlFunc func() (*url.URL, error)
+		want    string
+	}{
+		{(&Repo{URI: "github.com/foo/bar"}).URL, "https://sourcegraph.com/github.com/foo/bar"},
+		{
+			(&Def{Def: graph.Def{DefKey: graph.DefKey{Repo: "r", CommitID: commitID, UnitType: "GoPackage", Unit: "u", Path: "p"}}}).URL,
+			"https://sourcegraph.com/r@" + commitID + "/.GoPackage/u/.def/p",
+		},
+		{(&Build{Repo: "r", CommitID: commitID, Attempt: 3}).URL, "https://sourcegraph.com/r/.builds/" + commitID + "/3"},
+		{
+			DeltaSpec{Base: RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, Rev: "a"}, Head: RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, Rev: "b"}}.URL,
+			"https://sourcegraph.com/r@a/.deltas/b",
+		},
+		{(&User{Login: "alice"}).URL, "https://sourcegraph.com/~alice"},
+	}
+	for _, test := range tests {
+		u, err := test.urlFunc()
+		if err != nil {
+			t.Errorf("%s: %s", test.want, err)
+			continue
+		}
+		if got := u.String(); got != test.want {
+			t.Errorf("got URL %q, want %q", got, test.want)
+		}
+	}
+}
+
+func TestURLs_invalid(t *testing.T) {
+	tests := map[string]func() (*url.URL, error){
+		"empty repo":       (&Repo{}).URL,
+		"empty def":        (&Def{}).URL,
+		"empty build":      (&Build{}).URL,
+		"empty delta":      DeltaSpec{}.URL,
+		"def without unit": (&Def{Def: graph.Def{DefKey: graph.DefKey{Repo: "r", Path: "p"}}}).URL,
+	}
+	for label, urlFunc := range tests {
+		if u, err := urlFunc(); err == nil {
+			t.Errorf("%s: got URL %q, want error", label, u)
+		}
+	}
+}
+
+func TestMatchRoute(t *testing.T) {
+	const commitID = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	tests := []struct {
+		path      string
+		wantRoute string
+		wantSpec  interface{}
+	}{
+		{"/r", router.Repo, RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}}},
+		{"/r@v1===" + commitID, router.Repo, RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, Rev: "v1", CommitID: commitID}},
+		{"/r@v1/.tree/a/b", router.RepoTree, TreeEntrySpec{RepoRev: RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, Rev: "v1"}, Path: "a/b"}},
+		{"/r/.builds/" + commitID + "/2", router.RepoBuild, BuildSpec{Repo: RepoSpec{URI: "r"}, CommitID: commitID, Attempt: 2}},
+		{"/r@a/.deltas/b", router.Delta, DeltaSpec{Base: RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, Rev: "a"}, Head: RepoRevSpec{RepoSpec: RepoSpec{URI: "r"}, Rev: "b"}}},
+		{"/r@" + commitID + "/.GoPackage/u/.def/p", router.Def, DefSpec{Repo: "r", CommitID: commitID, UnitType: "GoPackage", Unit: "u", Path: "p"}},
+		{"/~alice", router.User, UserSpec{Login: "alice"}},
+	}
+	for _, test := range tests {
+		routeName, spec, err := MatchRoute(&http.Request{Method: "GET", URL: &url.URL{Path: test.path}})
+		if err != nil {
+			t.Errorf("%s: %s", test.path, err)
+			continue
+		}
+		if routeName != test.wantRoute {
+			t.Errorf("%s: got route %q, want %q", test.path, routeName, test.wantRoute)
+		}
+		if !reflect.DeepEqual(spec, test.wantSpec) {
+			t.Errorf("%s: got spec %+v, want %+v", test.path, spec, test.wantSpec)
+		}
+	}
+}
+
+// Random spec components for testing/quick round-trip tests of spec
+// strings.
+
+func randComponent(r *rand.Rand) string {
+	const chars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_"
+	b := make([]byte, 1+r.Intn(8))
+	for i := range b {
+		b[i] = chars[r.Intn(len(chars))]
+	}
+	return string(b)
+}
+
+func randPath(r *rand.Rand) string {
+	parts := make([]string, 1+r.Intn(4))
+	for i := range parts {
+		parts[i] = randComponent(r)
+	}
+	return strings.Join(parts, "/")
+}
+
+func randCommitID(r *rand.Rand) string {
+	const hex = "0123456789abcdef"
+	b := make([]byte, 40)
+	for i := range b {
+		b[i] = hex[r.Intn(len(hex))]
+	}
+	return string(b)
+}
+
+func randRepoRevSpec(r *rand.Rand) RepoRevSpec {
+	s := RepoRevSpec{RepoSpec: RepoSpec{URI: randPath(r)}}
+	if r.Intn(2) == 0 {
+		s.Rev = randPath(r)
+		if r.Intn(2) == 0 {
+			s.CommitID = randCommitID(r)
+		}
+	}
+	return s
+}
@@ -29,7 +29,9 @@ func (u *User) Person() *Person {
 	}
 }
 
-// SpecString returns the UserSpec string.
+// SpecString returns the UserSpec string. It is the inverse of
+// ParseUserSpec for UserSpecs that have either a UID or a Login (but
+// not both, since the UID takes precedence).
 func (s *UserSpec) SpecString() string {
 	return spec.UserString(uint32(s.UID), s.Login, s.Domain)
 }
@@ -38,7 +40,14 @@ func (s *UserSpec) RouteVars() map[string]string {
 	return map[string]string{"User": s.SpecString()}
 }
 
-// ParseUserSpec parses a string generated by (*UserSpec).String() and
+// UnmarshalUserSpec marshals a map containing route variables
+// generated by (*UserSpec).RouteVars() and returns the equivalent
+// UserSpec struct.
+func UnmarshalUserSpec(routeVars map[string]string) (UserSpec, error) {
+	return ParseUserSpec(routeVars["User"])
+}
+
+// ParseUserSpec parses a string generated by (*UserSpec).SpecString() and
 // returns the equivalent UserSpec struct.
 func ParseUserSpec(s string) (UserSpec, error) {
 	uid, login, domain, err := spec.ParseUser(s)
@@ -51,3 +60,26 @@ func ParseUserSpec(s string) (UserSpec, error) {
 		Domain: domain,
 	}, nil
 }
+
+// UserContributionsByCommits sorts a user's contributions by
+// descending commit count (breaking ties by repository URI).
+type UserContributionsByCommits []*UserContribution
+
+func (v UserContributionsByCommits) Len() int      { return len(v) }
+func (v UserContributionsByCommits) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v UserContributionsByCommits) Less(i, j int) bool {
+	if v[i].Commits != v[j].Commits {
+		return v[i].Commits > v[j].Commits
+	}
+	return v[i].Repo.URI < v[j].Repo.URI
+}
+
+// Totals returns the total number of commits and authored defs in
+// the contributions in l.
+func (l *UserContributionList) Totals() (commits, authoredDefs int) {
+	for _, c := range l.Contributions {
+		commits += int(c.Commits)
+		authoredDefs += int(c.AuthoredDefs)
+	}
+	return commits, authoredDefs
+}
@@ -1,6 +1,12 @@
 package sourcegraph
 
-import "testing"
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"testing/quick"
+)
 
 func TestUserSpec(t *testing.T) {
 	tests := []struct {
@@ -38,3 +44,51 @@ func TestUserSpec(t *testing.T) {
 		}
 	}
 }
+
+func (UserSpec) Generate(r *rand.Rand, size int) reflect.Value {
+	var s UserSpec
+	if r.Intn(2) == 0 {
+		s.UID = 1 + r.Int31()
+	} else {
+		s.Login = randComponent(r)
+	}
+	if r.Intn(2) == 0 {
+		s.Domain = "example.com"
+	}
+	return reflect.ValueOf(s)
+}
+
+func TestUserSpec_roundTrip(t *testing.T) {
+	roundTrip := func(s UserSpec) bool {
+		s2, err := ParseUserSpec(s.SpecString())
+		if err != nil {
+			t.Logf("%q: %s", s.SpecString(), err)
+			return false
+		}
+		return s2 == s
+	}
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUserContributionsByCommits(t *testing.T) {
+	contribs := []*UserContribution{
+		{Repo: RepoSpec{URI: "c"}, Commits: 1, AuthoredDefs: 4},
+		{Repo: RepoSpec{URI: "b"}, Commits: 7},
+		{Repo: RepoSpec{URI: "a"}, Commits: 7, AuthoredDefs: 2},
+	}
+	sort.Sort(UserContributionsByCommits(contribs))
+	var got []string
+	for _, c := range contribs {
+		got = append(got, c.Repo.URI)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	commits, defs := (&UserContributionList{Contributions: contribs}).Totals()
+	if commits != 15 || defs != 6 {
+		t.Errorf("got totals %d commits, %d defs, want 15 commits, 6 defs", commits, defs)
+	}
+}
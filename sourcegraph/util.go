@@ -34,3 +34,13 @@ func ParseRepoAndCommitID(repoAndCommitID string) (uri, commitID string) {
 	}
 	return repoAndCommitID, ""
 }
+
+// stringInSlice reports whether s is an element of ss.
+func stringInSlice(s string, ss []string) bool {
+	for _, s2 := range ss {
+		if s == s2 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+// Package vcr records the responses of Sourcegraph API calls to
+// golden files and replays them, so that tests of programs that use
+// the API client can run realistically but without network access.
+//
+// Record the responses once against a real server:
+//
+//	cassette := &vcr.Cassette{Dir: "testdata/vcr", Mode: vcr.Record}
+//	c.UseInterceptor(cassette.Interceptor())
+//
+// and then use Mode: vcr.Replay (the default) in tests. A common
+// pattern is to choose the mode based on a test flag (e.g., -record).
+package vcr
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"sourcegraph.com/sourcegraph/go-sourcegraph/sourcegraph"
+)
+
+// Mode is whether a Cassette records or replays calls.
+type Mode int
+
+const (
+	// Replay returns recorded responses without making calls. Calls
+	// without a recorded response fail.
+	Replay Mode = iota
+
+	// Record makes calls and records their responses, overwriting
+	// existing recordings.
+	Record
+)
+
+// A Cassette records and replays API calls using golden files in a
+// directory. Each call is stored in a file whose name is derived from
+// the method name and its argument, so calls with different arguments
+// are recorded separately.
+type Cassette struct {
+	Dir  string // directory of the golden files
+	Mode Mode
+}
+
+// recording is the format of a golden file.
+type recording struct {
+	Method   string          `json:"method"`
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    *recordedError  `json:"error,omitempty"`
+}
+
+type recordedError struct {
+	Code    codes.Code `json:"code"`
+	Message string     `json:"message"`
+}
+
+// Interceptor returns an Interceptor that records or replays calls.
+func (c *Cassette) Interceptor() sourcegraph.Interceptor {
+	return func(next sourcegraph.Invoker) sourcegraph.Invoker {
+		return func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+			req, err := json.Marshal(in)
+			if err != nil {
+				return nil, err
+			}
+			filename := c.filename(method, req)
+
+			if c.Mode == Record {
+				result, callErr := next(ctx, method, in)
+				if err := c.record(filename, method, req, result, callErr); err != nil {
+					return nil, err
+				}
+				return result, callErr
+			}
+			return c.replay(filename, method)
+		}
+	}
+}
+
+// filename returns the name of the golden file for a call to method
+// with the JSON-encoded argument req.
+func (c *Cassette) filename(method string, req []byte) string {
+	sum := sha1.Sum(req)
+	return filepath.Join(c.Dir, method+"-"+hex.EncodeToString(sum[:])[:12]+".json")
+}
+
+func (c *Cassette) record(filename, method string, req []byte, result interface{}, callErr error) error {
+	rec := recording{Method: method, Request: req}
+	if callErr != nil {
+		rec.Error = &recordedError{Code: sourcegraph.ErrorCode(callErr), Message: sourcegraph.ErrorDesc(callErr)}
+	} else {
+		resp, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		rec.Response = resp
+	}
+	data, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.Dir, 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, data, 0644)
+}
+
+func (c *Cassette) replay(filename, method string) (interface{}, error) {
+	data, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("vcr: no recorded response for %s call (%s); re-record in Record mode", method, filename)
+	} else if err != nil {
+		return nil, err
+	}
+	var rec recording
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, fmt.Errorf("vcr: %s: %s", filename, err)
+	}
+	if rec.Error != nil {
+		return nil, grpc.Errorf(rec.Error.Code, "%s", rec.Error.Message)
+	}
+	typ, ok := responseTypes[method]
+	if !ok {
+		return nil, fmt.Errorf("vcr: unknown method %s", method)
+	}
+	result := reflect.New(typ.Elem()).Interface()
+	if err := json.Unmarshal(rec.Response, result); err != nil {
+		return nil, fmt.Errorf("vcr: %s: %s", filename, err)
+	}
+	return result, nil
+}
+
+// responseTypes maps method names (e.g., "Repos.Get") to their
+// response types (e.g., *sourcegraph.Repo). It is determined from the
+// service client interfaces in sourcegraph.Client.
+var responseTypes = func() map[string]reflect.Type {
+	m := map[string]reflect.Type{}
+	ct := reflect.TypeOf(sourcegraph.Client{})
+	for i := 0; i < ct.NumField(); i++ {
+		it := ct.Field(i).Type
+		if it.Kind() != reflect.Interface || !strings.HasSuffix(it.Name(), "Client") {
+			continue
+		}
+		svc := strings.TrimSuffix(it.Name(), "Client")
+		for j := 0; j < it.NumMethod(); j++ {
+			mt := it.Method(j)
+			m[svc+"."+mt.Name] = mt.Type.Out(0)
+		}
+	}
+	return m
+}()
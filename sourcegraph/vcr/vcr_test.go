@@ -0,0 +1,58 @@
+package vcr
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"sourcegraph.com/sourcegraph/go-sourcegraph/sourcegraph"
+)
+
+func TestCassette(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vcr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx := context.Background()
+	server := func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		if uri := in.(*sourcegraph.RepoSpec).URI; uri != "r" {
+			return nil, grpc.Errorf(codes.NotFound, "repo %s not found", uri)
+		}
+		return &sourcegraph.Repo{URI: "r", Description: "d"}, nil
+	}
+
+	// Record.
+	rec := (&Cassette{Dir: dir, Mode: Record}).Interceptor()(server)
+	want, err := rec(ctx, "Repos.Get", &sourcegraph.RepoSpec{URI: "r"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rec(ctx, "Repos.Get", &sourcegraph.RepoSpec{URI: "x"}); grpc.Code(err) != codes.NotFound {
+		t.Fatalf("got error %v, want NotFound", err)
+	}
+
+	// Replay.
+	replay := (&Cassette{Dir: dir}).Interceptor()(func(ctx context.Context, method string, in interface{}) (interface{}, error) {
+		t.Fatal("call made in Replay mode")
+		return nil, nil
+	})
+	got, err := replay(ctx, "Repos.Get", &sourcegraph.RepoSpec{URI: "r"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if _, err := replay(ctx, "Repos.Get", &sourcegraph.RepoSpec{URI: "x"}); grpc.Code(err) != codes.NotFound || grpc.ErrorDesc(err) != "repo x not found" {
+		t.Errorf("got error %v, want recorded NotFound error", err)
+	}
+	if _, err := replay(ctx, "Repos.Get", &sourcegraph.RepoSpec{URI: "unrecorded"}); err == nil {
+		t.Error("got nil error for unrecorded call")
+	}
+}
@@ -0,0 +1,89 @@
+// Package sourcegraphtest provides helpers for testing HTTP handlers
+// and clients of mux-based endpoints, such as those whose routes are
+// defined using the patterns in package routevar.
+//
+// A typical test registers a fake endpoint on a Server's router and
+// checks the requests made to it:
+//
+//	s := sourcegraphtest.NewServer()
+//	defer s.Close()
+//	s.Mux.Path("/repos/" + routevar.Repo).Methods("GET").Name("repo")
+//	s.Mux.Get("repo").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+//		sourcegraphtest.TestFormValues(t, r, url.Values{"Rev": {"master"}})
+//		sourcegraphtest.WriteJSON(w, &sourcegraph.Repo{URI: mux.Vars(r)["Repo"]})
+//	})
+//	resp, err := http.Get(s.URL + sourcegraphtest.URLPath(t, s.Mux, "repo", "Repo", "r") + "?Rev=master")
+package sourcegraphtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/sourcegraph/mux"
+)
+
+// Server is an HTTP test server that serves the routes registered on
+// its router.
+type Server struct {
+	// Mux is the router that handles requests to the server.
+	Mux *mux.Router
+
+	*httptest.Server
+}
+
+// NewServer starts and returns a new Server with an empty router. The
+// caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	m := mux.NewRouter()
+	return &Server{Mux: m, Server: httptest.NewServer(m)}
+}
+
+// URLPath returns the path of the URL for the named route in r, with
+// route variables taken from the key/value pairs. It fails the test
+// if the route doesn't exist or the URL can't be built.
+func URLPath(t testing.TB, r *mux.Router, routeName string, pairs ...string) string {
+	route := r.Get(routeName)
+	if route == nil {
+		t.Fatalf("no such route %q", routeName)
+	}
+	u, err := route.URLPath(pairs...)
+	if err != nil {
+		t.Fatalf("building URL for route %q with %v: %s", routeName, pairs, err)
+	}
+	return u.Path
+}
+
+// WriteJSON writes the JSON encoding of v to w as the response body.
+func WriteJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("content-type", "application/json; charset=utf-8")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// TestMethod reports a test error if r's HTTP method is not want.
+func TestMethod(t testing.TB, r *http.Request, want string) {
+	if r.Method != want {
+		t.Errorf("got request method %s, want %s", r.Method, want)
+	}
+}
+
+// TestFormValues reports a test error if r's form values (from the
+// URL query and, for POST and PUT requests, the body) are not equal
+// to want.
+func TestFormValues(t testing.TB, r *http.Request, want url.Values) {
+	if err := r.ParseForm(); err != nil {
+		t.Errorf("parsing request form: %s", err)
+		return
+	}
+	if want == nil {
+		want = url.Values{}
+	}
+	if !reflect.DeepEqual(r.Form, want) {
+		t.Errorf("got form values %v, want %v", r.Form, want)
+	}
+}
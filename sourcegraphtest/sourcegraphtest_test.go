@@ -0,0 +1,45 @@
+package sourcegraphtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/sourcegraph/mux"
+	"sourcegraph.com/sourcegraph/go-sourcegraph/routevar"
+)
+
+func TestServer(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	called := false
+	s.Mux.Path("/repos/" + routevar.Repo).Methods("GET").Name("repo").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		TestMethod(t, r, "GET")
+		TestFormValues(t, r, url.Values{"Rev": {"master"}})
+		WriteJSON(w, map[string]string{"URI": mux.Vars(r)["Repo"]})
+	})
+
+	path := URLPath(t, s.Mux, "repo", "Repo", "example.com/foo")
+	if want := "/repos/example.com/foo"; path != want {
+		t.Errorf("got path %q, want %q", path, want)
+	}
+
+	resp, err := http.Get(s.URL + path + "?Rev=master")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var v map[string]string
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		t.Fatal(err)
+	}
+	if !called {
+		t.Error("handler not called")
+	}
+	if want := "example.com/foo"; v["URI"] != want {
+		t.Errorf("got URI %q, want %q", v["URI"], want)
+	}
+}
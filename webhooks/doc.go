@@ -0,0 +1,9 @@
+// Package webhooks parses and verifies the webhook payloads that a
+// Sourcegraph server sends to registered endpoints.
+//
+// Each request carries the event type in the X-Sourcegraph-Event
+// header and an HMAC-SHA256 signature of the body (keyed by the
+// webhook's shared secret) in the X-Sourcegraph-Signature header. Use
+// ParseEvent in your HTTP handler to verify the signature and decode
+// the payload into one of the typed events in this package.
+package webhooks
@@ -0,0 +1,64 @@
+package webhooks
+
+import (
+	"sourcegraph.com/sourcegraph/go-sourcegraph/sourcegraph"
+	"sourcegraph.com/sourcegraph/go-vcs/vcs"
+)
+
+// Event types, as sent in the X-Sourcegraph-Event header.
+const (
+	PushEventType           = "push"
+	BuildCompletedEventType = "build_completed"
+	RepoCreatedEventType    = "repo_created"
+)
+
+// PushEvent is sent when commits are pushed to a repository.
+type PushEvent struct {
+	// Repo is the repository that was pushed to.
+	Repo sourcegraph.RepoSpec `json:"repo"`
+
+	// Branch is the name of the branch that was pushed to.
+	Branch string `json:"branch"`
+
+	// Before is the commit ID of the branch head before the push. It
+	// is empty if the push created the branch.
+	Before vcs.CommitID `json:"before,omitempty"`
+
+	// After is the commit ID of the branch head after the push. It is
+	// empty if the push deleted the branch.
+	After vcs.CommitID `json:"after,omitempty"`
+
+	// Pusher is the user who pushed, if known.
+	Pusher *sourcegraph.UserSpec `json:"pusher,omitempty"`
+
+	// Commits are the commits that were pushed, newest first.
+	Commits []*vcs.Commit `json:"commits,omitempty"`
+}
+
+// BuildCompletedEvent is sent when a build finishes, whether or not it
+// succeeded.
+type BuildCompletedEvent struct {
+	Build sourcegraph.Build `json:"build"`
+}
+
+// RepoCreatedEvent is sent when a repository is created.
+type RepoCreatedEvent struct {
+	Repo sourcegraph.Repo `json:"repo"`
+
+	// Creator is the user who created the repository, if known.
+	Creator *sourcegraph.UserSpec `json:"creator,omitempty"`
+}
+
+// newEvent returns a pointer to a new zero value of the event type
+// named by typ, or nil if typ is not a known event type.
+func newEvent(typ string) interface{} {
+	switch typ {
+	case PushEventType:
+		return &PushEvent{}
+	case BuildCompletedEventType:
+		return &BuildCompletedEvent{}
+	case RepoCreatedEventType:
+		return &RepoCreatedEvent{}
+	}
+	return nil
+}
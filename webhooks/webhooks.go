@@ -0,0 +1,120 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+const (
+	// EventHeader is the HTTP request header that holds the event
+	// type (e.g., "push").
+	EventHeader = "X-Sourcegraph-Event"
+
+	// SignatureHeader is the HTTP request header that holds the
+	// signature of the request body (e.g., "sha256=abcd...").
+	SignatureHeader = "X-Sourcegraph-Signature"
+
+	// MaxPayloadSize is the maximum size (in bytes) of a webhook
+	// request body that ParseEvent will read.
+	MaxPayloadSize = 5 * 1024 * 1024
+)
+
+var (
+	// ErrEmptySecret is returned by ParseEvent when the shared secret
+	// is empty, because any request could be signed with it.
+	ErrEmptySecret = errors.New("webhook secret is empty")
+
+	// ErrMissingSignature is returned by ParseEvent when the request
+	// has no signature header.
+	ErrMissingSignature = errors.New("webhook request has no " + SignatureHeader + " header")
+
+	// ErrInvalidSignature is returned by ParseEvent when the
+	// request's signature does not match its body.
+	ErrInvalidSignature = errors.New("webhook request signature is invalid")
+
+	// ErrPayloadTooLarge is returned by ParseEvent when the request
+	// body exceeds MaxPayloadSize.
+	ErrPayloadTooLarge = errors.New("webhook request body is too large")
+)
+
+// UnknownEventError indicates that a webhook request's event type is
+// not one that this package knows how to parse.
+type UnknownEventError struct{ Type string }
+
+func (e *UnknownEventError) Error() string {
+	return fmt.Sprintf("unknown webhook event type %q", e.Type)
+}
+
+// Signature returns the value of the signature header for a webhook
+// request whose body is payload, signed with the shared secret.
+func Signature(secret, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// ValidSignature reports whether sig (the value of the signature
+// header) is a valid signature of payload using the shared secret.
+func ValidSignature(secret, payload []byte, sig string) bool {
+	if !strings.HasPrefix(sig, "sha256=") {
+		return false
+	}
+	got, err := hex.DecodeString(strings.TrimPrefix(sig, "sha256="))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// ParseEvent reads the body of a webhook request, verifies its
+// signature using the shared secret, and returns the decoded
+// event. The returned value is a pointer to one of the event types in
+// this package (*PushEvent, *BuildCompletedEvent, or
+// *RepoCreatedEvent), according to the request's event header.
+//
+// The signature is verified before the event type is examined, so an
+// UnknownEventError is only returned for authentic requests. If secret
+// is empty, ParseEvent returns ErrEmptySecret without reading the
+// request.
+func ParseEvent(r *http.Request, secret []byte) (interface{}, error) {
+	if len(secret) == 0 {
+		return nil, ErrEmptySecret
+	}
+
+	sig := r.Header.Get(SignatureHeader)
+	if sig == "" {
+		return nil, ErrMissingSignature
+	}
+
+	payload, err := ioutil.ReadAll(io.LimitReader(r.Body, MaxPayloadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) > MaxPayloadSize {
+		return nil, ErrPayloadTooLarge
+	}
+
+	if !ValidSignature(secret, payload, sig) {
+		return nil, ErrInvalidSignature
+	}
+
+	typ := r.Header.Get(EventHeader)
+	ev := newEvent(typ)
+	if ev == nil {
+		return nil, &UnknownEventError{Type: typ}
+	}
+	if err := json.Unmarshal(payload, ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
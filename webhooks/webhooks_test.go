@@ -0,0 +1,81 @@
+package webhooks
+
+import (
+	"bytes"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"sourcegraph.com/sourcegraph/go-sourcegraph/sourcegraph"
+)
+
+func newRequest(typ, sig string, payload []byte) *http.Request {
+	req, _ := http.NewRequest("POST", "http://example.com/hook", bytes.NewReader(payload))
+	req.Header.Set(EventHeader, typ)
+	if sig != "" {
+		req.Header.Set(SignatureHeader, sig)
+	}
+	return req
+}
+
+func TestParseEvent(t *testing.T) {
+	secret := []byte("s3cret")
+	payload := []byte(`{"repo":{"uri":"r"},"branch":"master","after":"c"}`)
+
+	ev, err := ParseEvent(newRequest(PushEventType, Signature(secret, payload), payload), secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &PushEvent{Repo: sourcegraph.RepoSpec{URI: "r"}, Branch: "master", After: "c"}
+	if !reflect.DeepEqual(ev, want) {
+		t.Errorf("got %+v, want %+v", ev, want)
+	}
+}
+
+func TestParseEvent_errors(t *testing.T) {
+	secret := []byte("s3cret")
+	payload := []byte(`{}`)
+
+	tests := map[string]struct {
+		req     *http.Request
+		secret  []byte
+		wantErr error
+	}{
+		"empty secret": {
+			req:     newRequest(PushEventType, Signature([]byte{}, payload), payload),
+			secret:  []byte{},
+			wantErr: ErrEmptySecret,
+		},
+		"nil secret": {
+			req:     newRequest(PushEventType, Signature(nil, payload), payload),
+			secret:  nil,
+			wantErr: ErrEmptySecret,
+		},
+		"missing signature": {
+			req:     newRequest(PushEventType, "", payload),
+			secret:  secret,
+			wantErr: ErrMissingSignature,
+		},
+		"wrong secret": {
+			req:     newRequest(PushEventType, Signature([]byte("other"), payload), payload),
+			secret:  secret,
+			wantErr: ErrInvalidSignature,
+		},
+		"malformed signature": {
+			req:     newRequest(PushEventType, "sha256=zz", payload),
+			secret:  secret,
+			wantErr: ErrInvalidSignature,
+		},
+		"unknown event type": {
+			req:     newRequest("foo", Signature(secret, payload), payload),
+			secret:  secret,
+			wantErr: &UnknownEventError{Type: "foo"},
+		},
+	}
+	for label, test := range tests {
+		_, err := ParseEvent(test.req, test.secret)
+		if !reflect.DeepEqual(err, test.wantErr) {
+			t.Errorf("%s: got error %v, want %v", label, err, test.wantErr)
+		}
+	}
+}